@@ -14,13 +14,20 @@
 package main
 
 import (
+	"errors"
 	"flag"
+	"fmt"
+	"os"
+	"strings"
 
+	"github.com/perses/community-mixins/pkg/components"
+	"github.com/perses/community-mixins/pkg/config"
 	"github.com/perses/community-mixins/pkg/dashboards"
 	"github.com/perses/community-mixins/pkg/dashboards/alertmanager"
 	"github.com/perses/community-mixins/pkg/dashboards/blackbox"
 	"github.com/perses/community-mixins/pkg/dashboards/etcd"
 	"github.com/perses/community-mixins/pkg/dashboards/istio"
+	k8sDashboards "github.com/perses/community-mixins/pkg/dashboards/kubernetes"
 	"github.com/perses/community-mixins/pkg/dashboards/kubernetes/apiserver"
 	k8sComputeResources "github.com/perses/community-mixins/pkg/dashboards/kubernetes/compute_resources"
 	"github.com/perses/community-mixins/pkg/dashboards/kubernetes/controller_manager"
@@ -36,22 +43,58 @@ import (
 	"github.com/perses/community-mixins/pkg/dashboards/prometheus"
 	"github.com/perses/community-mixins/pkg/dashboards/tempo"
 	"github.com/perses/community-mixins/pkg/dashboards/thanos"
+	thanosoperator "github.com/perses/community-mixins/pkg/dashboards/thanos_operator"
+	"github.com/perses/community-mixins/pkg/lint"
+	etcdPanels "github.com/perses/community-mixins/pkg/panels/etcd"
 	k8sPanels "github.com/perses/community-mixins/pkg/panels/kubernetes"
 	nodeExporterPanels "github.com/perses/community-mixins/pkg/panels/node_exporter"
+	tempoPanels "github.com/perses/community-mixins/pkg/panels/tempo"
 	"github.com/perses/community-mixins/pkg/rules"
 	alertmanagerrules "github.com/perses/community-mixins/pkg/rules/alertmanager"
 	blackboxrules "github.com/perses/community-mixins/pkg/rules/blackbox"
+	etcdrules "github.com/perses/community-mixins/pkg/rules/etcd"
+	istiorules "github.com/perses/community-mixins/pkg/rules/istio"
+	kubernetesrules "github.com/perses/community-mixins/pkg/rules/kubernetes"
+	nodeexporterrules "github.com/perses/community-mixins/pkg/rules/node_exporter"
+	opentelemetryrules "github.com/perses/community-mixins/pkg/rules/opentelemetry"
+	persesrules "github.com/perses/community-mixins/pkg/rules/perses"
+	prometheusrules "github.com/perses/community-mixins/pkg/rules/prometheus"
+	temporules "github.com/perses/community-mixins/pkg/rules/tempo"
 	thanosrules "github.com/perses/community-mixins/pkg/rules/thanos"
 	thanosoperatorrules "github.com/perses/community-mixins/pkg/rules/thanos-operator"
+	"github.com/perses/community-mixins/pkg/sink"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var (
+	configFile       string
 	project          string
 	datasource       string
 	lokiDatasource   string
 	clusterLabelName string
 	buildRules       bool
 
+	// Output of the dashboard and rule writers
+	dashboardOutput    string
+	dashboardOutputDir string
+	ruleOutput         string
+	ruleOutputDir      string
+	outputSink         string
+	managedOutput      bool
+	checkOutput        bool
+	outputPackage      string
+	helmChartName      string
+	helmChartVersion   string
+
+	configMapPerComponent bool
+	operatorNamespace     string
+
+	// Component selection
+	includeComponents string
+	excludeComponents string
+	listComponents    bool
+	lintOnly          bool
+
 	// Job label overrides
 	nodeExporterJob      string
 	apiserverJob         string
@@ -62,23 +105,53 @@ var (
 	controllerManagerJob string
 	schedulerJob         string
 	kubeProxyJob         string
+	etcdJob              string
+
+	istioUseRecordingRules bool
+	tempoTenantLabelName   string
+
+	// Rule settings only exposed through the config file
+	dashboardBaseURL      = "https://demo.perses.dev/projects/perses/dashboards"
+	runbookBaseURL        = "https://runbooks.prometheus-operator.dev/runbooks"
+	additionalAlertLabels = map[string]string{}
+
+	// ConfigMap settings only exposed through the config file
+	configMapLabels      = map[string]string{}
+	configMapAnnotations = map[string]string{}
+
+	// PersesDashboard metadata only exposed through the config file, but for the namespace
+	operatorResources config.OperatorResources
 )
 
 func main() {
+	flag.StringVar(&configFile, "config", "", "Path to a YAML config file, flags passed on the command line take precedence over it")
 	flag.StringVar(&project, "project", "default", "The project name")
 	flag.StringVar(&datasource, "datasource", "", "The datasource name")
 	flag.StringVar(&lokiDatasource, "loki-datasource", "", "The Loki datasource name (for log-based dashboards)")
 	flag.StringVar(&clusterLabelName, "cluster-label-name", "", "The cluster label name")
 	flag.BoolVar(&buildRules, "build-rules", false, "Whether to build rules")
 
-	flag.String("output-rules", rules.YAMLOutput, "output format of the rule exec")
-	flag.String("output-rules-dir", "./built/rules", "output directory of the rule exec")
+	flag.StringVar(&ruleOutput, "output-rules", rules.YAMLOutput, "output format of the rule exec")
+	flag.StringVar(&ruleOutputDir, "output-rules-dir", "./built/rules", "output directory of the rule exec")
+
+	flag.StringVar(&includeComponents, "components", "", "Comma-separated list of components to build, all components are built when empty")
+	flag.StringVar(&excludeComponents, "exclude-components", "", "Comma-separated list of components to skip")
+	flag.BoolVar(&listComponents, "list", false, "List the available components and the dashboards, or rule groups with --build-rules, they contain")
+	flag.BoolVar(&lintOnly, "lint", false, "Lint the dashboards, or rule groups with --build-rules, instead of writing them, and exit non-zero when there are errors")
 
-	flag.String("output", dashboards.YAMLOutput, "output format of the dashboard exec")
-	flag.String("output-dir", "./built", "output directory of the dashboard exec")
+	flag.StringVar(&dashboardOutput, "output", dashboards.YAMLOutput, "output format of the dashboard exec")
+	flag.StringVar(&dashboardOutputDir, "output-dir", "./built", "output directory of the dashboard exec")
+	flag.BoolVar(&managedOutput, "managed", false, "Track the generated files in a manifest in the output directory, remove the ones no longer generated and print a summary of the changes")
+	flag.BoolVar(&checkOutput, "check", false, "Like --managed, but only report the changes without touching the output directory, and exit non-zero when there are any")
+	flag.StringVar(&outputPackage, "package", "", fmt.Sprintf("package the operator resources: %q adds a kustomization.yaml per component and a root one, %q writes a Helm chart", kustomizePackage, helmPackage))
+	flag.StringVar(&helmChartName, "helm-chart-name", "community-mixins", "name of the Helm chart written with --package=helm")
+	flag.StringVar(&helmChartVersion, "helm-chart-version", "0.1.0", "version of the Helm chart written with --package=helm")
+	flag.BoolVar(&configMapPerComponent, "configmap-per-component", false, fmt.Sprintf("with the %q output format, group the dashboards of a component in the same ConfigMaps instead of writing one ConfigMap per dashboard", dashboards.ConfigMapOutput))
+	flag.StringVar(&operatorNamespace, "operator-namespace", "", fmt.Sprintf("namespace of the PersesDashboard resources written with the %q and %q output formats, the project when empty", dashboards.OperatorOutput, dashboards.OperatorJSONOutput))
+	flag.StringVar(&outputSink, "output-sink", dirSink, fmt.Sprintf("where to write the dashboards or rules: %q writes files under the output directory, %q writes a single stream to stdout, %q and %q write an archive at the output directory path", dirSink, stdoutSink, tarSink, zipSink))
 
 	// Job label flags for node-exporter dashboards
-	flag.StringVar(&nodeExporterJob, "node-exporter-job", "node", "The job label value for node-exporter dashboards")
+	flag.StringVar(&nodeExporterJob, "node-exporter-job", nodeExporterPanels.DefaultJobLabelValue, "The job label value for node-exporter dashboards")
 
 	// Job label flags for Kubernetes component dashboards
 	flag.StringVar(&apiserverJob, "apiserver-job", "kube-apiserver", "The job label value for kube-apiserver")
@@ -90,24 +163,43 @@ func main() {
 	flag.StringVar(&schedulerJob, "scheduler-job", "kube-scheduler", "The job label value for kube-scheduler")
 	flag.StringVar(&kubeProxyJob, "kube-proxy-job", "kube-proxy", "The job label value for kube-proxy")
 
+	// Job label flag shared by the etcd dashboard and rules
+	flag.StringVar(&etcdJob, "etcd-job", etcdPanels.DefaultJobSelector, "The job label regexp for etcd")
+
+	flag.StringVar(&tempoTenantLabelName, "tempo-tenant-label", tempoPanels.DefaultTenantLabelName, "The label carrying the tenant on Tempo metrics, shared by the Tempo dashboards and rules")
+	flag.BoolVar(&istioUseRecordingRules, "istio-use-recording-rules", false, "Whether the Istio dashboards query the series recorded by the Istio recording rules")
+
 	flag.Parse()
 
-	// Apply job label overrides
-	nodeExporterPanels.SetNodeExporterLabelValue(nodeExporterJob)
-	k8sPanels.SetAPIServerLabelValue(apiserverJob)
-	k8sPanels.SetKubeletLabelValue(kubeletJob)
-	k8sPanels.SetKubeStateMetricsLabelValue(kubeStateMetricsJob)
-	k8sPanels.SetCAdvisorLabelValue(cadvisorJob)
-	k8sPanels.SetNodeExporterLabelValue(nodeExporterK8sJob)
-	k8sPanels.SetControllerManagerLabelValue(controllerManagerJob)
-	k8sPanels.SetSchedulerLabelValue(schedulerJob)
-	k8sPanels.SetKubeProxyLabelValue(kubeProxyJob)
+	var cfg *config.Config
+	if configFile != "" {
+		var err error
+		cfg, err = config.Load(configFile)
+		exitOnError(err)
+		applyConfig(cfg)
+	}
+
+	k8sJobs := k8sPanels.JobLabelValues{
+		APIServer:         apiserverJob,
+		Kubelet:           kubeletJob,
+		NodeExporter:      nodeExporterK8sJob,
+		ControllerManager: controllerManagerJob,
+		Scheduler:         schedulerJob,
+		KubeProxy:         kubeProxyJob,
+		KubeStateMetrics:  kubeStateMetricsJob,
+		CAdvisor:          cadvisorJob,
+	}
+
+	filter := components.Filter{
+		Include: components.ParseList(includeComponents),
+		Exclude: components.ParseList(excludeComponents),
+	}
+	registry := components.NewRegistry()
 
 	if buildRules {
-		ruleWriter := rules.NewRuleWriter()
 
-		ruleWriter.Add(
-			thanosrules.BuildThanosRules(
+		registry.AddRule("thanos", func() rules.RuleResult {
+			return thanosrules.BuildThanosRules(
 				project,
 				map[string]string{
 					"app.kubernetes.io/component": "thanos",
@@ -118,15 +210,16 @@ func main() {
 				map[string]string{},
 				thanosrules.WithRunbookURL("https://github.com/thanos-io/thanos/blob/main/mixin/runbook.md"),
 				thanosrules.WithServiceLabelValue("thanos"),
-				thanosrules.WithCompactDashboardURL("https://demo.perses.dev/projects/perses/dashboards/thanoscompact"),
-				thanosrules.WithQueryDashboardURL("https://demo.perses.dev/projects/perses/dashboards/thanosquery"),
-				thanosrules.WithReceiveDashboardURL("https://demo.perses.dev/projects/perses/dashboards/thanosreceive"),
-				thanosrules.WithStoreDashboardURL("https://demo.perses.dev/projects/perses/dashboards/thanosstore"),
-				thanosrules.WithRuleDashboardURL("https://demo.perses.dev/projects/perses/dashboards/thanosrule"),
-			),
-		)
-		ruleWriter.Add(
-			thanosoperatorrules.BuildThanosOperatorRules(
+				thanosrules.WithCompactDashboardURL(dashboardURL("thanoscompact")),
+				thanosrules.WithQueryDashboardURL(dashboardURL("thanosquery")),
+				thanosrules.WithReceiveDashboardURL(dashboardURL("thanosreceive")),
+				thanosrules.WithStoreDashboardURL(dashboardURL("thanosstore")),
+				thanosrules.WithRuleDashboardURL(dashboardURL("thanosrule")),
+				thanosrules.WithAdditionalAlertLabels(additionalAlertLabels),
+			)
+		})
+		registry.AddRule("thanos-operator", func() rules.RuleResult {
+			return thanosoperatorrules.BuildThanosOperatorRules(
 				project,
 				map[string]string{
 					"app.kubernetes.io/component": "thanos-operator",
@@ -136,77 +229,566 @@ func main() {
 				},
 				map[string]string{},
 				thanosoperatorrules.WithRunbookURL("https://github.com/thanos-community/thanos-operator/blob/main/mixin/runbook.md"),
-				thanosoperatorrules.WithDashboardURL("https://demo.perses.dev/projects/perses/dashboards/thanosoperator"),
+				thanosoperatorrules.WithDashboardURL(dashboardURL("thanos-operator-overview")),
 				thanosoperatorrules.WithServiceLabelValue("thanos-operator"),
-			),
-		)
-		ruleWriter.Add(alertmanagerrules.BuildAlertmanagerRules(
-			project,
-			map[string]string{
-				"app.kubernetes.io/component": "alertmanager",
-				"app.kubernetes.io/name":      "alertmanager-rules",
-				"app.kubernetes.io/part-of":   "alertmanager",
-				"app.kubernetes.io/version":   "main",
-			},
-			map[string]string{},
-			alertmanagerrules.WithRunbookURL("https://github.com/prometheus/alertmanager/blob/main/doc/alertmanager-mixin/README.md"),
-			alertmanagerrules.WithDashboardURL("https://demo.perses.dev/projects/perses/dashboards/alertmanager"),
-			alertmanagerrules.WithServiceLabelValue("alertmanager"),
-		))
-		ruleWriter.Add(blackboxrules.BuildBlackboxRulesDefault(project))
-
-		ruleWriter.Write()
+				thanosoperatorrules.WithAdditionalAlertLabels(additionalAlertLabels),
+			)
+		})
+		registry.AddRule("alertmanager", func() rules.RuleResult {
+			return alertmanagerrules.BuildAlertmanagerRules(
+				project,
+				map[string]string{
+					"app.kubernetes.io/component": "alertmanager",
+					"app.kubernetes.io/name":      "alertmanager-rules",
+					"app.kubernetes.io/part-of":   "alertmanager",
+					"app.kubernetes.io/version":   "main",
+				},
+				map[string]string{},
+				alertmanagerrules.WithRunbookURL("https://github.com/prometheus/alertmanager/blob/main/doc/alertmanager-mixin/README.md"),
+				alertmanagerrules.WithDashboardURL(dashboardURL("alertmanager")),
+				alertmanagerrules.WithServiceLabelValue("alertmanager"),
+				alertmanagerrules.WithAdditionalAlertLabels(additionalAlertLabels),
+			)
+		})
+		registry.AddRule("blackbox-exporter", func() rules.RuleResult {
+			return blackboxrules.BuildBlackboxRules(
+				project,
+				map[string]string{
+					"app.kubernetes.io/component": "blackbox-exporter",
+					"app.kubernetes.io/name":      "blackbox-exporter-rules",
+					"app.kubernetes.io/part-of":   "blackbox-exporter",
+					"app.kubernetes.io/version":   "main",
+				},
+				map[string]string{},
+				blackboxrules.WithDashboardURL(dashboardURL("blackboxexporter")),
+				blackboxrules.WithAdditionalAlertLabels(additionalAlertLabels),
+			)
+		})
+		registry.AddRule("kubernetes", func() rules.RuleResult {
+			return kubernetesrules.BuildKubernetesRules(
+				project,
+				map[string]string{
+					"app.kubernetes.io/component": "kubernetes",
+					"app.kubernetes.io/name":      "kubernetes-rules",
+					"app.kubernetes.io/part-of":   "kubernetes",
+					"app.kubernetes.io/version":   "main",
+				},
+				map[string]string{},
+				kubernetesrules.WithRunbookURL(runbookURL("kubernetes")),
+				kubernetesrules.WithAPIServerDashboardURL(dashboardURL("api-server-overview")),
+				kubernetesrules.WithKubeletDashboardURL(dashboardURL("kubelet-overview")),
+				kubernetesrules.WithControllerManagerDashboardURL(dashboardURL("controller-manager-overview")),
+				kubernetesrules.WithSchedulerDashboardURL(dashboardURL("scheduler-overview")),
+				kubernetesrules.WithProxyDashboardURL(dashboardURL("proxy-overview")),
+				kubernetesrules.WithClusterDashboardURL(dashboardURL("kubernetes-cluster-resources-overview")),
+				kubernetesrules.WithWorkloadDashboardURL(dashboardURL("kubernetes-workload-resources-overview")),
+				kubernetesrules.WithPersistentVolumeDashboardURL(dashboardURL("kubernetes-persistent-volume-overview")),
+				kubernetesrules.WithJobLabelValues(k8sJobs),
+				kubernetesrules.WithAdditionalAlertLabels(additionalAlertLabels),
+			)
+		})
+
+		registry.AddRule("node-exporter", func() rules.RuleResult {
+			return nodeexporterrules.BuildNodeExporterRules(
+				project,
+				map[string]string{
+					"app.kubernetes.io/component": "node-exporter",
+					"app.kubernetes.io/name":      "node-exporter-rules",
+					"app.kubernetes.io/part-of":   "node-exporter",
+					"app.kubernetes.io/version":   "main",
+				},
+				map[string]string{},
+				nodeexporterrules.WithRunbookURL(runbookURL("node")),
+				nodeexporterrules.WithDashboardURL(dashboardURL("node-exporter-nodes")),
+				nodeexporterrules.WithNodeExporterSelector(nodeExporterJob),
+				nodeexporterrules.WithAdditionalAlertLabels(additionalAlertLabels),
+			)
+		})
+
+		registry.AddRule("etcd", func() rules.RuleResult {
+			return etcdrules.BuildEtcdRules(
+				project,
+				map[string]string{
+					"app.kubernetes.io/component": "etcd",
+					"app.kubernetes.io/name":      "etcd-rules",
+					"app.kubernetes.io/part-of":   "etcd",
+					"app.kubernetes.io/version":   "main",
+				},
+				map[string]string{},
+				etcdrules.WithRunbookURL(runbookURL("etcd")),
+				etcdrules.WithDashboardURL(dashboardURL("etcd-overview")),
+				etcdrules.WithJobSelector(etcdJob),
+				etcdrules.WithAdditionalAlertLabels(additionalAlertLabels),
+			)
+		})
+
+		registry.AddRule("prometheus", func() rules.RuleResult {
+			return prometheusrules.BuildPrometheusRules(
+				project,
+				map[string]string{
+					"app.kubernetes.io/component": "prometheus",
+					"app.kubernetes.io/name":      "prometheus-rules",
+					"app.kubernetes.io/part-of":   "prometheus",
+					"app.kubernetes.io/version":   "main",
+				},
+				map[string]string{},
+				prometheusrules.WithRunbookURL(runbookURL("prometheus")),
+				prometheusrules.WithOverviewDashboardURL(dashboardURL("prometheus-overview")),
+				prometheusrules.WithRemoteWriteDashboardURL(dashboardURL("prometheus-remote-write")),
+				prometheusrules.WithAdditionalAlertLabels(additionalAlertLabels),
+			)
+		})
+
+		registry.AddRule("istio", func() rules.RuleResult {
+			return istiorules.BuildIstioRules(
+				project,
+				map[string]string{
+					"app.kubernetes.io/component": "istio",
+					"app.kubernetes.io/name":      "istio-rules",
+					"app.kubernetes.io/part-of":   "istio",
+					"app.kubernetes.io/version":   "main",
+				},
+				map[string]string{},
+				istiorules.WithRunbookURL(mixinRunbookURL("istio")),
+				istiorules.WithControlPlaneDashboardURL(dashboardURL("istio-control-plane")),
+				istiorules.WithServiceDashboardURL(dashboardURL("istio-service-dashboard")),
+				istiorules.WithZtunnelDashboardURL(dashboardURL("istio-ztunnel-dashboard")),
+				istiorules.WithAdditionalAlertLabels(additionalAlertLabels),
+			)
+		})
+
+		registry.AddRule("opentelemetry-collector", func() rules.RuleResult {
+			return opentelemetryrules.BuildOpenTelemetryCollectorRules(
+				project,
+				map[string]string{
+					"app.kubernetes.io/component": "opentelemetry-collector",
+					"app.kubernetes.io/name":      "opentelemetry-collector-rules",
+					"app.kubernetes.io/part-of":   "opentelemetry-collector",
+					"app.kubernetes.io/version":   "main",
+				},
+				map[string]string{},
+				opentelemetryrules.WithRunbookURL(mixinRunbookURL("opentelemetry-collector")),
+				opentelemetryrules.WithDashboardURL(dashboardURL("opentelemetry-collector")),
+				opentelemetryrules.WithAdditionalAlertLabels(additionalAlertLabels),
+			)
+		})
+
+		registry.AddRule("tempo", func() rules.RuleResult {
+			return temporules.BuildTempoRules(
+				project,
+				map[string]string{
+					"app.kubernetes.io/component": "tempo",
+					"app.kubernetes.io/name":      "tempo-rules",
+					"app.kubernetes.io/part-of":   "tempo",
+					"app.kubernetes.io/version":   "main",
+				},
+				map[string]string{},
+				temporules.WithRunbookURL(mixinRunbookURL("tempo")),
+				temporules.WithWritesDashboardURL(dashboardURL("tempo-writes-overview")),
+				temporules.WithTenantDashboardURL(dashboardURL("tempo-tenant-overview")),
+				temporules.WithTenantLabelName(tempoTenantLabelName),
+				temporules.WithAdditionalAlertLabels(additionalAlertLabels),
+			)
+		})
+
+		registry.AddRule("perses", func() rules.RuleResult {
+			return persesrules.BuildPersesRules(
+				project,
+				map[string]string{
+					"app.kubernetes.io/component": "perses",
+					"app.kubernetes.io/name":      "perses-rules",
+					"app.kubernetes.io/part-of":   "perses",
+					"app.kubernetes.io/version":   "main",
+				},
+				map[string]string{},
+				persesrules.WithRunbookURL(mixinRunbookURL("perses")),
+				persesrules.WithDashboardURL(dashboardURL("perses-overview")),
+				persesrules.WithAdditionalAlertLabels(additionalAlertLabels),
+			)
+		})
+
+		exitOnError(validateComponents(cfg, registry, filter))
+		if listComponents {
+			exitOnError(registry.ListRules(os.Stdout, filter))
+			return
+		}
+		if lintOnly {
+			exitOnLintErrors(lint.Rules(registry.Rules(filter)))
+			return
+		}
+
+		out, finishSink, err := newSink(outputSink, ruleOutputDir, managedScope(registry, filter))
+		exitOnError(err)
+		out, finishSink, err = packageSink(out, finishSink, ruleOutput, "")
+		exitOnError(err)
+		ruleWriter := rules.NewRuleWriterWithSink(ruleOutput, out)
+		for _, result := range registry.Rules(filter) {
+			ruleWriter.Add(result)
+		}
+		exitOnError(ruleWriter.Write())
+		exitOnError(finishSink())
 	} else {
-		dashboardWriter := dashboards.NewDashboardWriter()
-
-		dashboardWriter.Add(perses.BuildPersesOverview(project, datasource, clusterLabelName))
-		dashboardWriter.Add(prometheus.BuildPrometheusOverview(project, datasource, clusterLabelName))
-		dashboardWriter.Add(prometheus.BuildPrometheusRemoteWrite(project, datasource, clusterLabelName))
-		dashboardWriter.Add(nodeexporter.BuildNodeExporterNodes(project, datasource, clusterLabelName))
-		dashboardWriter.Add(nodeexporter.BuildNodeExporterClusterUseMethod(project, datasource, clusterLabelName))
-		dashboardWriter.Add(alertmanager.BuildAlertManagerOverview(project, datasource, clusterLabelName))
-		dashboardWriter.Add(thanos.BuildThanosReceiveOverview(project, datasource, clusterLabelName))
-		dashboardWriter.Add(thanos.BuildThanosQueryOverview(project, datasource, clusterLabelName))
-		dashboardWriter.Add(thanos.BuildThanosStoreOverview(project, datasource, clusterLabelName))
-		dashboardWriter.Add(thanos.BuildThanosRulerOverview(project, datasource, clusterLabelName))
-		dashboardWriter.Add(thanos.BuildThanosQueryFrontendOverview(project, datasource, clusterLabelName))
-		dashboardWriter.Add(thanos.BuildThanosCompactOverview(project, datasource, clusterLabelName))
-		dashboardWriter.Add(blackbox.BuildBlackboxExporter(project, datasource, clusterLabelName))
-		dashboardWriter.Add(k8sComputeResources.BuildKubernetesNodeResourcesOverview(project, datasource, clusterLabelName))
-		dashboardWriter.Add(k8sComputeResources.BuildKubernetesClusterOverview(project, datasource, clusterLabelName))
-		dashboardWriter.Add(k8sComputeResources.BuildKubernetesNamespaceOverview(project, datasource, clusterLabelName))
-		dashboardWriter.Add(k8sComputeResources.BuildKubernetesPodOverview(project, datasource, clusterLabelName))
-		dashboardWriter.Add(k8sComputeResources.BuildKubernetesWorkloadOverview(project, datasource, clusterLabelName))
-		dashboardWriter.Add(k8sComputeResources.BuildKubernetesWorkloadNamespaceOverview(project, datasource, clusterLabelName))
-		dashboardWriter.Add(k8sComputeResources.BuildKubernetesMultiClusterOverview(project, datasource, clusterLabelName))
-		dashboardWriter.Add(kubelet.BuildKubeletOverview(project, datasource, clusterLabelName))
-		dashboardWriter.Add(controller_manager.BuildControllerManagerOverview(project, datasource, clusterLabelName))
-		dashboardWriter.Add(proxy.BuildProxyOverview(project, datasource, clusterLabelName))
-		dashboardWriter.Add(scheduler.BuildSchedulerOverview(project, datasource, clusterLabelName))
-		dashboardWriter.Add(k8sNetworking.BuildKubernetesClusterOverview(project, datasource, clusterLabelName))
-		dashboardWriter.Add(k8sNetworking.BuildKubernetesNamespaceByPodOverview(project, datasource, clusterLabelName))
-		dashboardWriter.Add(k8sNetworking.BuildKubernetesNamespaceByWorkloadOverview(project, datasource, clusterLabelName))
-		dashboardWriter.Add(k8sNetworking.BuildKubernetesPodOverview(project, datasource, clusterLabelName))
-		dashboardWriter.Add(k8sNetworking.BuildKubernetesWorkloadOverview(project, datasource, clusterLabelName))
-		dashboardWriter.Add(k8sPersistentVolume.BuildKubernetesPersistentVolumeOverview(project, datasource, clusterLabelName))
-		dashboardWriter.Add(etcd.BuildETCDOverview(project, datasource, clusterLabelName))
-		dashboardWriter.Add(apiserver.BuildAPIServerOverview(project, datasource, clusterLabelName))
-		dashboardWriter.Add(tempo.BuildTempoWritesOverview(project, datasource, clusterLabelName))
-		dashboardWriter.Add(tempo.BuildTempoTenantOverview(project, datasource, clusterLabelName))
-		dashboardWriter.Add(opentelemetry.BuildOpenTelemetryCollector(project, datasource, clusterLabelName))
-		dashboardWriter.Add(istio.BuildIstioControlPlane(project, datasource, clusterLabelName))
-		dashboardWriter.Add(istio.BuildIstioMesh(project, datasource, clusterLabelName))
-		dashboardWriter.Add(istio.BuildIstioWorkload(project, datasource, clusterLabelName))
-		dashboardWriter.Add(istio.BuildIstioService(project, datasource, clusterLabelName))
-		dashboardWriter.Add(istio.BuildIstioPerformance(project, datasource, clusterLabelName))
-		dashboardWriter.Add(istio.BuildIstioZtunnel(project, datasource, clusterLabelName))
-		dashboardWriter.Add(istio.BuildIstioExtension(project, datasource, clusterLabelName))
+		registry.AddDashboards("perses", func() []dashboards.DashboardResult {
+			return []dashboards.DashboardResult{
+				perses.BuildPersesOverview(project, datasource, clusterLabelName),
+			}
+		})
+		registry.AddDashboards("prometheus", func() []dashboards.DashboardResult {
+			return []dashboards.DashboardResult{
+				prometheus.BuildPrometheusOverview(project, datasource, clusterLabelName),
+				prometheus.BuildPrometheusRemoteWrite(project, datasource, clusterLabelName),
+			}
+		})
+		registry.AddDashboards("node-exporter", func() []dashboards.DashboardResult {
+			return []dashboards.DashboardResult{
+				nodeexporter.BuildNodeExporterNodes(project, datasource, clusterLabelName, nodeexporter.WithJobLabelValue(nodeExporterJob)),
+				nodeexporter.BuildNodeExporterClusterUseMethod(project, datasource, clusterLabelName, nodeexporter.WithJobLabelValue(nodeExporterJob)),
+			}
+		})
+		registry.AddDashboards("alertmanager", func() []dashboards.DashboardResult {
+			return []dashboards.DashboardResult{
+				alertmanager.BuildAlertManagerOverview(project, datasource, clusterLabelName),
+			}
+		})
+		registry.AddDashboards("thanos", func() []dashboards.DashboardResult {
+			return []dashboards.DashboardResult{
+				thanos.BuildThanosReceiveOverview(project, datasource, clusterLabelName),
+				thanos.BuildThanosQueryOverview(project, datasource, clusterLabelName),
+				thanos.BuildThanosStoreOverview(project, datasource, clusterLabelName),
+				thanos.BuildThanosRulerOverview(project, datasource, clusterLabelName),
+				thanos.BuildThanosQueryFrontendOverview(project, datasource, clusterLabelName),
+				thanos.BuildThanosCompactOverview(project, datasource, clusterLabelName),
+			}
+		})
+		registry.AddDashboards("thanos-operator", func() []dashboards.DashboardResult {
+			return []dashboards.DashboardResult{
+				thanosoperator.BuildThanosOperatorOverview(project, datasource, clusterLabelName),
+			}
+		})
+		registry.AddDashboards("blackbox-exporter", func() []dashboards.DashboardResult {
+			return []dashboards.DashboardResult{
+				blackbox.BuildBlackboxExporter(project, datasource, clusterLabelName),
+			}
+		})
+		registry.AddDashboards("kubernetes", func() []dashboards.DashboardResult {
+			return []dashboards.DashboardResult{
+				k8sComputeResources.BuildKubernetesNodeResourcesOverview(project, datasource, clusterLabelName, k8sDashboards.WithJobLabelValues(k8sJobs)),
+				k8sComputeResources.BuildKubernetesClusterOverview(project, datasource, clusterLabelName, k8sDashboards.WithJobLabelValues(k8sJobs)),
+				k8sComputeResources.BuildKubernetesNamespaceOverview(project, datasource, clusterLabelName, k8sDashboards.WithJobLabelValues(k8sJobs)),
+				k8sComputeResources.BuildKubernetesPodOverview(project, datasource, clusterLabelName, k8sDashboards.WithJobLabelValues(k8sJobs)),
+				k8sComputeResources.BuildKubernetesWorkloadOverview(project, datasource, clusterLabelName, k8sDashboards.WithJobLabelValues(k8sJobs)),
+				k8sComputeResources.BuildKubernetesWorkloadNamespaceOverview(project, datasource, clusterLabelName, k8sDashboards.WithJobLabelValues(k8sJobs)),
+				k8sComputeResources.BuildKubernetesMultiClusterOverview(project, datasource, clusterLabelName, k8sDashboards.WithJobLabelValues(k8sJobs)),
+				kubelet.BuildKubeletOverview(project, datasource, clusterLabelName, k8sDashboards.WithJobLabelValues(k8sJobs)),
+				controller_manager.BuildControllerManagerOverview(project, datasource, clusterLabelName, k8sDashboards.WithJobLabelValues(k8sJobs)),
+				proxy.BuildProxyOverview(project, datasource, clusterLabelName, k8sDashboards.WithJobLabelValues(k8sJobs)),
+				scheduler.BuildSchedulerOverview(project, datasource, clusterLabelName, k8sDashboards.WithJobLabelValues(k8sJobs)),
+				k8sNetworking.BuildKubernetesClusterOverview(project, datasource, clusterLabelName, k8sDashboards.WithJobLabelValues(k8sJobs)),
+				k8sNetworking.BuildKubernetesNamespaceByPodOverview(project, datasource, clusterLabelName, k8sDashboards.WithJobLabelValues(k8sJobs)),
+				k8sNetworking.BuildKubernetesNamespaceByWorkloadOverview(project, datasource, clusterLabelName, k8sDashboards.WithJobLabelValues(k8sJobs)),
+				k8sNetworking.BuildKubernetesPodOverview(project, datasource, clusterLabelName, k8sDashboards.WithJobLabelValues(k8sJobs)),
+				k8sNetworking.BuildKubernetesWorkloadOverview(project, datasource, clusterLabelName, k8sDashboards.WithJobLabelValues(k8sJobs)),
+				k8sPersistentVolume.BuildKubernetesPersistentVolumeOverview(project, datasource, clusterLabelName, k8sDashboards.WithJobLabelValues(k8sJobs)),
+				apiserver.BuildAPIServerOverview(project, datasource, clusterLabelName, k8sDashboards.WithJobLabelValues(k8sJobs)),
+			}
+		})
+		registry.AddDashboards("etcd", func() []dashboards.DashboardResult {
+			return []dashboards.DashboardResult{
+				etcd.BuildETCDOverview(project, datasource, clusterLabelName, etcd.WithJobSelector(etcdJob)),
+			}
+		})
+		registry.AddDashboards("tempo", func() []dashboards.DashboardResult {
+			return []dashboards.DashboardResult{
+				tempo.BuildTempoWritesOverview(project, datasource, clusterLabelName),
+				tempo.BuildTempoTenantOverview(project, datasource, clusterLabelName, tempo.WithTenantLabelName(tempoTenantLabelName)),
+			}
+		})
+		registry.AddDashboards("opentelemetry-collector", func() []dashboards.DashboardResult {
+			return []dashboards.DashboardResult{
+				opentelemetry.BuildOpenTelemetryCollector(project, datasource, clusterLabelName),
+			}
+		})
+		registry.AddDashboards("istio", func() []dashboards.DashboardResult {
+			return []dashboards.DashboardResult{
+				istio.BuildIstioControlPlane(project, datasource, clusterLabelName, istio.WithRecordingRules(istioUseRecordingRules)),
+				istio.BuildIstioMesh(project, datasource, clusterLabelName, istio.WithRecordingRules(istioUseRecordingRules)),
+				istio.BuildIstioWorkload(project, datasource, clusterLabelName, istio.WithRecordingRules(istioUseRecordingRules)),
+				istio.BuildIstioService(project, datasource, clusterLabelName, istio.WithRecordingRules(istioUseRecordingRules)),
+				istio.BuildIstioPerformance(project, datasource, clusterLabelName, istio.WithRecordingRules(istioUseRecordingRules)),
+				istio.BuildIstioZtunnel(project, datasource, clusterLabelName, istio.WithRecordingRules(istioUseRecordingRules)),
+				istio.BuildIstioExtension(project, datasource, clusterLabelName, istio.WithRecordingRules(istioUseRecordingRules)),
+			}
+		})
 
 		if lokiDatasource != "" {
-			dashboardWriter.Add(openshiftlogging.BuildAuditLogViewer(project, lokiDatasource))
+			registry.AddDashboards("openshift/logging", func() []dashboards.DashboardResult {
+				return []dashboards.DashboardResult{
+					openshiftlogging.BuildAuditLogViewer(project, lokiDatasource),
+				}
+			})
+		}
+
+		exitOnError(validateComponents(cfg, registry, filter))
+		if cfg != nil {
+			if err := cfg.ValidateOperatorResourceComponents(registry.Components()); err != nil {
+				exitOnError(fmt.Errorf("%s: %w", configFile, err))
+			}
+		}
+		if listComponents {
+			exitOnError(registry.ListDashboards(os.Stdout, filter))
+			return
+		}
+		if lintOnly {
+			exitOnLintErrors(lint.Dashboards(registry.Dashboards(filter)))
+			return
+		}
+
+		out, finishSink, err := newSink(outputSink, dashboardOutputDir, managedScope(registry, filter))
+		exitOnError(err)
+		out, finishSink, err = packageSink(out, finishSink, dashboardOutput, datasource)
+		exitOnError(err)
+		dashboardWriter := dashboards.NewDashboardWriterWithSink(dashboardOutput, out,
+			dashboards.WithConfigMap(dashboards.ConfigMapConfig{
+				Labels:       configMapLabels,
+				Annotations:  configMapAnnotations,
+				PerComponent: configMapPerComponent,
+			}),
+			dashboards.WithOperatorResource(operatorResourceConfig()),
+		)
+		for _, result := range registry.Dashboards(filter) {
+			dashboardWriter.Add(result)
+		}
+		exitOnError(dashboardWriter.Write())
+		exitOnError(finishSink())
+	}
+}
+
+// applyConfig copies the values of the config file to the flag variables, except for the
+// flags explicitly passed on the command line.
+func applyConfig(cfg *config.Config) {
+	setFlags := map[string]bool{}
+	flag.Visit(func(f *flag.Flag) {
+		setFlags[f.Name] = true
+	})
+	set := func(flagName string, target *string, value string) {
+		if value != "" && !setFlags[flagName] {
+			*target = value
+		}
+	}
+
+	set("project", &project, cfg.Project)
+	set("datasource", &datasource, cfg.Datasource)
+	set("loki-datasource", &lokiDatasource, cfg.LokiDatasource)
+	set("cluster-label-name", &clusterLabelName, cfg.ClusterLabelName)
+	set("components", &includeComponents, strings.Join(cfg.Components.Include, ","))
+	set("exclude-components", &excludeComponents, strings.Join(cfg.Components.Exclude, ","))
+
+	set("node-exporter-job", &nodeExporterJob, cfg.Jobs.NodeExporter)
+	set("apiserver-job", &apiserverJob, cfg.Jobs.APIServer)
+	set("kubelet-job", &kubeletJob, cfg.Jobs.Kubelet)
+	set("kube-state-metrics-job", &kubeStateMetricsJob, cfg.Jobs.KubeStateMetrics)
+	set("cadvisor-job", &cadvisorJob, cfg.Jobs.CAdvisor)
+	set("node-exporter-k8s-job", &nodeExporterK8sJob, cfg.Jobs.NodeExporterK8s)
+	set("controller-manager-job", &controllerManagerJob, cfg.Jobs.ControllerManager)
+	set("scheduler-job", &schedulerJob, cfg.Jobs.Scheduler)
+	set("kube-proxy-job", &kubeProxyJob, cfg.Jobs.KubeProxy)
+	set("etcd-job", &etcdJob, cfg.Jobs.Etcd)
+
+	if cfg.Rules.DashboardBaseURL != "" {
+		dashboardBaseURL = cfg.Rules.DashboardBaseURL
+	}
+	if cfg.Rules.RunbookBaseURL != "" {
+		runbookBaseURL = cfg.Rules.RunbookBaseURL
+	}
+	if cfg.Rules.AdditionalAlertLabels != nil {
+		additionalAlertLabels = cfg.Rules.AdditionalAlertLabels
+	}
+
+	if cfg.ConfigMaps.PerComponent && !setFlags["configmap-per-component"] {
+		configMapPerComponent = true
+	}
+	if cfg.ConfigMaps.Labels != nil {
+		configMapLabels = cfg.ConfigMaps.Labels
+	}
+	if cfg.ConfigMaps.Annotations != nil {
+		configMapAnnotations = cfg.ConfigMaps.Annotations
+	}
+
+	set("operator-namespace", &operatorNamespace, cfg.OperatorResources.Namespace)
+	operatorResources = cfg.OperatorResources
+}
+
+// operatorResourceConfig returns the metadata of the PersesDashboard resources, from --operator-namespace
+// and the operatorResources section of the config file.
+func operatorResourceConfig() dashboards.OperatorResourceConfig {
+	resourceConfig := toOperatorResourceConfig(operatorResources.ResourceMetadata)
+	resourceConfig.Namespace = operatorNamespace
+	for component, metadata := range operatorResources.Components {
+		if resourceConfig.Components == nil {
+			resourceConfig.Components = map[string]dashboards.OperatorResourceConfig{}
+		}
+		resourceConfig.Components[component] = toOperatorResourceConfig(metadata)
+	}
+	return resourceConfig
+}
+
+func toOperatorResourceConfig(metadata config.ResourceMetadata) dashboards.OperatorResourceConfig {
+	resourceConfig := dashboards.OperatorResourceConfig{
+		Labels:      metadata.Labels,
+		Annotations: metadata.Annotations,
+		Namespace:   metadata.Namespace,
+	}
+	if len(metadata.InstanceSelector) > 0 {
+		resourceConfig.InstanceSelector = &metav1.LabelSelector{MatchLabels: metadata.InstanceSelector}
+	}
+	return resourceConfig
+}
+
+// validateComponents checks the selected components, reporting the line of the config file
+// when the selection comes from there.
+func validateComponents(cfg *config.Config, registry *components.Registry, filter components.Filter) error {
+	if cfg != nil {
+		if err := cfg.ValidateComponents(registry.Components()); err != nil {
+			return fmt.Errorf("%s: %w", configFile, err)
+		}
+	}
+	return registry.Validate(filter)
+}
+
+const (
+	dirSink    = "dir"
+	stdoutSink = "stdout"
+	tarSink    = "tar"
+	zipSink    = "zip"
+)
+
+// newSink returns the sink selected by --output-sink for the given output directory,
+// along with the function to call once everything is written successfully. It flushes and closes
+// archives and, in managed mode, prunes the files of scope no longer generated and prints a summary.
+func newSink(kind string, outputDir string, scope []string) (sink.Sink, func() error, error) {
+	noop := func() error { return nil }
+	if (managedOutput || checkOutput) && kind != dirSink {
+		return nil, nil, fmt.Errorf("--managed and --check require --output-sink=%q", dirSink)
+	}
+	switch kind {
+	case dirSink:
+		if !managedOutput && !checkOutput {
+			return sink.NewDir(outputDir), noop, nil
+		}
+		managed := sink.NewManaged(outputDir, checkOutput).Scope(scope...)
+		return managed, func() error {
+			report, err := managed.Finish()
+			if err != nil {
+				return err
+			}
+			if err := report.WriteSummary(os.Stdout); err != nil {
+				return err
+			}
+			if checkOutput && report.HasChanges() {
+				return fmt.Errorf("generated output differs from %s, run without --check to update it", outputDir)
+			}
+			return nil
+		}, nil
+	case stdoutSink:
+		return sink.NewStream(os.Stdout), noop, nil
+	case tarSink, zipSink:
+		f, err := os.Create(outputDir)
+		if err != nil {
+			return nil, nil, err
+		}
+		if kind == tarSink {
+			archive := sink.NewTar(f)
+			return archive, func() error { return errors.Join(archive.Close(), f.Close()) }, nil
+		}
+		archive := sink.NewZip(f)
+		return archive, func() error { return errors.Join(archive.Close(), f.Close()) }, nil
+	default:
+		return nil, nil, fmt.Errorf("--output-sink must be %q, %q, %q or %q", dirSink, stdoutSink, tarSink, zipSink)
+	}
+}
+
+const (
+	kustomizePackage = "kustomize"
+	helmPackage      = "helm"
+)
+
+// packageSink wraps out in the packaging selected by --package. The returned function writes the
+// packaging files, then calls finish.
+func packageSink(out sink.Sink, finish func() error, outputFormat string, datasourceName string) (sink.Sink, func() error, error) {
+	if outputPackage == "" {
+		return out, finish, nil
+	}
+	if outputFormat != dashboards.OperatorOutput {
+		return nil, nil, fmt.Errorf("--package requires the %q output format", dashboards.OperatorOutput)
+	}
+	switch outputPackage {
+	case kustomizePackage:
+		kustomize := sink.NewKustomize(out)
+		return kustomize, func() error {
+			if err := kustomize.Finish(); err != nil {
+				return err
+			}
+			return finish()
+		}, nil
+	case helmPackage:
+		helm := sink.NewHelm(out, sink.HelmChart{
+			Name:       helmChartName,
+			Version:    helmChartVersion,
+			Datasource: datasourceName,
+		})
+		return helm, func() error {
+			if err := helm.Finish(); err != nil {
+				return err
+			}
+			return finish()
+		}, nil
+	default:
+		return nil, nil, fmt.Errorf("--package must be %q or %q", kustomizePackage, helmPackage)
+	}
+}
+
+// managedScope returns the components a managed run owns: every component when none is filtered
+// out, only the selected ones otherwise, so that building a subset leaves the others on disk.
+func managedScope(registry *components.Registry, filter components.Filter) []string {
+	if len(filter.Include) == 0 && len(filter.Exclude) == 0 {
+		return nil
+	}
+	scope := []string{}
+	for _, component := range registry.Components() {
+		if filter.Match(component) {
+			scope = append(scope, component)
 		}
+	}
+	return scope
+}
 
-		dashboardWriter.Write()
+func dashboardURL(name string) string {
+	return strings.TrimSuffix(dashboardBaseURL, "/") + "/" + name
+}
+
+func runbookURL(name string) string {
+	return strings.TrimSuffix(runbookBaseURL, "/") + "/" + name
+}
+
+// mixinRunbookURL returns the runbooks of the components which aren't covered by the prometheus-operator runbooks,
+// maintained in docs/runbooks.
+func mixinRunbookURL(component string) string {
+	return "https://github.com/perses/community-mixins/blob/main/docs/runbooks/" + component + ".md"
+}
+
+// exitOnLintErrors prints findings and exits non-zero when any of them is an error.
+func exitOnLintErrors(findings []lint.Finding) {
+	for _, f := range findings {
+		fmt.Println(f)
+	}
+	if lint.HasErrors(findings) {
+		os.Exit(1)
 	}
+}
 
+func exitOnError(err error) {
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(-1)
+	}
 }
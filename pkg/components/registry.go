@@ -0,0 +1,184 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package components
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/perses/community-mixins/pkg/dashboards"
+	"github.com/perses/community-mixins/pkg/rules"
+)
+
+// Registry collects the builders of the dashboards and rules of the mixin packages, keyed by
+// the component they build. A builder only runs once its component is selected, so that the
+// components filtered out are neither built nor report their errors.
+type Registry struct {
+	dashboardBuilders []dashboardBuilder
+	ruleBuilders      []ruleBuilder
+}
+
+type dashboardBuilder struct {
+	component string
+	build     func() []dashboards.DashboardResult
+}
+
+type ruleBuilder struct {
+	component string
+	build     func() rules.RuleResult
+}
+
+// Filter selects components by name. An empty Include selects every component,
+// Exclude is applied afterwards.
+type Filter struct {
+	Include []string
+	Exclude []string
+}
+
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// ParseList splits a comma-separated list of component names, dropping empty entries.
+func ParseList(list string) []string {
+	names := []string{}
+	for _, name := range strings.Split(list, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func (f Filter) Match(component string) bool {
+	if len(f.Include) > 0 && !slices.Contains(f.Include, component) {
+		return false
+	}
+	return !slices.Contains(f.Exclude, component)
+}
+
+// AddDashboards registers build as the builder of the dashboards of component.
+// build runs at most once, the first time component is selected.
+func (r *Registry) AddDashboards(component string, build func() []dashboards.DashboardResult) {
+	r.dashboardBuilders = append(r.dashboardBuilders, dashboardBuilder{
+		component: component,
+		build:     sync.OnceValue(build),
+	})
+}
+
+// AddRule registers build as the builder of the rules of component.
+// build runs at most once, the first time component is selected.
+func (r *Registry) AddRule(component string, build func() rules.RuleResult) {
+	r.ruleBuilders = append(r.ruleBuilders, ruleBuilder{
+		component: component,
+		build:     sync.OnceValue(build),
+	})
+}
+
+// Components returns the sorted names of every component registered so far.
+func (r *Registry) Components() []string {
+	components := []string{}
+	for _, db := range r.dashboardBuilders {
+		components = append(components, db.component)
+	}
+	for _, rb := range r.ruleBuilders {
+		components = append(components, rb.component)
+	}
+	slices.Sort(components)
+	return slices.Compact(components)
+}
+
+// Validate returns an error if the filter references a component that was never registered.
+func (r *Registry) Validate(f Filter) error {
+	components := r.Components()
+	for _, name := range slices.Concat(f.Include, f.Exclude) {
+		if !slices.Contains(components, name) {
+			return fmt.Errorf("unknown component %q, available components are: %s", name, strings.Join(components, ", "))
+		}
+	}
+	return nil
+}
+
+// Dashboards builds the dashboards of the components matching the filter and returns them, in registration order.
+// Each dashboard is tagged with the component it was registered for.
+func (r *Registry) Dashboards(f Filter) []dashboards.DashboardResult {
+	results := []dashboards.DashboardResult{}
+	for _, db := range r.dashboardBuilders {
+		if !f.Match(db.component) {
+			continue
+		}
+		for _, dr := range db.build() {
+			results = append(results, dr.Component(db.component))
+		}
+	}
+	return results
+}
+
+// Rules builds the rules of the components matching the filter and returns them, in registration order.
+// Each rule is tagged with the component it was registered for.
+func (r *Registry) Rules(f Filter) []rules.RuleResult {
+	results := []rules.RuleResult{}
+	for _, rb := range r.ruleBuilders {
+		if f.Match(rb.component) {
+			results = append(results, rb.build().Component(rb.component))
+		}
+	}
+	return results
+}
+
+// ListDashboards writes every component matching the filter followed by the names of its dashboards.
+func (r *Registry) ListDashboards(w io.Writer, f Filter) error {
+	entries := map[string][]string{}
+	for _, dr := range r.Dashboards(f) {
+		entries[dr.ComponentName()] = append(entries[dr.ComponentName()], dr.Builder().Dashboard.Metadata.Name)
+	}
+	return writeList(w, entries)
+}
+
+// ListRules writes every component matching the filter followed by the names of its rule groups.
+func (r *Registry) ListRules(w io.Writer, f Filter) error {
+	entries := map[string][]string{}
+	for _, rr := range r.Rules(f) {
+		if rr.Rule() == nil {
+			continue
+		}
+		for _, group := range rr.Rule().Spec.Groups {
+			entries[rr.ComponentName()] = append(entries[rr.ComponentName()], group.Name)
+		}
+	}
+	return writeList(w, entries)
+}
+
+func writeList(w io.Writer, entries map[string][]string) error {
+	components := make([]string, 0, len(entries))
+	for component := range entries {
+		components = append(components, component)
+	}
+	slices.Sort(components)
+
+	for _, component := range components {
+		if _, err := fmt.Fprintln(w, component); err != nil {
+			return err
+		}
+		for _, name := range entries[component] {
+			if _, err := fmt.Fprintf(w, "  %s\n", name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
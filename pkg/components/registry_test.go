@@ -0,0 +1,190 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package components
+
+import (
+	"bytes"
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/perses/perses/go-sdk/dashboard"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+
+	"github.com/perses/community-mixins/pkg/dashboards"
+	"github.com/perses/community-mixins/pkg/rules"
+)
+
+func newTestRegistry(t *testing.T) *Registry {
+	t.Helper()
+	registry := NewRegistry()
+	for _, c := range []struct {
+		component string
+		names     []string
+	}{
+		{"node-exporter", []string{"node-exporter-nodes"}},
+		{"kubernetes", []string{"api-server-overview", "kubelet-overview"}},
+		{"etcd", []string{"etcd-overview"}},
+	} {
+		registry.AddDashboards(c.component, func() []dashboards.DashboardResult {
+			results := []dashboards.DashboardResult{}
+			for _, name := range c.names {
+				builder, err := dashboard.New(name)
+				if err != nil {
+					t.Fatalf("dashboard.New() returned error: %v", err)
+				}
+				results = append(results, dashboards.NewDashboardResult(builder, nil))
+			}
+			return results
+		})
+	}
+	registry.AddRule("etcd", func() rules.RuleResult {
+		return rules.NewRuleResult(&monitoringv1.PrometheusRule{
+			Spec: monitoringv1.PrometheusRuleSpec{
+				Groups: []monitoringv1.RuleGroup{{Name: "etcd"}},
+			},
+		}, nil)
+	})
+	return registry
+}
+
+func dashboardNames(results []dashboards.DashboardResult) []string {
+	names := []string{}
+	for _, result := range results {
+		names = append(names, result.Builder().Dashboard.Metadata.Name)
+	}
+	return names
+}
+
+func TestParseList(t *testing.T) {
+	got := ParseList(" kubernetes, ,node-exporter,")
+	want := []string{"kubernetes", "node-exporter"}
+	if !slices.Equal(got, want) {
+		t.Errorf("ParseList() = %v, want %v", got, want)
+	}
+	if got := ParseList(""); len(got) != 0 {
+		t.Errorf("ParseList(\"\") = %v, want empty", got)
+	}
+}
+
+func TestRegistryComponents(t *testing.T) {
+	got := newTestRegistry(t).Components()
+	want := []string{"etcd", "kubernetes", "node-exporter"}
+	if !slices.Equal(got, want) {
+		t.Errorf("Components() = %v, want %v", got, want)
+	}
+}
+
+func TestRegistryDashboards(t *testing.T) {
+	registry := newTestRegistry(t)
+	tests := []struct {
+		name   string
+		filter Filter
+		want   []string
+	}{
+		{
+			name:   "empty filter selects everything",
+			filter: Filter{},
+			want:   []string{"node-exporter-nodes", "api-server-overview", "kubelet-overview", "etcd-overview"},
+		},
+		{
+			name:   "include",
+			filter: Filter{Include: []string{"kubernetes", "node-exporter"}},
+			want:   []string{"node-exporter-nodes", "api-server-overview", "kubelet-overview"},
+		},
+		{
+			name:   "exclude",
+			filter: Filter{Exclude: []string{"kubernetes"}},
+			want:   []string{"node-exporter-nodes", "etcd-overview"},
+		},
+		{
+			name:   "exclude wins over include",
+			filter: Filter{Include: []string{"kubernetes", "etcd"}, Exclude: []string{"etcd"}},
+			want:   []string{"api-server-overview", "kubelet-overview"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := dashboardNames(registry.Dashboards(tt.filter))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Dashboards() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegistryValidate(t *testing.T) {
+	registry := newTestRegistry(t)
+	if err := registry.Validate(Filter{Include: []string{"etcd"}, Exclude: []string{"kubernetes"}}); err != nil {
+		t.Errorf("Validate() returned error: %v", err)
+	}
+	if err := registry.Validate(Filter{Include: []string{"kubernetess"}}); err == nil {
+		t.Error("Validate() with an unknown included component returned no error")
+	}
+	if err := registry.Validate(Filter{Exclude: []string{"thanos"}}); err == nil {
+		t.Error("Validate() with an unknown excluded component returned no error")
+	}
+}
+
+func TestRegistryList(t *testing.T) {
+	registry := newTestRegistry(t)
+
+	var dashboardList bytes.Buffer
+	if err := registry.ListDashboards(&dashboardList, Filter{Exclude: []string{"node-exporter"}}); err != nil {
+		t.Fatalf("ListDashboards() returned error: %v", err)
+	}
+	want := "etcd\n  etcd-overview\nkubernetes\n  api-server-overview\n  kubelet-overview\n"
+	if got := dashboardList.String(); got != want {
+		t.Errorf("ListDashboards() = %q, want %q", got, want)
+	}
+
+	var ruleList bytes.Buffer
+	if err := registry.ListRules(&ruleList, Filter{}); err != nil {
+		t.Fatalf("ListRules() returned error: %v", err)
+	}
+	if got, want := ruleList.String(), "etcd\n  etcd\n"; got != want {
+		t.Errorf("ListRules() = %q, want %q", got, want)
+	}
+}
+
+func TestRegistryBuildsSelectedComponentsOnly(t *testing.T) {
+	registry := NewRegistry()
+	built := map[string]int{}
+	for _, component := range []string{"etcd", "kubernetes"} {
+		registry.AddDashboards(component, func() []dashboards.DashboardResult {
+			built[component]++
+			return nil
+		})
+		registry.AddRule(component, func() rules.RuleResult {
+			built[component]++
+			return rules.NewRuleResult(nil, errors.New("failed to build "+component))
+		})
+	}
+
+	if got := registry.Components(); !slices.Equal(got, []string{"etcd", "kubernetes"}) {
+		t.Errorf("Components() = %v, want [etcd kubernetes]", got)
+	}
+	filter := Filter{Exclude: []string{"kubernetes"}}
+	registry.Dashboards(filter)
+	registry.Dashboards(filter)
+	for _, result := range registry.Rules(filter) {
+		if result.ComponentName() != "etcd" {
+			t.Errorf("Rules() returned a rule of component %q", result.ComponentName())
+		}
+	}
+	if want := map[string]int{"etcd": 2}; !maps.Equal(built, want) {
+		t.Errorf("built = %v, want %v: each selected builder must run once and excluded ones never", built, want)
+	}
+}
@@ -0,0 +1,314 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/url"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// Version is the only schema version of the configuration file supported so far.
+const Version = "v1"
+
+var labelNameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// Config is the configuration file of the generator. Every field is optional, empty values
+// keep the defaults of the matching command line flag.
+type Config struct {
+	Version          string `yaml:"version"`
+	Project          string `yaml:"project"`
+	Datasource       string `yaml:"datasource"`
+	LokiDatasource   string `yaml:"lokiDatasource"`
+	ClusterLabelName string `yaml:"clusterLabelName"`
+
+	Components Components `yaml:"components"`
+	Jobs       Jobs       `yaml:"jobs"`
+	Rules      Rules      `yaml:"rules"`
+	ConfigMaps ConfigMaps `yaml:"configMaps"`
+
+	OperatorResources OperatorResources `yaml:"operatorResources"`
+
+	root *yaml.Node
+}
+
+// Components selects the components to build, see the --components and --exclude-components flags.
+type Components struct {
+	Include []string `yaml:"include"`
+	Exclude []string `yaml:"exclude"`
+}
+
+// Jobs holds the job label selectors used by the dashboards and rules of each component.
+type Jobs struct {
+	NodeExporter      string `yaml:"nodeExporter"`
+	APIServer         string `yaml:"apiserver"`
+	Kubelet           string `yaml:"kubelet"`
+	KubeStateMetrics  string `yaml:"kubeStateMetrics"`
+	CAdvisor          string `yaml:"cadvisor"`
+	NodeExporterK8s   string `yaml:"nodeExporterK8s"`
+	ControllerManager string `yaml:"controllerManager"`
+	Scheduler         string `yaml:"scheduler"`
+	KubeProxy         string `yaml:"kubeProxy"`
+	Etcd              string `yaml:"etcd"`
+}
+
+// Rules holds the settings shared by every rule builder.
+type Rules struct {
+	// RunbookBaseURL is the URL the runbooks.prometheus-operator.dev style runbook of a component
+	// is appended to, e.g. <RunbookBaseURL>/kubernetes. Components shipping their own runbook ignore it.
+	RunbookBaseURL string `yaml:"runbookBaseURL"`
+	// DashboardBaseURL is the URL the name of the dashboard linked by an alert is appended to.
+	DashboardBaseURL      string            `yaml:"dashboardBaseURL"`
+	AdditionalAlertLabels map[string]string `yaml:"additionalAlertLabels"`
+}
+
+// ConfigMaps holds the settings of the ConfigMaps written with --output=configmap.
+type ConfigMaps struct {
+	Labels      map[string]string `yaml:"labels"`
+	Annotations map[string]string `yaml:"annotations"`
+	// PerComponent groups the dashboards of a component in the same ConfigMaps, see --configmap-per-component.
+	PerComponent bool `yaml:"perComponent"`
+}
+
+// OperatorResources holds the metadata of the PersesDashboard resources written with --output=operator.
+type OperatorResources struct {
+	ResourceMetadata `yaml:",inline"`
+	// Components overrides the metadata for the dashboards of a component. Labels and annotations are
+	// merged with the ones above, the namespace and instance selector replace them.
+	Components map[string]ResourceMetadata `yaml:"components"`
+}
+
+// ResourceMetadata is the metadata set on a PersesDashboard resource.
+type ResourceMetadata struct {
+	// Namespace defaults to the project, see --operator-namespace.
+	Namespace   string            `yaml:"namespace"`
+	Labels      map[string]string `yaml:"labels"`
+	Annotations map[string]string `yaml:"annotations"`
+	// InstanceSelector holds the labels of the Perses instances the dashboards are created in.
+	InstanceSelector map[string]string `yaml:"instanceSelector"`
+}
+
+// Error is a schema validation error pointing at the line of the offending field.
+type Error struct {
+	Line    int
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+}
+
+// Load reads and validates the configuration file at path.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	config, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return config, nil
+}
+
+// Parse decodes and validates a configuration file. Unknown fields are rejected.
+func Parse(data []byte) (*Config, error) {
+	root := &yaml.Node{}
+	if err := yaml.Unmarshal(data, root); err != nil {
+		return nil, err
+	}
+
+	config := &Config{root: root}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(config); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func (c *Config) validate() error {
+	if c.Version == "" {
+		return &Error{Line: c.line(), Message: fmt.Sprintf("version is required, supported versions: %s", Version)}
+	}
+	if c.Version != Version {
+		return &Error{Line: c.line("version"), Message: fmt.Sprintf("unsupported version %q, supported versions: %s", c.Version, Version)}
+	}
+
+	if c.Jobs.Etcd != "" {
+		if _, err := regexp.Compile(c.Jobs.Etcd); err != nil {
+			return &Error{Line: c.line("jobs", "etcd"), Message: fmt.Sprintf("jobs.etcd is not a valid regexp: %s", err)}
+		}
+	}
+
+	for _, field := range []struct {
+		name  string
+		value string
+	}{
+		{"runbookBaseURL", c.Rules.RunbookBaseURL},
+		{"dashboardBaseURL", c.Rules.DashboardBaseURL},
+	} {
+		if field.value == "" {
+			continue
+		}
+		if u, err := url.Parse(field.value); err != nil || u.Scheme == "" || u.Host == "" {
+			return &Error{Line: c.line("rules", field.name), Message: fmt.Sprintf("rules.%s must be an absolute URL, got %q", field.name, field.value)}
+		}
+	}
+
+	for name := range c.Rules.AdditionalAlertLabels {
+		if !labelNameRegexp.MatchString(name) {
+			return &Error{Line: c.keyLine(name, "rules", "additionalAlertLabels"), Message: fmt.Sprintf("rules.additionalAlertLabels: invalid label name %q", name)}
+		}
+	}
+
+	if err := c.validateLabels(c.ConfigMaps.Labels, "configMaps", "labels"); err != nil {
+		return err
+	}
+	if err := c.validateAnnotations(c.ConfigMaps.Annotations, "configMaps", "annotations"); err != nil {
+		return err
+	}
+
+	if err := c.validateResourceMetadata(c.OperatorResources.ResourceMetadata, "operatorResources"); err != nil {
+		return err
+	}
+	for component, metadata := range c.OperatorResources.Components {
+		if err := c.validateResourceMetadata(metadata, "operatorResources", "components", component); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Config) validateResourceMetadata(metadata ResourceMetadata, keys ...string) error {
+	if metadata.Namespace != "" {
+		if errs := validation.IsDNS1123Label(metadata.Namespace); len(errs) > 0 {
+			field := append(slices.Clone(keys), "namespace")
+			return &Error{Line: c.line(field...), Message: fmt.Sprintf("%s: invalid namespace %q: %s", strings.Join(field, "."), metadata.Namespace, strings.Join(errs, ", "))}
+		}
+	}
+	if err := c.validateLabels(metadata.Labels, append(slices.Clone(keys), "labels")...); err != nil {
+		return err
+	}
+	if err := c.validateAnnotations(metadata.Annotations, append(slices.Clone(keys), "annotations")...); err != nil {
+		return err
+	}
+	return c.validateLabels(metadata.InstanceSelector, append(slices.Clone(keys), "instanceSelector")...)
+}
+
+// validateLabels checks the Kubernetes labels found under the given keys.
+func (c *Config) validateLabels(labels map[string]string, keys ...string) error {
+	for name, value := range labels {
+		if errs := append(validation.IsQualifiedName(name), validation.IsValidLabelValue(value)...); len(errs) > 0 {
+			return &Error{Line: c.keyLine(name, keys...), Message: fmt.Sprintf("%s: invalid label %q: %s", strings.Join(keys, "."), name, strings.Join(errs, ", "))}
+		}
+	}
+	return nil
+}
+
+// validateAnnotations checks the Kubernetes annotations found under the given keys.
+func (c *Config) validateAnnotations(annotations map[string]string, keys ...string) error {
+	for name := range annotations {
+		if errs := validation.IsQualifiedName(name); len(errs) > 0 {
+			return &Error{Line: c.keyLine(name, keys...), Message: fmt.Sprintf("%s: invalid annotation %q: %s", strings.Join(keys, "."), name, strings.Join(errs, ", "))}
+		}
+	}
+	return nil
+}
+
+// ValidateComponents checks that the included and excluded components are all part of known.
+func (c *Config) ValidateComponents(known []string) error {
+	for _, field := range []string{"include", "exclude"} {
+		list := c.node("components", field)
+		if list == nil || list.Kind != yaml.SequenceNode {
+			continue
+		}
+		for _, item := range list.Content {
+			if !slices.Contains(known, item.Value) {
+				return &Error{Line: item.Line, Message: fmt.Sprintf("components.%s: unknown component %q, available components are: %s", field, item.Value, strings.Join(known, ", "))}
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateOperatorResourceComponents checks that the components of operatorResources.components
+// are all part of known, the components with dashboards.
+func (c *Config) ValidateOperatorResourceComponents(known []string) error {
+	for _, component := range slices.Sorted(maps.Keys(c.OperatorResources.Components)) {
+		if !slices.Contains(known, component) {
+			return &Error{Line: c.keyLine(component, "operatorResources", "components"), Message: fmt.Sprintf("operatorResources.components: unknown component %q, available components are: %s", component, strings.Join(known, ", "))}
+		}
+	}
+	return nil
+}
+
+// node returns the value node found under the given mapping keys, or nil.
+func (c *Config) node(keys ...string) *yaml.Node {
+	if c.root == nil || len(c.root.Content) == 0 {
+		return nil
+	}
+	current := c.root.Content[0]
+	for _, key := range keys {
+		if current.Kind != yaml.MappingNode {
+			return nil
+		}
+		var next *yaml.Node
+		for i := 0; i+1 < len(current.Content); i += 2 {
+			if current.Content[i].Value == key {
+				next = current.Content[i+1]
+				break
+			}
+		}
+		if next == nil {
+			return nil
+		}
+		current = next
+	}
+	return current
+}
+
+// line returns the line of the value found under the given mapping keys, or 1 if it is missing.
+func (c *Config) line(keys ...string) int {
+	if n := c.node(keys...); n != nil && n.Line > 0 {
+		return n.Line
+	}
+	return 1
+}
+
+// keyLine returns the line of key inside the mapping found under the given keys.
+func (c *Config) keyLine(key string, keys ...string) int {
+	if mapping := c.node(keys...); mapping != nil && mapping.Kind == yaml.MappingNode {
+		for i := 0; i < len(mapping.Content); i += 2 {
+			if mapping.Content[i].Value == key {
+				return mapping.Content[i].Line
+			}
+		}
+	}
+	return c.line(keys...)
+}
@@ -0,0 +1,182 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	config, err := Parse([]byte(`version: v1
+project: monitoring
+datasource: prometheus
+clusterLabelName: cluster
+components:
+  include: [kubernetes, node-exporter]
+jobs:
+  nodeExporter: node-exporter
+  etcd: "etcd-.*"
+rules:
+  runbookBaseURL: https://runbooks.example.com
+  dashboardBaseURL: https://perses.example.com/projects/monitoring/dashboards
+  additionalAlertLabels:
+    team: infra
+configMaps:
+  labels:
+    perses.dev/dashboard: "true"
+  perComponent: true
+`))
+	if err != nil {
+		t.Fatalf("Parse() returned error: %v", err)
+	}
+	if config.Project != "monitoring" || config.Datasource != "prometheus" || config.ClusterLabelName != "cluster" {
+		t.Errorf("unexpected top-level fields: %+v", config)
+	}
+	if strings.Join(config.Components.Include, ",") != "kubernetes,node-exporter" {
+		t.Errorf("Components.Include = %v", config.Components.Include)
+	}
+	if config.Jobs.NodeExporter != "node-exporter" || config.Jobs.Etcd != "etcd-.*" {
+		t.Errorf("unexpected jobs: %+v", config.Jobs)
+	}
+	if config.Rules.AdditionalAlertLabels["team"] != "infra" {
+		t.Errorf("Rules.AdditionalAlertLabels = %v", config.Rules.AdditionalAlertLabels)
+	}
+	if config.ConfigMaps.Labels["perses.dev/dashboard"] != "true" || !config.ConfigMaps.PerComponent {
+		t.Errorf("unexpected ConfigMaps: %+v", config.ConfigMaps)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		line    int
+		message string
+	}{
+		{
+			name:    "missing version",
+			input:   "project: monitoring\n",
+			line:    1,
+			message: "version is required",
+		},
+		{
+			name:    "unsupported version",
+			input:   "project: monitoring\nversion: v2\n",
+			line:    2,
+			message: `unsupported version "v2"`,
+		},
+		{
+			name:    "invalid etcd regexp",
+			input:   "version: v1\njobs:\n  kubelet: kubelet\n  etcd: \"etcd(\"\n",
+			line:    4,
+			message: "jobs.etcd is not a valid regexp",
+		},
+		{
+			name:    "relative dashboard URL",
+			input:   "version: v1\nrules:\n  dashboardBaseURL: /dashboards\n",
+			line:    3,
+			message: "rules.dashboardBaseURL must be an absolute URL",
+		},
+		{
+			name:    "invalid alert label name",
+			input:   "version: v1\nrules:\n  additionalAlertLabels:\n    team: infra\n    cost-center: x\n",
+			line:    5,
+			message: `invalid label name "cost-center"`,
+		},
+		{
+			name:    "invalid ConfigMap label",
+			input:   "version: v1\nconfigMaps:\n  labels:\n    perses.dev/dashboard: \"yes please\"\n",
+			line:    4,
+			message: `configMaps.labels: invalid label "perses.dev/dashboard"`,
+		},
+		{
+			name:    "invalid ConfigMap annotation",
+			input:   "version: v1\nconfigMaps:\n  annotations:\n    \"folder name\": mixins\n",
+			line:    4,
+			message: `configMaps.annotations: invalid annotation "folder name"`,
+		},
+		{
+			name:    "invalid operator resource namespace",
+			input:   "version: v1\noperatorResources:\n  namespace: Monitoring\n",
+			line:    3,
+			message: `operatorResources.namespace: invalid namespace "Monitoring"`,
+		},
+		{
+			name:    "invalid component instance selector",
+			input:   "version: v1\noperatorResources:\n  components:\n    kubernetes:\n      instanceSelector:\n        perses: \"main instance\"\n",
+			line:    6,
+			message: `operatorResources.components.kubernetes.instanceSelector: invalid label "perses"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.input))
+			var configErr *Error
+			if !errors.As(err, &configErr) {
+				t.Fatalf("Parse() error = %v, want a *config.Error", err)
+			}
+			if configErr.Line != tt.line {
+				t.Errorf("Line = %d, want %d", configErr.Line, tt.line)
+			}
+			if !strings.Contains(configErr.Message, tt.message) {
+				t.Errorf("Message = %q, want it to contain %q", configErr.Message, tt.message)
+			}
+		})
+	}
+}
+
+func TestParseUnknownField(t *testing.T) {
+	_, err := Parse([]byte("version: v1\njobs:\n  kubelett: kubelet\n"))
+	if err == nil {
+		t.Fatal("Parse() with an unknown field returned no error")
+	}
+	if !strings.Contains(err.Error(), "line 3") || !strings.Contains(err.Error(), "kubelett") {
+		t.Errorf("Parse() error = %q, want it to point at kubelett on line 3", err)
+	}
+}
+
+func TestValidateComponents(t *testing.T) {
+	config, err := Parse([]byte("version: v1\ncomponents:\n  include:\n    - kubernetes\n  exclude:\n    - thanos\n    - nodeexporter\n"))
+	if err != nil {
+		t.Fatalf("Parse() returned error: %v", err)
+	}
+	if err := config.ValidateComponents([]string{"kubernetes", "node-exporter", "thanos"}); err == nil {
+		t.Fatal("ValidateComponents() returned no error")
+	} else if configErr, ok := err.(*Error); !ok || configErr.Line != 7 {
+		t.Errorf("ValidateComponents() error = %v, want it on line 7", err)
+	}
+	if err := config.ValidateComponents([]string{"kubernetes", "nodeexporter", "thanos"}); err != nil {
+		t.Errorf("ValidateComponents() returned error: %v", err)
+	}
+}
+
+func TestValidateOperatorResourceComponents(t *testing.T) {
+	config, err := Parse([]byte("version: v1\noperatorResources:\n  namespace: monitoring\n  components:\n    kubernetes:\n      namespace: kube-system\n    kubernets:\n      labels:\n        team: platform\n"))
+	if err != nil {
+		t.Fatalf("Parse() returned error: %v", err)
+	}
+	if config.OperatorResources.Namespace != "monitoring" || config.OperatorResources.Components["kubernetes"].Namespace != "kube-system" {
+		t.Errorf("unexpected OperatorResources: %+v", config.OperatorResources)
+	}
+	if err := config.ValidateOperatorResourceComponents([]string{"kubernetes", "thanos"}); err == nil {
+		t.Fatal("ValidateOperatorResourceComponents() returned no error")
+	} else if configErr, ok := err.(*Error); !ok || configErr.Line != 7 {
+		t.Errorf("ValidateOperatorResourceComponents() error = %v, want it on line 7", err)
+	}
+	if err := config.ValidateOperatorResourceComponents([]string{"kubernetes", "kubernets"}); err != nil {
+		t.Errorf("ValidateOperatorResourceComponents() returned error: %v", err)
+	}
+}
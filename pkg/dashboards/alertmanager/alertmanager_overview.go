@@ -16,7 +16,6 @@ package alertmanager
 import (
 	"github.com/perses/community-mixins/pkg/dashboards"
 	panels "github.com/perses/community-mixins/pkg/panels/alertmanager"
-	"github.com/perses/community-mixins/pkg/promql"
 	"github.com/perses/perses/go-sdk/dashboard"
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
 	listVar "github.com/perses/perses/go-sdk/variable/list-variable"
@@ -62,11 +61,9 @@ func BuildAlertManagerOverview(project string, datasource string, clusterLabelNa
 			dashboard.AddVariable("integration",
 				listVar.List(
 					labelValuesVar.PrometheusLabelValues("integration",
-						labelValuesVar.Matchers(
-							promql.SetLabelMatchersV2(
-								vector.New(vector.WithMetricName("alertmanager_notifications_total")),
-								[]*labels.Matcher{clusterLabelMatcher, {Name: "job", Type: labels.MatchEqual, Value: "$job"}},
-							).Pretty(0),
+						dashboards.AddVariableMatcher(
+							vector.New(vector.WithMetricName("alertmanager_notifications_total")),
+							[]*labels.Matcher{clusterLabelMatcher, {Name: "job", Type: labels.MatchEqual, Value: "$job"}},
 						),
 						dashboards.AddVariableDatasource(datasource),
 					),
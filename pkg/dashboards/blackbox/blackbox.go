@@ -16,7 +16,6 @@ package blackbox
 import (
 	"github.com/perses/community-mixins/pkg/dashboards"
 	panels "github.com/perses/community-mixins/pkg/panels/blackbox"
-	"github.com/perses/community-mixins/pkg/promql"
 	"github.com/perses/perses/go-sdk/dashboard"
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
 	listVar "github.com/perses/perses/go-sdk/variable/list-variable"
@@ -102,11 +101,9 @@ func BuildBlackboxExporter(project string, datasource string, clusterLabelName s
 			dashboard.AddVariable("instance",
 				listVar.List(
 					labelValuesVar.PrometheusLabelValues("instance",
-						labelValuesVar.Matchers(
-							promql.SetLabelMatchersV2(
-								vector.New(vector.WithMetricName("probe_success")),
-								[]*labels.Matcher{clusterLabelMatcher, {Name: "job", Type: labels.MatchEqual, Value: "$job"}},
-							).Pretty(0),
+						dashboards.AddVariableMatcher(
+							vector.New(vector.WithMetricName("probe_success")),
+							[]*labels.Matcher{clusterLabelMatcher, {Name: "job", Type: labels.MatchEqual, Value: "$job"}},
 						),
 						dashboards.AddVariableDatasource(datasource),
 					),
@@ -0,0 +1,169 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dashboards
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/perses/perses/go-sdk/dashboard"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8syaml "sigs.k8s.io/yaml"
+)
+
+// DefaultConfigMapMaxSize is the maximum size of the data of a ConfigMap accepted by the Kubernetes API server.
+const DefaultConfigMapMaxSize = corev1.MaxSecretSize
+
+// ConfigMapConfig configures the ConfigMaps written with the ConfigMapOutput format.
+type ConfigMapConfig struct {
+	// Labels and Annotations are set on every ConfigMap, typically to have them picked up
+	// by a provisioning sidecar watching ConfigMaps with a given label.
+	Labels      map[string]string
+	Annotations map[string]string
+	// PerComponent groups the dashboards of a component in the same ConfigMaps, instead of
+	// writing one ConfigMap per dashboard.
+	PerComponent bool
+	// MaxSize is the maximum size in bytes of the data of a ConfigMap, DefaultConfigMapMaxSize when zero.
+	// With PerComponent, the dashboards of a component are split across as many ConfigMaps as needed.
+	MaxSize int
+}
+
+// WithConfigMap configures the ConfigMaps written with the ConfigMapOutput format.
+func WithConfigMap(config ConfigMapConfig) ExecOption {
+	return func(e *Exec) {
+		e.configMap = config
+	}
+}
+
+func (c ConfigMapConfig) maxSize() int {
+	if c.MaxSize <= 0 {
+		return DefaultConfigMapMaxSize
+	}
+	return c.MaxSize
+}
+
+// configMapEntry is a dashboard file of a ConfigMap.
+type configMapEntry struct {
+	key   string
+	value string
+}
+
+func (e configMapEntry) size() int {
+	return len(e.key) + len(e.value)
+}
+
+func newConfigMapEntry(builder dashboard.Builder) (configMapEntry, error) {
+	data, err := json.MarshalIndent(builder.Dashboard, "", "  ")
+	if err != nil {
+		return configMapEntry{}, err
+	}
+	return configMapEntry{
+		key:   builder.Dashboard.Metadata.Name + "." + JSONOutput,
+		value: string(data),
+	}, nil
+}
+
+// renderConfigMap marshals a ConfigMap holding the given dashboard files.
+func (c ConfigMapConfig) renderConfigMap(name string, namespace string, entries []configMapEntry) ([]byte, error) {
+	configMap := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ConfigMap",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   namespace,
+			Labels:      maps.Clone(c.Labels),
+			Annotations: maps.Clone(c.Annotations),
+		},
+		Data: map[string]string{},
+	}
+	for _, entry := range entries {
+		configMap.Data[entry.key] = entry.value
+	}
+	return k8syaml.Marshal(configMap)
+}
+
+// renderDashboardConfigMap marshals a ConfigMap holding a single dashboard, named after it.
+func (c ConfigMapConfig) renderDashboardConfigMap(builder dashboard.Builder) ([]byte, error) {
+	entry, err := newConfigMapEntry(builder)
+	if err != nil {
+		return nil, err
+	}
+	if entry.size() > c.maxSize() {
+		return nil, fmt.Errorf("dashboard is %d bytes, over the ConfigMap size limit of %d bytes", entry.size(), c.maxSize())
+	}
+	return c.renderConfigMap(builder.Dashboard.Metadata.Name, builder.Dashboard.Metadata.Project, []configMapEntry{entry})
+}
+
+// BuildComponentConfigMaps writes the dashboards of a component to the sink of the Exec, packed in as
+// few ConfigMaps as the size limit allows. The ConfigMaps are named <component>-dashboards, suffixed
+// with their index when the dashboards don't fit in a single one.
+// It writes every dashboard it can and returns the errors of all the others joined together,
+// each one being a *DashboardError.
+func (b *Exec) BuildComponentConfigMaps(component string, drs []DashboardResult) error {
+	var errs []error
+	var namespace string
+	var groups [][]configMapEntry
+	var groupResults [][]DashboardResult
+	groupSize := 0
+	for _, dr := range drs {
+		if dr.err != nil {
+			errs = append(errs, newDashboardError(dr, dr.err))
+			continue
+		}
+		entry, err := newConfigMapEntry(dr.builder)
+		if err != nil {
+			errs = append(errs, newDashboardError(dr, err))
+			continue
+		}
+		if entry.size() > b.configMap.maxSize() {
+			errs = append(errs, newDashboardError(dr, fmt.Errorf("dashboard is %d bytes, over the ConfigMap size limit of %d bytes", entry.size(), b.configMap.maxSize())))
+			continue
+		}
+		if len(groups) == 0 || groupSize+entry.size() > b.configMap.maxSize() {
+			groups = append(groups, nil)
+			groupResults = append(groupResults, nil)
+			groupSize = 0
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], entry)
+		groupResults[len(groupResults)-1] = append(groupResults[len(groupResults)-1], dr)
+		groupSize += entry.size()
+		if namespace == "" {
+			namespace = dr.builder.Dashboard.Metadata.Project
+		}
+	}
+
+	baseName := strings.ReplaceAll(strings.ToLower(component), "_", "-") + "-dashboards"
+	for i, entries := range groups {
+		name := baseName
+		if len(groups) > 1 {
+			name = fmt.Sprintf("%s-%d", baseName, i+1)
+		}
+		output, err := b.configMap.renderConfigMap(name, namespace, entries)
+		if err == nil {
+			err = b.out.Write(component, name, YAMLOutput, output)
+		}
+		if err != nil {
+			for _, dr := range groupResults[i] {
+				errs = append(errs, newDashboardError(dr, fmt.Errorf("ConfigMap %q: %w", name, err)))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -14,6 +14,9 @@
 package dashboards
 
 import (
+	"errors"
+
+	"github.com/perses/community-mixins/pkg/sink"
 	"github.com/perses/perses/go-sdk/dashboard"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -53,29 +56,92 @@ func (d DashboardResult) Component(component string) DashboardResult {
 	return d
 }
 
+// ComponentName returns the component the dashboard was built for, as set with Component.
+func (d DashboardResult) ComponentName() string {
+	return d.component
+}
+
+// NewDashboardWriter returns a DashboardWriter writing dashboards in the format of the --output flag under the
+// directory of the --output-dir flag.
+//
+// Deprecated: use NewDashboardWriterWithDir, which doesn't depend on the command line flags.
 func NewDashboardWriter() *DashboardWriter {
 	return &DashboardWriter{
 		executor: NewExec(),
 	}
 }
 
+// NewDashboardWriterWithDir returns a DashboardWriter writing dashboards in outputFormat,
+// one of JSONOutput, YAMLOutput, OperatorOutput, OperatorJSONOutput or ConfigMapOutput, under outputDir/<component>.
+func NewDashboardWriterWithDir(outputFormat string, outputDir string, options ...ExecOption) *DashboardWriter {
+	return &DashboardWriter{
+		executor: NewExecWithDir(outputFormat, outputDir, options...),
+	}
+}
+
+// NewDashboardWriterWithSink returns a DashboardWriter writing dashboards in outputFormat to out.
+func NewDashboardWriterWithSink(outputFormat string, out sink.Sink, options ...ExecOption) *DashboardWriter {
+	return &DashboardWriter{
+		executor: NewExecWithSink(outputFormat, out, options...),
+	}
+}
+
 // Add adds a dashboard to the writer.
 func (w *DashboardWriter) Add(dr DashboardResult) {
 	w.dashboardResults = append(w.dashboardResults, dr)
 }
 
-// Write writes the dashboards to the output directory.
-func (w *DashboardWriter) Write() {
+// Write writes the dashboards to the sink of the writer.
+// It writes every dashboard it can and returns the errors of all the others joined together,
+// each one being a *DashboardError.
+func (w *DashboardWriter) Write() error {
+	if w.executor.outputFormat == ConfigMapOutput && w.executor.configMap.PerComponent {
+		return w.writeComponentConfigMaps()
+	}
+	var errs []error
 	for _, result := range w.dashboardResults {
-		w.executor.BuildDashboard(result)
+		if err := w.executor.BuildDashboard(result); err != nil {
+			errs = append(errs, err)
+		}
 	}
+	return errors.Join(errs...)
+}
+
+// writeComponentConfigMaps writes the dashboards of each component in their own ConfigMaps.
+func (w *DashboardWriter) writeComponentConfigMaps() error {
+	var components []string
+	byComponent := map[string][]DashboardResult{}
+	for _, result := range w.dashboardResults {
+		if _, ok := byComponent[result.component]; !ok {
+			components = append(components, result.component)
+		}
+		byComponent[result.component] = append(byComponent[result.component], result)
+	}
+	var errs []error
+	for _, component := range components {
+		if err := w.executor.BuildComponentConfigMaps(component, byComponent[component]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
 }
 
 // OperatorResources returns the operator resources of the dashboards added to the writer.
-func (w *DashboardWriter) OperatorResources() []runtime.Object {
+// When some dashboards fail, it returns the errors of all of them joined together,
+// each one being a *DashboardError.
+func (w *DashboardWriter) OperatorResources() ([]runtime.Object, error) {
 	operatorResources := []runtime.Object{}
+	var errs []error
 	for _, result := range w.dashboardResults {
-		operatorResources = append(operatorResources, w.executor.BuildDashboardOperatorResource(result))
+		resource, err := w.executor.BuildDashboardOperatorResource(result)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		operatorResources = append(operatorResources, resource)
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
 	}
-	return operatorResources
+	return operatorResources, nil
 }
@@ -26,71 +26,61 @@ import (
 	"github.com/prometheus/prometheus/model/labels"
 )
 
-func withETCDStatsGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withETCDStatsGroup(datasource string, labelMatchers ...*labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("etcd Status",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(8),
-		panels.EtcdUpStatus(datasource, labelMatcher),
+		panels.EtcdUpStatus(datasource, labelMatchers...),
 	)
 }
 
-func withRPCGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withRPCGroup(datasource string, labelMatchers ...*labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("RPC and Streams",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.RPCRate(datasource, labelMatcher),
-		panels.ActiveStreams(datasource, labelMatcher),
+		panels.RPCRate(datasource, labelMatchers...),
+		panels.ActiveStreams(datasource, labelMatchers...),
 	)
 }
 
-func withDBGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withDBGroup(datasource string, labelMatchers ...*labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("etcd DB",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.DBSize(datasource, labelMatcher),
-		panels.DiskSyncDuration(datasource, labelMatcher),
+		panels.DBSize(datasource, labelMatchers...),
+		panels.DiskSyncDuration(datasource, labelMatchers...),
 	)
 }
 
-func withTrafficGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withTrafficGroup(datasource string, labelMatchers ...*labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("etcd Traffic",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.ClientTrafficIn(datasource, labelMatcher),
-		panels.ClientTrafficOut(datasource, labelMatcher),
-		panels.PeerTrafficIn(datasource, labelMatcher),
-		panels.PeerTrafficOut(datasource, labelMatcher),
+		panels.ClientTrafficIn(datasource, labelMatchers...),
+		panels.ClientTrafficOut(datasource, labelMatchers...),
+		panels.PeerTrafficIn(datasource, labelMatchers...),
+		panels.PeerTrafficOut(datasource, labelMatchers...),
 	)
 }
 
-func withRaftGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withRaftGroup(datasource string, labelMatchers ...*labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("etcd Raft",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(8),
-		panels.RaftProposals(datasource, labelMatcher),
+		panels.RaftProposals(datasource, labelMatchers...),
 	)
 }
 
-func withRoundTripTimeGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withRoundTripTimeGroup(datasource string, labelMatchers ...*labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("etcd Peer Round Trip Time",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(8),
-		panels.PeerRoundtripTime(datasource, labelMatcher),
+		panels.PeerRoundtripTime(datasource, labelMatchers...),
 	)
 }
 
-func withETCDResources(datasource string, clusterLabelMatcher *labels.Matcher) dashboard.Option {
-	// TODO(saswatamcode): Add a way to configure these.
-	labelMatchersToUse := []*labels.Matcher{
-		promql.ClusterVarV2,
-		{
-			Name:  "job",
-			Value: ".*etcd.*",
-			Type:  labels.MatchRegexp,
-		},
-	}
-
-	labelMatchersToUse = append(labelMatchersToUse, clusterLabelMatcher)
+func withETCDResources(datasource string, labelMatchers ...*labels.Matcher) dashboard.Option {
+	labelMatchersToUse := append([]*labels.Matcher{promql.ClusterVarV2}, labelMatchers...)
 
 	return dashboard.AddPanelGroup("Resource Usage",
 		panelgroup.PanelsPerLine(2),
@@ -102,8 +92,33 @@ func withETCDResources(datasource string, clusterLabelMatcher *labels.Matcher) d
 	)
 }
 
-func BuildETCDOverview(project string, datasource string, clusterLabelName string) dashboards.DashboardResult {
+type ETCDOverviewConfig struct {
+	JobSelector string
+}
+
+type ETCDOverviewOption func(*ETCDOverviewConfig)
+
+// WithJobSelector sets the job regexp used to select the etcd targets.
+// Pass the same value to the etcd rules so that the dashboard and the alerts look at the same targets.
+func WithJobSelector(jobSelector string) ETCDOverviewOption {
+	return func(config *ETCDOverviewConfig) {
+		if jobSelector == "" {
+			jobSelector = panels.DefaultJobSelector
+		}
+		config.JobSelector = jobSelector
+	}
+}
+
+func BuildETCDOverview(project string, datasource string, clusterLabelName string, options ...ETCDOverviewOption) dashboards.DashboardResult {
+	config := ETCDOverviewConfig{
+		JobSelector: panels.DefaultJobSelector,
+	}
+	for _, option := range options {
+		option(&config)
+	}
+
 	clusterLabelMatcher := dashboards.GetClusterLabelMatcherV2(clusterLabelName)
+	jobMatcher := panels.JobMatcher(config.JobSelector)
 	return dashboards.NewDashboardResult(
 		dashboard.New("etcd-overview",
 			dashboard.ProjectName(project),
@@ -111,24 +126,22 @@ func BuildETCDOverview(project string, datasource string, clusterLabelName strin
 			dashboard.AddVariable("cluster",
 				listVar.List(
 					labelValuesVar.PrometheusLabelValues("cluster",
-						labelValuesVar.Matchers(
-							promql.SetLabelMatchersV2(
-								vector.New(vector.WithMetricName("etcd_server_has_leader")),
-								[]*labels.Matcher{clusterLabelMatcher, {Name: "job", Type: labels.MatchRegexp, Value: ".*etcd.*"}},
-							).Pretty(0),
+						dashboards.AddVariableMatcher(
+							vector.New(vector.WithMetricName("etcd_server_has_leader")),
+							[]*labels.Matcher{clusterLabelMatcher, jobMatcher},
 						),
 						dashboards.AddVariableDatasource(datasource),
 					),
 					listVar.DisplayName("cluster"),
 				),
 			),
-			withETCDStatsGroup(datasource, clusterLabelMatcher),
-			withRPCGroup(datasource, clusterLabelMatcher),
-			withDBGroup(datasource, clusterLabelMatcher),
-			withRaftGroup(datasource, clusterLabelMatcher),
-			withTrafficGroup(datasource, clusterLabelMatcher),
-			withRoundTripTimeGroup(datasource, clusterLabelMatcher),
-			withETCDResources(datasource, clusterLabelMatcher),
+			withETCDStatsGroup(datasource, clusterLabelMatcher, jobMatcher),
+			withRPCGroup(datasource, clusterLabelMatcher, jobMatcher),
+			withDBGroup(datasource, clusterLabelMatcher, jobMatcher),
+			withRaftGroup(datasource, clusterLabelMatcher, jobMatcher),
+			withTrafficGroup(datasource, clusterLabelMatcher, jobMatcher),
+			withRoundTripTimeGroup(datasource, clusterLabelMatcher, jobMatcher),
+			withETCDResources(datasource, clusterLabelMatcher, jobMatcher),
 		),
 	).Component("etcd")
 }
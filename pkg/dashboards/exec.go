@@ -17,10 +17,9 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
-	"io"
-	"os"
-	"path"
+	"maps"
 
+	"github.com/perses/community-mixins/pkg/sink"
 	operatorv2 "github.com/perses/perses-operator/api/v1alpha2"
 	"github.com/perses/perses/go-sdk/dashboard"
 	"gopkg.in/yaml.v3"
@@ -34,14 +33,17 @@ const (
 	YAMLOutput         = "yaml"
 	OperatorOutput     = "operator"
 	OperatorJSONOutput = "operator-json"
+	ConfigMapOutput    = "configmap"
 )
 
-func executeDashboardBuilder(builder dashboard.Builder, outputFormat string, outputDir string, errWriter io.Writer) {
+// renderDashboard marshals the dashboard of the given component in the output format of the Exec
+// and returns it with its file extension.
+func (b *Exec) renderDashboard(builder dashboard.Builder, component string) ([]byte, string, error) {
 	var err error
 	var output []byte
 	var ext string
 
-	switch outputFormat {
+	switch b.outputFormat {
 	case YAMLOutput:
 		output, err = yaml.Marshal(builder.Dashboard)
 		ext = YAMLOutput
@@ -49,46 +51,100 @@ func executeDashboardBuilder(builder dashboard.Builder, outputFormat string, out
 		output, err = json.MarshalIndent(builder.Dashboard, "", "  ")
 		ext = JSONOutput
 	case OperatorOutput:
-		output, err = k8syaml.Marshal(builderToOperatorResource(builder))
+		var resource runtime.Object
+		if resource, err = builderToOperatorResource(builder, b.operatorResource.forComponent(component)); err == nil {
+			output, err = k8syaml.Marshal(resource)
+		}
 		ext = YAMLOutput
 	case OperatorJSONOutput:
-		output, err = json.MarshalIndent(builderToOperatorResource(builder), "", "  ")
+		var resource runtime.Object
+		if resource, err = builderToOperatorResource(builder, b.operatorResource.forComponent(component)); err == nil {
+			output, err = json.MarshalIndent(resource, "", "  ")
+		}
 		ext = JSONOutput
+	case ConfigMapOutput:
+		output, err = b.configMap.renderDashboardConfigMap(builder)
+		ext = YAMLOutput
 	default:
-		err = fmt.Errorf("--output must be %q, %q, %q or %q", JSONOutput, YAMLOutput, OperatorOutput, OperatorJSONOutput)
+		err = fmt.Errorf("unsupported output format %q, supported: %q, %q, %q, %q, %q", b.outputFormat, JSONOutput, YAMLOutput, OperatorOutput, OperatorJSONOutput, ConfigMapOutput)
 	}
-
 	if err != nil {
-		if _, ferr := fmt.Fprint(errWriter, err); ferr != nil {
-			panic(fmt.Errorf("failed to write err: %w", err))
-		}
-		os.Exit(-1)
+		return nil, "", err
 	}
+	return output, ext, nil
+}
 
-	// create output directory if not exists
-	_, err = os.Stat(outputDir)
-	if err != nil && !os.IsNotExist(err) {
-		if _, ferr := fmt.Fprint(errWriter, err); ferr != nil {
-			panic(fmt.Errorf("failed to write err: %w", err))
-		}
-		os.Exit(-1)
+// OperatorResourceConfig configures the metadata of the PersesDashboard resources written with the
+// OperatorOutput and OperatorJSONOutput formats.
+type OperatorResourceConfig struct {
+	// Labels are added to the app.kubernetes.io labels of every resource, taking precedence over them.
+	Labels      map[string]string
+	Annotations map[string]string
+	// Namespace of the resources, the project of the dashboard when empty.
+	Namespace string
+	// InstanceSelector selects the Perses instances the dashboards are created in, all of them when nil.
+	InstanceSelector *metav1.LabelSelector
+	// Components overrides the settings above for the dashboards of a component. Its labels and
+	// annotations are merged with the ones above, its namespace and instance selector replace them when set.
+	Components map[string]OperatorResourceConfig
+}
+
+// WithOperatorResource configures the metadata of the PersesDashboard resources.
+func WithOperatorResource(config OperatorResourceConfig) ExecOption {
+	return func(e *Exec) {
+		e.operatorResource = config
 	}
+}
 
-	if err != nil && os.IsNotExist(err) {
-		_ = os.MkdirAll(outputDir, os.ModePerm)
+// forComponent returns the settings of the resources of the given component.
+func (c OperatorResourceConfig) forComponent(component string) OperatorResourceConfig {
+	override, ok := c.Components[component]
+	if !ok {
+		return c
+	}
+	config := OperatorResourceConfig{
+		Labels:           mergeMaps(c.Labels, override.Labels),
+		Annotations:      mergeMaps(c.Annotations, override.Annotations),
+		Namespace:        c.Namespace,
+		InstanceSelector: c.InstanceSelector,
 	}
+	if override.Namespace != "" {
+		config.Namespace = override.Namespace
+	}
+	if override.InstanceSelector != nil {
+		config.InstanceSelector = override.InstanceSelector
+	}
+	return config
+}
 
-	_ = os.WriteFile(fmt.Sprintf("%s/%s.%s", outputDir, builder.Dashboard.Metadata.Name, ext), output, os.ModePerm)
+// mergeMaps returns a new map holding the entries of all the given maps, the last ones taking precedence.
+func mergeMaps(ms ...map[string]string) map[string]string {
+	var merged map[string]string
+	for _, m := range ms {
+		if len(m) == 0 {
+			continue
+		}
+		if merged == nil {
+			merged = map[string]string{}
+		}
+		maps.Copy(merged, m)
+	}
+	return merged
 }
 
-func builderToOperatorResource(builder dashboard.Builder) runtime.Object {
+func builderToOperatorResource(builder dashboard.Builder, config OperatorResourceConfig) (runtime.Object, error) {
 	specData, err := json.Marshal(builder.Dashboard.Spec)
 	if err != nil {
-		panic(fmt.Errorf("failed to marshal dashboard spec: %w", err))
+		return nil, fmt.Errorf("failed to marshal dashboard spec: %w", err)
 	}
 	var operatorDashboard operatorv2.Dashboard
 	if err := json.Unmarshal(specData, &operatorDashboard); err != nil {
-		panic(fmt.Errorf("failed to unmarshal dashboard spec into operator dashboard: %w", err))
+		return nil, fmt.Errorf("failed to unmarshal dashboard spec into operator dashboard: %w", err)
+	}
+
+	namespace := config.Namespace
+	if namespace == "" {
+		namespace = builder.Dashboard.Metadata.Project
 	}
 
 	return &operatorv2.PersesDashboard{
@@ -98,49 +154,108 @@ func builderToOperatorResource(builder dashboard.Builder) runtime.Object {
 		},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      builder.Dashboard.Metadata.Name,
-			Namespace: builder.Dashboard.Metadata.Project,
-			Labels: map[string]string{
+			Namespace: namespace,
+			Labels: mergeMaps(map[string]string{
 				"app.kubernetes.io/name":      "perses-dashboard",
 				"app.kubernetes.io/instance":  builder.Dashboard.Metadata.Name,
 				"app.kubernetes.io/part-of":   "perses-operator",
 				"app.kubernetes.io/component": "dashboard",
-			},
+			}, config.Labels),
+			Annotations: mergeMaps(config.Annotations),
 		},
 		Spec: operatorv2.PersesDashboardSpec{
-			Config: operatorDashboard,
+			Config:           operatorDashboard,
+			InstanceSelector: config.InstanceSelector.DeepCopy(),
 		},
-	}
+	}, nil
 }
 
+// ExecOption configures the output of an Exec.
+type ExecOption func(*Exec)
+
+// NewExec returns an Exec writing dashboards in the format of the --output flag under the directory of the
+// --output-dir flag.
+//
+// Deprecated: use NewExecWithDir, which doesn't depend on the command line flags.
 func NewExec() Exec {
 	output := flag.Lookup("output").Value.String()
 	outputDir := flag.Lookup("output-dir").Value.String()
 
-	return Exec{
-		outputFormat: output,
-		outputDir:    outputDir,
+	return NewExecWithDir(output, outputDir)
+}
+
+// NewExecWithDir returns an Exec writing dashboards in outputFormat under outputDir.
+func NewExecWithDir(outputFormat string, outputDir string, options ...ExecOption) Exec {
+	return NewExecWithSink(outputFormat, sink.NewDir(outputDir), options...)
+}
+
+// NewExecWithSink returns an Exec writing dashboards in outputFormat to out.
+func NewExecWithSink(outputFormat string, out sink.Sink, options ...ExecOption) Exec {
+	exec := Exec{
+		outputFormat: outputFormat,
+		out:          out,
 	}
+	for _, option := range options {
+		option(&exec)
+	}
+	return exec
 }
 
 type Exec struct {
-	outputFormat string
-	outputDir    string
+	outputFormat     string
+	out              sink.Sink
+	configMap        ConfigMapConfig
+	operatorResource OperatorResourceConfig
 }
 
-// BuildDashboard is a helper to print the result of a dashboard builder in stdout and errors to stderr
-func (b *Exec) BuildDashboard(dr DashboardResult) {
+// BuildDashboard writes the result of a dashboard builder to the sink of the Exec.
+// The returned error, if any, is a *DashboardError.
+func (b *Exec) BuildDashboard(dr DashboardResult) error {
 	if dr.err != nil {
-		fmt.Fprint(os.Stderr, dr.err)
-		os.Exit(-1)
+		return newDashboardError(dr, dr.err)
+	}
+	output, ext, err := b.renderDashboard(dr.builder, dr.component)
+	if err != nil {
+		return newDashboardError(dr, err)
 	}
-	executeDashboardBuilder(dr.builder, b.outputFormat, path.Join(b.outputDir, dr.component), os.Stdout)
+	if err := b.out.Write(dr.component, dr.builder.Dashboard.Metadata.Name, ext, output); err != nil {
+		return newDashboardError(dr, err)
+	}
+	return nil
 }
 
-// BuildDashboardOperatorResource is a helper to return the operator resource of a dashboard builder as a runtime.Object.
-func (b *Exec) BuildDashboardOperatorResource(dr DashboardResult) runtime.Object {
+// BuildDashboardOperatorResource returns the operator resource of a dashboard builder as a runtime.Object.
+// The returned error, if any, is a *DashboardError.
+func (b *Exec) BuildDashboardOperatorResource(dr DashboardResult) (runtime.Object, error) {
 	if dr.err != nil {
-		fmt.Fprint(os.Stderr, dr.err)
-		os.Exit(-1)
+		return nil, newDashboardError(dr, dr.err)
+	}
+	resource, err := builderToOperatorResource(dr.builder, b.operatorResource.forComponent(dr.component))
+	if err != nil {
+		return nil, newDashboardError(dr, err)
+	}
+	return resource, nil
+}
+
+// DashboardError is the error of a single dashboard, identified by its component and name.
+type DashboardError struct {
+	Component string
+	Name      string
+	Err       error
+}
+
+func newDashboardError(dr DashboardResult, err error) *DashboardError {
+	return &DashboardError{
+		Component: dr.component,
+		Name:      dr.builder.Dashboard.Metadata.Name,
+		Err:       err,
 	}
-	return builderToOperatorResource(dr.builder)
+}
+
+func (e *DashboardError) Error() string {
+	return fmt.Sprintf("dashboard %q of component %q: %v", e.Name, e.Component, e.Err)
+}
+
+func (e *DashboardError) Unwrap() error {
+	return e.Err
 }
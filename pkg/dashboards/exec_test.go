@@ -14,11 +14,20 @@
 package dashboards
 
 import (
+	"errors"
+	"flag"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 
+	"github.com/perses/community-mixins/pkg/sink"
 	operatorv2 "github.com/perses/perses-operator/api/v1alpha2"
 	"github.com/perses/perses/go-sdk/dashboard"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8syaml "sigs.k8s.io/yaml"
 )
 
@@ -31,7 +40,10 @@ func TestBuilderToOperatorResource_v1alpha2(t *testing.T) {
 		t.Fatalf("dashboard.New() returned error: %v", err)
 	}
 
-	obj := builderToOperatorResource(builder)
+	obj, err := builderToOperatorResource(builder, OperatorResourceConfig{})
+	if err != nil {
+		t.Fatalf("builderToOperatorResource() returned error: %v", err)
+	}
 	cr, ok := obj.(*operatorv2.PersesDashboard)
 	if !ok {
 		t.Fatalf("expected *operatorv2.PersesDashboard, got %T", obj)
@@ -65,3 +77,291 @@ func TestBuilderToOperatorResource_v1alpha2(t *testing.T) {
 		t.Errorf("yaml should not contain v1alpha1:\n%s", output)
 	}
 }
+
+func TestDashboardWriterWrite_AggregatesErrors(t *testing.T) {
+	outputDir := t.TempDir()
+	writer := NewDashboardWriterWithDir(YAMLOutput, outputDir)
+
+	valid, err := dashboard.New("valid-dashboard", dashboard.ProjectName("perses-dev"))
+	if err != nil {
+		t.Fatalf("dashboard.New() returned error: %v", err)
+	}
+	first, _ := dashboard.New("first-broken", dashboard.ProjectName("perses-dev"))
+	second, _ := dashboard.New("second-broken", dashboard.ProjectName("perses-dev"))
+
+	writer.Add(NewDashboardResult(first, errors.New("first failure")).Component("alpha"))
+	writer.Add(NewDashboardResult(valid, nil).Component("beta"))
+	writer.Add(NewDashboardResult(second, errors.New("second failure")).Component("gamma"))
+
+	err = writer.Write()
+	if err == nil {
+		t.Fatal("Write() returned no error")
+	}
+
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		t.Fatalf("Write() error %T does not wrap multiple errors", err)
+	}
+	errs := joined.Unwrap()
+	if len(errs) != 2 {
+		t.Fatalf("Write() returned %d errors, want 2: %v", len(errs), err)
+	}
+
+	want := []DashboardError{
+		{Component: "alpha", Name: "first-broken"},
+		{Component: "gamma", Name: "second-broken"},
+	}
+	for i, e := range errs {
+		var dashboardErr *DashboardError
+		if !errors.As(e, &dashboardErr) {
+			t.Fatalf("error %d is %T, want *DashboardError", i, e)
+		}
+		if dashboardErr.Component != want[i].Component || dashboardErr.Name != want[i].Name {
+			t.Errorf("error %d = %s/%s, want %s/%s", i, dashboardErr.Component, dashboardErr.Name, want[i].Component, want[i].Name)
+		}
+	}
+
+	// The valid dashboard is written despite the failures around it.
+	if _, err := os.Stat(filepath.Join(outputDir, "beta", "valid-dashboard.yaml")); err != nil {
+		t.Errorf("valid dashboard was not written: %v", err)
+	}
+}
+
+func TestDashboardWriterWrite_UnknownOutputFormat(t *testing.T) {
+	writer := NewDashboardWriterWithDir("toml", t.TempDir())
+	builder, err := dashboard.New("test-dashboard", dashboard.ProjectName("perses-dev"))
+	if err != nil {
+		t.Fatalf("dashboard.New() returned error: %v", err)
+	}
+	writer.Add(NewDashboardResult(builder, nil).Component("test"))
+
+	err = writer.Write()
+	var dashboardErr *DashboardError
+	if !errors.As(err, &dashboardErr) {
+		t.Fatalf("Write() error = %v, want a *DashboardError", err)
+	}
+	if !strings.Contains(err.Error(), `unsupported output format "toml", supported: "json", "yaml"`) {
+		t.Errorf("Write() error = %q, want it to mention the supported formats", err)
+	}
+}
+
+func TestNewDashboardWriterWithDir_WithoutFlags(t *testing.T) {
+	// The writer must not depend on the command line flags of the binary embedding it.
+	if flag.Lookup("output") != nil || flag.Lookup("output-dir") != nil {
+		t.Fatal("test binary unexpectedly registers the --output flags")
+	}
+
+	outputDir := t.TempDir()
+	writer := NewDashboardWriterWithDir(JSONOutput, outputDir)
+	builder, err := dashboard.New("test-dashboard", dashboard.ProjectName("perses-dev"))
+	if err != nil {
+		t.Fatalf("dashboard.New() returned error: %v", err)
+	}
+	writer.Add(NewDashboardResult(builder, nil).Component("test"))
+
+	if err := writer.Write(); err != nil {
+		t.Fatalf("Write() returned error: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(outputDir, "test", "test-dashboard.json")); err != nil {
+		t.Errorf("dashboard was not written as JSON: %v", err)
+	}
+}
+
+func TestDashboardWriterWrite_MemorySink(t *testing.T) {
+	out := sink.NewMemory()
+	writer := NewDashboardWriterWithSink(JSONOutput, out)
+	builder, err := dashboard.New("test-dashboard", dashboard.ProjectName("perses-dev"))
+	if err != nil {
+		t.Fatalf("dashboard.New() returned error: %v", err)
+	}
+	writer.Add(NewDashboardResult(builder, nil).Component("test"))
+
+	if err := writer.Write(); err != nil {
+		t.Fatalf("Write() returned error: %v", err)
+	}
+	data, ok := out.Get("test", "test-dashboard")
+	if !ok {
+		t.Fatal("dashboard was not written to the memory sink")
+	}
+	if !strings.Contains(string(data), `"name": "test-dashboard"`) {
+		t.Errorf("dashboard is not rendered as JSON:\n%s", data)
+	}
+}
+
+func TestDashboardWriterWrite_ConfigMapPerDashboard(t *testing.T) {
+	out := sink.NewMemory()
+	writer := NewDashboardWriterWithSink(ConfigMapOutput, out, WithConfigMap(ConfigMapConfig{
+		Labels:      map[string]string{"perses.dev/dashboard": "true"},
+		Annotations: map[string]string{"perses.dev/folder": "mixins"},
+	}))
+	builder, err := dashboard.New("test-dashboard", dashboard.ProjectName("perses-dev"))
+	if err != nil {
+		t.Fatalf("dashboard.New() returned error: %v", err)
+	}
+	writer.Add(NewDashboardResult(builder, nil).Component("test"))
+
+	if err := writer.Write(); err != nil {
+		t.Fatalf("Write() returned error: %v", err)
+	}
+	data, ok := out.Get("test", "test-dashboard")
+	if !ok {
+		t.Fatal("ConfigMap was not written to the memory sink")
+	}
+	var configMap corev1.ConfigMap
+	if err := k8syaml.Unmarshal(data, &configMap); err != nil {
+		t.Fatalf("failed to unmarshal ConfigMap: %v", err)
+	}
+	if configMap.Kind != "ConfigMap" || configMap.Name != "test-dashboard" || configMap.Namespace != "perses-dev" {
+		t.Errorf("got %s %s/%s, want ConfigMap perses-dev/test-dashboard", configMap.Kind, configMap.Namespace, configMap.Name)
+	}
+	if configMap.Labels["perses.dev/dashboard"] != "true" {
+		t.Errorf("labels = %v, want the configured ones", configMap.Labels)
+	}
+	if configMap.Annotations["perses.dev/folder"] != "mixins" {
+		t.Errorf("annotations = %v, want the configured ones", configMap.Annotations)
+	}
+	if !strings.Contains(configMap.Data["test-dashboard.json"], `"name": "test-dashboard"`) {
+		t.Errorf("data does not hold the dashboard as JSON: %v", configMap.Data)
+	}
+}
+
+func TestDashboardWriterWrite_ConfigMapPerComponent(t *testing.T) {
+	var builders []dashboard.Builder
+	for _, name := range []string{"dashboard-a", "dashboard-b", "dashboard-c"} {
+		builder, err := dashboard.New(name, dashboard.ProjectName("perses-dev"))
+		if err != nil {
+			t.Fatalf("dashboard.New() returned error: %v", err)
+		}
+		builders = append(builders, builder)
+	}
+	entry, err := newConfigMapEntry(builders[0])
+	if err != nil {
+		t.Fatalf("newConfigMapEntry() returned error: %v", err)
+	}
+
+	for _, tc := range []struct {
+		name    string
+		maxSize int
+		want    map[string][]string
+	}{
+		{
+			name: "single ConfigMap",
+			want: map[string][]string{"test-dashboards": {"dashboard-a.json", "dashboard-b.json", "dashboard-c.json"}},
+		},
+		{
+			name:    "split",
+			maxSize: 2*entry.size() + 1,
+			want: map[string][]string{
+				"test-dashboards-1": {"dashboard-a.json", "dashboard-b.json"},
+				"test-dashboards-2": {"dashboard-c.json"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			out := sink.NewMemory()
+			writer := NewDashboardWriterWithSink(ConfigMapOutput, out, WithConfigMap(ConfigMapConfig{PerComponent: true, MaxSize: tc.maxSize}))
+			for _, builder := range builders {
+				writer.Add(NewDashboardResult(builder, nil).Component("test"))
+			}
+			if err := writer.Write(); err != nil {
+				t.Fatalf("Write() returned error: %v", err)
+			}
+
+			if len(out.Files()) != len(tc.want) {
+				t.Errorf("got %d ConfigMaps, want %d", len(out.Files()), len(tc.want))
+			}
+			for name, keys := range tc.want {
+				data, ok := out.Get("test", name)
+				if !ok {
+					t.Errorf("ConfigMap %q was not written", name)
+					continue
+				}
+				var configMap corev1.ConfigMap
+				if err := k8syaml.Unmarshal(data, &configMap); err != nil {
+					t.Fatalf("failed to unmarshal ConfigMap: %v", err)
+				}
+				gotKeys := slices.Sorted(maps.Keys(configMap.Data))
+				if !slices.Equal(gotKeys, keys) {
+					t.Errorf("ConfigMap %q holds %v, want %v", name, gotKeys, keys)
+				}
+			}
+		})
+	}
+}
+
+func TestDashboardWriterWrite_ConfigMapTooLarge(t *testing.T) {
+	out := sink.NewMemory()
+	writer := NewDashboardWriterWithSink(ConfigMapOutput, out, WithConfigMap(ConfigMapConfig{MaxSize: 10}))
+	builder, err := dashboard.New("test-dashboard", dashboard.ProjectName("perses-dev"))
+	if err != nil {
+		t.Fatalf("dashboard.New() returned error: %v", err)
+	}
+	writer.Add(NewDashboardResult(builder, nil).Component("test"))
+
+	err = writer.Write()
+	var dashboardErr *DashboardError
+	if !errors.As(err, &dashboardErr) || dashboardErr.Name != "test-dashboard" {
+		t.Fatalf("Write() error = %v, want a *DashboardError for test-dashboard", err)
+	}
+	if !strings.Contains(err.Error(), "size limit") {
+		t.Errorf("Write() error = %q, want it to mention the size limit", err)
+	}
+	if len(out.Files()) != 0 {
+		t.Errorf("got %d files, want none", len(out.Files()))
+	}
+}
+
+func TestDashboardWriterOperatorResources_Metadata(t *testing.T) {
+	writer := NewDashboardWriterWithSink(OperatorOutput, sink.NewMemory(), WithOperatorResource(OperatorResourceConfig{
+		Labels:           map[string]string{"team": "observability", "app.kubernetes.io/part-of": "mixins"},
+		Annotations:      map[string]string{"argocd.argoproj.io/sync-wave": "1"},
+		Namespace:        "monitoring",
+		InstanceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"perses": "main"}},
+		Components: map[string]OperatorResourceConfig{
+			"kubernetes": {
+				Labels:           map[string]string{"team": "platform"},
+				InstanceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"perses": "platform"}},
+			},
+		},
+	}))
+	for _, component := range []string{"etcd", "kubernetes"} {
+		builder, err := dashboard.New(component+"-dashboard", dashboard.ProjectName("perses-dev"))
+		if err != nil {
+			t.Fatalf("dashboard.New() returned error: %v", err)
+		}
+		writer.Add(NewDashboardResult(builder, nil).Component(component))
+	}
+
+	resources, err := writer.OperatorResources()
+	if err != nil {
+		t.Fatalf("OperatorResources() returned error: %v", err)
+	}
+	if len(resources) != 2 {
+		t.Fatalf("got %d resources, want 2", len(resources))
+	}
+
+	for i, want := range []struct {
+		team     string
+		instance string
+	}{
+		{team: "observability", instance: "main"},
+		{team: "platform", instance: "platform"},
+	} {
+		cr := resources[i].(*operatorv2.PersesDashboard)
+		if cr.Namespace != "monitoring" {
+			t.Errorf("%s: namespace = %q, want monitoring", cr.Name, cr.Namespace)
+		}
+		if cr.Labels["team"] != want.team {
+			t.Errorf("%s: labels[team] = %q, want %q", cr.Name, cr.Labels["team"], want.team)
+		}
+		if cr.Labels["app.kubernetes.io/part-of"] != "mixins" || cr.Labels["app.kubernetes.io/name"] != "perses-dashboard" {
+			t.Errorf("%s: labels = %v, want the configured ones merged over the default ones", cr.Name, cr.Labels)
+		}
+		if cr.Annotations["argocd.argoproj.io/sync-wave"] != "1" {
+			t.Errorf("%s: annotations = %v, want the configured ones", cr.Name, cr.Annotations)
+		}
+		if cr.Spec.InstanceSelector == nil || cr.Spec.InstanceSelector.MatchLabels["perses"] != want.instance {
+			t.Errorf("%s: instanceSelector = %v, want perses=%s", cr.Name, cr.Spec.InstanceSelector, want.instance)
+		}
+	}
+}
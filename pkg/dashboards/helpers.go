@@ -14,13 +14,15 @@
 package dashboards
 
 import (
-	"github.com/perses/community-mixins/pkg/promql"
 	commonSdk "github.com/perses/perses/go-sdk/common"
 	"github.com/perses/perses/go-sdk/dashboard"
 	listVar "github.com/perses/perses/go-sdk/variable/list-variable"
 	"github.com/perses/plugins/prometheus/sdk/go/query"
 	labelValuesVar "github.com/perses/plugins/prometheus/sdk/go/variable/label-values"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/perses/community-mixins/pkg/promql"
 )
 
 var (
@@ -48,6 +50,18 @@ func AddVariableDatasource(datasourceName string) labelValuesVar.Option {
 	return labelValuesVar.Datasource(datasourceName)
 }
 
+// AddVariableMatcher returns a labelValuesVar.Option adding query, with labelMatchers set, to the matchers of a
+// label values variable. The option fails when the resulting series selector is invalid.
+func AddVariableMatcher(query parser.Expr, labelMatchers []*labels.Matcher) labelValuesVar.Option {
+	return func(plugin *labelValuesVar.Builder) error {
+		matcher, err := promql.TrySetLabelMatchersV2(query, labelMatchers)
+		if err != nil {
+			return err
+		}
+		return labelValuesVar.Matchers(matcher.Pretty(0))(plugin)
+	}
+}
+
 func AddQueryDataSource(datasourceName string) query.Option {
 	if datasourceName == "" {
 		return func(plugin *query.Builder) error {
@@ -74,14 +88,6 @@ func AddClusterVariable(datasource, clusterLabelName, matcher string) dashboard.
 	)
 }
 
-func GetClusterLabelMatcher(clusterLabelName string) promql.LabelMatcher {
-	return promql.LabelMatcher{
-		Name:  clusterLabelName,
-		Value: "$cluster",
-		Type:  "=",
-	}
-}
-
 func GetClusterLabelMatcherV2(clusterLabelName string) *labels.Matcher {
 	return &labels.Matcher{
 		Name:  clusterLabelName,
@@ -19,59 +19,62 @@ import (
 	"github.com/perses/perses/go-sdk/dashboard"
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func withControlPlaneResources(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withControlPlaneResources(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Resource Usage",
 		panelgroup.PanelsPerLine(4),
 		panelgroup.PanelHeight(10),
-		panels.MemoryUsage(datasource, labelMatcher),
-		panels.MemoryAllocations(datasource, labelMatcher),
-		panels.CPUUsage(datasource, labelMatcher),
-		panels.Goroutines(datasource, labelMatcher),
+		panels.MemoryUsage(datasource, queries, labelMatcher),
+		panels.MemoryAllocations(datasource, queries, labelMatcher),
+		panels.CPUUsage(datasource, queries, labelMatcher),
+		panels.Goroutines(datasource, queries, labelMatcher),
 	)
 }
 
-func withPushInformation(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withPushInformation(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Push Information",
 		panelgroup.PanelsPerLine(3),
 		panelgroup.PanelHeight(10),
-		panels.XDSPushes(datasource, labelMatcher),
-		panels.Events(datasource, labelMatcher),
-		panels.Connections(datasource, labelMatcher),
-		panels.PushErrors(datasource, labelMatcher),
-		panels.PushTime(datasource, labelMatcher),
-		panels.PushSize(datasource, labelMatcher),
+		panels.XDSPushes(datasource, queries, labelMatcher),
+		panels.Events(datasource, queries, labelMatcher),
+		panels.Connections(datasource, queries, labelMatcher),
+		panels.PushErrors(datasource, queries, labelMatcher),
+		panels.PushTime(datasource, queries, labelMatcher),
+		panels.PushSize(datasource, queries, labelMatcher),
 	)
 }
 
-func withDeployedVersions(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withDeployedVersions(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Deployed Versions",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(5),
-		panels.PilotVersions(datasource, labelMatcher),
+		panels.PilotVersions(datasource, queries, labelMatcher),
 	)
 }
 
-func withWebhooks(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWebhooks(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Webhooks",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.Validation(datasource, labelMatcher),
-		panels.Injection(datasource, labelMatcher),
+		panels.Validation(datasource, queries, labelMatcher),
+		panels.Injection(datasource, queries, labelMatcher),
 	)
 }
 
-func BuildIstioControlPlane(project string, datasource string, clusterLabelName string) dashboards.DashboardResult {
-	emptyLabelMatcher := &labels.Matcher{}
+func BuildIstioControlPlane(project string, datasource string, clusterLabelName string, options ...IstioDashboardOption) dashboards.DashboardResult {
+	queries := panels.PanelQueries(newIstioDashboardConfig(options...).UseRecordingRules)
+	clusterLabelMatcher := dashboards.GetClusterLabelMatcherV2(clusterLabelName)
 	return dashboards.NewDashboardResult(
 		dashboard.New("istio-control-plane",
 			dashboard.ProjectName(project),
 			dashboard.Name("Istio Control Plane Dashboard"),
-			withDeployedVersions(datasource, emptyLabelMatcher),
-			withControlPlaneResources(datasource, emptyLabelMatcher),
-			withPushInformation(datasource, emptyLabelMatcher),
-			withWebhooks(datasource, emptyLabelMatcher),
+			dashboards.AddClusterVariable(datasource, clusterLabelName, "istio_build"),
+			withDeployedVersions(datasource, queries, clusterLabelMatcher),
+			withControlPlaneResources(datasource, queries, clusterLabelMatcher),
+			withPushInformation(datasource, queries, clusterLabelMatcher),
+			withWebhooks(datasource, queries, clusterLabelMatcher),
 		),
 	).Component("istio")
 }
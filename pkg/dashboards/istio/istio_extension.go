@@ -19,45 +19,48 @@ import (
 	"github.com/perses/perses/go-sdk/dashboard"
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func withWasmVMsGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWasmVMsGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Wasm VMs",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.WasmVMActive(datasource, labelMatcher),
-		panels.WasmVMCreated(datasource, labelMatcher),
+		panels.WasmVMActive(datasource, queries, labelMatcher),
+		panels.WasmVMCreated(datasource, queries, labelMatcher),
 	)
 }
 
-func withWasmModuleRemoteLoadGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWasmModuleRemoteLoadGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Wasm Module Remote Load",
 		panelgroup.PanelsPerLine(3),
 		panelgroup.PanelHeight(8),
-		panels.WasmRemoteLoadCacheEntry(datasource, labelMatcher),
-		panels.WasmRemoteLoadCacheVisit(datasource, labelMatcher),
-		panels.WasmRemoteLoadFetch(datasource, labelMatcher),
+		panels.WasmRemoteLoadCacheEntry(datasource, queries, labelMatcher),
+		panels.WasmRemoteLoadCacheVisit(datasource, queries, labelMatcher),
+		panels.WasmRemoteLoadFetch(datasource, queries, labelMatcher),
 	)
 }
 
-func withWasmProxyResourceUsageGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWasmProxyResourceUsageGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Proxy Resource Usage",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.WasmProxyMemory(datasource, labelMatcher),
-		panels.WasmProxyVCPU(datasource, labelMatcher),
+		panels.WasmProxyMemory(datasource, queries, labelMatcher),
+		panels.WasmProxyVCPU(datasource, queries, labelMatcher),
 	)
 }
 
-func BuildIstioExtension(project string, datasource string, clusterLabelName string) dashboards.DashboardResult {
-	emptyLabelMatcher := &labels.Matcher{}
+func BuildIstioExtension(project string, datasource string, clusterLabelName string, options ...IstioDashboardOption) dashboards.DashboardResult {
+	queries := panels.PanelQueries(newIstioDashboardConfig(options...).UseRecordingRules)
+	clusterLabelMatcher := dashboards.GetClusterLabelMatcherV2(clusterLabelName)
 	return dashboards.NewDashboardResult(
 		dashboard.New("istio-extension-dashboard",
 			dashboard.ProjectName(project),
 			dashboard.Name("Istio Wasm Extension Dashboard"),
-			withWasmVMsGroup(datasource, emptyLabelMatcher),
-			withWasmModuleRemoteLoadGroup(datasource, emptyLabelMatcher),
-			withWasmProxyResourceUsageGroup(datasource, emptyLabelMatcher),
+			dashboards.AddClusterVariable(datasource, clusterLabelName, "istio_build"),
+			withWasmVMsGroup(datasource, queries, clusterLabelMatcher),
+			withWasmModuleRemoteLoadGroup(datasource, queries, clusterLabelMatcher),
+			withWasmProxyResourceUsageGroup(datasource, queries, clusterLabelMatcher),
 		),
 	).Component("istio")
 }
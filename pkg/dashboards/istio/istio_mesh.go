@@ -19,45 +19,48 @@ import (
 	"github.com/perses/perses/go-sdk/dashboard"
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func withMeshOverview(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withMeshOverview(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Global Traffic",
 		panelgroup.PanelsPerLine(4),
 		panelgroup.PanelHeight(6),
-		panels.GlobalRequestVolume(datasource, labelMatcher),
-		panels.GlobalSuccessRate(datasource, labelMatcher),
-		panels.Global4xxRate(datasource, labelMatcher),
-		panels.Global5xxRate(datasource, labelMatcher),
+		panels.GlobalRequestVolume(datasource, queries, labelMatcher),
+		panels.GlobalSuccessRate(datasource, queries, labelMatcher),
+		panels.Global4xxRate(datasource, queries, labelMatcher),
+		panels.Global5xxRate(datasource, queries, labelMatcher),
 	)
 }
 
-func withMeshWorkloads(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withMeshWorkloads(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Global Traffic",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(16),
-		panels.HTTPGRPCWorkloads(datasource, labelMatcher),
-		panels.TCPServices(datasource, labelMatcher),
+		panels.HTTPGRPCWorkloads(datasource, queries, labelMatcher),
+		panels.TCPServices(datasource, queries, labelMatcher),
 	)
 }
 
-func withIstioComponentVersions(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withIstioComponentVersions(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Istio Component Versions",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(8),
-		panels.IstioComponentVersions(datasource, labelMatcher),
+		panels.IstioComponentVersions(datasource, queries, labelMatcher),
 	)
 }
 
-func BuildIstioMesh(project string, datasource string, clusterLabelName string) dashboards.DashboardResult {
-	emptyLabelMatcher := &labels.Matcher{}
+func BuildIstioMesh(project string, datasource string, clusterLabelName string, options ...IstioDashboardOption) dashboards.DashboardResult {
+	queries := panels.PanelQueries(newIstioDashboardConfig(options...).UseRecordingRules)
+	clusterLabelMatcher := dashboards.GetClusterLabelMatcherV2(clusterLabelName)
 	return dashboards.NewDashboardResult(
 		dashboard.New("istio-mesh-dashboard",
 			dashboard.ProjectName(project),
 			dashboard.Name("Istio Mesh Dashboard"),
-			withMeshOverview(datasource, emptyLabelMatcher),
-			withMeshWorkloads(datasource, emptyLabelMatcher),
-			withIstioComponentVersions(datasource, emptyLabelMatcher),
+			dashboards.AddClusterVariable(datasource, clusterLabelName, "istio_build"),
+			withMeshOverview(datasource, queries, clusterLabelMatcher),
+			withMeshWorkloads(datasource, queries, clusterLabelMatcher),
+			withIstioComponentVersions(datasource, queries, clusterLabelMatcher),
 		),
 	).Component("istio")
 }
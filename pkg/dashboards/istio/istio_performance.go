@@ -19,6 +19,7 @@ import (
 	"github.com/perses/perses/go-sdk/dashboard"
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
 func withPerformanceNotes(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
@@ -29,65 +30,67 @@ func withPerformanceNotes(datasource string, labelMatcher *labels.Matcher) dashb
 	)
 }
 
-func withVCPUUsage(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withVCPUUsage(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("vCPU Usage",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.VCPUPer1kRPS(datasource, labelMatcher),
-		panels.VCPU(datasource, labelMatcher),
+		panels.VCPUPer1kRPS(datasource, queries, labelMatcher),
+		panels.VCPU(datasource, queries, labelMatcher),
 	)
 }
 
-func withMemoryAndDataRates(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withMemoryAndDataRates(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Memory and Data Rates",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.PerformanceMemoryUsage(datasource, labelMatcher),
-		panels.BytesTransferred(datasource, labelMatcher),
+		panels.PerformanceMemoryUsage(datasource, queries, labelMatcher),
+		panels.BytesTransferred(datasource, queries, labelMatcher),
 	)
 }
 
-func withIstioComponentVersionsPerf(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withIstioComponentVersionsPerf(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Istio Component Versions",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(8),
-		panels.IstioComponentsByVersion(datasource, labelMatcher),
+		panels.IstioComponentsByVersion(datasource, queries, labelMatcher),
 	)
 }
 
-func withProxyResourceUsage(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withProxyResourceUsage(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Proxy Resource Usage",
 		panelgroup.PanelsPerLine(3),
 		panelgroup.PanelHeight(7),
-		panels.ProxyMemory(datasource, labelMatcher),
-		panels.ProxyVCPU(datasource, labelMatcher),
-		panels.ProxyDisk(datasource, labelMatcher),
+		panels.ProxyMemory(datasource, queries, labelMatcher),
+		panels.ProxyVCPU(datasource, queries, labelMatcher),
+		panels.ProxyDisk(datasource, queries, labelMatcher),
 	)
 }
 
-func withIstiodResourceUsage(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withIstiodResourceUsage(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Istiod Resource Usage",
 		panelgroup.PanelsPerLine(4),
 		panelgroup.PanelHeight(7),
-		panels.IstiodMemory(datasource, labelMatcher),
-		panels.IstiodVCPU(datasource, labelMatcher),
-		panels.IstiodDisk(datasource, labelMatcher),
-		panels.IstiodGoroutines(datasource, labelMatcher),
+		panels.IstiodMemory(datasource, queries, labelMatcher),
+		panels.IstiodVCPU(datasource, queries, labelMatcher),
+		panels.IstiodDisk(datasource, queries, labelMatcher),
+		panels.IstiodGoroutines(datasource, queries, labelMatcher),
 	)
 }
 
-func BuildIstioPerformance(project string, datasource string, clusterLabelName string) dashboards.DashboardResult {
-	emptyLabelMatcher := &labels.Matcher{}
+func BuildIstioPerformance(project string, datasource string, clusterLabelName string, options ...IstioDashboardOption) dashboards.DashboardResult {
+	queries := panels.PanelQueries(newIstioDashboardConfig(options...).UseRecordingRules)
+	clusterLabelMatcher := dashboards.GetClusterLabelMatcherV2(clusterLabelName)
 	return dashboards.NewDashboardResult(
 		dashboard.New("istio-performance",
 			dashboard.ProjectName(project),
 			dashboard.Name("Istio Performance Dashboard"),
-			withPerformanceNotes(datasource, emptyLabelMatcher),
-			withVCPUUsage(datasource, emptyLabelMatcher),
-			withMemoryAndDataRates(datasource, emptyLabelMatcher),
-			withIstioComponentVersionsPerf(datasource, emptyLabelMatcher),
-			withProxyResourceUsage(datasource, emptyLabelMatcher),
-			withIstiodResourceUsage(datasource, emptyLabelMatcher),
+			dashboards.AddClusterVariable(datasource, clusterLabelName, "istio_build"),
+			withPerformanceNotes(datasource, clusterLabelMatcher),
+			withVCPUUsage(datasource, queries, clusterLabelMatcher),
+			withMemoryAndDataRates(datasource, queries, clusterLabelMatcher),
+			withIstioComponentVersionsPerf(datasource, queries, clusterLabelMatcher),
+			withProxyResourceUsage(datasource, queries, clusterLabelMatcher),
+			withIstiodResourceUsage(datasource, queries, clusterLabelMatcher),
 		),
 	).Component("istio")
 }
@@ -22,6 +22,7 @@ import (
 	markdownPanel "github.com/perses/plugins/markdown/sdk/go"
 	promqlVar "github.com/perses/plugins/prometheus/sdk/go/variable/promql"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
 // General section
@@ -37,25 +38,25 @@ func withGeneralSection() dashboard.Option {
 	)
 }
 
-func withGeneralSectionII(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withGeneralSectionII(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("General",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(4),
 		// First row of stats
-		panels.ClientRequestVolumeStat(datasource, labelMatcher),
-		panels.ClientSuccessRateStat(datasource, labelMatcher),
-		panels.ClientRequestDurationChart(datasource, labelMatcher),
-		panels.TCPReceivedBytesStat(datasource, labelMatcher),
+		panels.ClientRequestVolumeStat(datasource, queries, labelMatcher),
+		panels.ClientSuccessRateStat(datasource, queries, labelMatcher),
+		panels.ClientRequestDurationChart(datasource, queries, labelMatcher),
+		panels.TCPReceivedBytesStat(datasource, queries, labelMatcher),
 		// Second row of stats
-		panels.ServerRequestVolumeStat(datasource, labelMatcher),
-		panels.ServerSuccessRateStat(datasource, labelMatcher),
-		panels.ServerRequestDurationChart(datasource, labelMatcher),
-		panels.TCPSentBytesStat(datasource, labelMatcher),
+		panels.ServerRequestVolumeStat(datasource, queries, labelMatcher),
+		panels.ServerSuccessRateStat(datasource, queries, labelMatcher),
+		panels.ServerRequestDurationChart(datasource, queries, labelMatcher),
+		panels.TCPSentBytesStat(datasource, queries, labelMatcher),
 	)
 }
 
 // Client Workloads section
-func withClientWorkloadsSection(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withClientWorkloadsSection(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Client Workloads",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(6),
@@ -65,34 +66,34 @@ func withClientWorkloadsSection(datasource string, labelMatcher *labels.Matcher)
 				markdownPanel.Text("<div class=\"dashboard-header text-center\">\n<span>CLIENT WORKLOADS</span>\n</div>"),
 			),
 		),
-		panels.IncomingRequestsByClient(datasource, labelMatcher),
-		panels.IncomingSuccessRateByClient(datasource, labelMatcher),
+		panels.IncomingRequestsByClient(datasource, queries, labelMatcher),
+		panels.IncomingSuccessRateByClient(datasource, queries, labelMatcher),
 	)
 }
 
 // Client Workloads (II) section
-func withClientWorkloadsIISection(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withClientWorkloadsIISection(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Client Workloads (II)",
 		panelgroup.PanelsPerLine(3),
 		panelgroup.PanelHeight(6),
-		panels.IncomingRequestDurationByClient(datasource, labelMatcher),
-		panels.IncomingRequestSizeByClient(datasource, labelMatcher),
-		panels.ResponseSizeByClient(datasource, labelMatcher),
+		panels.IncomingRequestDurationByClient(datasource, queries, labelMatcher),
+		panels.IncomingRequestSizeByClient(datasource, queries, labelMatcher),
+		panels.ResponseSizeByClient(datasource, queries, labelMatcher),
 	)
 }
 
 // Client Workloads (III) section
-func withClientWorkloadsIIISection(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withClientWorkloadsIIISection(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Client Workloads (III)",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(6),
-		panels.BytesReceivedFromTCPClient(datasource, labelMatcher),
-		panels.BytesSentToTCPClient(datasource, labelMatcher),
+		panels.BytesReceivedFromTCPClient(datasource, queries, labelMatcher),
+		panels.BytesSentToTCPClient(datasource, queries, labelMatcher),
 	)
 }
 
 // Service Workloads section
-func withServiceWorkloadsSection(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withServiceWorkloadsSection(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Service Workloads",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(6),
@@ -102,33 +103,34 @@ func withServiceWorkloadsSection(datasource string, labelMatcher *labels.Matcher
 				markdownPanel.Text("<div class=\"dashboard-header text-center\">\n<span>SERVICE WORKLOADS</span>\n</div>"),
 			),
 		),
-		panels.IncomingRequestsByService(datasource, labelMatcher),
-		panels.IncomingSuccessRateByService(datasource, labelMatcher),
+		panels.IncomingRequestsByService(datasource, queries, labelMatcher),
+		panels.IncomingSuccessRateByService(datasource, queries, labelMatcher),
 	)
 }
 
 // Service Workloads (II) section
-func withServiceWorkloadsIISection(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withServiceWorkloadsIISection(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Service Workloads (II)",
 		panelgroup.PanelsPerLine(3),
 		panelgroup.PanelHeight(6),
-		panels.IncomingRequestDurationByService(datasource, labelMatcher),
-		panels.IncomingRequestSizeByService(datasource, labelMatcher),
-		panels.ResponseSizeByService(datasource, labelMatcher),
+		panels.IncomingRequestDurationByService(datasource, queries, labelMatcher),
+		panels.IncomingRequestSizeByService(datasource, queries, labelMatcher),
+		panels.ResponseSizeByService(datasource, queries, labelMatcher),
 	)
 }
 
 // Service Workloads (III) section
-func withServiceWorkloadsIIISection(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withServiceWorkloadsIIISection(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Service Workloads (III)",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(6),
-		panels.BytesReceivedFromTCPService(datasource, labelMatcher),
-		panels.BytesSentToTCPService(datasource, labelMatcher),
+		panels.BytesReceivedFromTCPService(datasource, queries, labelMatcher),
+		panels.BytesSentToTCPService(datasource, queries, labelMatcher),
 	)
 }
 
-func BuildIstioService(project string, datasource string, clusterLabelName string) dashboards.DashboardResult {
+func BuildIstioService(project string, datasource string, clusterLabelName string, options ...IstioDashboardOption) dashboards.DashboardResult {
+	queries := panels.PanelQueries(newIstioDashboardConfig(options...).UseRecordingRules)
 	clusterLabelMatcher := dashboards.GetClusterLabelMatcherV2(clusterLabelName)
 	return dashboards.NewDashboardResult(
 		dashboard.New("istio-service-dashboard",
@@ -239,13 +241,13 @@ func BuildIstioService(project string, datasource string, clusterLabelName strin
 				),
 			),
 			withGeneralSection(),
-			withGeneralSectionII(datasource, clusterLabelMatcher),
-			withClientWorkloadsSection(datasource, clusterLabelMatcher),
-			withClientWorkloadsIISection(datasource, clusterLabelMatcher),
-			withClientWorkloadsIIISection(datasource, clusterLabelMatcher),
-			withServiceWorkloadsSection(datasource, clusterLabelMatcher),
-			withServiceWorkloadsIISection(datasource, clusterLabelMatcher),
-			withServiceWorkloadsIIISection(datasource, clusterLabelMatcher),
+			withGeneralSectionII(datasource, queries, clusterLabelMatcher),
+			withClientWorkloadsSection(datasource, queries, clusterLabelMatcher),
+			withClientWorkloadsIISection(datasource, queries, clusterLabelMatcher),
+			withClientWorkloadsIIISection(datasource, queries, clusterLabelMatcher),
+			withServiceWorkloadsSection(datasource, queries, clusterLabelMatcher),
+			withServiceWorkloadsIISection(datasource, queries, clusterLabelMatcher),
+			withServiceWorkloadsIIISection(datasource, queries, clusterLabelMatcher),
 		),
 	).Component("istio")
 }
@@ -23,6 +23,7 @@ import (
 	labelValuesVar "github.com/perses/plugins/prometheus/sdk/go/variable/label-values"
 	promqlVar "github.com/perses/plugins/prometheus/sdk/go/variable/promql"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
 // Helper function to create markdown header panels
@@ -46,22 +47,22 @@ func withWorkloadGeneralSection() dashboard.Option {
 	)
 }
 
-func withWorkloadGeneralIISection(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadGeneralIISection(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("General (II)",
 		panelgroup.PanelsPerLine(3),
 		panelgroup.PanelHeight(4),
-		panels.IncomingRequestVolumeStat(datasource, labelMatcher),
-		panels.IncomingSuccessRateStat(datasource, labelMatcher),
-		panels.RequestDurationChart(datasource, labelMatcher),
+		panels.IncomingRequestVolumeStat(datasource, queries, labelMatcher),
+		panels.IncomingSuccessRateStat(datasource, queries, labelMatcher),
+		panels.RequestDurationChart(datasource, queries, labelMatcher),
 	)
 }
 
-func withWorkloadGeneralIIISection(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadGeneralIIISection(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("General (III)",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(4),
-		panels.TCPServerTrafficStat(datasource, labelMatcher),
-		panels.TCPClientTrafficStat(datasource, labelMatcher),
+		panels.TCPServerTrafficStat(datasource, queries, labelMatcher),
+		panels.TCPClientTrafficStat(datasource, queries, labelMatcher),
 	)
 }
 
@@ -77,31 +78,31 @@ func withWorkloadInboundWorkloadsSection() dashboard.Option {
 	)
 }
 
-func withWorkloadInboundWorkloadsIISection(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadInboundWorkloadsIISection(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Inbound Workloads",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(6),
-		panels.IncomingRequestVolume(datasource, labelMatcher), // "Incoming Requests By Source And Response Code"
-		panels.IncomingSuccessRate(datasource, labelMatcher),   // "Incoming Success Rate (non-5xx responses) By Source"
+		panels.IncomingRequestVolume(datasource, queries, labelMatcher), // "Incoming Requests By Source And Response Code"
+		panels.IncomingSuccessRate(datasource, queries, labelMatcher),   // "Incoming Success Rate (non-5xx responses) By Source"
 	)
 }
 
-func withWorkloadInboundWorkloadsIIISection(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadInboundWorkloadsIIISection(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Inbound Workloads (II)",
 		panelgroup.PanelsPerLine(3),
 		panelgroup.PanelHeight(6),
-		panels.IncomingRequestDuration(datasource, labelMatcher),      // "Incoming Request Duration By Source"
-		panels.IncomingRequestSizeBySource(datasource, labelMatcher),  // "Incoming Request Size By Source"
-		panels.IncomingResponseSizeBySource(datasource, labelMatcher), // "Incoming Response Size By Source"
+		panels.IncomingRequestDuration(datasource, queries, labelMatcher),      // "Incoming Request Duration By Source"
+		panels.IncomingRequestSizeBySource(datasource, queries, labelMatcher),  // "Incoming Request Size By Source"
+		panels.IncomingResponseSizeBySource(datasource, queries, labelMatcher), // "Incoming Response Size By Source"
 	)
 }
 
-func withWorkloadInboundWorkloadsIVSection(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadInboundWorkloadsIVSection(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Inbound Workloads (III)",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(6),
-		panels.InboundTCPBytesReceived(datasource, labelMatcher), // "Bytes Received from Incoming TCP Connection"
-		panels.InboundTCPBytesSent(datasource, labelMatcher),     // "Bytes Sent to Incoming TCP Connection"
+		panels.InboundTCPBytesReceived(datasource, queries, labelMatcher), // "Bytes Received from Incoming TCP Connection"
+		panels.InboundTCPBytesSent(datasource, queries, labelMatcher),     // "Bytes Sent to Incoming TCP Connection"
 	)
 }
 
@@ -113,35 +114,36 @@ func withWorkloadOutboundServicesSection() dashboard.Option {
 	)
 }
 
-func withWorkloadOutboundServicesIISection(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadOutboundServicesIISection(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Outbound Services (II)",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(6),
-		panels.OutgoingRequestVolume(datasource, labelMatcher),
-		panels.OutgoingSuccessRate(datasource, labelMatcher),
+		panels.OutgoingRequestVolume(datasource, queries, labelMatcher),
+		panels.OutgoingSuccessRate(datasource, queries, labelMatcher),
 	)
 }
 
-func withWorkloadOutboundServicesIIISection(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadOutboundServicesIIISection(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Outbound Services (III)",
 		panelgroup.PanelsPerLine(3),
 		panelgroup.PanelHeight(6),
-		panels.OutgoingRequestDuration(datasource, labelMatcher),
-		panels.OutgoingRequestSize(datasource, labelMatcher),
-		panels.OutgoingResponseSize(datasource, labelMatcher),
+		panels.OutgoingRequestDuration(datasource, queries, labelMatcher),
+		panels.OutgoingRequestSize(datasource, queries, labelMatcher),
+		panels.OutgoingResponseSize(datasource, queries, labelMatcher),
 	)
 }
 
-func withWorkloadOutboundServicesIVSection(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadOutboundServicesIVSection(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Outbound Services (IV)",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(6),
-		panels.TCPBytesSent(datasource, labelMatcher),
-		panels.TCPBytesReceived(datasource, labelMatcher),
+		panels.TCPBytesSent(datasource, queries, labelMatcher),
+		panels.TCPBytesReceived(datasource, queries, labelMatcher),
 	)
 }
 
-func BuildIstioWorkload(project string, datasource string, clusterLabelName string) dashboards.DashboardResult {
+func BuildIstioWorkload(project string, datasource string, clusterLabelName string, options ...IstioDashboardOption) dashboards.DashboardResult {
+	queries := panels.PanelQueries(newIstioDashboardConfig(options...).UseRecordingRules)
 	clusterLabelMatcher := dashboards.GetClusterLabelMatcherV2(clusterLabelName)
 	return dashboards.NewDashboardResult(
 		dashboard.New("istio-workload-dashboard",
@@ -218,16 +220,16 @@ func BuildIstioWorkload(project string, datasource string, clusterLabelName stri
 			),
 			// Add all sections that match the JSON layout
 			withWorkloadGeneralSection(),
-			withWorkloadGeneralIISection(datasource, clusterLabelMatcher),
-			withWorkloadGeneralIIISection(datasource, clusterLabelMatcher),
+			withWorkloadGeneralIISection(datasource, queries, clusterLabelMatcher),
+			withWorkloadGeneralIIISection(datasource, queries, clusterLabelMatcher),
 			withWorkloadInboundWorkloadsSection(),
-			withWorkloadInboundWorkloadsIISection(datasource, clusterLabelMatcher),
-			withWorkloadInboundWorkloadsIIISection(datasource, clusterLabelMatcher),
-			withWorkloadInboundWorkloadsIVSection(datasource, clusterLabelMatcher),
+			withWorkloadInboundWorkloadsIISection(datasource, queries, clusterLabelMatcher),
+			withWorkloadInboundWorkloadsIIISection(datasource, queries, clusterLabelMatcher),
+			withWorkloadInboundWorkloadsIVSection(datasource, queries, clusterLabelMatcher),
 			withWorkloadOutboundServicesSection(),
-			withWorkloadOutboundServicesIISection(datasource, clusterLabelMatcher),
-			withWorkloadOutboundServicesIIISection(datasource, clusterLabelMatcher),
-			withWorkloadOutboundServicesIVSection(datasource, clusterLabelMatcher),
+			withWorkloadOutboundServicesIISection(datasource, queries, clusterLabelMatcher),
+			withWorkloadOutboundServicesIIISection(datasource, queries, clusterLabelMatcher),
+			withWorkloadOutboundServicesIVSection(datasource, queries, clusterLabelMatcher),
 		),
 	).Component("istio")
 }
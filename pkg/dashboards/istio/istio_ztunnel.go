@@ -19,56 +19,59 @@ import (
 	"github.com/perses/perses/go-sdk/dashboard"
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func withProcessGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withProcessGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Process",
 		panelgroup.PanelsPerLine(3),
 		panelgroup.PanelHeight(8),
-		panels.ZtunnelVersions(datasource, labelMatcher),
-		panels.ZtunnelMemoryUsage(datasource, labelMatcher),
-		panels.ZtunnelCPUUsage(datasource, labelMatcher),
+		panels.ZtunnelVersions(datasource, queries, labelMatcher),
+		panels.ZtunnelMemoryUsage(datasource, queries, labelMatcher),
+		panels.ZtunnelCPUUsage(datasource, queries, labelMatcher),
 	)
 }
 
-func withNetworkGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withNetworkGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Network",
 		panelgroup.PanelsPerLine(3),
 		panelgroup.PanelHeight(8),
-		panels.ZtunnelConnections(datasource, labelMatcher),
-		panels.ZtunnelBytesTransmitted(datasource, labelMatcher),
-		panels.ZtunnelDNSRequest(datasource, labelMatcher),
+		panels.ZtunnelConnections(datasource, queries, labelMatcher),
+		panels.ZtunnelBytesTransmitted(datasource, queries, labelMatcher),
+		panels.ZtunnelDNSRequest(datasource, queries, labelMatcher),
 	)
 }
 
-func withOperationsGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withOperationsGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Operations",
 		panelgroup.PanelsPerLine(3),
 		panelgroup.PanelHeight(8),
-		panels.ZtunnelXDSConnections(datasource, labelMatcher),
-		panels.ZtunnelXDSPushes(datasource, labelMatcher),
-		panels.ZtunnelWorkloadManager(datasource, labelMatcher),
+		panels.ZtunnelXDSConnections(datasource, queries, labelMatcher),
+		panels.ZtunnelXDSPushes(datasource, queries, labelMatcher),
+		panels.ZtunnelWorkloadManager(datasource, queries, labelMatcher),
 	)
 }
 
-func withNetworkResourcesGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withNetworkResourcesGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Network Resources",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(8),
-		panels.ZtunnelResourceUsage(datasource, labelMatcher),
+		panels.ZtunnelResourceUsage(datasource, queries, labelMatcher),
 	)
 }
 
-func BuildIstioZtunnel(project string, datasource string, clusterLabelName string) dashboards.DashboardResult {
-	emptyLabelMatcher := &labels.Matcher{}
+func BuildIstioZtunnel(project string, datasource string, clusterLabelName string, options ...IstioDashboardOption) dashboards.DashboardResult {
+	queries := panels.PanelQueries(newIstioDashboardConfig(options...).UseRecordingRules)
+	clusterLabelMatcher := dashboards.GetClusterLabelMatcherV2(clusterLabelName)
 	return dashboards.NewDashboardResult(
 		dashboard.New("istio-ztunnel-dashboard",
 			dashboard.ProjectName(project),
 			dashboard.Name("Istio Ztunnel Dashboard"),
-			withProcessGroup(datasource, emptyLabelMatcher),
-			withNetworkGroup(datasource, emptyLabelMatcher),
-			withOperationsGroup(datasource, emptyLabelMatcher),
-			withNetworkResourcesGroup(datasource, emptyLabelMatcher),
+			dashboards.AddClusterVariable(datasource, clusterLabelName, "istio_build"),
+			withProcessGroup(datasource, queries, clusterLabelMatcher),
+			withNetworkGroup(datasource, queries, clusterLabelMatcher),
+			withOperationsGroup(datasource, queries, clusterLabelMatcher),
+			withNetworkResourcesGroup(datasource, queries, clusterLabelMatcher),
 		),
 	).Component("istio")
 }
@@ -0,0 +1,38 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package istio
+
+// IstioDashboardConfig holds the settings shared by the Istio dashboard builders.
+type IstioDashboardConfig struct {
+	UseRecordingRules bool
+}
+
+type IstioDashboardOption func(*IstioDashboardConfig)
+
+// WithRecordingRules makes the panels query the workload rates recorded by the Istio rules
+// (see pkg/rules/istio) instead of the raw Istio standard metrics.
+// Only enable it when the Istio recording rules are loaded in the queried Prometheus.
+func WithRecordingRules(useRecordingRules bool) IstioDashboardOption {
+	return func(config *IstioDashboardConfig) {
+		config.UseRecordingRules = useRecordingRules
+	}
+}
+
+func newIstioDashboardConfig(options ...IstioDashboardOption) IstioDashboardConfig {
+	var config IstioDashboardConfig
+	for _, option := range options {
+		option(&config)
+	}
+	return config
+}
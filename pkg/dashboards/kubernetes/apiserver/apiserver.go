@@ -15,6 +15,7 @@ package apiserver
 
 import (
 	"github.com/perses/community-mixins/pkg/dashboards"
+	"github.com/perses/community-mixins/pkg/dashboards/kubernetes"
 	panelsGostats "github.com/perses/community-mixins/pkg/panels/gostats"
 	panels "github.com/perses/community-mixins/pkg/panels/kubernetes"
 	"github.com/perses/community-mixins/pkg/promql"
@@ -22,7 +23,9 @@ import (
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
 	listVar "github.com/perses/perses/go-sdk/variable/list-variable"
 	labelValuesVar "github.com/perses/plugins/prometheus/sdk/go/variable/label-values"
+	"github.com/perses/promql-builder/vector"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
 func withMarkdown(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
@@ -33,56 +36,52 @@ func withMarkdown(datasource string, labelMatcher *labels.Matcher) dashboard.Opt
 	)
 }
 
-func withAllAvailabilityAndErrorBudget(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withAllAvailabilityAndErrorBudget(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("All Availability And Error Budget",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.APIServerAvailability(datasource, labelMatcher),
-		panels.APIServerErrorBudget(datasource, labelMatcher),
+		panels.APIServerAvailability(datasource, queries, labelMatcher),
+		panels.APIServerErrorBudget(datasource, queries, labelMatcher),
 	)
 }
 
-func withReadStats(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withReadStats(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("API Server Read",
 		panelgroup.PanelsPerLine(4),
 		panelgroup.PanelHeight(8),
-		panels.APIServerReadAvailability(datasource, labelMatcher),
-		panels.APIServerReadSLIRequests(datasource, labelMatcher),
-		panels.APIServerReadSLIErrors(datasource, labelMatcher),
-		panels.APIServerReadSLIDuration(datasource, labelMatcher),
+		panels.APIServerReadAvailability(datasource, queries, labelMatcher),
+		panels.APIServerReadSLIRequests(datasource, queries, labelMatcher),
+		panels.APIServerReadSLIErrors(datasource, queries, labelMatcher),
+		panels.APIServerReadSLIDuration(datasource, queries, labelMatcher),
 	)
 }
 
-func withWriteStats(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWriteStats(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("API Server Write",
 		panelgroup.PanelsPerLine(4),
 		panelgroup.PanelHeight(8),
-		panels.APIServerWriteAvailability(datasource, labelMatcher),
-		panels.APIServerWriteSLIRequests(datasource, labelMatcher),
-		panels.APIServerWriteSLIErrors(datasource, labelMatcher),
-		panels.APIServerWriteSLIDuration(datasource, labelMatcher),
+		panels.APIServerWriteAvailability(datasource, queries, labelMatcher),
+		panels.APIServerWriteSLIRequests(datasource, queries, labelMatcher),
+		panels.APIServerWriteSLIErrors(datasource, queries, labelMatcher),
+		panels.APIServerWriteSLIDuration(datasource, queries, labelMatcher),
 	)
 }
 
-func withWorkQueueGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkQueueGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Work Queue",
 		panelgroup.PanelsPerLine(3),
 		panelgroup.PanelHeight(8),
-		panels.APIServerWorkQueueAddRate(datasource, labelMatcher),
-		panels.APIServerWorkQueueDepth(datasource, labelMatcher),
-		panels.APIServerWorkQueueLatency(datasource, labelMatcher),
+		panels.APIServerWorkQueueAddRate(datasource, queries, labelMatcher),
+		panels.APIServerWorkQueueDepth(datasource, queries, labelMatcher),
+		panels.APIServerWorkQueueLatency(datasource, queries, labelMatcher),
 	)
 }
 
-func withAPIServerResources(datasource string, clusterLabelMatcher *labels.Matcher) dashboard.Option {
+func withAPIServerResources(datasource string, jobMatcher *labels.Matcher, clusterLabelMatcher *labels.Matcher) dashboard.Option {
 	labelMatchersToUse := []*labels.Matcher{
 		promql.ClusterVarV2,
 		promql.InstanceVarV2,
-		{
-			Name:  "job",
-			Value: panels.API_SERVER_LABEL_VALUE,
-			Type:  labels.MatchEqual,
-		},
+		jobMatcher,
 	}
 
 	labelMatchersToUse = append(labelMatchersToUse, clusterLabelMatcher)
@@ -97,12 +96,20 @@ func withAPIServerResources(datasource string, clusterLabelMatcher *labels.Match
 	)
 }
 
-func BuildAPIServerOverview(project string, datasource string, clusterLabelName string, variableOverrides ...dashboard.Option) dashboards.DashboardResult {
+func BuildAPIServerOverview(project string, datasource string, clusterLabelName string, opts ...kubernetes.KubernetesDashboardOption) dashboards.DashboardResult {
+	config := kubernetes.NewKubernetesDashboardConfig(opts...)
+	queries := config.PanelQueries()
 	defaultVars := []dashboard.Option{
 		dashboard.AddVariable("cluster",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("cluster",
-					labelValuesVar.Matchers("up{"+panels.GetAPIServerMatcher()+"}"),
+					dashboards.AddVariableMatcher(
+						vector.New(
+							vector.WithMetricName("up"),
+							vector.WithLabelMatchers(config.JobLabelValues.APIServerMatcher()),
+						),
+						nil,
+					),
 					dashboards.AddVariableDatasource(datasource),
 				),
 				listVar.DisplayName("cluster"),
@@ -111,11 +118,12 @@ func BuildAPIServerOverview(project string, datasource string, clusterLabelName
 		dashboard.AddVariable("instance",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("instance",
-					labelValuesVar.Matchers(
-						promql.SetLabelMatchers(
-							"up{"+panels.GetAPIServerMatcher()+"}",
-							[]promql.LabelMatcher{{Name: "cluster", Type: "=", Value: "$cluster"}},
+					dashboards.AddVariableMatcher(
+						vector.New(
+							vector.WithMetricName("up"),
+							vector.WithLabelMatchers(config.JobLabelValues.APIServerMatcher()),
 						),
+						[]*labels.Matcher{{Name: "cluster", Type: labels.MatchEqual, Value: "$cluster"}},
 					),
 					dashboards.AddVariableDatasource(datasource),
 				),
@@ -126,21 +134,18 @@ func BuildAPIServerOverview(project string, datasource string, clusterLabelName
 
 	clusterLabelMatcher := dashboards.GetClusterLabelMatcherV2(clusterLabelName)
 
-	vars := defaultVars
-	if len(variableOverrides) > 0 {
-		vars = variableOverrides
-	}
+	vars := config.Variables(defaultVars)
 	options := append([]dashboard.Option{
 		dashboard.ProjectName(project),
 		dashboard.Name("Kubernetes / API server"),
 	}, vars...)
 	options = append(options,
 		withMarkdown(datasource, clusterLabelMatcher),
-		withAllAvailabilityAndErrorBudget(datasource, clusterLabelMatcher),
-		withReadStats(datasource, clusterLabelMatcher),
-		withWriteStats(datasource, clusterLabelMatcher),
-		withWorkQueueGroup(datasource, clusterLabelMatcher),
-		withAPIServerResources(datasource, clusterLabelMatcher),
+		withAllAvailabilityAndErrorBudget(datasource, queries, clusterLabelMatcher),
+		withReadStats(datasource, queries, clusterLabelMatcher),
+		withWriteStats(datasource, queries, clusterLabelMatcher),
+		withWorkQueueGroup(datasource, queries, clusterLabelMatcher),
+		withAPIServerResources(datasource, config.JobLabelValues.APIServerMatcher(), clusterLabelMatcher),
 	)
 	return dashboards.NewDashboardResult(
 		dashboard.New("api-server-overview", options...),
@@ -15,127 +15,138 @@ package compute_resources
 
 import (
 	"github.com/perses/community-mixins/pkg/dashboards"
+	"github.com/perses/community-mixins/pkg/dashboards/kubernetes"
 	panels "github.com/perses/community-mixins/pkg/panels/kubernetes"
 	"github.com/perses/perses/go-sdk/dashboard"
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
 
 	listVar "github.com/perses/perses/go-sdk/variable/list-variable"
 	labelValuesVar "github.com/perses/plugins/prometheus/sdk/go/variable/label-values"
+	"github.com/perses/promql-builder/vector"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func withClusterStatsGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withClusterStatsGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Cluster Stats",
 		panelgroup.PanelsPerLine(6),
 		panelgroup.PanelHeight(4),
-		panels.KubernetesCPUUtilizationStat("cluster", datasource, labelMatcher),
-		panels.KubernetesCPURequestsCommitmentStat("cluster", datasource, labelMatcher),
-		panels.KubernetesCPULimitsCommitmentStat("cluster", datasource, labelMatcher),
-		panels.KubernetesMemoryUtilizationStat("cluster", datasource, labelMatcher),
-		panels.KubernetesMemoryRequestsCommitmentStat("cluster", datasource, labelMatcher),
-		panels.KubernetesMemoryLimitsCommitmentStat("cluster", datasource, labelMatcher),
+		panels.KubernetesCPUUtilizationStat("cluster", datasource, queries, labelMatcher),
+		panels.KubernetesCPURequestsCommitmentStat("cluster", datasource, queries, labelMatcher),
+		panels.KubernetesCPULimitsCommitmentStat("cluster", datasource, queries, labelMatcher),
+		panels.KubernetesMemoryUtilizationStat("cluster", datasource, queries, labelMatcher),
+		panels.KubernetesMemoryRequestsCommitmentStat("cluster", datasource, queries, labelMatcher),
+		panels.KubernetesMemoryLimitsCommitmentStat("cluster", datasource, queries, labelMatcher),
 	)
 }
 
-func withClusterCPUUsageGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withClusterCPUUsageGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("CPU Usage",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesCPUUsage("cluster", datasource, labelMatcher),
+		panels.KubernetesCPUUsage("cluster", datasource, queries, labelMatcher),
 	)
 }
 
-func withClusterCPUUsageQuotaGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withClusterCPUUsageQuotaGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("CPU Usage Quota",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(10),
-		panels.ClusterCPUUsageQuota(datasource, labelMatcher),
+		panels.ClusterCPUUsageQuota(datasource, queries, labelMatcher),
 	)
 }
 
-func withClusterMemoryUsageGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withClusterMemoryUsageGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Memory Usage",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesMemoryUsage("cluster", datasource, labelMatcher),
+		panels.KubernetesMemoryUsage("cluster", datasource, queries, labelMatcher),
 	)
 }
 
-func withClusterMemoryUsageQuotaGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withClusterMemoryUsageQuotaGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Memory Usage Quota",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(10),
-		panels.ClusterMemoryUsageQuota(datasource, labelMatcher),
+		panels.ClusterMemoryUsageQuota(datasource, queries, labelMatcher),
 	)
 }
 
-func withClusterNetworkUsageGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withClusterNetworkUsageGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Network Usage",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(10),
-		panels.ClusterCurrentNetworkUsage(datasource, labelMatcher),
+		panels.ClusterCurrentNetworkUsage(datasource, queries, labelMatcher),
 	)
 }
 
-func withClusterBandwidthGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withClusterBandwidthGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Bandwidth",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesReceiveBandwidth("cluster", datasource, labelMatcher),
-		panels.KubernetesTransmitBandwidth("cluster", datasource, labelMatcher),
+		panels.KubernetesReceiveBandwidth("cluster", datasource, queries, labelMatcher),
+		panels.KubernetesTransmitBandwidth("cluster", datasource, queries, labelMatcher),
 	)
 }
 
-func withClusterAvgBandwidthGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withClusterAvgBandwidthGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Average Container Bandwidth",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesAvgContainerBandwidthReceived("cluster", datasource, labelMatcher),
-		panels.KubernetesAvgContainerBandwidthTransmitted("cluster", datasource, labelMatcher),
+		panels.KubernetesAvgContainerBandwidthReceived("cluster", datasource, queries, labelMatcher),
+		panels.KubernetesAvgContainerBandwidthTransmitted("cluster", datasource, queries, labelMatcher),
 	)
 }
 
-func withClusterRateOfPacketsGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withClusterRateOfPacketsGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Rate of Packets",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesReceivedPackets("cluster", datasource, labelMatcher),
-		panels.KubernetesTransmittedPackets("cluster", datasource, labelMatcher),
+		panels.KubernetesReceivedPackets("cluster", datasource, queries, labelMatcher),
+		panels.KubernetesTransmittedPackets("cluster", datasource, queries, labelMatcher),
 	)
 }
 
-func withClusterRateOfPacketsDroppedGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withClusterRateOfPacketsDroppedGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Rate of Packets Dropped",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesReceivedPacketsDropped("cluster", datasource, labelMatcher),
-		panels.KubernetesTransmittedPacketsDropped("cluster", datasource, labelMatcher),
+		panels.KubernetesReceivedPacketsDropped("cluster", datasource, queries, labelMatcher),
+		panels.KubernetesTransmittedPacketsDropped("cluster", datasource, queries, labelMatcher),
 	)
 }
 
-func withClusterStorageIOGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withClusterStorageIOGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Storage IO",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesIOPS("cluster", datasource, labelMatcher),
-		panels.KubernetesThroughput("cluster", datasource, labelMatcher),
+		panels.KubernetesIOPS("cluster", datasource, queries, labelMatcher),
+		panels.KubernetesThroughput("cluster", datasource, queries, labelMatcher),
 	)
 }
 
-func withClusterCurrentStorageIOGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withClusterCurrentStorageIOGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Storage IO - Distribution",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(10),
-		panels.ClusterCurrentStorageIO(datasource, labelMatcher),
+		panels.ClusterCurrentStorageIO(datasource, queries, labelMatcher),
 	)
 }
 
-func BuildKubernetesClusterOverview(project string, datasource string, clusterLabelName string, variableOverrides ...dashboard.Option) dashboards.DashboardResult {
+func BuildKubernetesClusterOverview(project string, datasource string, clusterLabelName string, opts ...kubernetes.KubernetesDashboardOption) dashboards.DashboardResult {
+	config := kubernetes.NewKubernetesDashboardConfig(opts...)
+	queries := config.PanelQueries()
 	defaultVars := []dashboard.Option{
 		dashboard.AddVariable("cluster",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("cluster",
-					labelValuesVar.Matchers("up{"+panels.GetKubeletMatcher()+"}"),
+					dashboards.AddVariableMatcher(
+						vector.New(
+							vector.WithMetricName("up"),
+							vector.WithLabelMatchers(config.JobLabelValues.KubeletMatcher()),
+						),
+						nil,
+					),
 					dashboards.AddVariableDatasource(datasource),
 				),
 				listVar.DisplayName("cluster"),
@@ -143,27 +154,24 @@ func BuildKubernetesClusterOverview(project string, datasource string, clusterLa
 		),
 	}
 	clusterLabelMatcher := dashboards.GetClusterLabelMatcherV2(clusterLabelName)
-	vars := defaultVars
-	if len(variableOverrides) > 0 {
-		vars = variableOverrides
-	}
+	vars := config.Variables(defaultVars)
 	options := append([]dashboard.Option{
 		dashboard.ProjectName(project),
 		dashboard.Name("Kubernetes / Compute Resources / Cluster"),
 	}, vars...)
 	options = append(options,
-		withClusterStatsGroup(datasource, clusterLabelMatcher),
-		withClusterCPUUsageGroup(datasource, clusterLabelMatcher),
-		withClusterCPUUsageQuotaGroup(datasource, clusterLabelMatcher),
-		withClusterMemoryUsageGroup(datasource, clusterLabelMatcher),
-		withClusterMemoryUsageQuotaGroup(datasource, clusterLabelMatcher),
-		withClusterNetworkUsageGroup(datasource, clusterLabelMatcher),
-		withClusterBandwidthGroup(datasource, clusterLabelMatcher),
-		withClusterAvgBandwidthGroup(datasource, clusterLabelMatcher),
-		withClusterRateOfPacketsGroup(datasource, clusterLabelMatcher),
-		withClusterRateOfPacketsDroppedGroup(datasource, clusterLabelMatcher),
-		withClusterStorageIOGroup(datasource, clusterLabelMatcher),
-		withClusterCurrentStorageIOGroup(datasource, clusterLabelMatcher),
+		withClusterStatsGroup(datasource, queries, clusterLabelMatcher),
+		withClusterCPUUsageGroup(datasource, queries, clusterLabelMatcher),
+		withClusterCPUUsageQuotaGroup(datasource, queries, clusterLabelMatcher),
+		withClusterMemoryUsageGroup(datasource, queries, clusterLabelMatcher),
+		withClusterMemoryUsageQuotaGroup(datasource, queries, clusterLabelMatcher),
+		withClusterNetworkUsageGroup(datasource, queries, clusterLabelMatcher),
+		withClusterBandwidthGroup(datasource, queries, clusterLabelMatcher),
+		withClusterAvgBandwidthGroup(datasource, queries, clusterLabelMatcher),
+		withClusterRateOfPacketsGroup(datasource, queries, clusterLabelMatcher),
+		withClusterRateOfPacketsDroppedGroup(datasource, queries, clusterLabelMatcher),
+		withClusterStorageIOGroup(datasource, queries, clusterLabelMatcher),
+		withClusterCurrentStorageIOGroup(datasource, queries, clusterLabelMatcher),
 	)
 	return dashboards.NewDashboardResult(
 		dashboard.New("kubernetes-cluster-resources-overview", options...),
@@ -15,68 +15,75 @@ package compute_resources
 
 import (
 	"github.com/perses/community-mixins/pkg/dashboards"
+	"github.com/perses/community-mixins/pkg/dashboards/kubernetes"
 	panels "github.com/perses/community-mixins/pkg/panels/kubernetes"
 	"github.com/perses/perses/go-sdk/dashboard"
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func withMultiClusterStatsGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withMultiClusterStatsGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Multi-Cluster Stats",
 		panelgroup.PanelsPerLine(6),
 		panelgroup.PanelHeight(4),
-		panels.KubernetesCPUUtilizationStat("multicluster", datasource, labelMatcher),
-		panels.KubernetesCPURequestsCommitmentStat("multicluster", datasource, labelMatcher),
-		panels.KubernetesCPULimitsCommitmentStat("multicluster", datasource, labelMatcher),
-		panels.KubernetesMemoryUtilizationStat("multicluster", datasource, labelMatcher),
-		panels.KubernetesMemoryRequestsCommitmentStat("multicluster", datasource, labelMatcher),
-		panels.KubernetesMemoryLimitsCommitmentStat("multicluster", datasource, labelMatcher),
+		panels.KubernetesCPUUtilizationStat("multicluster", datasource, queries, labelMatcher),
+		panels.KubernetesCPURequestsCommitmentStat("multicluster", datasource, queries, labelMatcher),
+		panels.KubernetesCPULimitsCommitmentStat("multicluster", datasource, queries, labelMatcher),
+		panels.KubernetesMemoryUtilizationStat("multicluster", datasource, queries, labelMatcher),
+		panels.KubernetesMemoryRequestsCommitmentStat("multicluster", datasource, queries, labelMatcher),
+		panels.KubernetesMemoryLimitsCommitmentStat("multicluster", datasource, queries, labelMatcher),
 	)
 }
 
-func withMultiClusterCPUUsageGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withMultiClusterCPUUsageGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Multi-Cluster CPU Usage",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesCPUUsage("multicluster", datasource, labelMatcher),
+		panels.KubernetesCPUUsage("multicluster", datasource, queries, labelMatcher),
 	)
 }
 
-func withMultiClusterCPUUsageQuotaGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withMultiClusterCPUUsageQuotaGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Multi-Cluster CPU Usage Quota",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(10),
-		panels.MultiClusterCPUUsageQuota(datasource, labelMatcher),
+		panels.MultiClusterCPUUsageQuota(datasource, queries, labelMatcher),
 	)
 }
 
-func withMultiClusterMemoryUsageGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withMultiClusterMemoryUsageGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Multi-Cluster Memory Usage",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesMemoryUsage("multicluster", datasource, labelMatcher),
+		panels.KubernetesMemoryUsage("multicluster", datasource, queries, labelMatcher),
 	)
 }
 
-func withMultiClusterMemoryUsageQuotaGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withMultiClusterMemoryUsageQuotaGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Multi-Cluster Memory Usage Quota",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(10),
-		panels.MultiClusterMemoryUsageQuota(datasource, labelMatcher),
+		panels.MultiClusterMemoryUsageQuota(datasource, queries, labelMatcher),
 	)
 }
 
-func BuildKubernetesMultiClusterOverview(project string, datasource string, clusterLabelName string) dashboards.DashboardResult {
+func BuildKubernetesMultiClusterOverview(project string, datasource string, clusterLabelName string, opts ...kubernetes.KubernetesDashboardOption) dashboards.DashboardResult {
+	config := kubernetes.NewKubernetesDashboardConfig(opts...)
+	queries := config.PanelQueries()
 	clusterLabelMatcher := dashboards.GetClusterLabelMatcherV2(clusterLabelName)
+	options := append([]dashboard.Option{
+		dashboard.ProjectName(project),
+		dashboard.Name("Kubernetes / Compute Resources / Multi-Cluster"),
+	}, config.Variables(nil)...)
+	options = append(options,
+		withMultiClusterStatsGroup(datasource, queries, clusterLabelMatcher),
+		withMultiClusterCPUUsageGroup(datasource, queries, clusterLabelMatcher),
+		withMultiClusterCPUUsageQuotaGroup(datasource, queries, clusterLabelMatcher),
+		withMultiClusterMemoryUsageGroup(datasource, queries, clusterLabelMatcher),
+		withMultiClusterMemoryUsageQuotaGroup(datasource, queries, clusterLabelMatcher),
+	)
 	return dashboards.NewDashboardResult(
-		dashboard.New("kubernetes-multi-cluster-resources-overview",
-			dashboard.ProjectName(project),
-			dashboard.Name("Kubernetes / Compute Resources / Multi-Cluster"),
-			withMultiClusterStatsGroup(datasource, clusterLabelMatcher),
-			withMultiClusterCPUUsageGroup(datasource, clusterLabelMatcher),
-			withMultiClusterCPUUsageQuotaGroup(datasource, clusterLabelMatcher),
-			withMultiClusterMemoryUsageGroup(datasource, clusterLabelMatcher),
-			withMultiClusterMemoryUsageQuotaGroup(datasource, clusterLabelMatcher),
-		),
+		dashboard.New("kubernetes-multi-cluster-resources-overview", options...),
 	).Component("kubernetes")
 }
@@ -15,121 +15,131 @@ package compute_resources
 
 import (
 	"github.com/perses/community-mixins/pkg/dashboards"
+	"github.com/perses/community-mixins/pkg/dashboards/kubernetes"
 	panels "github.com/perses/community-mixins/pkg/panels/kubernetes"
-	"github.com/perses/community-mixins/pkg/promql"
 	"github.com/perses/perses/go-sdk/dashboard"
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
+	"github.com/perses/promql-builder/vector"
 
 	listVar "github.com/perses/perses/go-sdk/variable/list-variable"
 	labelValuesVar "github.com/perses/plugins/prometheus/sdk/go/variable/label-values"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func withNamespaceStatsGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withNamespaceStatsGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Namespace Stats",
 		panelgroup.PanelsPerLine(4),
 		panelgroup.PanelHeight(4),
-		panels.KubernetesCPUUtilizationStat("namespace-requests", datasource, labelMatcher),
-		panels.KubernetesCPUUtilizationStat("namespace-limits", datasource, labelMatcher),
-		panels.KubernetesMemoryUtilizationStat("namespace-requests", datasource, labelMatcher),
-		panels.KubernetesMemoryUtilizationStat("namespace-limits", datasource, labelMatcher),
+		panels.KubernetesCPUUtilizationStat("namespace-requests", datasource, queries, labelMatcher),
+		panels.KubernetesCPUUtilizationStat("namespace-limits", datasource, queries, labelMatcher),
+		panels.KubernetesMemoryUtilizationStat("namespace-requests", datasource, queries, labelMatcher),
+		panels.KubernetesMemoryUtilizationStat("namespace-limits", datasource, queries, labelMatcher),
 	)
 }
 
-func withNamespaceCPUUsageGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withNamespaceCPUUsageGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("CPU Usage",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesCPUUsage("namespace-pod", datasource, labelMatcher),
+		panels.KubernetesCPUUsage("namespace-pod", datasource, queries, labelMatcher),
 	)
 }
 
-func withNamespaceCPUUsageQuotaGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withNamespaceCPUUsageQuotaGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("CPU Usage Quota",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(10),
-		panels.NamespaceCPUUsageQuota(datasource, labelMatcher),
+		panels.NamespaceCPUUsageQuota(datasource, queries, labelMatcher),
 	)
 }
 
-func withNamespaceMemoryUsageGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withNamespaceMemoryUsageGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Memory Usage",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesMemoryUsage("namespace-pod", datasource, labelMatcher),
+		panels.KubernetesMemoryUsage("namespace-pod", datasource, queries, labelMatcher),
 	)
 }
 
-func withNamespaceMemoryUsageQuotaGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withNamespaceMemoryUsageQuotaGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Memory Usage Quota",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(10),
-		panels.NamespaceMemoryUsageQuota(datasource, labelMatcher),
+		panels.NamespaceMemoryUsageQuota(datasource, queries, labelMatcher),
 	)
 }
 
-func withNamespaceNetworkUsageGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withNamespaceNetworkUsageGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Network Usage",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(10),
-		panels.NamespaceCurrentNetworkUsage(datasource, labelMatcher),
+		panels.NamespaceCurrentNetworkUsage(datasource, queries, labelMatcher),
 	)
 }
 
-func withNamespaceBandwidthGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withNamespaceBandwidthGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Bandwidth",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesReceiveBandwidth("namespace-pod", datasource, labelMatcher),
-		panels.KubernetesTransmitBandwidth("namespace-pod", datasource, labelMatcher),
+		panels.KubernetesReceiveBandwidth("namespace-pod", datasource, queries, labelMatcher),
+		panels.KubernetesTransmitBandwidth("namespace-pod", datasource, queries, labelMatcher),
 	)
 }
 
-func withNamespaceRateOfPacketsGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withNamespaceRateOfPacketsGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Rate of Packets",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesReceivedPackets("namespace-pod", datasource, labelMatcher),
-		panels.KubernetesTransmittedPackets("namespace-pod", datasource, labelMatcher),
+		panels.KubernetesReceivedPackets("namespace-pod", datasource, queries, labelMatcher),
+		panels.KubernetesTransmittedPackets("namespace-pod", datasource, queries, labelMatcher),
 	)
 }
 
-func withNamespaceRateOfPacketsDroppedGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withNamespaceRateOfPacketsDroppedGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Rate of Packets Dropped",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesReceivedPacketsDropped("namespace-pod", datasource, labelMatcher),
-		panels.KubernetesTransmittedPacketsDropped("namespace-pod", datasource, labelMatcher),
+		panels.KubernetesReceivedPacketsDropped("namespace-pod", datasource, queries, labelMatcher),
+		panels.KubernetesTransmittedPacketsDropped("namespace-pod", datasource, queries, labelMatcher),
 	)
 }
 
-func withNamespaceStorageIOGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withNamespaceStorageIOGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Storage IO",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesIOPS("namespace-pod", datasource, labelMatcher),
-		panels.KubernetesThroughput("namespace-pod", datasource, labelMatcher),
+		panels.KubernetesIOPS("namespace-pod", datasource, queries, labelMatcher),
+		panels.KubernetesThroughput("namespace-pod", datasource, queries, labelMatcher),
 	)
 }
 
-func withNamespaceCurrentStorageIOGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withNamespaceCurrentStorageIOGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Storage IO - Distribution",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(10),
-		panels.NamespaceCurrentStorageIO(datasource, labelMatcher),
+		panels.NamespaceCurrentStorageIO(datasource, queries, labelMatcher),
 	)
 }
 
 func BuildKubernetesNamespaceOverview(project string,
 	datasource string,
 	clusterLabelName string,
-	variableOverrides ...dashboard.Option,
+	opts ...kubernetes.KubernetesDashboardOption,
 ) dashboards.DashboardResult {
+	config := kubernetes.NewKubernetesDashboardConfig(opts...)
+	queries := config.PanelQueries()
 	defaultVars := []dashboard.Option{
 		dashboard.AddVariable("cluster",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("cluster",
-					labelValuesVar.Matchers("up{"+panels.GetKubeletMatcher()+"}"),
+					dashboards.AddVariableMatcher(
+						vector.New(
+							vector.WithMetricName("up"),
+							vector.WithLabelMatchers(config.JobLabelValues.KubeletMatcher()),
+						),
+						nil,
+					),
 					dashboards.AddVariableDatasource(datasource),
 				),
 				listVar.DisplayName("cluster"),
@@ -138,11 +148,12 @@ func BuildKubernetesNamespaceOverview(project string,
 		dashboard.AddVariable("namespace",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("namespace",
-					labelValuesVar.Matchers(
-						promql.SetLabelMatchers(
-							"kube_namespace_status_phase{"+panels.GetKubeStateMetricsMatcher()+"}",
-							[]promql.LabelMatcher{{Name: "cluster", Type: "=", Value: "$cluster"}},
+					dashboards.AddVariableMatcher(
+						vector.New(
+							vector.WithMetricName("kube_namespace_status_phase"),
+							vector.WithLabelMatchers(config.JobLabelValues.KubeStateMetricsMatcher()),
 						),
+						[]*labels.Matcher{{Name: "cluster", Type: labels.MatchEqual, Value: "$cluster"}},
 					),
 					dashboards.AddVariableDatasource(datasource),
 				),
@@ -153,26 +164,23 @@ func BuildKubernetesNamespaceOverview(project string,
 
 	clusterLabelMatcher := dashboards.GetClusterLabelMatcherV2(clusterLabelName)
 
-	vars := defaultVars
-	if len(variableOverrides) > 0 {
-		vars = variableOverrides
-	}
+	vars := config.Variables(defaultVars)
 	options := append([]dashboard.Option{
 		dashboard.ProjectName(project),
 		dashboard.Name("Kubernetes / Compute Resources / Namespace (Pods)"),
 	}, vars...)
 	options = append(options,
-		withNamespaceStatsGroup(datasource, clusterLabelMatcher),
-		withNamespaceCPUUsageGroup(datasource, clusterLabelMatcher),
-		withNamespaceCPUUsageQuotaGroup(datasource, clusterLabelMatcher),
-		withNamespaceMemoryUsageGroup(datasource, clusterLabelMatcher),
-		withNamespaceMemoryUsageQuotaGroup(datasource, clusterLabelMatcher),
-		withNamespaceNetworkUsageGroup(datasource, clusterLabelMatcher),
-		withNamespaceBandwidthGroup(datasource, clusterLabelMatcher),
-		withNamespaceRateOfPacketsGroup(datasource, clusterLabelMatcher),
-		withNamespaceRateOfPacketsDroppedGroup(datasource, clusterLabelMatcher),
-		withNamespaceStorageIOGroup(datasource, clusterLabelMatcher),
-		withNamespaceCurrentStorageIOGroup(datasource, clusterLabelMatcher),
+		withNamespaceStatsGroup(datasource, queries, clusterLabelMatcher),
+		withNamespaceCPUUsageGroup(datasource, queries, clusterLabelMatcher),
+		withNamespaceCPUUsageQuotaGroup(datasource, queries, clusterLabelMatcher),
+		withNamespaceMemoryUsageGroup(datasource, queries, clusterLabelMatcher),
+		withNamespaceMemoryUsageQuotaGroup(datasource, queries, clusterLabelMatcher),
+		withNamespaceNetworkUsageGroup(datasource, queries, clusterLabelMatcher),
+		withNamespaceBandwidthGroup(datasource, queries, clusterLabelMatcher),
+		withNamespaceRateOfPacketsGroup(datasource, queries, clusterLabelMatcher),
+		withNamespaceRateOfPacketsDroppedGroup(datasource, queries, clusterLabelMatcher),
+		withNamespaceStorageIOGroup(datasource, queries, clusterLabelMatcher),
+		withNamespaceCurrentStorageIOGroup(datasource, queries, clusterLabelMatcher),
 	)
 	return dashboards.NewDashboardResult(
 		dashboard.New("kubernetes-namespace-resources-overview", options...),
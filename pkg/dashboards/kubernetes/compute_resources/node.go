@@ -15,62 +15,72 @@ package compute_resources
 
 import (
 	"github.com/perses/community-mixins/pkg/dashboards"
+	"github.com/perses/community-mixins/pkg/dashboards/kubernetes"
 	panels "github.com/perses/community-mixins/pkg/panels/kubernetes"
-	"github.com/perses/community-mixins/pkg/promql"
 	"github.com/perses/perses/go-sdk/dashboard"
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
+	"github.com/perses/promql-builder/vector"
 
 	listVar "github.com/perses/perses/go-sdk/variable/list-variable"
 	labelValuesVar "github.com/perses/plugins/prometheus/sdk/go/variable/label-values"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func withNodeCPUUsageGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withNodeCPUUsageGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("CPU Usage",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesCPUUsage("node", datasource, labelMatcher),
+		panels.KubernetesCPUUsage("node", datasource, queries, labelMatcher),
 	)
 }
 
-func withNodeCPUQuotaGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withNodeCPUQuotaGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("CPU Quota",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(12),
-		panels.CPUUsageQuota(datasource, labelMatcher),
+		panels.CPUUsageQuota(datasource, queries, labelMatcher),
 	)
 }
 
-func withNodeMemoryUsageGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withNodeMemoryUsageGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Memory Usage with Cache",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesMemoryUsage("node-with-cache", datasource, labelMatcher),
+		panels.KubernetesMemoryUsage("node-with-cache", datasource, queries, labelMatcher),
 	)
 }
 
-func withNodeMemoryUsageWithoutCacheGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withNodeMemoryUsageWithoutCacheGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Memory Usage without Cache",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesMemoryUsage("node-without-cache", datasource, labelMatcher),
+		panels.KubernetesMemoryUsage("node-without-cache", datasource, queries, labelMatcher),
 	)
 }
 
-func withNodeMemoryQuotaGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withNodeMemoryQuotaGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Memory Quota",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(12),
-		panels.MemoryQuota(datasource, labelMatcher),
+		panels.MemoryQuota(datasource, queries, labelMatcher),
 	)
 }
 
-func BuildKubernetesNodeResourcesOverview(project string, datasource string, clusterLabelName string, variableOverrides ...dashboard.Option) dashboards.DashboardResult {
+func BuildKubernetesNodeResourcesOverview(project string, datasource string, clusterLabelName string, opts ...kubernetes.KubernetesDashboardOption) dashboards.DashboardResult {
+	config := kubernetes.NewKubernetesDashboardConfig(opts...)
+	queries := config.PanelQueries()
 	defaultVars := []dashboard.Option{
 		dashboard.AddVariable("cluster",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("cluster",
-					labelValuesVar.Matchers("up{"+panels.GetKubeletMatcher()+"}"),
+					dashboards.AddVariableMatcher(
+						vector.New(
+							vector.WithMetricName("up"),
+							vector.WithLabelMatchers(config.JobLabelValues.KubeletMatcher()),
+						),
+						nil,
+					),
 					dashboards.AddVariableDatasource(datasource),
 				),
 				listVar.DisplayName("cluster"),
@@ -79,11 +89,9 @@ func BuildKubernetesNodeResourcesOverview(project string, datasource string, clu
 		dashboard.AddVariable("node",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("node",
-					labelValuesVar.Matchers(
-						promql.SetLabelMatchers(
-							"kube_pod_info",
-							[]promql.LabelMatcher{{Name: "cluster", Type: "=", Value: "$cluster"}},
-						),
+					dashboards.AddVariableMatcher(
+						vector.New(vector.WithMetricName("kube_pod_info")),
+						[]*labels.Matcher{{Name: "cluster", Type: labels.MatchEqual, Value: "$cluster"}},
 					),
 					dashboards.AddVariableDatasource(datasource),
 				),
@@ -94,20 +102,17 @@ func BuildKubernetesNodeResourcesOverview(project string, datasource string, clu
 
 	clusterLabelMatcher := dashboards.GetClusterLabelMatcherV2(clusterLabelName)
 
-	vars := defaultVars
-	if len(variableOverrides) > 0 {
-		vars = variableOverrides
-	}
+	vars := config.Variables(defaultVars)
 	options := append([]dashboard.Option{
 		dashboard.ProjectName(project),
 		dashboard.Name("Kubernetes / Compute Resources / Node (Pods)"),
 	}, vars...)
 	options = append(options,
-		withNodeCPUUsageGroup(datasource, clusterLabelMatcher),
-		withNodeCPUQuotaGroup(datasource, clusterLabelMatcher),
-		withNodeMemoryUsageGroup(datasource, clusterLabelMatcher),
-		withNodeMemoryUsageWithoutCacheGroup(datasource, clusterLabelMatcher),
-		withNodeMemoryQuotaGroup(datasource, clusterLabelMatcher),
+		withNodeCPUUsageGroup(datasource, queries, clusterLabelMatcher),
+		withNodeCPUQuotaGroup(datasource, queries, clusterLabelMatcher),
+		withNodeMemoryUsageGroup(datasource, queries, clusterLabelMatcher),
+		withNodeMemoryUsageWithoutCacheGroup(datasource, queries, clusterLabelMatcher),
+		withNodeMemoryQuotaGroup(datasource, queries, clusterLabelMatcher),
 	)
 	return dashboards.NewDashboardResult(
 		dashboard.New("kubernetes-node-resources-overview", options...),
@@ -15,115 +15,125 @@ package compute_resources
 
 import (
 	"github.com/perses/community-mixins/pkg/dashboards"
+	"github.com/perses/community-mixins/pkg/dashboards/kubernetes"
 	panels "github.com/perses/community-mixins/pkg/panels/kubernetes"
-	"github.com/perses/community-mixins/pkg/promql"
 	"github.com/perses/perses/go-sdk/dashboard"
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
+	"github.com/perses/promql-builder/vector"
 
 	listVar "github.com/perses/perses/go-sdk/variable/list-variable"
 	labelValuesVar "github.com/perses/plugins/prometheus/sdk/go/variable/label-values"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func withPodCPUUsageGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withPodCPUUsageGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("CPU Usage",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesCPUUsage("pod", datasource, labelMatcher),
+		panels.KubernetesCPUUsage("pod", datasource, queries, labelMatcher),
 	)
 }
 
-func withPodCPUThrottlingGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withPodCPUThrottlingGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("CPU Throttling",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(8),
-		panels.PodCPUThrottling(datasource, labelMatcher),
+		panels.PodCPUThrottling(datasource, queries, labelMatcher),
 	)
 }
 
-func withPodCPUUsageQuotaGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withPodCPUUsageQuotaGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("CPU Usage Quota",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(10),
-		panels.PodCPUUsageQuota(datasource, labelMatcher),
+		panels.PodCPUUsageQuota(datasource, queries, labelMatcher),
 	)
 }
 
-func withPodMemoryUsageGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withPodMemoryUsageGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Memory Usage",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesMemoryUsage("pod", datasource, labelMatcher),
+		panels.KubernetesMemoryUsage("pod", datasource, queries, labelMatcher),
 	)
 }
 
-func withPodMemoryUsageQuotaGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withPodMemoryUsageQuotaGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Memory Usage Quota",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(10),
-		panels.PodMemoryUsageQuota(datasource, labelMatcher),
+		panels.PodMemoryUsageQuota(datasource, queries, labelMatcher),
 	)
 }
 
-func withPodBandwidthGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withPodBandwidthGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Bandwidth",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesReceiveBandwidth("pod", datasource, labelMatcher),
-		panels.KubernetesTransmitBandwidth("pod", datasource, labelMatcher),
+		panels.KubernetesReceiveBandwidth("pod", datasource, queries, labelMatcher),
+		panels.KubernetesTransmitBandwidth("pod", datasource, queries, labelMatcher),
 	)
 }
 
-func withPodRateOfPacketsGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withPodRateOfPacketsGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Rate of Packets",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesReceivedPackets("pod", datasource, labelMatcher),
-		panels.KubernetesTransmittedPackets("pod", datasource, labelMatcher),
+		panels.KubernetesReceivedPackets("pod", datasource, queries, labelMatcher),
+		panels.KubernetesTransmittedPackets("pod", datasource, queries, labelMatcher),
 	)
 }
 
-func withPodRateOfPacketsDroppedGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withPodRateOfPacketsDroppedGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Rate of Packets Dropped",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesReceivedPacketsDropped("pod", datasource, labelMatcher),
-		panels.KubernetesTransmittedPacketsDropped("pod", datasource, labelMatcher),
+		panels.KubernetesReceivedPacketsDropped("pod", datasource, queries, labelMatcher),
+		panels.KubernetesTransmittedPacketsDropped("pod", datasource, queries, labelMatcher),
 	)
 }
 
-func withPodStorageIOGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withPodStorageIOGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Storage IO",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesIOPS("pod", datasource, labelMatcher),
-		panels.KubernetesThroughput("pod", datasource, labelMatcher),
+		panels.KubernetesIOPS("pod", datasource, queries, labelMatcher),
+		panels.KubernetesThroughput("pod", datasource, queries, labelMatcher),
 	)
 }
 
-func withPodStorageIOContainerGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withPodStorageIOContainerGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Storage IO - Container",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesIOPS("pod-container", datasource, labelMatcher),
-		panels.KubernetesThroughput("pod-container", datasource, labelMatcher),
+		panels.KubernetesIOPS("pod-container", datasource, queries, labelMatcher),
+		panels.KubernetesThroughput("pod-container", datasource, queries, labelMatcher),
 	)
 }
 
-func withPodCurrentStorageIOGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withPodCurrentStorageIOGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Storage IO - Distribution",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(10),
-		panels.PodCurrentStorageIO(datasource, labelMatcher),
+		panels.PodCurrentStorageIO(datasource, queries, labelMatcher),
 	)
 }
 
-func BuildKubernetesPodOverview(project string, datasource string, clusterLabelName string, variableOverrides ...dashboard.Option) dashboards.DashboardResult {
+func BuildKubernetesPodOverview(project string, datasource string, clusterLabelName string, opts ...kubernetes.KubernetesDashboardOption) dashboards.DashboardResult {
+	config := kubernetes.NewKubernetesDashboardConfig(opts...)
+	queries := config.PanelQueries()
 	defaultVars := []dashboard.Option{
 		dashboard.AddVariable("cluster",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("cluster",
-					labelValuesVar.Matchers("up{"+panels.GetKubeletMatcher()+"}"),
+					dashboards.AddVariableMatcher(
+						vector.New(
+							vector.WithMetricName("up"),
+							vector.WithLabelMatchers(config.JobLabelValues.KubeletMatcher()),
+						),
+						nil,
+					),
 					dashboards.AddVariableDatasource(datasource),
 				),
 				listVar.DisplayName("cluster"),
@@ -132,11 +142,12 @@ func BuildKubernetesPodOverview(project string, datasource string, clusterLabelN
 		dashboard.AddVariable("namespace",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("namespace",
-					labelValuesVar.Matchers(
-						promql.SetLabelMatchers(
-							"kube_namespace_status_phase{"+panels.GetKubeStateMetricsMatcher()+"}",
-							[]promql.LabelMatcher{{Name: "cluster", Type: "=", Value: "$cluster"}},
+					dashboards.AddVariableMatcher(
+						vector.New(
+							vector.WithMetricName("kube_namespace_status_phase"),
+							vector.WithLabelMatchers(config.JobLabelValues.KubeStateMetricsMatcher()),
 						),
+						[]*labels.Matcher{{Name: "cluster", Type: labels.MatchEqual, Value: "$cluster"}},
 					),
 					dashboards.AddVariableDatasource(datasource),
 				),
@@ -146,14 +157,15 @@ func BuildKubernetesPodOverview(project string, datasource string, clusterLabelN
 		dashboard.AddVariable("pod",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("pod",
-					labelValuesVar.Matchers(
-						promql.SetLabelMatchers(
-							"kube_pod_info{"+panels.GetKubeStateMetricsMatcher()+"}",
-							[]promql.LabelMatcher{
-								{Name: "cluster", Type: "=", Value: "$cluster"},
-								{Name: "namespace", Type: "=", Value: "$namespace"},
-							},
+					dashboards.AddVariableMatcher(
+						vector.New(
+							vector.WithMetricName("kube_pod_info"),
+							vector.WithLabelMatchers(config.JobLabelValues.KubeStateMetricsMatcher()),
 						),
+						[]*labels.Matcher{
+							{Name: "cluster", Type: labels.MatchEqual, Value: "$cluster"},
+							{Name: "namespace", Type: labels.MatchEqual, Value: "$namespace"},
+						},
 					),
 					dashboards.AddVariableDatasource(datasource),
 				),
@@ -164,26 +176,23 @@ func BuildKubernetesPodOverview(project string, datasource string, clusterLabelN
 
 	clusterLabelMatcher := dashboards.GetClusterLabelMatcherV2(clusterLabelName)
 
-	vars := defaultVars
-	if len(variableOverrides) > 0 {
-		vars = variableOverrides
-	}
+	vars := config.Variables(defaultVars)
 	options := append([]dashboard.Option{
 		dashboard.ProjectName(project),
 		dashboard.Name("Kubernetes / Compute Resources / Pod"),
 	}, vars...)
 	options = append(options,
-		withPodCPUUsageGroup(datasource, clusterLabelMatcher),
-		withPodCPUThrottlingGroup(datasource, clusterLabelMatcher),
-		withPodCPUUsageQuotaGroup(datasource, clusterLabelMatcher),
-		withPodMemoryUsageGroup(datasource, clusterLabelMatcher),
-		withPodMemoryUsageQuotaGroup(datasource, clusterLabelMatcher),
-		withPodBandwidthGroup(datasource, clusterLabelMatcher),
-		withPodRateOfPacketsGroup(datasource, clusterLabelMatcher),
-		withPodRateOfPacketsDroppedGroup(datasource, clusterLabelMatcher),
-		withPodStorageIOGroup(datasource, clusterLabelMatcher),
-		withPodStorageIOContainerGroup(datasource, clusterLabelMatcher),
-		withPodCurrentStorageIOGroup(datasource, clusterLabelMatcher),
+		withPodCPUUsageGroup(datasource, queries, clusterLabelMatcher),
+		withPodCPUThrottlingGroup(datasource, queries, clusterLabelMatcher),
+		withPodCPUUsageQuotaGroup(datasource, queries, clusterLabelMatcher),
+		withPodMemoryUsageGroup(datasource, queries, clusterLabelMatcher),
+		withPodMemoryUsageQuotaGroup(datasource, queries, clusterLabelMatcher),
+		withPodBandwidthGroup(datasource, queries, clusterLabelMatcher),
+		withPodRateOfPacketsGroup(datasource, queries, clusterLabelMatcher),
+		withPodRateOfPacketsDroppedGroup(datasource, queries, clusterLabelMatcher),
+		withPodStorageIOGroup(datasource, queries, clusterLabelMatcher),
+		withPodStorageIOContainerGroup(datasource, queries, clusterLabelMatcher),
+		withPodCurrentStorageIOGroup(datasource, queries, clusterLabelMatcher),
 	)
 	return dashboards.NewDashboardResult(
 		dashboard.New("kubernetes-pod-resources-overview", options...),
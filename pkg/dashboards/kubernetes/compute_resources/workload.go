@@ -15,98 +15,108 @@ package compute_resources
 
 import (
 	"github.com/perses/community-mixins/pkg/dashboards"
+	"github.com/perses/community-mixins/pkg/dashboards/kubernetes"
 	panels "github.com/perses/community-mixins/pkg/panels/kubernetes"
-	"github.com/perses/community-mixins/pkg/promql"
 	"github.com/perses/perses/go-sdk/dashboard"
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
+	"github.com/perses/promql-builder/vector"
 
 	listVar "github.com/perses/perses/go-sdk/variable/list-variable"
 	labelValuesVar "github.com/perses/plugins/prometheus/sdk/go/variable/label-values"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func withWorkloadCPUUsageGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadCPUUsageGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("CPU Usage",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesCPUUsage("workload", datasource, labelMatcher),
+		panels.KubernetesCPUUsage("workload", datasource, queries, labelMatcher),
 	)
 }
 
-func withWorkloadCPUUsageQuotaGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadCPUUsageQuotaGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("CPU Usage Quota",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(10),
-		panels.WorkloadCPUUsageQuota(datasource, labelMatcher),
+		panels.WorkloadCPUUsageQuota(datasource, queries, labelMatcher),
 	)
 }
 
-func withWorkloadMemoryUsageGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadMemoryUsageGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Memory Usage",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesMemoryUsage("workload", datasource, labelMatcher),
+		panels.KubernetesMemoryUsage("workload", datasource, queries, labelMatcher),
 	)
 }
 
-func withWorkloadMemoryUsageQuotaGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadMemoryUsageQuotaGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Memory Usage Quota",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(10),
-		panels.WorkloadMemoryUsageQuota(datasource, labelMatcher),
+		panels.WorkloadMemoryUsageQuota(datasource, queries, labelMatcher),
 	)
 }
 
-func withWorkloadNetworkUsageGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadNetworkUsageGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Network Usage",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(10),
-		panels.WorkloadCurrentNetworkUsage(datasource, labelMatcher),
+		panels.WorkloadCurrentNetworkUsage(datasource, queries, labelMatcher),
 	)
 }
 
-func withWorkloadBandwidthGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadBandwidthGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Bandwidth",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesReceiveBandwidth("workload", datasource, labelMatcher),
-		panels.KubernetesTransmitBandwidth("workload", datasource, labelMatcher),
+		panels.KubernetesReceiveBandwidth("workload", datasource, queries, labelMatcher),
+		panels.KubernetesTransmitBandwidth("workload", datasource, queries, labelMatcher),
 	)
 }
 
-func withWorkloadAvgContainerBandwidthGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadAvgContainerBandwidthGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Average Container Bandwidth",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesAvgContainerBandwidthReceived("workload", datasource, labelMatcher),
-		panels.KubernetesAvgContainerBandwidthTransmitted("workload", datasource, labelMatcher),
+		panels.KubernetesAvgContainerBandwidthReceived("workload", datasource, queries, labelMatcher),
+		panels.KubernetesAvgContainerBandwidthTransmitted("workload", datasource, queries, labelMatcher),
 	)
 }
 
-func withWorkloadRateOfPacketsGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadRateOfPacketsGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Rate of Packets",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesReceivedPackets("workload", datasource, labelMatcher),
-		panels.KubernetesTransmittedPackets("workload", datasource, labelMatcher),
+		panels.KubernetesReceivedPackets("workload", datasource, queries, labelMatcher),
+		panels.KubernetesTransmittedPackets("workload", datasource, queries, labelMatcher),
 	)
 }
 
-func withWorkloadRateOfPacketsDroppedGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadRateOfPacketsDroppedGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Rate of Packets Dropped",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesReceivedPacketsDropped("workload", datasource, labelMatcher),
-		panels.KubernetesTransmittedPacketsDropped("workload", datasource, labelMatcher),
+		panels.KubernetesReceivedPacketsDropped("workload", datasource, queries, labelMatcher),
+		panels.KubernetesTransmittedPacketsDropped("workload", datasource, queries, labelMatcher),
 	)
 }
 
-func BuildKubernetesWorkloadOverview(project string, datasource string, clusterLabelName string, variableOverrides ...dashboard.Option) dashboards.DashboardResult {
+func BuildKubernetesWorkloadOverview(project string, datasource string, clusterLabelName string, opts ...kubernetes.KubernetesDashboardOption) dashboards.DashboardResult {
+	config := kubernetes.NewKubernetesDashboardConfig(opts...)
+	queries := config.PanelQueries()
 	defaultVars := []dashboard.Option{
 		dashboard.AddVariable("cluster",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("cluster",
-					labelValuesVar.Matchers("up{"+panels.GetKubeletMatcher()+"}"),
+					dashboards.AddVariableMatcher(
+						vector.New(
+							vector.WithMetricName("up"),
+							vector.WithLabelMatchers(config.JobLabelValues.KubeletMatcher()),
+						),
+						nil,
+					),
 					dashboards.AddVariableDatasource(datasource),
 				),
 				listVar.DisplayName("cluster"),
@@ -115,11 +125,12 @@ func BuildKubernetesWorkloadOverview(project string, datasource string, clusterL
 		dashboard.AddVariable("namespace",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("namespace",
-					labelValuesVar.Matchers(
-						promql.SetLabelMatchers(
-							"kube_namespace_status_phase{"+panels.GetKubeStateMetricsMatcher()+"}",
-							[]promql.LabelMatcher{{Name: "cluster", Type: "=", Value: "$cluster"}},
+					dashboards.AddVariableMatcher(
+						vector.New(
+							vector.WithMetricName("kube_namespace_status_phase"),
+							vector.WithLabelMatchers(config.JobLabelValues.KubeStateMetricsMatcher()),
 						),
+						[]*labels.Matcher{{Name: "cluster", Type: labels.MatchEqual, Value: "$cluster"}},
 					),
 					dashboards.AddVariableDatasource(datasource),
 				),
@@ -129,14 +140,12 @@ func BuildKubernetesWorkloadOverview(project string, datasource string, clusterL
 		dashboard.AddVariable("type",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("workload_type",
-					labelValuesVar.Matchers(
-						promql.SetLabelMatchers(
-							"namespace_workload_pod:kube_pod_owner:relabel",
-							[]promql.LabelMatcher{
-								{Name: "cluster", Type: "=", Value: "$cluster"},
-								{Name: "namespace", Type: "=", Value: "$namespace"},
-							},
-						),
+					dashboards.AddVariableMatcher(
+						vector.New(vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel")),
+						[]*labels.Matcher{
+							{Name: "cluster", Type: labels.MatchEqual, Value: "$cluster"},
+							{Name: "namespace", Type: labels.MatchEqual, Value: "$namespace"},
+						},
 					),
 					dashboards.AddVariableDatasource(datasource),
 				),
@@ -146,15 +155,13 @@ func BuildKubernetesWorkloadOverview(project string, datasource string, clusterL
 		dashboard.AddVariable("workload",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("workload",
-					labelValuesVar.Matchers(
-						promql.SetLabelMatchers(
-							"namespace_workload_pod:kube_pod_owner:relabel",
-							[]promql.LabelMatcher{
-								{Name: "cluster", Type: "=", Value: "$cluster"},
-								{Name: "namespace", Type: "=", Value: "$namespace"},
-								{Name: "workload_type", Type: "=", Value: "$type"},
-							},
-						),
+					dashboards.AddVariableMatcher(
+						vector.New(vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel")),
+						[]*labels.Matcher{
+							{Name: "cluster", Type: labels.MatchEqual, Value: "$cluster"},
+							{Name: "namespace", Type: labels.MatchEqual, Value: "$namespace"},
+							{Name: "workload_type", Type: labels.MatchEqual, Value: "$type"},
+						},
 					),
 					dashboards.AddVariableDatasource(datasource),
 				),
@@ -165,24 +172,21 @@ func BuildKubernetesWorkloadOverview(project string, datasource string, clusterL
 
 	clusterLabelMatcher := dashboards.GetClusterLabelMatcherV2(clusterLabelName)
 
-	vars := defaultVars
-	if len(variableOverrides) > 0 {
-		vars = variableOverrides
-	}
+	vars := config.Variables(defaultVars)
 	options := append([]dashboard.Option{
 		dashboard.ProjectName(project),
 		dashboard.Name("Kubernetes / Compute Resources / Workload"),
 	}, vars...)
 	options = append(options,
-		withWorkloadCPUUsageGroup(datasource, clusterLabelMatcher),
-		withWorkloadCPUUsageQuotaGroup(datasource, clusterLabelMatcher),
-		withWorkloadMemoryUsageGroup(datasource, clusterLabelMatcher),
-		withWorkloadMemoryUsageQuotaGroup(datasource, clusterLabelMatcher),
-		withWorkloadNetworkUsageGroup(datasource, clusterLabelMatcher),
-		withWorkloadBandwidthGroup(datasource, clusterLabelMatcher),
-		withWorkloadAvgContainerBandwidthGroup(datasource, clusterLabelMatcher),
-		withWorkloadRateOfPacketsGroup(datasource, clusterLabelMatcher),
-		withWorkloadRateOfPacketsDroppedGroup(datasource, clusterLabelMatcher),
+		withWorkloadCPUUsageGroup(datasource, queries, clusterLabelMatcher),
+		withWorkloadCPUUsageQuotaGroup(datasource, queries, clusterLabelMatcher),
+		withWorkloadMemoryUsageGroup(datasource, queries, clusterLabelMatcher),
+		withWorkloadMemoryUsageQuotaGroup(datasource, queries, clusterLabelMatcher),
+		withWorkloadNetworkUsageGroup(datasource, queries, clusterLabelMatcher),
+		withWorkloadBandwidthGroup(datasource, queries, clusterLabelMatcher),
+		withWorkloadAvgContainerBandwidthGroup(datasource, queries, clusterLabelMatcher),
+		withWorkloadRateOfPacketsGroup(datasource, queries, clusterLabelMatcher),
+		withWorkloadRateOfPacketsDroppedGroup(datasource, queries, clusterLabelMatcher),
 	)
 	return dashboards.NewDashboardResult(
 		dashboard.New("kubernetes-workload-resources-overview", options...),
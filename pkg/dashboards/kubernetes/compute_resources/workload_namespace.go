@@ -15,98 +15,109 @@ package compute_resources
 
 import (
 	"github.com/perses/community-mixins/pkg/dashboards"
+	"github.com/perses/community-mixins/pkg/dashboards/kubernetes"
 	panels "github.com/perses/community-mixins/pkg/panels/kubernetes"
-	"github.com/perses/community-mixins/pkg/promql"
 	"github.com/perses/perses/go-sdk/dashboard"
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
+	"github.com/perses/promql-builder/label"
+	"github.com/perses/promql-builder/vector"
 
 	listVar "github.com/perses/perses/go-sdk/variable/list-variable"
 	labelValuesVar "github.com/perses/plugins/prometheus/sdk/go/variable/label-values"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func withWorkloadNamespaceCPUUsageGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadNamespaceCPUUsageGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("CPU Usage",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesCPUUsage("namespace-workload", datasource, labelMatcher),
+		panels.KubernetesCPUUsage("namespace-workload", datasource, queries, labelMatcher),
 	)
 }
 
-func withWorkloadNamespaceCPUUsageQuotaGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadNamespaceCPUUsageQuotaGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("CPU Usage Quota",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(10),
-		panels.WorkloadNamespaceCPUUsageQuota(datasource, labelMatcher),
+		panels.WorkloadNamespaceCPUUsageQuota(datasource, queries, labelMatcher),
 	)
 }
 
-func withWorkloadNamespaceMemoryUsageGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadNamespaceMemoryUsageGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Memory Usage",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesMemoryUsage("namespace-workload", datasource, labelMatcher),
+		panels.KubernetesMemoryUsage("namespace-workload", datasource, queries, labelMatcher),
 	)
 }
 
-func withWorkloadNamespaceMemoryUsageQuotaGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadNamespaceMemoryUsageQuotaGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Memory Usage Quota",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(10),
-		panels.WorkloadNamespaceMemoryUsageQuota(datasource, labelMatcher),
+		panels.WorkloadNamespaceMemoryUsageQuota(datasource, queries, labelMatcher),
 	)
 }
 
-func withWorkloadNamespaceNetworkUsageGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadNamespaceNetworkUsageGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Network Usage",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(10),
-		panels.WorkloadNamespaceCurrentNetworkUsage(datasource, labelMatcher),
+		panels.WorkloadNamespaceCurrentNetworkUsage(datasource, queries, labelMatcher),
 	)
 }
 
-func withWorkloadNamespaceBandwidthGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadNamespaceBandwidthGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Bandwidth",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesReceiveBandwidth("namespace-workload", datasource, labelMatcher),
-		panels.KubernetesTransmitBandwidth("namespace-workload", datasource, labelMatcher),
+		panels.KubernetesReceiveBandwidth("namespace-workload", datasource, queries, labelMatcher),
+		panels.KubernetesTransmitBandwidth("namespace-workload", datasource, queries, labelMatcher),
 	)
 }
 
-func withWorkloadNamespaceAvgContainerBandwidthGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadNamespaceAvgContainerBandwidthGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Average Container Bandwidth",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesAvgContainerBandwidthReceived("namespace-workload", datasource, labelMatcher),
-		panels.KubernetesAvgContainerBandwidthTransmitted("namespace-workload", datasource, labelMatcher),
+		panels.KubernetesAvgContainerBandwidthReceived("namespace-workload", datasource, queries, labelMatcher),
+		panels.KubernetesAvgContainerBandwidthTransmitted("namespace-workload", datasource, queries, labelMatcher),
 	)
 }
 
-func withWorkloadNamespaceRateOfPacketsGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadNamespaceRateOfPacketsGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Rate of Packets",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesReceivedPackets("namespace-workload", datasource, labelMatcher),
-		panels.KubernetesTransmittedPackets("namespace-workload", datasource, labelMatcher),
+		panels.KubernetesReceivedPackets("namespace-workload", datasource, queries, labelMatcher),
+		panels.KubernetesTransmittedPackets("namespace-workload", datasource, queries, labelMatcher),
 	)
 }
 
-func withWorkloadNamespaceRateOfPacketsDroppedGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadNamespaceRateOfPacketsDroppedGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Rate of Packets Dropped",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesReceivedPacketsDropped("namespace-workload", datasource, labelMatcher),
-		panels.KubernetesTransmittedPacketsDropped("namespace-workload", datasource, labelMatcher),
+		panels.KubernetesReceivedPacketsDropped("namespace-workload", datasource, queries, labelMatcher),
+		panels.KubernetesTransmittedPacketsDropped("namespace-workload", datasource, queries, labelMatcher),
 	)
 }
 
-func BuildKubernetesWorkloadNamespaceOverview(project string, datasource string, clusterLabelName string, variableOverrides ...dashboard.Option) dashboards.DashboardResult {
+func BuildKubernetesWorkloadNamespaceOverview(project string, datasource string, clusterLabelName string, opts ...kubernetes.KubernetesDashboardOption) dashboards.DashboardResult {
+	config := kubernetes.NewKubernetesDashboardConfig(opts...)
+	queries := config.PanelQueries()
 	defaultVars := []dashboard.Option{
 		dashboard.AddVariable("cluster",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("cluster",
-					labelValuesVar.Matchers("up{"+panels.GetKubeletMatcher()+"}"),
+					dashboards.AddVariableMatcher(
+						vector.New(
+							vector.WithMetricName("up"),
+							vector.WithLabelMatchers(config.JobLabelValues.KubeletMatcher()),
+						),
+						nil,
+					),
 					dashboards.AddVariableDatasource(datasource),
 				),
 				listVar.DisplayName("cluster"),
@@ -115,11 +126,12 @@ func BuildKubernetesWorkloadNamespaceOverview(project string, datasource string,
 		dashboard.AddVariable("namespace",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("namespace",
-					labelValuesVar.Matchers(
-						promql.SetLabelMatchers(
-							"kube_namespace_status_phase{"+panels.GetKubeStateMetricsMatcher()+"}",
-							[]promql.LabelMatcher{{Name: "cluster", Type: "=", Value: "$cluster"}},
+					dashboards.AddVariableMatcher(
+						vector.New(
+							vector.WithMetricName("kube_namespace_status_phase"),
+							vector.WithLabelMatchers(config.JobLabelValues.KubeStateMetricsMatcher()),
 						),
+						[]*labels.Matcher{{Name: "cluster", Type: labels.MatchEqual, Value: "$cluster"}},
 					),
 					dashboards.AddVariableDatasource(datasource),
 				),
@@ -129,14 +141,15 @@ func BuildKubernetesWorkloadNamespaceOverview(project string, datasource string,
 		dashboard.AddVariable("type",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("workload_type",
-					labelValuesVar.Matchers(
-						promql.SetLabelMatchers(
-							"namespace_workload_pod:kube_pod_owner:relabel{workload=~\".+\"}",
-							[]promql.LabelMatcher{
-								{Name: "cluster", Type: "=", Value: "$cluster"},
-								{Name: "namespace", Type: "=", Value: "$namespace"},
-							},
+					dashboards.AddVariableMatcher(
+						vector.New(
+							vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+							vector.WithLabelMatchers(label.New("workload").EqualRegexp(".+")),
 						),
+						[]*labels.Matcher{
+							{Name: "cluster", Type: labels.MatchEqual, Value: "$cluster"},
+							{Name: "namespace", Type: labels.MatchEqual, Value: "$namespace"},
+						},
 					),
 					dashboards.AddVariableDatasource(datasource),
 				),
@@ -147,10 +160,7 @@ func BuildKubernetesWorkloadNamespaceOverview(project string, datasource string,
 
 	clusterLabelMatcher := dashboards.GetClusterLabelMatcherV2(clusterLabelName)
 
-	vars := defaultVars
-	if len(variableOverrides) > 0 {
-		vars = variableOverrides
-	}
+	vars := config.Variables(defaultVars)
 	options := append([]dashboard.Option{
 		dashboard.ProjectName(project),
 		dashboard.Name("Kubernetes / Compute Resources / Namespace (Workloads)"),
@@ -158,15 +168,15 @@ func BuildKubernetesWorkloadNamespaceOverview(project string, datasource string,
 	options = append(options,
 		dashboard.ProjectName(project),
 		dashboard.Name("Kubernetes / Compute Resources / Namespace (Workloads)"),
-		withWorkloadNamespaceCPUUsageGroup(datasource, clusterLabelMatcher),
-		withWorkloadNamespaceCPUUsageQuotaGroup(datasource, clusterLabelMatcher),
-		withWorkloadNamespaceMemoryUsageGroup(datasource, clusterLabelMatcher),
-		withWorkloadNamespaceMemoryUsageQuotaGroup(datasource, clusterLabelMatcher),
-		withWorkloadNamespaceNetworkUsageGroup(datasource, clusterLabelMatcher),
-		withWorkloadNamespaceBandwidthGroup(datasource, clusterLabelMatcher),
-		withWorkloadNamespaceAvgContainerBandwidthGroup(datasource, clusterLabelMatcher),
-		withWorkloadNamespaceRateOfPacketsGroup(datasource, clusterLabelMatcher),
-		withWorkloadNamespaceRateOfPacketsDroppedGroup(datasource, clusterLabelMatcher),
+		withWorkloadNamespaceCPUUsageGroup(datasource, queries, clusterLabelMatcher),
+		withWorkloadNamespaceCPUUsageQuotaGroup(datasource, queries, clusterLabelMatcher),
+		withWorkloadNamespaceMemoryUsageGroup(datasource, queries, clusterLabelMatcher),
+		withWorkloadNamespaceMemoryUsageQuotaGroup(datasource, queries, clusterLabelMatcher),
+		withWorkloadNamespaceNetworkUsageGroup(datasource, queries, clusterLabelMatcher),
+		withWorkloadNamespaceBandwidthGroup(datasource, queries, clusterLabelMatcher),
+		withWorkloadNamespaceAvgContainerBandwidthGroup(datasource, queries, clusterLabelMatcher),
+		withWorkloadNamespaceRateOfPacketsGroup(datasource, queries, clusterLabelMatcher),
+		withWorkloadNamespaceRateOfPacketsDroppedGroup(datasource, queries, clusterLabelMatcher),
 	)
 	return dashboards.NewDashboardResult(
 		dashboard.New("kubernetes-workload-ns-resources-overview", options...),
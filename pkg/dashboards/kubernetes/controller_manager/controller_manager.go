@@ -15,6 +15,7 @@ package controller_manager
 
 import (
 	"github.com/perses/community-mixins/pkg/dashboards"
+	"github.com/perses/community-mixins/pkg/dashboards/kubernetes"
 	panelsGostats "github.com/perses/community-mixins/pkg/panels/gostats"
 	panels "github.com/perses/community-mixins/pkg/panels/kubernetes"
 	"github.com/perses/community-mixins/pkg/promql"
@@ -22,36 +23,34 @@ import (
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
 	listVar "github.com/perses/perses/go-sdk/variable/list-variable"
 	labelValuesVar "github.com/perses/plugins/prometheus/sdk/go/variable/label-values"
+	"github.com/perses/promql-builder/vector"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func withCMStatsGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withCMStatsGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Controller Manager Status",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(8),
-		panels.ControllerManagerUpStatus(datasource, labelMatcher),
+		panels.ControllerManagerUpStatus(datasource, queries, labelMatcher),
 	)
 }
 
-func withCMWorkQueueGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withCMWorkQueueGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Work Queue",
 		panelgroup.PanelsPerLine(3),
 		panelgroup.PanelHeight(8),
-		panels.WorkQueueAddRate(datasource, labelMatcher),
-		panels.WorkQueueDepth(datasource, labelMatcher),
-		panels.WorkQueueLatency(datasource, labelMatcher),
+		panels.WorkQueueAddRate(datasource, queries, labelMatcher),
+		panels.WorkQueueDepth(datasource, queries, labelMatcher),
+		panels.WorkQueueLatency(datasource, queries, labelMatcher),
 	)
 }
 
-func withCMKubeAPIRequestsGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withCMKubeAPIRequestsGroup(datasource string, queries map[string]parser.Expr, jobMatcher *labels.Matcher, labelMatcher *labels.Matcher) dashboard.Option {
 	labelMatchersToUse := []*labels.Matcher{
 		promql.ClusterVarV2,
 		promql.InstanceVarV2,
-		{
-			Name:  "job",
-			Value: panels.CONTROLLER_MANAGER_LABEL_VALUE,
-			Type:  labels.MatchEqual,
-		},
+		jobMatcher,
 	}
 
 	labelMatchersToUse = append(labelMatchersToUse, labelMatcher)
@@ -59,22 +58,18 @@ func withCMKubeAPIRequestsGroup(datasource string, labelMatcher *labels.Matcher)
 	return dashboard.AddPanelGroup("Kube API Requests",
 		panelgroup.PanelsPerLine(3),
 		panelgroup.PanelHeight(8),
-		panels.KubeAPIRequestRate(datasource, labelMatchersToUse...),
-		panels.PostRequestLatency(datasource, labelMatchersToUse...),
-		panels.GetRequestLatency(datasource, labelMatchersToUse...),
+		panels.KubeAPIRequestRate(datasource, queries, labelMatchersToUse...),
+		panels.PostRequestLatency(datasource, queries, labelMatchersToUse...),
+		panels.GetRequestLatency(datasource, queries, labelMatchersToUse...),
 	)
 }
 
-func withCMResources(datasource string, clusterLabelMatcher *labels.Matcher) dashboard.Option {
+func withCMResources(datasource string, jobMatcher *labels.Matcher, clusterLabelMatcher *labels.Matcher) dashboard.Option {
 	// TODO(saswatamcode): Add a way to configure these.
 	labelMatchersToUse := []*labels.Matcher{
 		promql.ClusterVarV2,
 		promql.InstanceVarV2,
-		{
-			Name:  "job",
-			Value: panels.CONTROLLER_MANAGER_LABEL_VALUE,
-			Type:  labels.MatchEqual,
-		},
+		jobMatcher,
 	}
 
 	labelMatchersToUse = append(labelMatchersToUse, clusterLabelMatcher)
@@ -89,12 +84,20 @@ func withCMResources(datasource string, clusterLabelMatcher *labels.Matcher) das
 	)
 }
 
-func BuildControllerManagerOverview(project string, datasource string, clusterLabelName string, variableOverrides ...dashboard.Option) dashboards.DashboardResult {
+func BuildControllerManagerOverview(project string, datasource string, clusterLabelName string, opts ...kubernetes.KubernetesDashboardOption) dashboards.DashboardResult {
+	config := kubernetes.NewKubernetesDashboardConfig(opts...)
+	queries := config.PanelQueries()
 	defaultVars := []dashboard.Option{
 		dashboard.AddVariable("cluster",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("cluster",
-					labelValuesVar.Matchers("up{"+panels.GetControllerManagerMatcher()+"}"),
+					dashboards.AddVariableMatcher(
+						vector.New(
+							vector.WithMetricName("up"),
+							vector.WithLabelMatchers(config.JobLabelValues.ControllerManagerMatcher()),
+						),
+						nil,
+					),
 					dashboards.AddVariableDatasource(datasource),
 				),
 				listVar.DisplayName("cluster"),
@@ -103,11 +106,12 @@ func BuildControllerManagerOverview(project string, datasource string, clusterLa
 		dashboard.AddVariable("instance",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("instance",
-					labelValuesVar.Matchers(
-						promql.SetLabelMatchers(
-							"up{"+panels.GetControllerManagerMatcher()+"}",
-							[]promql.LabelMatcher{{Name: "cluster", Type: "=", Value: "$cluster"}},
+					dashboards.AddVariableMatcher(
+						vector.New(
+							vector.WithMetricName("up"),
+							vector.WithLabelMatchers(config.JobLabelValues.ControllerManagerMatcher()),
 						),
+						[]*labels.Matcher{{Name: "cluster", Type: labels.MatchEqual, Value: "$cluster"}},
 					),
 					dashboards.AddVariableDatasource(datasource),
 				),
@@ -118,19 +122,16 @@ func BuildControllerManagerOverview(project string, datasource string, clusterLa
 
 	clusterLabelMatcher := dashboards.GetClusterLabelMatcherV2(clusterLabelName)
 
-	vars := defaultVars
-	if len(variableOverrides) > 0 {
-		vars = variableOverrides
-	}
+	vars := config.Variables(defaultVars)
 	options := append([]dashboard.Option{
 		dashboard.ProjectName(project),
 		dashboard.Name("Kubernetes / Controller Manager"),
 	}, vars...)
 	options = append(options,
-		withCMStatsGroup(datasource, clusterLabelMatcher),
-		withCMWorkQueueGroup(datasource, clusterLabelMatcher),
-		withCMKubeAPIRequestsGroup(datasource, clusterLabelMatcher),
-		withCMResources(datasource, clusterLabelMatcher),
+		withCMStatsGroup(datasource, queries, clusterLabelMatcher),
+		withCMWorkQueueGroup(datasource, queries, clusterLabelMatcher),
+		withCMKubeAPIRequestsGroup(datasource, queries, config.JobLabelValues.ControllerManagerMatcher(), clusterLabelMatcher),
+		withCMResources(datasource, config.JobLabelValues.ControllerManagerMatcher(), clusterLabelMatcher),
 	)
 	return dashboards.NewDashboardResult(
 		dashboard.New("controller-manager-overview", options...),
@@ -15,6 +15,7 @@ package kubelet
 
 import (
 	"github.com/perses/community-mixins/pkg/dashboards"
+	"github.com/perses/community-mixins/pkg/dashboards/kubernetes"
 	panelsGostats "github.com/perses/community-mixins/pkg/panels/gostats"
 	panels "github.com/perses/community-mixins/pkg/panels/kubernetes"
 	"github.com/perses/community-mixins/pkg/promql"
@@ -22,106 +23,104 @@ import (
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
 	listVar "github.com/perses/perses/go-sdk/variable/list-variable"
 	labelValuesVar "github.com/perses/plugins/prometheus/sdk/go/variable/label-values"
+	"github.com/perses/promql-builder/vector"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func withKubeletStats(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withKubeletStats(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Kubelet Stats",
 		panelgroup.PanelsPerLine(6),
-		panels.RunningKubeletStat(datasource, labelMatcher),
-		panels.RunningPodStat(datasource, labelMatcher),
-		panels.RunningContainersStat(datasource, labelMatcher),
-		panels.ActVolumeCountStat(datasource, labelMatcher),
-		panels.DesiredVolumeCountStat(datasource, labelMatcher),
-		panels.ConfigErrorCountStat(datasource, labelMatcher),
+		panels.RunningKubeletStat(datasource, queries, labelMatcher),
+		panels.RunningPodStat(datasource, queries, labelMatcher),
+		panels.RunningContainersStat(datasource, queries, labelMatcher),
+		panels.ActVolumeCountStat(datasource, queries, labelMatcher),
+		panels.DesiredVolumeCountStat(datasource, queries, labelMatcher),
+		panels.ConfigErrorCountStat(datasource, queries, labelMatcher),
 	)
 }
 
-func withKubeletOperations(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withKubeletOperations(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Operation Rate and Errors",
 		panelgroup.PanelsPerLine(2),
-		panels.OperationRate(datasource, labelMatcher),
-		panels.OperationErrorRate(datasource, labelMatcher),
+		panels.OperationRate(datasource, queries, labelMatcher),
+		panels.OperationErrorRate(datasource, queries, labelMatcher),
 	)
 }
 
-func withKubeletOperationsQuantile(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withKubeletOperationsQuantile(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Operation Duration 99th quantile",
 		panelgroup.PanelsPerLine(1),
-		panels.OperationDurationQuantile(datasource, labelMatcher),
+		panels.OperationDurationQuantile(datasource, queries, labelMatcher),
 	)
 }
 
-func withPodStartRateAndDuration(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withPodStartRateAndDuration(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Pod Start Rate and Duration",
 		panelgroup.PanelsPerLine(2),
-		panels.PodStartRate(datasource, labelMatcher),
-		panels.PodStartDuration(datasource, labelMatcher),
+		panels.PodStartRate(datasource, queries, labelMatcher),
+		panels.PodStartDuration(datasource, queries, labelMatcher),
 	)
 }
 
-func withStorageOperationsAndErrors(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withStorageOperationsAndErrors(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Storage Operations Rate and Errors",
 		panelgroup.PanelsPerLine(2),
-		panels.StorageOperationRate(datasource, labelMatcher),
-		panels.StorageOperationErrorRate(datasource, labelMatcher),
+		panels.StorageOperationRate(datasource, queries, labelMatcher),
+		panels.StorageOperationErrorRate(datasource, queries, labelMatcher),
 	)
 }
 
-func withStorageOperationsQuantile(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withStorageOperationsQuantile(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Storage Operation Duration 99th quantile",
 		panelgroup.PanelsPerLine(1),
-		panels.StorageOperationDuration(datasource, labelMatcher),
+		panels.StorageOperationDuration(datasource, queries, labelMatcher),
 	)
 }
 
-func withCgroupManager(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withCgroupManager(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Cgroup manager",
 		panelgroup.PanelsPerLine(2),
-		panels.CgroupManagerOperationRate(datasource, labelMatcher),
-		panels.CgroupManagerQuantile(datasource, labelMatcher),
+		panels.CgroupManagerOperationRate(datasource, queries, labelMatcher),
+		panels.CgroupManagerQuantile(datasource, queries, labelMatcher),
 	)
 }
 
-func withPLEGRelist(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withPLEGRelist(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("PLEG relist",
 		panelgroup.PanelsPerLine(2),
-		panels.PLEGRelistRate(datasource, labelMatcher),
-		panels.PLEGRelistInterval(datasource, labelMatcher),
+		panels.PLEGRelistRate(datasource, queries, labelMatcher),
+		panels.PLEGRelistInterval(datasource, queries, labelMatcher),
 	)
 }
 
-func withPLEGRelistDuration(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withPLEGRelistDuration(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("PLEG relist duration",
 		panelgroup.PanelsPerLine(1),
-		panels.PLEGRelistDuration(datasource, labelMatcher),
+		panels.PLEGRelistDuration(datasource, queries, labelMatcher),
 	)
 }
 
-func withRPCRate(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withRPCRate(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("RPC rate",
 		panelgroup.PanelsPerLine(1),
-		panels.RPCRate(datasource, labelMatcher),
+		panels.RPCRate(datasource, queries, labelMatcher),
 	)
 }
 
-func withRequestDurationQuantile(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withRequestDurationQuantile(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Request duration 99th quantile",
 		panelgroup.PanelsPerLine(1),
-		panels.RequestDurationQuantile(datasource, labelMatcher),
+		panels.RequestDurationQuantile(datasource, queries, labelMatcher),
 	)
 }
 
-func withKubeletResources(datasource string, clusterLabelMatcher *labels.Matcher) dashboard.Option {
+func withKubeletResources(datasource string, jobMatcher *labels.Matcher, clusterLabelMatcher *labels.Matcher) dashboard.Option {
 	// TODO(saswatamcode): Add a way to configure these.
 	labelMatchersToUse := []*labels.Matcher{
 		promql.ClusterVarV2,
 		promql.InstanceVarV2,
-		{
-			Name:  "job",
-			Value: panels.KUBELET_LABEL_VALUE,
-			Type:  labels.MatchEqual,
-		},
+		jobMatcher,
 	}
 
 	labelMatchersToUse = append(labelMatchersToUse, clusterLabelMatcher)
@@ -136,12 +135,20 @@ func withKubeletResources(datasource string, clusterLabelMatcher *labels.Matcher
 	)
 }
 
-func BuildKubeletOverview(project string, datasource string, clusterLabelName string, variableOverrides ...dashboard.Option) dashboards.DashboardResult {
+func BuildKubeletOverview(project string, datasource string, clusterLabelName string, opts ...kubernetes.KubernetesDashboardOption) dashboards.DashboardResult {
+	config := kubernetes.NewKubernetesDashboardConfig(opts...)
+	queries := config.PanelQueries()
 	defaultVars := []dashboard.Option{
 		dashboard.AddVariable("cluster",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("cluster",
-					labelValuesVar.Matchers("up{"+panels.GetKubeletMatcher()+"}"),
+					dashboards.AddVariableMatcher(
+						vector.New(
+							vector.WithMetricName("up"),
+							vector.WithLabelMatchers(config.JobLabelValues.KubeletMatcher()),
+						),
+						nil,
+					),
 					dashboards.AddVariableDatasource(datasource),
 				),
 				listVar.DisplayName("cluster"),
@@ -150,11 +157,12 @@ func BuildKubeletOverview(project string, datasource string, clusterLabelName st
 		dashboard.AddVariable("instance",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("instance",
-					labelValuesVar.Matchers(
-						promql.SetLabelMatchers(
-							"up{"+panels.GetKubeletMatcher()+"}",
-							[]promql.LabelMatcher{{Name: "cluster", Type: "=", Value: "$cluster"}},
+					dashboards.AddVariableMatcher(
+						vector.New(
+							vector.WithMetricName("up"),
+							vector.WithLabelMatchers(config.JobLabelValues.KubeletMatcher()),
 						),
+						[]*labels.Matcher{{Name: "cluster", Type: labels.MatchEqual, Value: "$cluster"}},
 					),
 					dashboards.AddVariableDatasource(datasource),
 				),
@@ -165,27 +173,24 @@ func BuildKubeletOverview(project string, datasource string, clusterLabelName st
 
 	clusterLabelMatcher := dashboards.GetClusterLabelMatcherV2(clusterLabelName)
 
-	vars := defaultVars
-	if len(variableOverrides) > 0 {
-		vars = variableOverrides
-	}
+	vars := config.Variables(defaultVars)
 	options := append([]dashboard.Option{
 		dashboard.ProjectName(project),
 		dashboard.Name("Kubernetes / Kubelet"),
 	}, vars...)
 	options = append(options,
-		withKubeletStats(datasource, clusterLabelMatcher),
-		withKubeletOperations(datasource, clusterLabelMatcher),
-		withKubeletOperationsQuantile(datasource, clusterLabelMatcher),
-		withPodStartRateAndDuration(datasource, clusterLabelMatcher),
-		withStorageOperationsAndErrors(datasource, clusterLabelMatcher),
-		withStorageOperationsQuantile(datasource, clusterLabelMatcher),
-		withCgroupManager(datasource, clusterLabelMatcher),
-		withPLEGRelist(datasource, clusterLabelMatcher),
-		withPLEGRelistDuration(datasource, clusterLabelMatcher),
-		withRPCRate(datasource, clusterLabelMatcher),
-		withRequestDurationQuantile(datasource, clusterLabelMatcher),
-		withKubeletResources(datasource, clusterLabelMatcher),
+		withKubeletStats(datasource, queries, clusterLabelMatcher),
+		withKubeletOperations(datasource, queries, clusterLabelMatcher),
+		withKubeletOperationsQuantile(datasource, queries, clusterLabelMatcher),
+		withPodStartRateAndDuration(datasource, queries, clusterLabelMatcher),
+		withStorageOperationsAndErrors(datasource, queries, clusterLabelMatcher),
+		withStorageOperationsQuantile(datasource, queries, clusterLabelMatcher),
+		withCgroupManager(datasource, queries, clusterLabelMatcher),
+		withPLEGRelist(datasource, queries, clusterLabelMatcher),
+		withPLEGRelistDuration(datasource, queries, clusterLabelMatcher),
+		withRPCRate(datasource, queries, clusterLabelMatcher),
+		withRequestDurationQuantile(datasource, queries, clusterLabelMatcher),
+		withKubeletResources(datasource, config.JobLabelValues.KubeletMatcher(), clusterLabelMatcher),
 	)
 	return dashboards.NewDashboardResult(
 		dashboard.New("kubelet-overview", options...),
@@ -15,83 +15,94 @@ package networking
 
 import (
 	"github.com/perses/community-mixins/pkg/dashboards"
+	"github.com/perses/community-mixins/pkg/dashboards/kubernetes"
 	panels "github.com/perses/community-mixins/pkg/panels/kubernetes"
 	"github.com/perses/perses/go-sdk/dashboard"
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
 
 	listVar "github.com/perses/perses/go-sdk/variable/list-variable"
 	labelValuesVar "github.com/perses/plugins/prometheus/sdk/go/variable/label-values"
+	"github.com/perses/promql-builder/vector"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func withClusterCurrentRateBytesGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withClusterCurrentRateBytesGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Current Rate of Bytes",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesCurrentRateOfBytesReceived("cluster-networking", datasource, labelMatcher),
-		panels.KubernetesCurrentRateOfBytesTransmitted("cluster-networking", datasource, labelMatcher),
+		panels.KubernetesCurrentRateOfBytesReceived("cluster-networking", datasource, queries, labelMatcher),
+		panels.KubernetesCurrentRateOfBytesTransmitted("cluster-networking", datasource, queries, labelMatcher),
 	)
 }
 
-func withClusterNetworkingCurrentStatusGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withClusterNetworkingCurrentStatusGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Current Status",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(10),
-		panels.ClusterNetworkingCurrentStatus(datasource, labelMatcher),
+		panels.ClusterNetworkingCurrentStatus(datasource, queries, labelMatcher),
 	)
 }
 
-func withClusterAvgRateOfBytesGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withClusterAvgRateOfBytesGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Average Rate of Bytes",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesAverageRateOfBytesReceived("cluster-networking", datasource, labelMatcher),
-		panels.KubernetesAverageRateOfBytesTransmitted("cluster-networking", datasource, labelMatcher),
+		panels.KubernetesAverageRateOfBytesReceived("cluster-networking", datasource, queries, labelMatcher),
+		panels.KubernetesAverageRateOfBytesTransmitted("cluster-networking", datasource, queries, labelMatcher),
 	)
 }
 
-func withClusterBandwidthGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withClusterBandwidthGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Bandwidth",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesReceiveBandwidth("cluster-networking", datasource, labelMatcher),
-		panels.KubernetesTransmitBandwidth("cluster-networking", datasource, labelMatcher),
+		panels.KubernetesReceiveBandwidth("cluster-networking", datasource, queries, labelMatcher),
+		panels.KubernetesTransmitBandwidth("cluster-networking", datasource, queries, labelMatcher),
 	)
 }
 
-func withClusterRateOfPacketsGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withClusterRateOfPacketsGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Rate of Packets",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesReceivedPackets("cluster-networking", datasource, labelMatcher),
-		panels.KubernetesTransmittedPackets("cluster-networking", datasource, labelMatcher),
+		panels.KubernetesReceivedPackets("cluster-networking", datasource, queries, labelMatcher),
+		panels.KubernetesTransmittedPackets("cluster-networking", datasource, queries, labelMatcher),
 	)
 }
 
-func withClusterRateOfPacketsDroppedGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withClusterRateOfPacketsDroppedGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Rate of Packets Dropped",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesReceivedPacketsDropped("cluster-networking", datasource, labelMatcher),
-		panels.KubernetesTransmittedPacketsDropped("cluster-networking", datasource, labelMatcher),
+		panels.KubernetesReceivedPacketsDropped("cluster-networking", datasource, queries, labelMatcher),
+		panels.KubernetesTransmittedPacketsDropped("cluster-networking", datasource, queries, labelMatcher),
 	)
 }
 
-func withClusterTCPRetransmitRateGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withClusterTCPRetransmitRateGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("TCP Retransmit Rate",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.ClusterTCPRetransmitRate(datasource, labelMatcher),
-		panels.ClusterTCPSYNRetransmitRate(datasource, labelMatcher),
+		panels.ClusterTCPRetransmitRate(datasource, queries, labelMatcher),
+		panels.ClusterTCPSYNRetransmitRate(datasource, queries, labelMatcher),
 	)
 }
 
-func BuildKubernetesClusterOverview(project string, datasource string, clusterLabelName string, variableOverrides ...dashboard.Option) dashboards.DashboardResult {
+func BuildKubernetesClusterOverview(project string, datasource string, clusterLabelName string, opts ...kubernetes.KubernetesDashboardOption) dashboards.DashboardResult {
+	config := kubernetes.NewKubernetesDashboardConfig(opts...)
+	queries := config.PanelQueries()
 	defaultVars := []dashboard.Option{
 		dashboard.AddVariable("cluster",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("cluster",
-					labelValuesVar.Matchers("up{"+panels.GetKubeletMatcher()+"}"),
+					dashboards.AddVariableMatcher(
+						vector.New(
+							vector.WithMetricName("up"),
+							vector.WithLabelMatchers(config.JobLabelValues.KubeletMatcher()),
+						),
+						nil,
+					),
 					dashboards.AddVariableDatasource(datasource),
 				),
 				listVar.DisplayName("cluster"),
@@ -100,22 +111,19 @@ func BuildKubernetesClusterOverview(project string, datasource string, clusterLa
 	}
 	clusterLabelMatcher := dashboards.GetClusterLabelMatcherV2(clusterLabelName)
 
-	vars := defaultVars
-	if len(variableOverrides) > 0 {
-		vars = variableOverrides
-	}
+	vars := config.Variables(defaultVars)
 	options := append([]dashboard.Option{
 		dashboard.ProjectName(project),
 		dashboard.Name("Kubernetes / Networking / Cluster"),
 	}, vars...)
 	options = append(options,
-		withClusterCurrentRateBytesGroup(datasource, clusterLabelMatcher),
-		withClusterNetworkingCurrentStatusGroup(datasource, clusterLabelMatcher),
-		withClusterAvgRateOfBytesGroup(datasource, clusterLabelMatcher),
-		withClusterBandwidthGroup(datasource, clusterLabelMatcher),
-		withClusterRateOfPacketsGroup(datasource, clusterLabelMatcher),
-		withClusterRateOfPacketsDroppedGroup(datasource, clusterLabelMatcher),
-		withClusterTCPRetransmitRateGroup(datasource, clusterLabelMatcher),
+		withClusterCurrentRateBytesGroup(datasource, queries, clusterLabelMatcher),
+		withClusterNetworkingCurrentStatusGroup(datasource, queries, clusterLabelMatcher),
+		withClusterAvgRateOfBytesGroup(datasource, queries, clusterLabelMatcher),
+		withClusterBandwidthGroup(datasource, queries, clusterLabelMatcher),
+		withClusterRateOfPacketsGroup(datasource, queries, clusterLabelMatcher),
+		withClusterRateOfPacketsDroppedGroup(datasource, queries, clusterLabelMatcher),
+		withClusterTCPRetransmitRateGroup(datasource, queries, clusterLabelMatcher),
 	)
 	return dashboards.NewDashboardResult(
 		dashboard.New("kubernetes-cluster-networking-overview", options...),
@@ -15,66 +15,76 @@ package networking
 
 import (
 	"github.com/perses/community-mixins/pkg/dashboards"
+	"github.com/perses/community-mixins/pkg/dashboards/kubernetes"
 	panels "github.com/perses/community-mixins/pkg/panels/kubernetes"
-	"github.com/perses/community-mixins/pkg/promql"
 	"github.com/perses/perses/go-sdk/dashboard"
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
+	"github.com/perses/promql-builder/vector"
 
 	listVar "github.com/perses/perses/go-sdk/variable/list-variable"
 	labelValuesVar "github.com/perses/plugins/prometheus/sdk/go/variable/label-values"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func withNamespaceCurrentRateBytesGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withNamespaceCurrentRateBytesGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Current Rate of Bytes",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesCurrentRateOfBytesReceived("namespace-pod-networking", datasource, labelMatcher),
-		panels.KubernetesCurrentRateOfBytesTransmitted("namespace-pod-networking", datasource, labelMatcher),
+		panels.KubernetesCurrentRateOfBytesReceived("namespace-pod-networking", datasource, queries, labelMatcher),
+		panels.KubernetesCurrentRateOfBytesTransmitted("namespace-pod-networking", datasource, queries, labelMatcher),
 	)
 }
 
-func withNamespaceNetworkUsageGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withNamespaceNetworkUsageGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Network Usage",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(10),
-		panels.NamespaceCurrentNetworkUsage(datasource, labelMatcher),
+		panels.NamespaceCurrentNetworkUsage(datasource, queries, labelMatcher),
 	)
 }
 
-func withNamespaceBandwidthGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withNamespaceBandwidthGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Bandwidth",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesReceiveBandwidth("namespace-pod-networking", datasource, labelMatcher),
-		panels.KubernetesTransmitBandwidth("namespace-pod-networking", datasource, labelMatcher),
+		panels.KubernetesReceiveBandwidth("namespace-pod-networking", datasource, queries, labelMatcher),
+		panels.KubernetesTransmitBandwidth("namespace-pod-networking", datasource, queries, labelMatcher),
 	)
 }
 
-func withNamespaceRateOfPacketsGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withNamespaceRateOfPacketsGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Rate of Packets",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesReceivedPackets("namespace-pod-networking", datasource, labelMatcher),
-		panels.KubernetesTransmittedPackets("namespace-pod-networking", datasource, labelMatcher),
+		panels.KubernetesReceivedPackets("namespace-pod-networking", datasource, queries, labelMatcher),
+		panels.KubernetesTransmittedPackets("namespace-pod-networking", datasource, queries, labelMatcher),
 	)
 }
 
-func withNamespaceRateOfPacketsDroppedGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withNamespaceRateOfPacketsDroppedGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Rate of Packets Dropped",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesReceivedPacketsDropped("namespace-pod-networking", datasource, labelMatcher),
-		panels.KubernetesTransmittedPacketsDropped("namespace-pod-networking", datasource, labelMatcher),
+		panels.KubernetesReceivedPacketsDropped("namespace-pod-networking", datasource, queries, labelMatcher),
+		panels.KubernetesTransmittedPacketsDropped("namespace-pod-networking", datasource, queries, labelMatcher),
 	)
 }
 
-func BuildKubernetesNamespaceByPodOverview(project string, datasource string, clusterLabelName string, variableOverrides ...dashboard.Option) dashboards.DashboardResult {
+func BuildKubernetesNamespaceByPodOverview(project string, datasource string, clusterLabelName string, opts ...kubernetes.KubernetesDashboardOption) dashboards.DashboardResult {
+	config := kubernetes.NewKubernetesDashboardConfig(opts...)
+	queries := config.PanelQueries()
 	defaultVars := []dashboard.Option{
 		dashboard.AddVariable("cluster",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("cluster",
-					labelValuesVar.Matchers("up{"+panels.GetKubeletMatcher()+"}"),
+					dashboards.AddVariableMatcher(
+						vector.New(
+							vector.WithMetricName("up"),
+							vector.WithLabelMatchers(config.JobLabelValues.KubeletMatcher()),
+						),
+						nil,
+					),
 					dashboards.AddVariableDatasource(datasource),
 				),
 				listVar.DisplayName("cluster"),
@@ -83,11 +93,9 @@ func BuildKubernetesNamespaceByPodOverview(project string, datasource string, cl
 		dashboard.AddVariable("namespace",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("namespace",
-					labelValuesVar.Matchers(
-						promql.SetLabelMatchers(
-							"container_network_receive_packets_total",
-							[]promql.LabelMatcher{{Name: "cluster", Type: "=", Value: "$cluster"}},
-						),
+					dashboards.AddVariableMatcher(
+						vector.New(vector.WithMetricName("container_network_receive_packets_total")),
+						[]*labels.Matcher{{Name: "cluster", Type: labels.MatchEqual, Value: "$cluster"}},
 					),
 					dashboards.AddVariableDatasource(datasource),
 				),
@@ -98,20 +106,17 @@ func BuildKubernetesNamespaceByPodOverview(project string, datasource string, cl
 
 	clusterLabelMatcher := dashboards.GetClusterLabelMatcherV2(clusterLabelName)
 
-	vars := defaultVars
-	if len(variableOverrides) > 0 {
-		vars = variableOverrides
-	}
+	vars := config.Variables(defaultVars)
 	options := append([]dashboard.Option{
 		dashboard.ProjectName(project),
 		dashboard.Name("Kubernetes / Networking / Namespace (Pods)"),
 	}, vars...)
 	options = append(options,
-		withNamespaceCurrentRateBytesGroup(datasource, clusterLabelMatcher),
-		withNamespaceNetworkUsageGroup(datasource, clusterLabelMatcher),
-		withNamespaceBandwidthGroup(datasource, clusterLabelMatcher),
-		withNamespaceRateOfPacketsGroup(datasource, clusterLabelMatcher),
-		withNamespaceRateOfPacketsDroppedGroup(datasource, clusterLabelMatcher),
+		withNamespaceCurrentRateBytesGroup(datasource, queries, clusterLabelMatcher),
+		withNamespaceNetworkUsageGroup(datasource, queries, clusterLabelMatcher),
+		withNamespaceBandwidthGroup(datasource, queries, clusterLabelMatcher),
+		withNamespaceRateOfPacketsGroup(datasource, queries, clusterLabelMatcher),
+		withNamespaceRateOfPacketsDroppedGroup(datasource, queries, clusterLabelMatcher),
 	)
 	return dashboards.NewDashboardResult(
 		dashboard.New("kubernetes-namespace-networking-overview", options...),
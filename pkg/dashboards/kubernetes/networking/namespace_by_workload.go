@@ -15,75 +15,86 @@ package networking
 
 import (
 	"github.com/perses/community-mixins/pkg/dashboards"
+	"github.com/perses/community-mixins/pkg/dashboards/kubernetes"
 	panels "github.com/perses/community-mixins/pkg/panels/kubernetes"
-	"github.com/perses/community-mixins/pkg/promql"
 	"github.com/perses/perses/go-sdk/dashboard"
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
+	"github.com/perses/promql-builder/label"
+	"github.com/perses/promql-builder/vector"
 
 	listVar "github.com/perses/perses/go-sdk/variable/list-variable"
 	labelValuesVar "github.com/perses/plugins/prometheus/sdk/go/variable/label-values"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func withWorkloadNamespaceCurrentRateBytesGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadNamespaceCurrentRateBytesGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Current Rate of Bytes",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesCurrentRateOfBytesReceived("namespace-workload-networking", datasource, labelMatcher),
-		panels.KubernetesCurrentRateOfBytesTransmitted("namespace-workload-networking", datasource, labelMatcher),
+		panels.KubernetesCurrentRateOfBytesReceived("namespace-workload-networking", datasource, queries, labelMatcher),
+		panels.KubernetesCurrentRateOfBytesTransmitted("namespace-workload-networking", datasource, queries, labelMatcher),
 	)
 }
 
-func withWorkloadNamespaceNetworkStatusGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadNamespaceNetworkStatusGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Current Status",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(10),
-		panels.WorkloadNamespaceCurrentNetworkStatus(datasource, labelMatcher),
+		panels.WorkloadNamespaceCurrentNetworkStatus(datasource, queries, labelMatcher),
 	)
 }
 
-func withWorkloadNamespaceBandwidthGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadNamespaceBandwidthGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Bandwidth",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesReceiveBandwidth("namespace-workload-networking", datasource, labelMatcher),
-		panels.KubernetesTransmitBandwidth("namespace-workload-networking", datasource, labelMatcher),
+		panels.KubernetesReceiveBandwidth("namespace-workload-networking", datasource, queries, labelMatcher),
+		panels.KubernetesTransmitBandwidth("namespace-workload-networking", datasource, queries, labelMatcher),
 	)
 }
 
-func withWorkloadNamespaceAvgContainerBandwidthGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadNamespaceAvgContainerBandwidthGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Average Container Bandwidth",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesAvgContainerBandwidthReceived("namespace-workload-networking", datasource, labelMatcher),
-		panels.KubernetesAvgContainerBandwidthTransmitted("namespace-workload-networking", datasource, labelMatcher),
+		panels.KubernetesAvgContainerBandwidthReceived("namespace-workload-networking", datasource, queries, labelMatcher),
+		panels.KubernetesAvgContainerBandwidthTransmitted("namespace-workload-networking", datasource, queries, labelMatcher),
 	)
 }
 
-func withWorkloadNamespaceRateOfPacketsGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadNamespaceRateOfPacketsGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Rate of Packets",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesReceivedPackets("namespace-workload-networking", datasource, labelMatcher),
-		panels.KubernetesTransmittedPackets("namespace-workload-networking", datasource, labelMatcher),
+		panels.KubernetesReceivedPackets("namespace-workload-networking", datasource, queries, labelMatcher),
+		panels.KubernetesTransmittedPackets("namespace-workload-networking", datasource, queries, labelMatcher),
 	)
 }
 
-func withWorkloadNamespaceRateOfPacketsDroppedGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadNamespaceRateOfPacketsDroppedGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Rate of Packets Dropped",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesReceivedPacketsDropped("namespace-workload-networking", datasource, labelMatcher),
-		panels.KubernetesTransmittedPacketsDropped("namespace-workload-networking", datasource, labelMatcher),
+		panels.KubernetesReceivedPacketsDropped("namespace-workload-networking", datasource, queries, labelMatcher),
+		panels.KubernetesTransmittedPacketsDropped("namespace-workload-networking", datasource, queries, labelMatcher),
 	)
 }
 
-func BuildKubernetesNamespaceByWorkloadOverview(project string, datasource string, clusterLabelName string, variableOverrides ...dashboard.Option) dashboards.DashboardResult {
+func BuildKubernetesNamespaceByWorkloadOverview(project string, datasource string, clusterLabelName string, opts ...kubernetes.KubernetesDashboardOption) dashboards.DashboardResult {
+	config := kubernetes.NewKubernetesDashboardConfig(opts...)
+	queries := config.PanelQueries()
 	defaultVars := []dashboard.Option{
 		dashboard.AddVariable("cluster",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("cluster",
-					labelValuesVar.Matchers("up{"+panels.GetKubeletMatcher()+"}"),
+					dashboards.AddVariableMatcher(
+						vector.New(
+							vector.WithMetricName("up"),
+							vector.WithLabelMatchers(config.JobLabelValues.KubeletMatcher()),
+						),
+						nil,
+					),
 					dashboards.AddVariableDatasource(datasource),
 				),
 				listVar.DisplayName("cluster"),
@@ -92,11 +103,9 @@ func BuildKubernetesNamespaceByWorkloadOverview(project string, datasource strin
 		dashboard.AddVariable("namespace",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("namespace",
-					labelValuesVar.Matchers(
-						promql.SetLabelMatchers(
-							"container_network_receive_packets_total",
-							[]promql.LabelMatcher{{Name: "cluster", Type: "=", Value: "$cluster"}},
-						),
+					dashboards.AddVariableMatcher(
+						vector.New(vector.WithMetricName("container_network_receive_packets_total")),
+						[]*labels.Matcher{{Name: "cluster", Type: labels.MatchEqual, Value: "$cluster"}},
 					),
 					dashboards.AddVariableDatasource(datasource),
 				),
@@ -106,14 +115,15 @@ func BuildKubernetesNamespaceByWorkloadOverview(project string, datasource strin
 		dashboard.AddVariable("type",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("workload_type",
-					labelValuesVar.Matchers(
-						promql.SetLabelMatchers(
-							"namespace_workload_pod:kube_pod_owner:relabel{workload=~\".+\"}",
-							[]promql.LabelMatcher{
-								{Name: "cluster", Type: "=", Value: "$cluster"},
-								{Name: "namespace", Type: "=", Value: "$namespace"},
-							},
+					dashboards.AddVariableMatcher(
+						vector.New(
+							vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+							vector.WithLabelMatchers(label.New("workload").EqualRegexp(".+")),
 						),
+						[]*labels.Matcher{
+							{Name: "cluster", Type: labels.MatchEqual, Value: "$cluster"},
+							{Name: "namespace", Type: labels.MatchEqual, Value: "$namespace"},
+						},
 					),
 					dashboards.AddVariableDatasource(datasource),
 				),
@@ -124,21 +134,18 @@ func BuildKubernetesNamespaceByWorkloadOverview(project string, datasource strin
 
 	clusterLabelMatcher := dashboards.GetClusterLabelMatcherV2(clusterLabelName)
 
-	vars := defaultVars
-	if len(variableOverrides) > 0 {
-		vars = variableOverrides
-	}
+	vars := config.Variables(defaultVars)
 	options := append([]dashboard.Option{
 		dashboard.ProjectName(project),
 		dashboard.Name("Kubernetes / Networking / Namespace (Workloads)"),
 	}, vars...)
 	options = append(options,
-		withWorkloadNamespaceCurrentRateBytesGroup(datasource, clusterLabelMatcher),
-		withWorkloadNamespaceNetworkStatusGroup(datasource, clusterLabelMatcher),
-		withWorkloadNamespaceBandwidthGroup(datasource, clusterLabelMatcher),
-		withWorkloadNamespaceAvgContainerBandwidthGroup(datasource, clusterLabelMatcher),
-		withWorkloadNamespaceRateOfPacketsGroup(datasource, clusterLabelMatcher),
-		withWorkloadNamespaceRateOfPacketsDroppedGroup(datasource, clusterLabelMatcher),
+		withWorkloadNamespaceCurrentRateBytesGroup(datasource, queries, clusterLabelMatcher),
+		withWorkloadNamespaceNetworkStatusGroup(datasource, queries, clusterLabelMatcher),
+		withWorkloadNamespaceBandwidthGroup(datasource, queries, clusterLabelMatcher),
+		withWorkloadNamespaceAvgContainerBandwidthGroup(datasource, queries, clusterLabelMatcher),
+		withWorkloadNamespaceRateOfPacketsGroup(datasource, queries, clusterLabelMatcher),
+		withWorkloadNamespaceRateOfPacketsDroppedGroup(datasource, queries, clusterLabelMatcher),
 	)
 	return dashboards.NewDashboardResult(
 		dashboard.New("kubernetes-workload-ns-networking-overview", options...),
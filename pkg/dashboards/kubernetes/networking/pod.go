@@ -15,58 +15,68 @@ package networking
 
 import (
 	"github.com/perses/community-mixins/pkg/dashboards"
+	"github.com/perses/community-mixins/pkg/dashboards/kubernetes"
 	panels "github.com/perses/community-mixins/pkg/panels/kubernetes"
-	"github.com/perses/community-mixins/pkg/promql"
 	"github.com/perses/perses/go-sdk/dashboard"
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
+	"github.com/perses/promql-builder/vector"
 
 	listVar "github.com/perses/perses/go-sdk/variable/list-variable"
 	labelValuesVar "github.com/perses/plugins/prometheus/sdk/go/variable/label-values"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func withPodCurrentRateOfBytesGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withPodCurrentRateOfBytesGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Current Rate of Bytes",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesCurrentRateOfBytesReceived("pod-networking", datasource, labelMatcher),
-		panels.KubernetesCurrentRateOfBytesTransmitted("pod-networking", datasource, labelMatcher),
+		panels.KubernetesCurrentRateOfBytesReceived("pod-networking", datasource, queries, labelMatcher),
+		panels.KubernetesCurrentRateOfBytesTransmitted("pod-networking", datasource, queries, labelMatcher),
 	)
 }
 
-func withPodBandwidthGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withPodBandwidthGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Bandwidth",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesReceiveBandwidth("pod-networking", datasource, labelMatcher),
-		panels.KubernetesTransmitBandwidth("pod-networking", datasource, labelMatcher),
+		panels.KubernetesReceiveBandwidth("pod-networking", datasource, queries, labelMatcher),
+		panels.KubernetesTransmitBandwidth("pod-networking", datasource, queries, labelMatcher),
 	)
 }
 
-func withPodRateOfPacketsGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withPodRateOfPacketsGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Rate of Packets",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesReceivedPackets("pod-networking", datasource, labelMatcher),
-		panels.KubernetesTransmittedPackets("pod-networking", datasource, labelMatcher),
+		panels.KubernetesReceivedPackets("pod-networking", datasource, queries, labelMatcher),
+		panels.KubernetesTransmittedPackets("pod-networking", datasource, queries, labelMatcher),
 	)
 }
 
-func withPodRateOfPacketsDroppedGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withPodRateOfPacketsDroppedGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Rate of Packets Dropped",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesReceivedPacketsDropped("pod-networking", datasource, labelMatcher),
-		panels.KubernetesTransmittedPacketsDropped("pod-networking", datasource, labelMatcher),
+		panels.KubernetesReceivedPacketsDropped("pod-networking", datasource, queries, labelMatcher),
+		panels.KubernetesTransmittedPacketsDropped("pod-networking", datasource, queries, labelMatcher),
 	)
 }
 
-func BuildKubernetesPodOverview(project string, datasource string, clusterLabelName string, variableOverrides ...dashboard.Option) dashboards.DashboardResult {
+func BuildKubernetesPodOverview(project string, datasource string, clusterLabelName string, opts ...kubernetes.KubernetesDashboardOption) dashboards.DashboardResult {
+	config := kubernetes.NewKubernetesDashboardConfig(opts...)
+	queries := config.PanelQueries()
 	defaultVars := []dashboard.Option{
 		dashboard.AddVariable("cluster",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("cluster",
-					labelValuesVar.Matchers("up{"+panels.GetKubeletMatcher()+"}"),
+					dashboards.AddVariableMatcher(
+						vector.New(
+							vector.WithMetricName("up"),
+							vector.WithLabelMatchers(config.JobLabelValues.KubeletMatcher()),
+						),
+						nil,
+					),
 					dashboards.AddVariableDatasource(datasource),
 				),
 				listVar.DisplayName("cluster"),
@@ -75,11 +85,9 @@ func BuildKubernetesPodOverview(project string, datasource string, clusterLabelN
 		dashboard.AddVariable("namespace",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("namespace",
-					labelValuesVar.Matchers(
-						promql.SetLabelMatchers(
-							"container_network_receive_packets_total",
-							[]promql.LabelMatcher{{Name: "cluster", Type: "=", Value: "$cluster"}},
-						),
+					dashboards.AddVariableMatcher(
+						vector.New(vector.WithMetricName("container_network_receive_packets_total")),
+						[]*labels.Matcher{{Name: "cluster", Type: labels.MatchEqual, Value: "$cluster"}},
 					),
 					dashboards.AddVariableDatasource(datasource),
 				),
@@ -89,14 +97,12 @@ func BuildKubernetesPodOverview(project string, datasource string, clusterLabelN
 		dashboard.AddVariable("pod",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("pod",
-					labelValuesVar.Matchers(
-						promql.SetLabelMatchers(
-							"container_network_receive_packets_total",
-							[]promql.LabelMatcher{
-								{Name: "cluster", Type: "=", Value: "$cluster"},
-								{Name: "namespace", Type: "=", Value: "$namespace"},
-							},
-						),
+					dashboards.AddVariableMatcher(
+						vector.New(vector.WithMetricName("container_network_receive_packets_total")),
+						[]*labels.Matcher{
+							{Name: "cluster", Type: labels.MatchEqual, Value: "$cluster"},
+							{Name: "namespace", Type: labels.MatchEqual, Value: "$namespace"},
+						},
 					),
 					dashboards.AddVariableDatasource(datasource),
 				),
@@ -106,19 +112,16 @@ func BuildKubernetesPodOverview(project string, datasource string, clusterLabelN
 	}
 	clusterLabelMatcher := dashboards.GetClusterLabelMatcherV2(clusterLabelName)
 
-	vars := defaultVars
-	if len(variableOverrides) > 0 {
-		vars = variableOverrides
-	}
+	vars := config.Variables(defaultVars)
 	options := append([]dashboard.Option{
 		dashboard.ProjectName(project),
 		dashboard.Name("Kubernetes / Networking / Pod"),
 	}, vars...)
 	options = append(options,
-		withPodCurrentRateOfBytesGroup(datasource, clusterLabelMatcher),
-		withPodBandwidthGroup(datasource, clusterLabelMatcher),
-		withPodRateOfPacketsGroup(datasource, clusterLabelMatcher),
-		withPodRateOfPacketsDroppedGroup(datasource, clusterLabelMatcher),
+		withPodCurrentRateOfBytesGroup(datasource, queries, clusterLabelMatcher),
+		withPodBandwidthGroup(datasource, queries, clusterLabelMatcher),
+		withPodRateOfPacketsGroup(datasource, queries, clusterLabelMatcher),
+		withPodRateOfPacketsDroppedGroup(datasource, queries, clusterLabelMatcher),
 	)
 	return dashboards.NewDashboardResult(
 		dashboard.New("kubernetes-pod-networking-overview", options...),
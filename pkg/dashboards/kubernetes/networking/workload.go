@@ -15,67 +15,77 @@ package networking
 
 import (
 	"github.com/perses/community-mixins/pkg/dashboards"
+	"github.com/perses/community-mixins/pkg/dashboards/kubernetes"
 	panels "github.com/perses/community-mixins/pkg/panels/kubernetes"
-	"github.com/perses/community-mixins/pkg/promql"
 	"github.com/perses/perses/go-sdk/dashboard"
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
+	"github.com/perses/promql-builder/vector"
 
 	listVar "github.com/perses/perses/go-sdk/variable/list-variable"
 	labelValuesVar "github.com/perses/plugins/prometheus/sdk/go/variable/label-values"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func withWorkloadCurrentRateOfBytesGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadCurrentRateOfBytesGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Current Rate of Bytes",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesCurrentRateOfBytesReceived("workload-networking", datasource, labelMatcher),
-		panels.KubernetesCurrentRateOfBytesTransmitted("workload-networking", datasource, labelMatcher),
+		panels.KubernetesCurrentRateOfBytesReceived("workload-networking", datasource, queries, labelMatcher),
+		panels.KubernetesCurrentRateOfBytesTransmitted("workload-networking", datasource, queries, labelMatcher),
 	)
 }
 
-func withWorkloadAverageRateOfBytesGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadAverageRateOfBytesGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Average Rate of Bytes",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesAverageRateOfBytesReceived("workload-networking", datasource, labelMatcher),
-		panels.KubernetesAverageRateOfBytesTransmitted("workload-networking", datasource, labelMatcher),
+		panels.KubernetesAverageRateOfBytesReceived("workload-networking", datasource, queries, labelMatcher),
+		panels.KubernetesAverageRateOfBytesTransmitted("workload-networking", datasource, queries, labelMatcher),
 	)
 }
 
-func withWorkloadBandwidthGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadBandwidthGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Bandwidth",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesReceiveBandwidth("workload-networking", datasource, labelMatcher),
-		panels.KubernetesTransmitBandwidth("workload-networking", datasource, labelMatcher),
+		panels.KubernetesReceiveBandwidth("workload-networking", datasource, queries, labelMatcher),
+		panels.KubernetesTransmitBandwidth("workload-networking", datasource, queries, labelMatcher),
 	)
 }
 
-func withWorkloadRateOfPacketsGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadRateOfPacketsGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Rate of Packets",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesReceivedPackets("workload-networking", datasource, labelMatcher),
-		panels.KubernetesTransmittedPackets("workload-networking", datasource, labelMatcher),
+		panels.KubernetesReceivedPackets("workload-networking", datasource, queries, labelMatcher),
+		panels.KubernetesTransmittedPackets("workload-networking", datasource, queries, labelMatcher),
 	)
 }
 
-func withWorkloadRateOfPacketsDroppedGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withWorkloadRateOfPacketsDroppedGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Rate of Packets Dropped",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.KubernetesReceivedPacketsDropped("workload-networking", datasource, labelMatcher),
-		panels.KubernetesTransmittedPacketsDropped("workload-networking", datasource, labelMatcher),
+		panels.KubernetesReceivedPacketsDropped("workload-networking", datasource, queries, labelMatcher),
+		panels.KubernetesTransmittedPacketsDropped("workload-networking", datasource, queries, labelMatcher),
 	)
 }
 
-func BuildKubernetesWorkloadOverview(project string, datasource string, clusterLabelName string, variableOverrides ...dashboard.Option) dashboards.DashboardResult {
+func BuildKubernetesWorkloadOverview(project string, datasource string, clusterLabelName string, opts ...kubernetes.KubernetesDashboardOption) dashboards.DashboardResult {
+	config := kubernetes.NewKubernetesDashboardConfig(opts...)
+	queries := config.PanelQueries()
 	defaultVars := []dashboard.Option{
 		dashboard.AddVariable("cluster",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("cluster",
-					labelValuesVar.Matchers("up{"+panels.GetKubeletMatcher()+"}"),
+					dashboards.AddVariableMatcher(
+						vector.New(
+							vector.WithMetricName("up"),
+							vector.WithLabelMatchers(config.JobLabelValues.KubeletMatcher()),
+						),
+						nil,
+					),
 					dashboards.AddVariableDatasource(datasource),
 				),
 				listVar.DisplayName("cluster"),
@@ -84,11 +94,9 @@ func BuildKubernetesWorkloadOverview(project string, datasource string, clusterL
 		dashboard.AddVariable("namespace",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("namespace",
-					labelValuesVar.Matchers(
-						promql.SetLabelMatchers(
-							"container_network_receive_packets_total",
-							[]promql.LabelMatcher{{Name: "cluster", Type: "=", Value: "$cluster"}},
-						),
+					dashboards.AddVariableMatcher(
+						vector.New(vector.WithMetricName("container_network_receive_packets_total")),
+						[]*labels.Matcher{{Name: "cluster", Type: labels.MatchEqual, Value: "$cluster"}},
 					),
 					dashboards.AddVariableDatasource(datasource),
 				),
@@ -98,14 +106,12 @@ func BuildKubernetesWorkloadOverview(project string, datasource string, clusterL
 		dashboard.AddVariable("type",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("workload_type",
-					labelValuesVar.Matchers(
-						promql.SetLabelMatchers(
-							"namespace_workload_pod:kube_pod_owner:relabel",
-							[]promql.LabelMatcher{
-								{Name: "cluster", Type: "=", Value: "$cluster"},
-								{Name: "namespace", Type: "=", Value: "$namespace"},
-							},
-						),
+					dashboards.AddVariableMatcher(
+						vector.New(vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel")),
+						[]*labels.Matcher{
+							{Name: "cluster", Type: labels.MatchEqual, Value: "$cluster"},
+							{Name: "namespace", Type: labels.MatchEqual, Value: "$namespace"},
+						},
 					),
 					dashboards.AddVariableDatasource(datasource),
 				),
@@ -115,15 +121,13 @@ func BuildKubernetesWorkloadOverview(project string, datasource string, clusterL
 		dashboard.AddVariable("workload",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("workload",
-					labelValuesVar.Matchers(
-						promql.SetLabelMatchers(
-							"namespace_workload_pod:kube_pod_owner:relabel",
-							[]promql.LabelMatcher{
-								{Name: "cluster", Type: "=", Value: "$cluster"},
-								{Name: "namespace", Type: "=", Value: "$namespace"},
-								{Name: "workload_type", Type: "=", Value: "$type"},
-							},
-						),
+					dashboards.AddVariableMatcher(
+						vector.New(vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel")),
+						[]*labels.Matcher{
+							{Name: "cluster", Type: labels.MatchEqual, Value: "$cluster"},
+							{Name: "namespace", Type: labels.MatchEqual, Value: "$namespace"},
+							{Name: "workload_type", Type: labels.MatchEqual, Value: "$type"},
+						},
 					),
 					dashboards.AddVariableDatasource(datasource),
 				),
@@ -134,20 +138,17 @@ func BuildKubernetesWorkloadOverview(project string, datasource string, clusterL
 
 	clusterLabelMatcher := dashboards.GetClusterLabelMatcherV2(clusterLabelName)
 
-	vars := defaultVars
-	if len(variableOverrides) > 0 {
-		vars = variableOverrides
-	}
+	vars := config.Variables(defaultVars)
 	options := append([]dashboard.Option{
 		dashboard.ProjectName(project),
 		dashboard.Name("Kubernetes / Networking / Workload"),
 	}, vars...)
 	options = append(options,
-		withWorkloadCurrentRateOfBytesGroup(datasource, clusterLabelMatcher),
-		withWorkloadAverageRateOfBytesGroup(datasource, clusterLabelMatcher),
-		withWorkloadBandwidthGroup(datasource, clusterLabelMatcher),
-		withWorkloadRateOfPacketsGroup(datasource, clusterLabelMatcher),
-		withWorkloadRateOfPacketsDroppedGroup(datasource, clusterLabelMatcher),
+		withWorkloadCurrentRateOfBytesGroup(datasource, queries, clusterLabelMatcher),
+		withWorkloadAverageRateOfBytesGroup(datasource, queries, clusterLabelMatcher),
+		withWorkloadBandwidthGroup(datasource, queries, clusterLabelMatcher),
+		withWorkloadRateOfPacketsGroup(datasource, queries, clusterLabelMatcher),
+		withWorkloadRateOfPacketsDroppedGroup(datasource, queries, clusterLabelMatcher),
 	)
 	return dashboards.NewDashboardResult(
 		dashboard.New("kubernetes-workload-networking-overview", options...),
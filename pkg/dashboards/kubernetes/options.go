@@ -0,0 +1,84 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"maps"
+
+	panels "github.com/perses/community-mixins/pkg/panels/kubernetes"
+	"github.com/perses/perses/go-sdk/dashboard"
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+// KubernetesDashboardConfig holds the settings shared by the Kubernetes dashboard builders.
+// Each Build call gets its own copy, so dashboards for different cluster flavours can be
+// built side by side, including concurrently.
+type KubernetesDashboardConfig struct {
+	JobLabelValues      panels.JobLabelValues
+	VariableOverrides   []dashboard.Option
+	PanelQueryOverrides map[string]parser.Expr
+}
+
+type KubernetesDashboardOption func(*KubernetesDashboardConfig)
+
+// WithJobLabelValues sets the job label values used by the dashboard variables and panels.
+// Empty values fall back to their defaults. Pass the same values to the Kubernetes rules so that
+// the dashboards and the alerts look at the same targets.
+func WithJobLabelValues(jobLabelValues panels.JobLabelValues) KubernetesDashboardOption {
+	return func(config *KubernetesDashboardConfig) {
+		config.JobLabelValues = jobLabelValues.WithDefaults()
+	}
+}
+
+// WithVariableOverrides replaces the default dashboard variables.
+func WithVariableOverrides(variableOverrides ...dashboard.Option) KubernetesDashboardOption {
+	return func(config *KubernetesDashboardConfig) {
+		config.VariableOverrides = variableOverrides
+	}
+}
+
+// WithPanelQueryOverrides replaces panel queries, keyed as panels.KubernetesCommonPanelQueries, i.e. by panel
+// function name (with _suffix, in case panel has multiple queries).
+func WithPanelQueryOverrides(panelQueryOverrides map[string]parser.Expr) KubernetesDashboardOption {
+	return func(config *KubernetesDashboardConfig) {
+		config.PanelQueryOverrides = maps.Clone(panelQueryOverrides)
+	}
+}
+
+// NewKubernetesDashboardConfig returns the config resulting from applying options to the defaults.
+func NewKubernetesDashboardConfig(options ...KubernetesDashboardOption) KubernetesDashboardConfig {
+	config := KubernetesDashboardConfig{
+		JobLabelValues: panels.DefaultJobLabelValues(),
+	}
+	for _, option := range options {
+		option(&config)
+	}
+	return config
+}
+
+// Variables returns the variable overrides when set, and defaultVars otherwise.
+func (c KubernetesDashboardConfig) Variables(defaultVars []dashboard.Option) []dashboard.Option {
+	if len(c.VariableOverrides) > 0 {
+		return c.VariableOverrides
+	}
+	return defaultVars
+}
+
+// PanelQueries returns the panel queries selecting the jobs of c.JobLabelValues, with the panel query overrides
+// applied. Each call returns a new map.
+func (c KubernetesDashboardConfig) PanelQueries() map[string]parser.Expr {
+	queries := panels.KubernetesPanelQueries(c.JobLabelValues)
+	maps.Copy(queries, c.PanelQueryOverrides)
+	return queries
+}
@@ -0,0 +1,97 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes_test
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/perses/promql-builder/vector"
+	"github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/perses/community-mixins/pkg/dashboards/kubernetes"
+	"github.com/perses/community-mixins/pkg/dashboards/kubernetes/kubelet"
+	panels "github.com/perses/community-mixins/pkg/panels/kubernetes"
+)
+
+func TestPanelQueryOverrides(t *testing.T) {
+	overrides := map[string]parser.Expr{
+		"RunningKubeletStat": vector.New(vector.WithMetricName("my_running_kubelets")),
+	}
+	config := kubernetes.NewKubernetesDashboardConfig(kubernetes.WithPanelQueryOverrides(overrides))
+	if got, want := config.PanelQueries()["RunningKubeletStat"].String(), "my_running_kubelets"; got != want {
+		t.Errorf("overridden RunningKubeletStat = %q, want %q", got, want)
+	}
+
+	overrides["RunningPodStat"] = vector.New(vector.WithMetricName("my_running_pods"))
+	if got := config.PanelQueries()["RunningPodStat"].String(); got == "my_running_pods" {
+		t.Error("modifying the overrides passed to WithPanelQueryOverrides changed the config")
+	}
+
+	defaults := kubernetes.NewKubernetesDashboardConfig().PanelQueries()
+	if got, want := defaults["RunningKubeletStat"].String(), panels.KubernetesCommonPanelQueries["RunningKubeletStat"].String(); got != want {
+		t.Errorf("RunningKubeletStat without overrides = %q, want %q", got, want)
+	}
+}
+
+func TestConcurrentFlavours(t *testing.T) {
+	kubeletJobs := []string{"kubelet", "eks-kubelet", "openshift-kubelet"}
+	outputs := make([]string, len(kubeletJobs))
+
+	var wg sync.WaitGroup
+	for i, job := range kubeletJobs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			jobLabelValues := panels.DefaultJobLabelValues()
+			jobLabelValues.Kubelet = job
+			result := kubelet.BuildKubeletOverview("default", "", "",
+				kubernetes.WithJobLabelValues(jobLabelValues),
+				kubernetes.WithPanelQueryOverrides(map[string]parser.Expr{
+					"RunningPodStat": vector.New(vector.WithMetricName("running_pods_for_" + strings.ReplaceAll(job, "-", "_"))),
+				}),
+			)
+			if err := result.Err(); err != nil {
+				t.Errorf("BuildKubeletOverview() returned error: %v", err)
+				return
+			}
+			output, err := json.Marshal(result.Builder().Dashboard)
+			if err != nil {
+				t.Errorf("json.Marshal() returned error: %v", err)
+				return
+			}
+			outputs[i] = string(output)
+		}()
+	}
+	wg.Wait()
+
+	for i, job := range kubeletJobs {
+		if !strings.Contains(outputs[i], `job=\"`+job+`\"`) {
+			t.Errorf("dashboard built with job %q does not select it", job)
+		}
+		for j, other := range kubeletJobs {
+			if i == j {
+				continue
+			}
+			if strings.Contains(outputs[i], `job=\"`+other+`\"`) {
+				t.Errorf("dashboard built with job %q selects job %q", job, other)
+			}
+			if strings.Contains(outputs[i], "running_pods_for_"+strings.ReplaceAll(other, "-", "_")) {
+				t.Errorf("dashboard built with job %q uses the query overrides of job %q", job, other)
+			}
+		}
+	}
+}
@@ -15,40 +15,50 @@ package persistent_volume
 
 import (
 	"github.com/perses/community-mixins/pkg/dashboards"
+	"github.com/perses/community-mixins/pkg/dashboards/kubernetes"
 	panels "github.com/perses/community-mixins/pkg/panels/kubernetes"
-	"github.com/perses/community-mixins/pkg/promql"
 	"github.com/perses/perses/go-sdk/dashboard"
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
+	"github.com/perses/promql-builder/vector"
 
 	listVar "github.com/perses/perses/go-sdk/variable/list-variable"
 	labelValuesVar "github.com/perses/plugins/prometheus/sdk/go/variable/label-values"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func withPVVolumeUsageGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withPVVolumeUsageGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Volume Space Usage",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.VolumeSpaceUsage(datasource, labelMatcher),
-		panels.VolumeSpaceUsageGauge(datasource, labelMatcher),
+		panels.VolumeSpaceUsage(datasource, queries, labelMatcher),
+		panels.VolumeSpaceUsageGauge(datasource, queries, labelMatcher),
 	)
 }
 
-func withPVInodesUsageGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withPVInodesUsageGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Volume Inodes Usage",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.VolumeInodesUsage(datasource, labelMatcher),
-		panels.VolumeInodesUsageGauge(datasource, labelMatcher),
+		panels.VolumeInodesUsage(datasource, queries, labelMatcher),
+		panels.VolumeInodesUsageGauge(datasource, queries, labelMatcher),
 	)
 }
 
-func BuildKubernetesPersistentVolumeOverview(project string, datasource string, clusterLabelName string, variableOverrides ...dashboard.Option) dashboards.DashboardResult {
+func BuildKubernetesPersistentVolumeOverview(project string, datasource string, clusterLabelName string, opts ...kubernetes.KubernetesDashboardOption) dashboards.DashboardResult {
+	config := kubernetes.NewKubernetesDashboardConfig(opts...)
+	queries := config.PanelQueries()
 	defaultVars := []dashboard.Option{
 		dashboard.AddVariable("cluster",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("cluster",
-					labelValuesVar.Matchers("kubelet_volume_stats_capacity_bytes{"+panels.GetKubeletMatcher()+"}"),
+					dashboards.AddVariableMatcher(
+						vector.New(
+							vector.WithMetricName("kubelet_volume_stats_capacity_bytes"),
+							vector.WithLabelMatchers(config.JobLabelValues.KubeletMatcher()),
+						),
+						nil,
+					),
 					dashboards.AddVariableDatasource(datasource),
 				),
 				listVar.DisplayName("cluster"),
@@ -57,11 +67,12 @@ func BuildKubernetesPersistentVolumeOverview(project string, datasource string,
 		dashboard.AddVariable("namespace",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("namespace",
-					labelValuesVar.Matchers(
-						promql.SetLabelMatchers(
-							"kubelet_volume_stats_capacity_bytes{"+panels.GetKubeletMatcher()+"}",
-							[]promql.LabelMatcher{{Name: "cluster", Type: "=", Value: "$cluster"}},
+					dashboards.AddVariableMatcher(
+						vector.New(
+							vector.WithMetricName("kubelet_volume_stats_capacity_bytes"),
+							vector.WithLabelMatchers(config.JobLabelValues.KubeletMatcher()),
 						),
+						[]*labels.Matcher{{Name: "cluster", Type: labels.MatchEqual, Value: "$cluster"}},
 					),
 					dashboards.AddVariableDatasource(datasource),
 				),
@@ -71,14 +82,15 @@ func BuildKubernetesPersistentVolumeOverview(project string, datasource string,
 		dashboard.AddVariable("volume",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("persistentvolumeclaim",
-					labelValuesVar.Matchers(
-						promql.SetLabelMatchers(
-							"kubelet_volume_stats_capacity_bytes{"+panels.GetKubeletMatcher()+"}",
-							[]promql.LabelMatcher{
-								{Name: "cluster", Type: "=", Value: "$cluster"},
-								{Name: "namespace", Type: "=", Value: "$namespace"},
-							},
+					dashboards.AddVariableMatcher(
+						vector.New(
+							vector.WithMetricName("kubelet_volume_stats_capacity_bytes"),
+							vector.WithLabelMatchers(config.JobLabelValues.KubeletMatcher()),
 						),
+						[]*labels.Matcher{
+							{Name: "cluster", Type: labels.MatchEqual, Value: "$cluster"},
+							{Name: "namespace", Type: labels.MatchEqual, Value: "$namespace"},
+						},
 					),
 					dashboards.AddVariableDatasource(datasource),
 				),
@@ -88,17 +100,14 @@ func BuildKubernetesPersistentVolumeOverview(project string, datasource string,
 	}
 
 	clusterLabelMatcher := dashboards.GetClusterLabelMatcherV2(clusterLabelName)
-	vars := defaultVars
-	if len(variableOverrides) > 0 {
-		vars = variableOverrides
-	}
+	vars := config.Variables(defaultVars)
 	options := append([]dashboard.Option{
 		dashboard.ProjectName(project),
 		dashboard.Name("Kubernetes / Persistent Volume"),
 	}, vars...)
 	options = append(options,
-		withPVVolumeUsageGroup(datasource, clusterLabelMatcher),
-		withPVInodesUsageGroup(datasource, clusterLabelMatcher),
+		withPVVolumeUsageGroup(datasource, queries, clusterLabelMatcher),
+		withPVInodesUsageGroup(datasource, queries, clusterLabelMatcher),
 	)
 	return dashboards.NewDashboardResult(
 		dashboard.New("kubernetes-persistent-volume-overview", options...),
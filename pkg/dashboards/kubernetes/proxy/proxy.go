@@ -15,6 +15,7 @@ package proxy
 
 import (
 	"github.com/perses/community-mixins/pkg/dashboards"
+	"github.com/perses/community-mixins/pkg/dashboards/kubernetes"
 	panelsGostats "github.com/perses/community-mixins/pkg/panels/gostats"
 	panels "github.com/perses/community-mixins/pkg/panels/kubernetes"
 	"github.com/perses/community-mixins/pkg/promql"
@@ -22,45 +23,43 @@ import (
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
 	listVar "github.com/perses/perses/go-sdk/variable/list-variable"
 	labelValuesVar "github.com/perses/plugins/prometheus/sdk/go/variable/label-values"
+	"github.com/perses/promql-builder/vector"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func withProxyStatsGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withProxyStatsGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Proxy Status",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(8),
-		panels.ProxyUpStatus(datasource, labelMatcher),
+		panels.ProxyUpStatus(datasource, queries, labelMatcher),
 	)
 }
 
-func withProxyRulesSyncRateGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withProxyRulesSyncRateGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Proxy Rules Sync Rate",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.RulesSyncRate(datasource, labelMatcher),
-		panels.RulesSyncLatency(datasource, labelMatcher),
+		panels.RulesSyncRate(datasource, queries, labelMatcher),
+		panels.RulesSyncLatency(datasource, queries, labelMatcher),
 	)
 }
 
-func withProxyNetworkProgrammingRateGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withProxyNetworkProgrammingRateGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Proxy Network Programming Rate",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.NetworkProgrammingRate(datasource, labelMatcher),
-		panels.NetworkProgrammingLatency(datasource, labelMatcher),
+		panels.NetworkProgrammingRate(datasource, queries, labelMatcher),
+		panels.NetworkProgrammingLatency(datasource, queries, labelMatcher),
 	)
 }
 
-func withProxyKubeAPIRequestsGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withProxyKubeAPIRequestsGroup(datasource string, queries map[string]parser.Expr, jobMatcher *labels.Matcher, labelMatcher *labels.Matcher) dashboard.Option {
 	// TODO(saswatamcode): Add a way to configure these.
 	labelMatchersToUse := []*labels.Matcher{
 		promql.ClusterVarV2,
 		promql.InstanceVarV2,
-		{
-			Name:  "job",
-			Value: panels.KUBE_PROXY_LABEL_VALUE,
-			Type:  labels.MatchEqual,
-		},
+		jobMatcher,
 	}
 
 	labelMatchersToUse = append(labelMatchersToUse, labelMatcher)
@@ -68,22 +67,18 @@ func withProxyKubeAPIRequestsGroup(datasource string, labelMatcher *labels.Match
 	return dashboard.AddPanelGroup("Kube API Requests",
 		panelgroup.PanelsPerLine(3),
 		panelgroup.PanelHeight(8),
-		panels.KubeAPIRequestRate(datasource, labelMatchersToUse...),
-		panels.PostRequestLatency(datasource, labelMatchersToUse...),
-		panels.GetRequestLatency(datasource, labelMatchersToUse...),
+		panels.KubeAPIRequestRate(datasource, queries, labelMatchersToUse...),
+		panels.PostRequestLatency(datasource, queries, labelMatchersToUse...),
+		panels.GetRequestLatency(datasource, queries, labelMatchersToUse...),
 	)
 }
 
-func withProxyResources(datasource string, clusterLabelMatcher *labels.Matcher) dashboard.Option {
+func withProxyResources(datasource string, jobMatcher *labels.Matcher, clusterLabelMatcher *labels.Matcher) dashboard.Option {
 	// TODO(saswatamcode): Add a way to configure these.
 	labelMatchersToUse := []*labels.Matcher{
 		promql.ClusterVarV2,
 		promql.InstanceVarV2,
-		{
-			Name:  "job",
-			Value: panels.KUBE_PROXY_LABEL_VALUE,
-			Type:  labels.MatchEqual,
-		},
+		jobMatcher,
 	}
 
 	labelMatchersToUse = append(labelMatchersToUse, clusterLabelMatcher)
@@ -98,12 +93,20 @@ func withProxyResources(datasource string, clusterLabelMatcher *labels.Matcher)
 	)
 }
 
-func BuildProxyOverview(project string, datasource string, clusterLabelName string, variableOverrides ...dashboard.Option) dashboards.DashboardResult {
+func BuildProxyOverview(project string, datasource string, clusterLabelName string, opts ...kubernetes.KubernetesDashboardOption) dashboards.DashboardResult {
+	config := kubernetes.NewKubernetesDashboardConfig(opts...)
+	queries := config.PanelQueries()
 	defaultVars := []dashboard.Option{
 		dashboard.AddVariable("cluster",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("cluster",
-					labelValuesVar.Matchers("up{"+panels.GetKubeProxyMatcher()+"}"),
+					dashboards.AddVariableMatcher(
+						vector.New(
+							vector.WithMetricName("up"),
+							vector.WithLabelMatchers(config.JobLabelValues.KubeProxyMatcher()),
+						),
+						nil,
+					),
 					dashboards.AddVariableDatasource(datasource),
 				),
 				listVar.DisplayName("cluster"),
@@ -112,11 +115,12 @@ func BuildProxyOverview(project string, datasource string, clusterLabelName stri
 		dashboard.AddVariable("instance",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("instance",
-					labelValuesVar.Matchers(
-						promql.SetLabelMatchers(
-							"up{"+panels.GetKubeProxyMatcher()+"}",
-							[]promql.LabelMatcher{{Name: "cluster", Type: "=", Value: "$cluster"}},
+					dashboards.AddVariableMatcher(
+						vector.New(
+							vector.WithMetricName("up"),
+							vector.WithLabelMatchers(config.JobLabelValues.KubeProxyMatcher()),
 						),
+						[]*labels.Matcher{{Name: "cluster", Type: labels.MatchEqual, Value: "$cluster"}},
 					),
 					dashboards.AddVariableDatasource(datasource),
 				),
@@ -127,20 +131,17 @@ func BuildProxyOverview(project string, datasource string, clusterLabelName stri
 
 	clusterLabelMatcher := dashboards.GetClusterLabelMatcherV2(clusterLabelName)
 
-	vars := defaultVars
-	if len(variableOverrides) > 0 {
-		vars = variableOverrides
-	}
+	vars := config.Variables(defaultVars)
 	options := append([]dashboard.Option{
 		dashboard.ProjectName(project),
 		dashboard.Name("Kubernetes / Proxy"),
 	}, vars...)
 	options = append(options,
-		withProxyStatsGroup(datasource, clusterLabelMatcher),
-		withProxyRulesSyncRateGroup(datasource, clusterLabelMatcher),
-		withProxyNetworkProgrammingRateGroup(datasource, clusterLabelMatcher),
-		withProxyKubeAPIRequestsGroup(datasource, clusterLabelMatcher),
-		withProxyResources(datasource, clusterLabelMatcher),
+		withProxyStatsGroup(datasource, queries, clusterLabelMatcher),
+		withProxyRulesSyncRateGroup(datasource, queries, clusterLabelMatcher),
+		withProxyNetworkProgrammingRateGroup(datasource, queries, clusterLabelMatcher),
+		withProxyKubeAPIRequestsGroup(datasource, queries, config.JobLabelValues.KubeProxyMatcher(), clusterLabelMatcher),
+		withProxyResources(datasource, config.JobLabelValues.KubeProxyMatcher(), clusterLabelMatcher),
 	)
 	return dashboards.NewDashboardResult(
 		dashboard.New("proxy-overview", options...),
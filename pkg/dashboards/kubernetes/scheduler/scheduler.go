@@ -15,6 +15,7 @@ package scheduler
 
 import (
 	"github.com/perses/community-mixins/pkg/dashboards"
+	"github.com/perses/community-mixins/pkg/dashboards/kubernetes"
 	panelsGostats "github.com/perses/community-mixins/pkg/panels/gostats"
 	panels "github.com/perses/community-mixins/pkg/panels/kubernetes"
 	"github.com/perses/community-mixins/pkg/promql"
@@ -22,35 +23,33 @@ import (
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
 	listVar "github.com/perses/perses/go-sdk/variable/list-variable"
 	labelValuesVar "github.com/perses/plugins/prometheus/sdk/go/variable/label-values"
+	"github.com/perses/promql-builder/vector"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func withSchedulerStatsGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withSchedulerStatsGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Scheduler Status",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(8),
-		panels.SchedulerUpStatus(datasource, labelMatcher),
+		panels.SchedulerUpStatus(datasource, queries, labelMatcher),
 	)
 }
 
-func withSchedulingRateGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withSchedulingRateGroup(datasource string, queries map[string]parser.Expr, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Scheduling Rate",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.SchedulingRate(datasource, labelMatcher),
-		panels.SchedulingLatency(datasource, labelMatcher),
+		panels.SchedulingRate(datasource, queries, labelMatcher),
+		panels.SchedulingLatency(datasource, queries, labelMatcher),
 	)
 }
 
-func withSchedulerKubeAPIRequestsGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+func withSchedulerKubeAPIRequestsGroup(datasource string, queries map[string]parser.Expr, jobMatcher *labels.Matcher, labelMatcher *labels.Matcher) dashboard.Option {
 	labelMatchersToUse := []*labels.Matcher{
 		promql.ClusterVarV2,
 		promql.InstanceVarV2,
-		{
-			Name:  "job",
-			Value: panels.KUBE_SCHEDULER_LABEL_VALUE,
-			Type:  labels.MatchEqual,
-		},
+		jobMatcher,
 	}
 
 	labelMatchersToUse = append(labelMatchersToUse, labelMatcher)
@@ -58,22 +57,18 @@ func withSchedulerKubeAPIRequestsGroup(datasource string, labelMatcher *labels.M
 	return dashboard.AddPanelGroup("Kube API Requests",
 		panelgroup.PanelsPerLine(3),
 		panelgroup.PanelHeight(8),
-		panels.KubeAPIRequestRate(datasource, labelMatchersToUse...),
-		panels.PostRequestLatency(datasource, labelMatchersToUse...),
-		panels.GetRequestLatency(datasource, labelMatchersToUse...),
+		panels.KubeAPIRequestRate(datasource, queries, labelMatchersToUse...),
+		panels.PostRequestLatency(datasource, queries, labelMatchersToUse...),
+		panels.GetRequestLatency(datasource, queries, labelMatchersToUse...),
 	)
 }
 
-func withSchedulerResources(datasource string, clusterLabelMatcher *labels.Matcher) dashboard.Option {
+func withSchedulerResources(datasource string, jobMatcher *labels.Matcher, clusterLabelMatcher *labels.Matcher) dashboard.Option {
 	// TODO(saswatamcode): Add a way to configure these.
 	labelMatchersToUse := []*labels.Matcher{
 		promql.ClusterVarV2,
 		promql.InstanceVarV2,
-		{
-			Name:  "job",
-			Value: panels.KUBE_SCHEDULER_LABEL_VALUE,
-			Type:  labels.MatchEqual,
-		},
+		jobMatcher,
 	}
 
 	labelMatchersToUse = append(labelMatchersToUse, clusterLabelMatcher)
@@ -88,12 +83,20 @@ func withSchedulerResources(datasource string, clusterLabelMatcher *labels.Match
 	)
 }
 
-func BuildSchedulerOverview(project string, datasource string, clusterLabelName string, variableOverrides ...dashboard.Option) dashboards.DashboardResult {
+func BuildSchedulerOverview(project string, datasource string, clusterLabelName string, opts ...kubernetes.KubernetesDashboardOption) dashboards.DashboardResult {
+	config := kubernetes.NewKubernetesDashboardConfig(opts...)
+	queries := config.PanelQueries()
 	defaultVars := []dashboard.Option{
 		dashboard.AddVariable("cluster",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("cluster",
-					labelValuesVar.Matchers("up{"+panels.GetSchedulerMatcher()+"}"),
+					dashboards.AddVariableMatcher(
+						vector.New(
+							vector.WithMetricName("up"),
+							vector.WithLabelMatchers(config.JobLabelValues.SchedulerMatcher()),
+						),
+						nil,
+					),
 					dashboards.AddVariableDatasource(datasource),
 				),
 				listVar.DisplayName("cluster"),
@@ -102,11 +105,12 @@ func BuildSchedulerOverview(project string, datasource string, clusterLabelName
 		dashboard.AddVariable("instance",
 			listVar.List(
 				labelValuesVar.PrometheusLabelValues("instance",
-					labelValuesVar.Matchers(
-						promql.SetLabelMatchers(
-							"up{"+panels.GetSchedulerMatcher()+"}",
-							[]promql.LabelMatcher{{Name: "cluster", Type: "=", Value: "$cluster"}},
+					dashboards.AddVariableMatcher(
+						vector.New(
+							vector.WithMetricName("up"),
+							vector.WithLabelMatchers(config.JobLabelValues.SchedulerMatcher()),
 						),
+						[]*labels.Matcher{{Name: "cluster", Type: labels.MatchEqual, Value: "$cluster"}},
 					),
 					dashboards.AddVariableDatasource(datasource),
 				),
@@ -117,19 +121,16 @@ func BuildSchedulerOverview(project string, datasource string, clusterLabelName
 
 	clusterLabelMatcher := dashboards.GetClusterLabelMatcherV2(clusterLabelName)
 
-	vars := defaultVars
-	if len(variableOverrides) > 0 {
-		vars = variableOverrides
-	}
+	vars := config.Variables(defaultVars)
 	options := append([]dashboard.Option{
 		dashboard.ProjectName(project),
 		dashboard.Name("Kubernetes / Scheduler"),
 	}, vars...)
 	options = append(options,
-		withSchedulerStatsGroup(datasource, clusterLabelMatcher),
-		withSchedulingRateGroup(datasource, clusterLabelMatcher),
-		withSchedulerKubeAPIRequestsGroup(datasource, clusterLabelMatcher),
-		withSchedulerResources(datasource, clusterLabelMatcher),
+		withSchedulerStatsGroup(datasource, queries, clusterLabelMatcher),
+		withSchedulingRateGroup(datasource, queries, clusterLabelMatcher),
+		withSchedulerKubeAPIRequestsGroup(datasource, queries, config.JobLabelValues.SchedulerMatcher(), clusterLabelMatcher),
+		withSchedulerResources(datasource, config.JobLabelValues.SchedulerMatcher(), clusterLabelMatcher),
 	)
 	return dashboards.NewDashboardResult(
 		dashboard.New("scheduler-overview", options...),
@@ -17,7 +17,6 @@ import (
 	"fmt"
 
 	"github.com/perses/community-mixins/pkg/dashboards"
-	"github.com/perses/community-mixins/pkg/promql"
 	"github.com/perses/perses/go-sdk/dashboard"
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
 	"github.com/perses/promql-builder/vector"
@@ -60,9 +59,37 @@ func withNodeExporterNodesNetwork(datasource string, labelMatchers ...*labels.Ma
 	)
 }
 
-func BuildNodeExporterNodes(project string, datasource string, clusterLabelName string) dashboards.DashboardResult {
+type NodeExporterConfig struct {
+	JobLabelValue string
+}
+
+type NodeExporterOption func(*NodeExporterConfig)
+
+// WithJobLabelValue sets the job label value used to select the node-exporter targets.
+// Pass the same value to the node-exporter rules so that the dashboards and the alerts look at the same targets.
+func WithJobLabelValue(jobLabelValue string) NodeExporterOption {
+	return func(config *NodeExporterConfig) {
+		if jobLabelValue == "" {
+			jobLabelValue = panels.DefaultJobLabelValue
+		}
+		config.JobLabelValue = jobLabelValue
+	}
+}
+
+func newNodeExporterConfig(options ...NodeExporterOption) NodeExporterConfig {
+	config := NodeExporterConfig{
+		JobLabelValue: panels.DefaultJobLabelValue,
+	}
+	for _, option := range options {
+		option(&config)
+	}
+	return config
+}
+
+func BuildNodeExporterNodes(project string, datasource string, clusterLabelName string, options ...NodeExporterOption) dashboards.DashboardResult {
+	config := newNodeExporterConfig(options...)
 	clusterLabelMatcher := dashboards.GetClusterLabelMatcherV2(clusterLabelName)
-	jobValue := panels.GetNodeExporterLabelValue()
+	jobValue := config.JobLabelValue
 	jobMatcher := &labels.Matcher{Name: "job", Type: labels.MatchEqual, Value: jobValue}
 	return dashboards.NewDashboardResult(
 		dashboard.New("node-exporter-nodes",
@@ -73,13 +100,11 @@ func BuildNodeExporterNodes(project string, datasource string, clusterLabelName
 				listVar.List(
 					labelValuesVar.PrometheusLabelValues("instance",
 						dashboards.AddVariableDatasource(datasource),
-						labelValuesVar.Matchers(
-							promql.SetLabelMatchersV2(
-								vector.New(vector.WithMetricName("node_uname_info")),
-								[]*labels.Matcher{clusterLabelMatcher,
-									{Name: "job", Type: labels.MatchEqual, Value: jobValue},
-									{Name: "sysname", Type: labels.MatchNotEqual, Value: "Darwin"}},
-							).Pretty(0),
+						dashboards.AddVariableMatcher(
+							vector.New(vector.WithMetricName("node_uname_info")),
+							[]*labels.Matcher{clusterLabelMatcher,
+								{Name: "job", Type: labels.MatchEqual, Value: jobValue},
+								{Name: "sysname", Type: labels.MatchNotEqual, Value: "Darwin"}},
 						),
 					),
 					listVar.DisplayName("instance"),
@@ -18,7 +18,6 @@ import (
 
 	"github.com/perses/community-mixins/pkg/dashboards"
 	panels "github.com/perses/community-mixins/pkg/panels/node_exporter"
-	"github.com/perses/community-mixins/pkg/promql"
 	"github.com/perses/perses/go-sdk/dashboard"
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
 	listVar "github.com/perses/perses/go-sdk/variable/list-variable"
@@ -66,14 +65,15 @@ func withClusterDiskSpace(datasource string, labelMatchers ...*labels.Matcher) d
 	)
 }
 
-func BuildNodeExporterClusterUseMethod(project string, datasource string, clusterLabelName string) dashboards.DashboardResult {
+func BuildNodeExporterClusterUseMethod(project string, datasource string, clusterLabelName string, options ...NodeExporterOption) dashboards.DashboardResult {
+	config := newNodeExporterConfig(options...)
 	clusterLabelMatcher := dashboards.GetClusterLabelMatcherV2(clusterLabelName)
 	instanceLabelMatcher := &labels.Matcher{
 		Name:  "instance",
 		Value: "$instance",
 		Type:  labels.MatchRegexp,
 	}
-	jobValue := panels.GetNodeExporterLabelValue()
+	jobValue := config.JobLabelValue
 	jobMatcher := &labels.Matcher{Name: "job", Type: labels.MatchEqual, Value: jobValue}
 	return dashboards.NewDashboardResult(
 		dashboard.New("node-exporter-cluster-use-method",
@@ -84,13 +84,11 @@ func BuildNodeExporterClusterUseMethod(project string, datasource string, cluste
 				listVar.List(
 					labelValuesVar.PrometheusLabelValues("instance",
 						dashboards.AddVariableDatasource(datasource),
-						labelValuesVar.Matchers(
-							promql.SetLabelMatchersV2(
-								vector.New(vector.WithMetricName("node_uname_info")),
-								[]*labels.Matcher{clusterLabelMatcher,
-									{Name: "job", Type: labels.MatchEqual, Value: jobValue},
-									{Name: "sysname", Type: labels.MatchNotEqual, Value: "Darwin"}},
-							).Pretty(0),
+						dashboards.AddVariableMatcher(
+							vector.New(vector.WithMetricName("node_uname_info")),
+							[]*labels.Matcher{clusterLabelMatcher,
+								{Name: "job", Type: labels.MatchEqual, Value: jobValue},
+								{Name: "sysname", Type: labels.MatchNotEqual, Value: "Darwin"}},
 						),
 					),
 					listVar.DisplayName("instance"),
@@ -1,12 +1,12 @@
 // Copyright The Perses Authors
-// Licensed under the Apache License, Version 2.0 (the "License");
+// Licensed under the Apache License, Version 2.0 (the \"License\");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
 //
 // http://www.apache.org/licenses/LICENSE-2.0
 //
 // Unless required by applicable law or agreed to in writing, software
-// distributed under the License is distributed on an "AS IS" BASIS,
+// distributed under the License is distributed on an \"AS IS\" BASIS,
 // WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
 // See the License for the specific language governing permissions and
 // limitations under the License.
@@ -16,76 +16,84 @@ package nodeexporter
 import (
 	"encoding/json"
 	"strings"
+	"sync"
 	"testing"
 
-	panels "github.com/perses/community-mixins/pkg/panels/node_exporter"
+	"github.com/perses/community-mixins/pkg/dashboards"
 )
 
-func TestBuildNodeExporterNodes_DefaultJobLabel(t *testing.T) {
-	panels.SetNodeExporterLabelValue("node")
-	defer panels.SetNodeExporterLabelValue("node")
-
-	result := BuildNodeExporterNodes("default", "", "")
+func marshalDashboard(t *testing.T, result dashboards.DashboardResult) string {
+	t.Helper()
 	if result.Err() != nil {
-		t.Fatalf("BuildNodeExporterNodes() returned error: %v", result.Err())
+		t.Fatalf("build returned error: %v", result.Err())
 	}
 
 	dashboardJSON, err := json.Marshal(result.Builder().Dashboard)
 	if err != nil {
 		t.Fatalf("failed to marshal dashboard: %v", err)
 	}
+	return string(dashboardJSON)
+}
 
-	output := string(dashboardJSON)
+func TestBuildNodeExporterNodes_DefaultJobLabel(t *testing.T) {
+	output := marshalDashboard(t, BuildNodeExporterNodes("default", "", ""))
 	if !strings.Contains(output, `job=\"node\"`) && !strings.Contains(output, `job="node"`) && !strings.Contains(output, `job='node'`) {
 		t.Error("expected dashboard to contain job=\"node\" with default config")
 	}
 }
 
-func TestBuildNodeExporterNodes_CustomJobLabel(t *testing.T) {
-	panels.SetNodeExporterLabelValue("node-exporter")
-	defer panels.SetNodeExporterLabelValue("node")
-
-	result := BuildNodeExporterNodes("default", "", "")
-	if result.Err() != nil {
-		t.Fatalf("BuildNodeExporterNodes() returned error: %v", result.Err())
-	}
-
-	dashboardJSON, err := json.Marshal(result.Builder().Dashboard)
-	if err != nil {
-		t.Fatalf("failed to marshal dashboard: %v", err)
+func TestBuildNodeExporterNodes_EmptyJobLabelFallsBackToDefault(t *testing.T) {
+	output := marshalDashboard(t, BuildNodeExporterNodes("default", "", "", WithJobLabelValue("")))
+	if !strings.Contains(output, `job='node'`) {
+		t.Error("expected dashboard to contain job='node' when the job label value is empty")
 	}
+}
 
-	output := string(dashboardJSON)
+func TestBuildNodeExporterNodes_CustomJobLabel(t *testing.T) {
+	output := marshalDashboard(t, BuildNodeExporterNodes("default", "", "", WithJobLabelValue("node-exporter")))
 	if !strings.Contains(output, "node-exporter") {
-		t.Error("expected dashboard to contain 'node-exporter' after setting custom job label")
+		t.Error("expected dashboard to contain 'node-exporter' with a custom job label")
 	}
 
 	// The variable matcher string should use the custom value, not the default
 	if strings.Contains(output, `job='node'`) {
-		t.Error("dashboard should not contain job='node' after setting job label to 'node-exporter'")
+		t.Error("dashboard should not contain job='node' with job label 'node-exporter'")
 	}
 }
 
 func TestBuildNodeExporterClusterUseMethod_CustomJobLabel(t *testing.T) {
-	panels.SetNodeExporterLabelValue("node-exporter")
-	defer panels.SetNodeExporterLabelValue("node")
-
-	result := BuildNodeExporterClusterUseMethod("default", "", "")
-	if result.Err() != nil {
-		t.Fatalf("BuildNodeExporterClusterUseMethod() returned error: %v", result.Err())
+	output := marshalDashboard(t, BuildNodeExporterClusterUseMethod("default", "", "", WithJobLabelValue("node-exporter")))
+	if !strings.Contains(output, "node-exporter") {
+		t.Error("expected dashboard to contain 'node-exporter' with a custom job label")
 	}
 
-	dashboardJSON, err := json.Marshal(result.Builder().Dashboard)
-	if err != nil {
-		t.Fatalf("failed to marshal dashboard: %v", err)
+	if strings.Contains(output, `job='node'`) {
+		t.Error("dashboard should not contain job='node' with job label 'node-exporter'")
 	}
+}
 
-	output := string(dashboardJSON)
-	if !strings.Contains(output, "node-exporter") {
-		t.Error("expected dashboard to contain 'node-exporter' after setting custom job label")
+func TestBuildNodeExporterNodes_ConcurrentFlavours(t *testing.T) {
+	jobs := []string{"node", "node-exporter", "prometheus-node-exporter"}
+	outputs := make([]string, len(jobs))
+
+	var wg sync.WaitGroup
+	for i, job := range jobs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			outputs[i] = marshalDashboard(t, BuildNodeExporterNodes("default", "", "", WithJobLabelValue(job)))
+		}()
 	}
-
-	if strings.Contains(output, `job='node'`) {
-		t.Error("dashboard should not contain job='node' after setting job label to 'node-exporter'")
+	wg.Wait()
+
+	for i, job := range jobs {
+		if !strings.Contains(outputs[i], "job='"+job+"'") {
+			t.Errorf("dashboard built with job %q does not contain job='%s'", job, job)
+		}
+		for j, other := range jobs {
+			if i != j && strings.Contains(outputs[i], "job='"+other+"'") {
+				t.Errorf("dashboard built with job %q contains job='%s'", job, other)
+			}
+		}
 	}
 }
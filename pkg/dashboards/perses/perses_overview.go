@@ -44,11 +44,9 @@ func BuildPersesOverview(project string, datasource string, clusterLabelName str
 			dashboard.AddVariable("instance",
 				listvariable.List(
 					labelvalues.PrometheusLabelValues("instance",
-						labelvalues.Matchers(
-							promql.SetLabelMatchersV2(
-								vector.New(vector.WithMetricName("perses_build_info")),
-								[]*labels.Matcher{clusterLabelMatcher, {Name: "job", Type: labels.MatchEqual, Value: "$job"}},
-							).Pretty(0),
+						dashboards.AddVariableMatcher(
+							vector.New(vector.WithMetricName("perses_build_info")),
+							[]*labels.Matcher{clusterLabelMatcher, {Name: "job", Type: labels.MatchEqual, Value: "$job"}},
 						),
 						dashboards.AddVariableDatasource(datasource),
 					),
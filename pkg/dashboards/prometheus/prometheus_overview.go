@@ -103,11 +103,9 @@ func BuildPrometheusOverview(project string, datasource string, clusterLabelName
 			dashboard.AddVariable("instance",
 				listVar.List(
 					labelValuesVar.PrometheusLabelValues("instance",
-						labelValuesVar.Matchers(
-							promql.SetLabelMatchersV2(
-								vector.New(vector.WithMetricName("prometheus_build_info")),
-								[]*labels.Matcher{clusterLabelMatcher, {Name: "job", Type: labels.MatchEqual, Value: "$job"}},
-							).Pretty(0),
+						dashboards.AddVariableMatcher(
+							vector.New(vector.WithMetricName("prometheus_build_info")),
+							[]*labels.Matcher{clusterLabelMatcher, {Name: "job", Type: labels.MatchEqual, Value: "$job"}},
 						),
 						dashboards.AddVariableDatasource(datasource),
 					),
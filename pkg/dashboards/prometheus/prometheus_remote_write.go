@@ -16,7 +16,6 @@ package prometheus
 import (
 	"github.com/perses/community-mixins/pkg/dashboards"
 	panels "github.com/perses/community-mixins/pkg/panels/prometheus"
-	"github.com/perses/community-mixins/pkg/promql"
 	"github.com/perses/perses/go-sdk/dashboard"
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
 	listVar "github.com/perses/perses/go-sdk/variable/list-variable"
@@ -87,11 +86,9 @@ func BuildPrometheusRemoteWrite(project string, datasource string, clusterLabelN
 			dashboard.AddVariable("instance",
 				listVar.List(
 					labelValuesVar.PrometheusLabelValues("instance",
-						labelValuesVar.Matchers(
-							promql.SetLabelMatchersV2(
-								vector.New(vector.WithMetricName("prometheus_remote_storage_shards")),
-								[]*labels.Matcher{clusterLabelMatcher},
-							).Pretty(0),
+						dashboards.AddVariableMatcher(
+							vector.New(vector.WithMetricName("prometheus_remote_storage_shards")),
+							[]*labels.Matcher{clusterLabelMatcher},
 						),
 						dashboards.AddVariableDatasource(datasource),
 					),
@@ -101,16 +98,14 @@ func BuildPrometheusRemoteWrite(project string, datasource string, clusterLabelN
 			dashboard.AddVariable("url",
 				listVar.List(
 					labelValuesVar.PrometheusLabelValues("url",
-						labelValuesVar.Matchers(
-							promql.SetLabelMatchersV2(
-								vector.New(
-									vector.WithMetricName("prometheus_remote_storage_shards"),
-									vector.WithLabelMatchers(
-										label.New("instance").Equal("$instance"),
-									),
+						dashboards.AddVariableMatcher(
+							vector.New(
+								vector.WithMetricName("prometheus_remote_storage_shards"),
+								vector.WithLabelMatchers(
+									label.New("instance").Equal("$instance"),
 								),
-								[]*labels.Matcher{clusterLabelMatcher},
-							).Pretty(0),
+							),
+							[]*labels.Matcher{clusterLabelMatcher},
 						),
 						dashboards.AddVariableDatasource(datasource),
 					),
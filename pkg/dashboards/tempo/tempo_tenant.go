@@ -19,12 +19,13 @@ import (
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
 	listVar "github.com/perses/perses/go-sdk/variable/list-variable"
 	labelValuesVar "github.com/perses/plugins/prometheus/sdk/go/variable/label-values"
+	"github.com/perses/promql-builder/vector"
+	"github.com/prometheus/prometheus/model/labels"
 
 	"github.com/perses/community-mixins/pkg/dashboards"
-	"github.com/perses/community-mixins/pkg/promql"
 )
 
-func withTenantInfo(datasource string, labelMatcher promql.LabelMatcher) dashboard.Option {
+func withTenantInfo(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Tenant Info",
 		panelgroup.PanelsPerLine(1),
 		panelgroup.PanelHeight(8),
@@ -32,45 +33,73 @@ func withTenantInfo(datasource string, labelMatcher promql.LabelMatcher) dashboa
 	)
 }
 
-func withTenantIngestion(datasource string, labelMatcher promql.LabelMatcher) dashboard.Option {
+func withTenantIngestion(datasource string, tenantLabelName string, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Ingestion",
 		panelgroup.PanelsPerLine(3),
 		panelgroup.PanelHeight(8),
-		panels.TenantDistributorBytes(datasource, labelMatcher),
-		panels.TenantDistributorSpan(datasource, labelMatcher),
-		panels.TenantLiveTraces(datasource, labelMatcher),
+		panels.TenantDistributorBytes(datasource, tenantLabelName, labelMatcher),
+		panels.TenantDistributorSpan(datasource, tenantLabelName, labelMatcher),
+		panels.TenantLiveTraces(datasource, tenantLabelName, labelMatcher),
 	)
 }
 
-func withTenantReads(datasource string, labelMatcher promql.LabelMatcher) dashboard.Option {
+func withTenantReads(datasource string, tenantLabelName string, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Reads",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.TenantQueriesID(datasource, labelMatcher),
-		panels.TenantQueriesSearch(datasource, labelMatcher),
+		panels.TenantQueriesID(datasource, tenantLabelName, labelMatcher),
+		panels.TenantQueriesSearch(datasource, tenantLabelName, labelMatcher),
 	)
 }
 
-func withTenantStorage(datasource string, labelMatcher promql.LabelMatcher) dashboard.Option {
+func withTenantStorage(datasource string, tenantLabelName string, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Storage",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.TenantBlockslistLength(datasource, labelMatcher),
-		panels.TenantOutstandingCompactions(datasource, labelMatcher),
+		panels.TenantBlockslistLength(datasource, tenantLabelName, labelMatcher),
+		panels.TenantOutstandingCompactions(datasource, tenantLabelName, labelMatcher),
 	)
 }
 
-func withTenantMetricGenerator(datasource string, labelMatcher promql.LabelMatcher) dashboard.Option {
+func withTenantMetricGenerator(datasource string, tenantLabelName string, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Metrics Generator",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
-		panels.TenantMetricGeneratorBytes(datasource, labelMatcher),
-		panels.TenantMetricGeneratorActiveSeries(datasource, labelMatcher),
+		panels.TenantMetricGeneratorBytes(datasource, tenantLabelName, labelMatcher),
+		panels.TenantMetricGeneratorActiveSeries(datasource, tenantLabelName, labelMatcher),
 	)
 }
 
-func BuildTempoTenantOverview(project string, datasource string, clusterLabelName string) dashboards.DashboardResult {
-	clusterLabelMatcher := dashboards.GetClusterLabelMatcher(clusterLabelName)
+type TempoTenantConfig struct {
+	TenantLabelName string
+}
+
+type TempoTenantOption func(*TempoTenantConfig)
+
+// WithTenantLabelName sets the label carrying the tenant on Tempo metrics, read by the tenant variable and panels.
+// Pass the same label to the Tempo rules so that the per-tenant alerts match the tenant variable.
+func WithTenantLabelName(tenantLabelName string) TempoTenantOption {
+	return func(config *TempoTenantConfig) {
+		if tenantLabelName == "" {
+			tenantLabelName = panels.DefaultTenantLabelName
+		}
+		config.TenantLabelName = tenantLabelName
+	}
+}
+
+func newTempoTenantConfig(options ...TempoTenantOption) TempoTenantConfig {
+	config := TempoTenantConfig{
+		TenantLabelName: panels.DefaultTenantLabelName,
+	}
+	for _, option := range options {
+		option(&config)
+	}
+	return config
+}
+
+func BuildTempoTenantOverview(project string, datasource string, clusterLabelName string, options ...TempoTenantOption) dashboards.DashboardResult {
+	config := newTempoTenantConfig(options...)
+	clusterLabelMatcher := dashboards.GetClusterLabelMatcherV2(clusterLabelName)
 	return dashboards.NewDashboardResult(
 		dashboard.New("tempo-tenant-overview",
 			dashboard.ProjectName(project),
@@ -89,11 +118,9 @@ func BuildTempoTenantOverview(project string, datasource string, clusterLabelNam
 			dashboard.AddVariable("namespace",
 				listVar.List(
 					labelValuesVar.PrometheusLabelValues("namespace",
-						labelValuesVar.Matchers(
-							promql.SetLabelMatchers(
-								"tempo_build_info",
-								[]promql.LabelMatcher{clusterLabelMatcher, {Name: "cluster", Type: "=", Value: "$cluster"}},
-							),
+						dashboards.AddVariableMatcher(
+							vector.New(vector.WithMetricName("tempo_build_info")),
+							[]*labels.Matcher{clusterLabelMatcher, {Name: "cluster", Type: labels.MatchEqual, Value: "$cluster"}},
 						),
 						dashboards.AddVariableDatasource(datasource),
 					),
@@ -102,14 +129,13 @@ func BuildTempoTenantOverview(project string, datasource string, clusterLabelNam
 			),
 			dashboard.AddVariable("tenant",
 				listVar.List(
-					labelValuesVar.PrometheusLabelValues("tenant",
-						labelValuesVar.Matchers(
-							promql.SetLabelMatchers(
-								"tempodb_blocklist_length",
-								[]promql.LabelMatcher{
-									{Name: "cluster", Type: "=", Value: "$cluster"},
-									{Name: "job", Type: "=~", Value: "($namespace)/compactor"},
-								}),
+					labelValuesVar.PrometheusLabelValues(config.TenantLabelName,
+						dashboards.AddVariableMatcher(
+							vector.New(vector.WithMetricName("tempodb_blocklist_length")),
+							[]*labels.Matcher{
+								{Name: "cluster", Type: labels.MatchEqual, Value: "$cluster"},
+								{Name: "job", Type: labels.MatchRegexp, Value: "($namespace)/compactor"},
+							},
 						),
 						dashboards.AddVariableDatasource(datasource),
 					),
@@ -117,10 +143,10 @@ func BuildTempoTenantOverview(project string, datasource string, clusterLabelNam
 				),
 			),
 			withTenantInfo(datasource, clusterLabelMatcher),
-			withTenantIngestion(datasource, clusterLabelMatcher),
-			withTenantReads(datasource, clusterLabelMatcher),
-			withTenantStorage(datasource, clusterLabelMatcher),
-			withTenantMetricGenerator(datasource, clusterLabelMatcher),
+			withTenantIngestion(datasource, config.TenantLabelName, clusterLabelMatcher),
+			withTenantReads(datasource, config.TenantLabelName, clusterLabelMatcher),
+			withTenantStorage(datasource, config.TenantLabelName, clusterLabelMatcher),
+			withTenantMetricGenerator(datasource, config.TenantLabelName, clusterLabelMatcher),
 		),
 	).Component("tempo")
 }
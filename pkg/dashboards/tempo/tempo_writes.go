@@ -19,12 +19,13 @@ import (
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
 	listVar "github.com/perses/perses/go-sdk/variable/list-variable"
 	labelValuesVar "github.com/perses/plugins/prometheus/sdk/go/variable/label-values"
+	"github.com/perses/promql-builder/vector"
+	"github.com/prometheus/prometheus/model/labels"
 
 	"github.com/perses/community-mixins/pkg/dashboards"
-	"github.com/perses/community-mixins/pkg/promql"
 )
 
-func withWritesGateway(datasource string, labelMatcher promql.LabelMatcher) dashboard.Option {
+func withWritesGateway(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Gateway",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
@@ -33,7 +34,7 @@ func withWritesGateway(datasource string, labelMatcher promql.LabelMatcher) dash
 	)
 }
 
-func withWritesEnvoyProxy(datasource string, labelMatcher promql.LabelMatcher) dashboard.Option {
+func withWritesEnvoyProxy(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Envoy Proxy",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
@@ -42,7 +43,7 @@ func withWritesEnvoyProxy(datasource string, labelMatcher promql.LabelMatcher) d
 	)
 }
 
-func withWritesDistributor(datasource string, labelMatcher promql.LabelMatcher) dashboard.Option {
+func withWritesDistributor(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Distributor",
 		panelgroup.PanelsPerLine(3),
 		panelgroup.PanelHeight(8),
@@ -52,7 +53,7 @@ func withWritesDistributor(datasource string, labelMatcher promql.LabelMatcher)
 	)
 }
 
-func withWritesKafkaProducedRecords(datasource string, labelMatcher promql.LabelMatcher) dashboard.Option {
+func withWritesKafkaProducedRecords(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Kafka produced records",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
@@ -61,7 +62,7 @@ func withWritesKafkaProducedRecords(datasource string, labelMatcher promql.Label
 	)
 }
 
-func withWritesKafkaWrites(datasource string, labelMatcher promql.LabelMatcher) dashboard.Option {
+func withWritesKafkaWrites(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Kafka Writes",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
@@ -70,7 +71,7 @@ func withWritesKafkaWrites(datasource string, labelMatcher promql.LabelMatcher)
 	)
 }
 
-func withWritesIngester(datasource string, labelMatcher promql.LabelMatcher) dashboard.Option {
+func withWritesIngester(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Ingester",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
@@ -79,7 +80,7 @@ func withWritesIngester(datasource string, labelMatcher promql.LabelMatcher) das
 	)
 }
 
-func withWritesMemcachedIngester(datasource string, labelMatcher promql.LabelMatcher) dashboard.Option {
+func withWritesMemcachedIngester(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Memcached - Ingester",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
@@ -88,7 +89,7 @@ func withWritesMemcachedIngester(datasource string, labelMatcher promql.LabelMat
 	)
 }
 
-func withWritesBackendIngester(datasource string, labelMatcher promql.LabelMatcher) dashboard.Option {
+func withWritesBackendIngester(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Backend - Ingester",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
@@ -97,7 +98,7 @@ func withWritesBackendIngester(datasource string, labelMatcher promql.LabelMatch
 	)
 }
 
-func withWritesMemcachedCompactor(datasource string, labelMatcher promql.LabelMatcher) dashboard.Option {
+func withWritesMemcachedCompactor(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Memcached - Compactor",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
@@ -106,7 +107,7 @@ func withWritesMemcachedCompactor(datasource string, labelMatcher promql.LabelMa
 	)
 }
 
-func withWritesBackendCompactor(datasource string, labelMatcher promql.LabelMatcher) dashboard.Option {
+func withWritesBackendCompactor(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
 	return dashboard.AddPanelGroup("Backend - Compactor",
 		panelgroup.PanelsPerLine(2),
 		panelgroup.PanelHeight(8),
@@ -116,7 +117,7 @@ func withWritesBackendCompactor(datasource string, labelMatcher promql.LabelMatc
 }
 
 func BuildTempoWritesOverview(project string, datasource string, clusterLabelName string) dashboards.DashboardResult {
-	clusterLabelMatcher := dashboards.GetClusterLabelMatcher(clusterLabelName)
+	clusterLabelMatcher := dashboards.GetClusterLabelMatcherV2(clusterLabelName)
 	return dashboards.NewDashboardResult(
 		dashboard.New("tempo-writes-overview",
 			dashboard.ProjectName(project),
@@ -135,11 +136,9 @@ func BuildTempoWritesOverview(project string, datasource string, clusterLabelNam
 			dashboard.AddVariable("namespace",
 				listVar.List(
 					labelValuesVar.PrometheusLabelValues("namespace",
-						labelValuesVar.Matchers(
-							promql.SetLabelMatchers(
-								"tempo_build_info",
-								[]promql.LabelMatcher{clusterLabelMatcher, {Name: "cluster", Type: "=", Value: "$cluster"}},
-							),
+						dashboards.AddVariableMatcher(
+							vector.New(vector.WithMetricName("tempo_build_info")),
+							[]*labels.Matcher{clusterLabelMatcher, {Name: "cluster", Type: labels.MatchEqual, Value: "$cluster"}},
 						),
 						dashboards.AddVariableDatasource(datasource),
 					),
@@ -24,7 +24,6 @@ import (
 
 	"github.com/perses/community-mixins/pkg/dashboards"
 	panels "github.com/perses/community-mixins/pkg/panels/thanos"
-	"github.com/perses/community-mixins/pkg/promql"
 )
 
 func withThanosReceiveRemoteWriteGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
@@ -135,20 +134,18 @@ func BuildThanosReceiveOverview(project string, datasource string, clusterLabelN
 			dashboard.AddVariable("tenant",
 				listVar.List(
 					labelValuesVar.PrometheusLabelValues("tenant",
-						labelValuesVar.Matchers(
-							promql.SetLabelMatchersV2(
-								vector.New(
-									vector.WithMetricName("prometheus_tsdb_head_max_time"),
-									vector.WithLabelMatchers(
-										label.New("container").Equal("thanos-receive"),
-									),
+						dashboards.AddVariableMatcher(
+							vector.New(
+								vector.WithMetricName("prometheus_tsdb_head_max_time"),
+								vector.WithLabelMatchers(
+									label.New("container").Equal("thanos-receive"),
 								),
-								[]*labels.Matcher{
-									clusterLabelMatcherV2,
-									label.New("job").Equal("$job"),
-									label.New("namespace").Equal("$namespace"),
-								},
-							).Pretty(0),
+							),
+							[]*labels.Matcher{
+								clusterLabelMatcherV2,
+								label.New("job").Equal("$job"),
+								label.New("namespace").Equal("$namespace"),
+							},
 						),
 						dashboards.AddVariableDatasource(datasource),
 					),
@@ -0,0 +1,149 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thanosoperator
+
+import (
+	"github.com/perses/perses/go-sdk/dashboard"
+	panelgroup "github.com/perses/perses/go-sdk/panel-group"
+	listVar "github.com/perses/perses/go-sdk/variable/list-variable"
+	labelValuesVar "github.com/perses/plugins/prometheus/sdk/go/variable/label-values"
+	"github.com/prometheus/prometheus/model/labels"
+
+	"github.com/perses/community-mixins/pkg/dashboards"
+	panelsGostats "github.com/perses/community-mixins/pkg/panels/gostats"
+	panels "github.com/perses/community-mixins/pkg/panels/thanos_operator"
+	"github.com/perses/community-mixins/pkg/promql"
+)
+
+func withReconciliationGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+	return dashboard.AddPanelGroup("Reconciliation",
+		panelgroup.PanelsPerLine(3),
+		panelgroup.PanelHeight(10),
+		panels.ReconcileRate(datasource, labelMatcher),
+		panels.ReconcileErrors(datasource, labelMatcher),
+		panels.ReconcileDuration(datasource, labelMatcher),
+	)
+}
+
+func withWorkqueueGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+	return dashboard.AddPanelGroup("Workqueue",
+		panelgroup.PanelsPerLine(3),
+		panelgroup.PanelHeight(10),
+		panels.WorkqueueDepth(datasource, labelMatcher),
+		panels.WorkqueueRetries(datasource, labelMatcher),
+		panels.WorkqueueLatency(datasource, labelMatcher),
+	)
+}
+
+func withPausedResourcesGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+	return dashboard.AddPanelGroup("Paused Resources",
+		panelgroup.PanelsPerLine(1),
+		panelgroup.PanelHeight(8),
+		panels.PausedResources(datasource, labelMatcher),
+	)
+}
+
+func withThanosQueryGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+	return dashboard.AddPanelGroup("ThanosQuery",
+		panelgroup.PanelsPerLine(2),
+		panelgroup.PanelHeight(10),
+		panels.QueryEndpointsConfigured(datasource, labelMatcher),
+		panels.QueryServiceEventReconciliations(datasource, labelMatcher),
+	)
+}
+
+func withThanosReceiveGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+	return dashboard.AddPanelGroup("ThanosReceive",
+		panelgroup.PanelsPerLine(3),
+		panelgroup.PanelHeight(10),
+		panels.ReceiveHashringsConfigured(datasource, labelMatcher),
+		panels.ReceiveHashringEndpointsConfigured(datasource, labelMatcher),
+		panels.ReceiveEndpointEventReconciliations(datasource, labelMatcher),
+	)
+}
+
+func withThanosRulerGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+	return dashboard.AddPanelGroup("ThanosRuler",
+		panelgroup.PanelsPerLine(4),
+		panelgroup.PanelHeight(10),
+		panels.RulerQueryEndpointsConfigured(datasource, labelMatcher),
+		panels.RulerRulesConfigured(datasource, labelMatcher),
+		panels.RulerConfigMapOperations(datasource, labelMatcher),
+		panels.RulerEventReconciliations(datasource, labelMatcher),
+	)
+}
+
+func withThanosStoreGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+	return dashboard.AddPanelGroup("ThanosStore",
+		panelgroup.PanelsPerLine(2),
+		panelgroup.PanelHeight(10),
+		panels.StoreShardsConfigured(datasource, labelMatcher),
+		panels.StoreShardFailures(datasource, labelMatcher),
+	)
+}
+
+func withThanosCompactGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+	return dashboard.AddPanelGroup("ThanosCompact",
+		panelgroup.PanelsPerLine(2),
+		panelgroup.PanelHeight(10),
+		panels.CompactShardsConfigured(datasource, labelMatcher),
+		panels.CompactShardFailures(datasource, labelMatcher),
+	)
+}
+
+func withResourcesGroup(datasource string, labelMatcher *labels.Matcher) dashboard.Option {
+	labelMatchersToUse := []*labels.Matcher{
+		promql.JobVarV2,
+	}
+	labelMatchersToUse = append(labelMatchersToUse, labelMatcher)
+
+	return dashboard.AddPanelGroup("Resources",
+		panelgroup.PanelsPerLine(4),
+		panelgroup.PanelHeight(10),
+		panelsGostats.CPUUsage(datasource, "pod", labelMatchersToUse...),
+		panelsGostats.MemoryUsage(datasource, "pod", labelMatchersToUse...),
+		panelsGostats.Goroutines(datasource, "pod", labelMatchersToUse...),
+		panelsGostats.GarbageCollectionPauseTimeQuantiles(datasource, "pod", labelMatchersToUse...),
+	)
+}
+
+func BuildThanosOperatorOverview(project string, datasource string, clusterLabelName string) dashboards.DashboardResult {
+	clusterLabelMatcher := dashboards.GetClusterLabelMatcherV2(clusterLabelName)
+	return dashboards.NewDashboardResult(
+		dashboard.New("thanos-operator-overview",
+			dashboard.ProjectName(project),
+			dashboard.Name("Thanos Operator / Overview"),
+			dashboard.AddVariable("job",
+				listVar.List(
+					labelValuesVar.PrometheusLabelValues("job",
+						labelValuesVar.Matchers("controller_runtime_reconcile_total{controller=~\"thanos.*\"}"),
+						dashboards.AddVariableDatasource(datasource),
+					),
+					listVar.DisplayName("job"),
+					listVar.AllowMultiple(true),
+				),
+			),
+			dashboards.AddClusterVariable(datasource, clusterLabelName, "controller_runtime_reconcile_total"),
+			withReconciliationGroup(datasource, clusterLabelMatcher),
+			withWorkqueueGroup(datasource, clusterLabelMatcher),
+			withPausedResourcesGroup(datasource, clusterLabelMatcher),
+			withThanosQueryGroup(datasource, clusterLabelMatcher),
+			withThanosReceiveGroup(datasource, clusterLabelMatcher),
+			withThanosRulerGroup(datasource, clusterLabelMatcher),
+			withThanosStoreGroup(datasource, clusterLabelMatcher),
+			withThanosCompactGroup(datasource, clusterLabelMatcher),
+			withResourcesGroup(datasource, clusterLabelMatcher),
+		),
+	).Component("thanos-operator")
+}
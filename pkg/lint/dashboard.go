@@ -0,0 +1,282 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/perses/community-mixins/pkg/promql"
+)
+
+// The dashboards are linted from their JSON, as written to the output directory, so that the checks don't depend
+// on the Go types of the plugins. These types only hold the fields the checks read.
+
+type dashboardModel struct {
+	Metadata struct {
+		Name string `json:"name"`
+	} `json:"metadata"`
+	Spec struct {
+		Variables []variableModel        `json:"variables"`
+		Panels    map[string]*panelModel `json:"panels"`
+		Layouts   []layoutModel          `json:"layouts"`
+	} `json:"spec"`
+}
+
+type pluginModel struct {
+	Kind string          `json:"kind"`
+	Spec json.RawMessage `json:"spec"`
+}
+
+type variableModel struct {
+	Kind string `json:"kind"`
+	Spec struct {
+		Name   string      `json:"name"`
+		Plugin pluginModel `json:"plugin"`
+	} `json:"spec"`
+}
+
+// prometheusVariableSpec covers the specs of the label values, label names and PromQL variables of the Prometheus plugin.
+type prometheusVariableSpec struct {
+	Expr     string   `json:"expr"`
+	Matchers []string `json:"matchers"`
+}
+
+type panelModel struct {
+	Spec struct {
+		Display struct {
+			Name        string `json:"name"`
+			Description string `json:"description"`
+		} `json:"display"`
+		Queries []struct {
+			Spec struct {
+				Plugin pluginModel `json:"plugin"`
+			} `json:"spec"`
+		} `json:"queries"`
+	} `json:"spec"`
+}
+
+type prometheusQuerySpec struct {
+	Query            string `json:"query"`
+	SeriesNameFormat string `json:"seriesNameFormat"`
+}
+
+type layoutModel struct {
+	Spec struct {
+		Display *struct {
+			Title string `json:"title"`
+		} `json:"display"`
+		Items []struct {
+			Content struct {
+				Ref string `json:"$ref"`
+			} `json:"content"`
+		} `json:"items"`
+		RepeatVariable string `json:"repeatVariable"`
+	} `json:"spec"`
+}
+
+const (
+	prometheusQueryKind           = "PrometheusTimeSeriesQuery"
+	prometheusLabelValuesVariable = "PrometheusLabelValuesVariable"
+	prometheusLabelNamesVariable  = "PrometheusLabelNamesVariable"
+	prometheusPromQLVariable      = "PrometheusPromQLVariable"
+	panelRefPrefix                = "#/spec/panels/"
+)
+
+// dashboardLinter accumulates the findings of a dashboard and the variables its queries reference.
+type dashboardLinter struct {
+	component string
+	dashboard string
+	findings  []Finding
+	// references maps the name of the variables referenced by the dashboard to the first place referencing them.
+	references map[string]string
+}
+
+func (l *dashboardLinter) report(severity Severity, location string, format string, args ...any) {
+	l.findings = append(l.findings, Finding{
+		Severity:  severity,
+		Component: l.component,
+		Resource:  l.dashboard,
+		Location:  location,
+		Message:   fmt.Sprintf(format, args...),
+	})
+}
+
+func (l *dashboardLinter) reference(location string, s string) {
+	for _, name := range promql.ReferencedVariables(s) {
+		if _, ok := l.references[name]; !ok {
+			l.references[name] = location
+		}
+	}
+}
+
+// lintDashboard lints the JSON of a dashboard of component.
+func lintDashboard(component string, data []byte) []Finding {
+	var d dashboardModel
+	if err := json.Unmarshal(data, &d); err != nil {
+		return []Finding{{Severity: Error, Component: component, Message: err.Error()}}
+	}
+	l := &dashboardLinter{component: component, dashboard: d.Metadata.Name, references: map[string]string{}}
+
+	for _, v := range d.Spec.Variables {
+		l.lintVariable(v)
+	}
+	groups := panelGroups(d)
+	for _, key := range panelOrder(d) {
+		l.lintPanel(fmt.Sprintf("panel %q%s", d.Spec.Panels[key].Spec.Display.Name, groups[key]), d.Spec.Panels[key])
+	}
+	for _, layout := range d.Spec.Layouts {
+		if layout.Spec.RepeatVariable != "" {
+			l.reference("layout", "$"+layout.Spec.RepeatVariable)
+		}
+	}
+	l.lintDuplicateTitles(d)
+	l.lintVariableReferences(d)
+	return l.findings
+}
+
+func (l *dashboardLinter) lintVariable(v variableModel) {
+	location := fmt.Sprintf("variable %q", v.Spec.Name)
+	var spec prometheusVariableSpec
+	switch v.Spec.Plugin.Kind {
+	case prometheusLabelValuesVariable, prometheusLabelNamesVariable, prometheusPromQLVariable:
+		if err := json.Unmarshal(v.Spec.Plugin.Spec, &spec); err != nil {
+			l.report(Error, location, "%v", err)
+			return
+		}
+	default:
+		return
+	}
+	for _, matcher := range spec.Matchers {
+		l.reference(location, matcher)
+		if _, err := parseSeriesSelector(matcher); err != nil {
+			l.report(Error, location, "invalid matcher %q: %v", matcher, err)
+		}
+	}
+	if spec.Expr != "" {
+		l.reference(location, spec.Expr)
+		if _, err := parseQuery(spec.Expr); err != nil {
+			l.report(Error, location, "invalid query %q: %v", spec.Expr, err)
+		}
+	}
+}
+
+func (l *dashboardLinter) lintPanel(location string, p *panelModel) {
+	// Variables only shown in the title or description of a panel still count as used.
+	l.reference(location, p.Spec.Display.Name)
+	l.reference(location, p.Spec.Display.Description)
+	for i, q := range p.Spec.Queries {
+		if q.Spec.Plugin.Kind != prometheusQueryKind {
+			continue
+		}
+		queryLocation := fmt.Sprintf("%s, query %d", location, i+1)
+		var spec prometheusQuerySpec
+		if err := json.Unmarshal(q.Spec.Plugin.Spec, &spec); err != nil {
+			l.report(Error, queryLocation, "%v", err)
+			continue
+		}
+		l.reference(queryLocation, spec.Query)
+		expr, err := parseQuery(spec.Query)
+		if err != nil {
+			l.report(Error, queryLocation, "invalid query %q: %v", spec.Query, err)
+			continue
+		}
+		if dropped := droppedLabels(expr, spec.SeriesNameFormat); len(dropped) > 0 {
+			l.report(Warning, queryLocation, "series name format %q refers to %s, which the query doesn't return",
+				spec.SeriesNameFormat, strings.Join(dropped, ", "))
+		}
+		for _, name := range nonCounterRates(expr) {
+			l.report(Warning, queryLocation, "applies a counter function to %s, whose name doesn't look like a counter's", name)
+		}
+	}
+}
+
+// lintDuplicateTitles reports the panels of a group that have the same title.
+func (l *dashboardLinter) lintDuplicateTitles(d dashboardModel) {
+	for _, layout := range d.Spec.Layouts {
+		var seen []string
+		var reported []string
+		for _, item := range layout.Spec.Items {
+			p, ok := d.Spec.Panels[strings.TrimPrefix(item.Content.Ref, panelRefPrefix)]
+			if !ok {
+				continue
+			}
+			title := p.Spec.Display.Name
+			if slices.Contains(seen, title) && !slices.Contains(reported, title) {
+				reported = append(reported, title)
+				l.report(Warning, fmt.Sprintf("group %q", layoutTitle(layout)), "several panels are titled %q", title)
+			}
+			seen = append(seen, title)
+		}
+	}
+}
+
+// lintVariableReferences reports the variables referenced but not defined, and the reverse.
+func (l *dashboardLinter) lintVariableReferences(d dashboardModel) {
+	defined := map[string]bool{}
+	for _, v := range d.Spec.Variables {
+		defined[v.Spec.Name] = true
+	}
+	for _, name := range slices.Sorted(maps.Keys(l.references)) {
+		if _, builtin := builtinVariables[name]; !builtin && !defined[name] {
+			l.report(Error, l.references[name], "references variable %q, which the dashboard doesn't define", name)
+		}
+	}
+	for _, v := range d.Spec.Variables {
+		if _, ok := l.references[v.Spec.Name]; !ok {
+			l.report(Warning, fmt.Sprintf("variable %q", v.Spec.Name), "isn't referenced by any query")
+		}
+	}
+}
+
+// panelGroups maps the key of the panels of d to the title of their group, formatted to be appended to a location.
+func panelGroups(d dashboardModel) map[string]string {
+	groups := map[string]string{}
+	for _, layout := range d.Spec.Layouts {
+		for _, item := range layout.Spec.Items {
+			groups[strings.TrimPrefix(item.Content.Ref, panelRefPrefix)] = fmt.Sprintf(" in group %q", layoutTitle(layout))
+		}
+	}
+	return groups
+}
+
+func layoutTitle(layout layoutModel) string {
+	if layout.Spec.Display == nil {
+		return ""
+	}
+	return layout.Spec.Display.Title
+}
+
+// panelOrder returns the keys of the panels of d in the order of the layouts, followed by the panels missing from
+// the layouts.
+func panelOrder(d dashboardModel) []string {
+	var keys []string
+	for _, layout := range d.Spec.Layouts {
+		for _, item := range layout.Spec.Items {
+			key := strings.TrimPrefix(item.Content.Ref, panelRefPrefix)
+			if _, ok := d.Spec.Panels[key]; ok && !slices.Contains(keys, key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	for _, key := range slices.Sorted(maps.Keys(d.Spec.Panels)) {
+		if !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
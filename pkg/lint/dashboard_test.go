@@ -0,0 +1,76 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testDashboard = `{
+  "kind": "Dashboard",
+  "metadata": {"name": "etcd-overview", "project": "perses-dev"},
+  "spec": {
+    "variables": [
+      {"kind": "ListVariable", "spec": {"name": "cluster", "plugin": {"kind": "PrometheusLabelValuesVariable", "spec": {"labelName": "cluster", "matchers": ["etcd_server_has_leader{job=~\".*etcd.*\"}"]}}}},
+      {"kind": "ListVariable", "spec": {"name": "instance", "plugin": {"kind": "PrometheusLabelValuesVariable", "spec": {"labelName": "instance", "matchers": ["etcd_server_has_leader{cluster=\"$cluster\""]}}}},
+      {"kind": "ListVariable", "spec": {"name": "unused", "plugin": {"kind": "PrometheusPromQLVariable", "spec": {"expr": "group by (pod) (up)", "labelName": "pod"}}}}
+    ],
+    "panels": {
+      "0_0": {"kind": "Panel", "spec": {"display": {"name": "Up"}, "queries": [
+        {"kind": "TimeSeriesQuery", "spec": {"plugin": {"kind": "PrometheusTimeSeriesQuery", "spec": {"query": "sum(etcd_server_has_leader{cluster=\"$cluster\"})", "seriesNameFormat": "{{cluster}} {{namespace}}"}}}}
+      ]}},
+      "0_1": {"kind": "Panel", "spec": {"display": {"name": "Up"}, "queries": [
+        {"kind": "TimeSeriesQuery", "spec": {"plugin": {"kind": "PrometheusTimeSeriesQuery", "spec": {"query": "sum by (instance) (rate(etcd_network_peer_sent_failures_total{job=~\"$job\"}[$__rate_interval]))"}}}},
+        {"kind": "TimeSeriesQuery", "spec": {"plugin": {"kind": "PrometheusTimeSeriesQuery", "spec": {"query": "rate(go_memstats_heap_alloc_bytes{cluster=\"$cluster\"}[$__rate_interval])"}}}}
+      ]}},
+      "1_0": {"kind": "Panel", "spec": {"display": {"name": "RPC Rate"}, "queries": [
+        {"kind": "TimeSeriesQuery", "spec": {"plugin": {"kind": "PrometheusTimeSeriesQuery", "spec": {"query": "sum(rate(grpc_server_started_total[$__rate_interval])"}}}}
+      ]}}
+    },
+    "layouts": [
+      {"kind": "Grid", "spec": {"display": {"title": "etcd Status"}, "items": [
+        {"x": 0, "y": 0, "width": 12, "height": 8, "content": {"$ref": "#/spec/panels/0_0"}},
+        {"x": 12, "y": 0, "width": 12, "height": 8, "content": {"$ref": "#/spec/panels/0_1"}}
+      ]}},
+      {"kind": "Grid", "spec": {"display": {"title": "gRPC"}, "repeatVariable": "instance", "items": [
+        {"x": 0, "y": 0, "width": 24, "height": 8, "content": {"$ref": "#/spec/panels/1_0"}}
+      ]}}
+    ]
+  }
+}`
+
+func TestLintDashboard(t *testing.T) {
+	var got []string
+	for _, f := range lintDashboard("etcd", []byte(testDashboard)) {
+		got = append(got, f.String())
+	}
+	assert.Equal(t, []string{
+		`error: etcd/etcd-overview: variable "instance": invalid matcher "etcd_server_has_leader{cluster=\"$cluster\"": 1:42: parse error: unexpected end of input inside braces`,
+		`warning: etcd/etcd-overview: panel "Up" in group "etcd Status", query 1: series name format "{{cluster}} {{namespace}}" refers to cluster, namespace, which the query doesn't return`,
+		`warning: etcd/etcd-overview: panel "Up" in group "etcd Status", query 2: applies a counter function to go_memstats_heap_alloc_bytes, whose name doesn't look like a counter's`,
+		`error: etcd/etcd-overview: panel "RPC Rate" in group "gRPC", query 1: invalid query "sum(rate(grpc_server_started_total[$__rate_interval])": 1:40: parse error: unclosed left parenthesis`,
+		`warning: etcd/etcd-overview: group "etcd Status": several panels are titled "Up"`,
+		`error: etcd/etcd-overview: panel "Up" in group "etcd Status", query 1: references variable "job", which the dashboard doesn't define`,
+		`warning: etcd/etcd-overview: variable "unused": isn't referenced by any query`,
+	}, got)
+}
+
+func TestLintDashboard_InvalidJSON(t *testing.T) {
+	findings := lintDashboard("etcd", []byte(`{"spec": []}`))
+	assert.Len(t, findings, 1)
+	assert.Equal(t, Error, findings[0].Severity)
+	assert.True(t, HasErrors(findings))
+}
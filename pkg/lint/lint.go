@@ -0,0 +1,99 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package lint checks the generated dashboards and rules for mistakes that marshalling them doesn't catch,
+// e.g. a PromQL query that doesn't parse or a variable a query references but the dashboard doesn't define.
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/perses/community-mixins/pkg/dashboards"
+	"github.com/perses/community-mixins/pkg/rules"
+)
+
+// Severity tells whether a Finding is a mistake, which fails the lint, or a likely one worth a look.
+type Severity string
+
+const (
+	Error   Severity = "error"
+	Warning Severity = "warning"
+)
+
+// Finding is a problem found in a dashboard or a rule group.
+type Finding struct {
+	Severity  Severity
+	Component string
+	// Resource is the name of the dashboard or of the PrometheusRule.
+	Resource string
+	// Location is the part of the resource the finding is about, e.g. `panel "Up" in group "etcd Status"`.
+	Location string
+	Message  string
+}
+
+func (f Finding) String() string {
+	if f.Location == "" {
+		return fmt.Sprintf("%s: %s/%s: %s", f.Severity, f.Component, f.Resource, f.Message)
+	}
+	return fmt.Sprintf("%s: %s/%s: %s: %s", f.Severity, f.Component, f.Resource, f.Location, f.Message)
+}
+
+// HasErrors returns whether any of findings is an Error.
+func HasErrors(findings []Finding) bool {
+	for _, f := range findings {
+		if f.Severity == Error {
+			return true
+		}
+	}
+	return false
+}
+
+// Dashboards lints the dashboards of results. A dashboard that failed to build is reported as an Error.
+func Dashboards(results []dashboards.DashboardResult) []Finding {
+	var findings []Finding
+	for _, dr := range results {
+		name := dr.Builder().Dashboard.Metadata.Name
+		if err := dr.Err(); err != nil {
+			findings = append(findings, Finding{Severity: Error, Component: dr.ComponentName(), Resource: name, Message: err.Error()})
+			continue
+		}
+		data, err := json.Marshal(dr.Builder().Dashboard)
+		if err != nil {
+			findings = append(findings, Finding{Severity: Error, Component: dr.ComponentName(), Resource: name, Message: err.Error()})
+			continue
+		}
+		findings = append(findings, lintDashboard(dr.ComponentName(), data)...)
+	}
+	return findings
+}
+
+// Rules lints the rule groups of results. A PrometheusRule that failed to build is reported as an Error.
+func Rules(results []rules.RuleResult) []Finding {
+	var findings []Finding
+	for _, rr := range results {
+		if err := rr.Err(); err != nil {
+			resource := ""
+			if rr.Rule() != nil {
+				resource = rr.Rule().Name
+			}
+			findings = append(findings, Finding{Severity: Error, Component: rr.ComponentName(), Resource: resource, Message: err.Error()})
+			continue
+		}
+		if rr.Rule() == nil {
+			continue
+		}
+		findings = append(findings, lintRules(rr.ComponentName(), rr.Rule())...)
+	}
+	return findings
+}
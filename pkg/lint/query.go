@@ -0,0 +1,244 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/perses/community-mixins/pkg/promql"
+)
+
+// builtinVariables maps the variables Perses defines on every dashboard to a value the PromQL parser accepts where
+// they are used outside of a string, e.g. a duration for $__rate_interval.
+var builtinVariables = map[string]string{
+	"__dashboard":     "1",
+	"__project":       "1",
+	"__from":          "1715222400",
+	"__to":            "1715222400",
+	"__interval":      "5m",
+	"__interval_ms":   "300000",
+	"__rate_interval": "5m",
+	"__range":         "5m",
+	"__range_s":       "300",
+	"__range_ms":      "300000",
+}
+
+// substituteVariables replaces the variables query references outside of strings with a value the PromQL parser
+// accepts there: the builtin variables with a value of their type, the dashboard variables with a number.
+// Variables inside strings, e.g. in label matchers, are valid PromQL as is.
+func substituteVariables(query string) string {
+	var b strings.Builder
+	var quote byte
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case quote != 0:
+			b.WriteByte(c)
+			if c == '\\' && quote != '`' && i+1 < len(query) {
+				i++
+				b.WriteByte(query[i])
+			} else if c == quote {
+				quote = 0
+			}
+			continue
+		case c == '"' || c == '\'' || c == '`':
+			quote = c
+		case c == '$':
+			if loc := promql.VariableReference.FindStringSubmatchIndex(query[i:]); loc != nil && loc[0] == 0 {
+				name := query[i+loc[2] : i+loc[3]]
+				if loc[2] < 0 {
+					name = query[i+loc[4] : i+loc[5]]
+				}
+				value, ok := builtinVariables[name]
+				if !ok {
+					value = "1"
+				}
+				b.WriteString(value)
+				i += loc[1] - 1
+				continue
+			}
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+// parseQuery parses a PromQL query that may reference variables.
+func parseQuery(query string) (parser.Expr, error) {
+	return parser.NewParser(parser.Options{}).ParseExpr(substituteVariables(query))
+}
+
+// parseSeriesSelector parses a series selector that may reference variables, e.g. a matcher of a label values variable.
+func parseSeriesSelector(selector string) ([]*labels.Matcher, error) {
+	return parser.NewParser(parser.Options{}).ParseMetricSelector(substituteVariables(selector))
+}
+
+// outputLabels returns the labels of the series expr returns, when it can tell them from expr alone, e.g. the
+// grouping labels of an aggregation. It returns false when the labels depend on the series expr selects.
+func outputLabels(expr parser.Expr) ([]string, bool) {
+	switch e := expr.(type) {
+	case *parser.AggregateExpr:
+		switch {
+		case e.Op == parser.TOPK || e.Op == parser.BOTTOMK || e.Op == parser.LIMITK || e.Op == parser.LIMIT_RATIO:
+			return outputLabels(e.Expr)
+		case e.Without:
+			return nil, false
+		case e.Op == parser.COUNT_VALUES:
+			if s, ok := e.Param.(*parser.StringLiteral); ok {
+				return append(slices.Clone(e.Grouping), s.Val), true
+			}
+			return nil, false
+		}
+		return e.Grouping, true
+	case *parser.Call:
+		switch e.Func.Name {
+		case "label_replace", "label_join":
+			inner, ok := outputLabels(e.Args[0])
+			if s, isString := e.Args[1].(*parser.StringLiteral); ok && isString {
+				return append(slices.Clone(inner), s.Val), true
+			}
+			return nil, false
+		case "histogram_quantile":
+			inner, ok := outputLabels(e.Args[1])
+			if !ok {
+				return nil, false
+			}
+			return slices.DeleteFunc(slices.Clone(inner), func(l string) bool { return l == labels.BucketLabel }), true
+		case "vector", "time", "scalar", "absent", "absent_over_time":
+			return nil, false
+		}
+		for _, arg := range e.Args {
+			if arg.Type() == parser.ValueTypeVector {
+				return outputLabels(arg)
+			}
+		}
+		return nil, false
+	case *parser.ParenExpr:
+		return outputLabels(e.Expr)
+	case *parser.UnaryExpr:
+		return outputLabels(e.Expr)
+	case *parser.StepInvariantExpr:
+		return outputLabels(e.Expr)
+	case *parser.BinaryExpr:
+		return binaryOutputLabels(e)
+	case *parser.NumberLiteral:
+		return nil, true
+	}
+	return nil, false
+}
+
+// binaryOutputLabels returns the labels of the series a binary expression returns, following the vector matching
+// rules of the Prometheus engine.
+func binaryOutputLabels(e *parser.BinaryExpr) ([]string, bool) {
+	lhsVector := e.LHS.Type() == parser.ValueTypeVector
+	rhsVector := e.RHS.Type() == parser.ValueTypeVector
+	switch {
+	case !lhsVector && !rhsVector:
+		return nil, true
+	case !lhsVector:
+		return outputLabels(e.RHS)
+	case !rhsVector:
+		return outputLabels(e.LHS)
+	}
+
+	matching := e.VectorMatching
+	if matching == nil {
+		matching = &parser.VectorMatching{Card: parser.CardOneToOne}
+	}
+	switch {
+	case e.Op == parser.LOR:
+		lhs, lok := outputLabels(e.LHS)
+		rhs, rok := outputLabels(e.RHS)
+		if !lok || !rok {
+			return nil, false
+		}
+		return slices.Concat(lhs, rhs), true
+	case e.Op == parser.LAND || e.Op == parser.LUNLESS:
+		return outputLabels(e.LHS)
+	case matching.Card == parser.CardOneToOne && matching.On:
+		return matching.MatchingLabels, true
+	case matching.Card == parser.CardOneToOne:
+		lhs, ok := outputLabels(e.LHS)
+		if !ok {
+			return nil, false
+		}
+		return slices.DeleteFunc(slices.Clone(lhs), func(l string) bool { return slices.Contains(matching.MatchingLabels, l) }), true
+	case matching.Card == parser.CardManyToOne:
+		lhs, ok := outputLabels(e.LHS)
+		return slices.Concat(lhs, matching.Include), ok
+	case matching.Card == parser.CardOneToMany:
+		rhs, ok := outputLabels(e.RHS)
+		return slices.Concat(rhs, matching.Include), ok
+	}
+	return nil, false
+}
+
+// seriesNameLabel matches the labels a series name format refers to, e.g. {{ instance }}.
+var seriesNameLabel = regexp.MustCompile(`\{\{\s*([A-Za-z_]\w*)\s*\}\}`)
+
+// droppedLabels returns the labels seriesNameFormat refers to that the series expr returns don't have.
+func droppedLabels(expr parser.Expr, seriesNameFormat string) []string {
+	kept, ok := outputLabels(expr)
+	if !ok {
+		return nil
+	}
+	var dropped []string
+	for _, m := range seriesNameLabel.FindAllStringSubmatch(seriesNameFormat, -1) {
+		if !slices.Contains(kept, m[1]) && !slices.Contains(dropped, m[1]) {
+			dropped = append(dropped, m[1])
+		}
+	}
+	return dropped
+}
+
+// counterFunctions are the functions that only make sense over counters.
+var counterFunctions = []string{"rate", "irate", "increase", "resets"}
+
+// counterSuffixes are the suffixes of the names of counters and of the counter series of histograms and summaries.
+var counterSuffixes = []string{"_total", "_count", "_sum", "_bucket"}
+
+// nonCounterRates returns the names of the metrics expr applies a counter function to, e.g. rate(), whose name
+// doesn't look like the name of a counter. Recorded series, whose name has a colon, are left out.
+func nonCounterRates(expr parser.Expr) []string {
+	var names []string
+	parser.Inspect(expr, func(node parser.Node, _ []parser.Node) error {
+		call, ok := node.(*parser.Call)
+		if !ok || !slices.Contains(counterFunctions, call.Func.Name) || len(call.Args) == 0 {
+			return nil
+		}
+		matrix, ok := call.Args[0].(*parser.MatrixSelector)
+		if !ok {
+			return nil
+		}
+		name := promql.MetricName(matrix.VectorSelector.(*parser.VectorSelector))
+		if name == "" || strings.Contains(name, ":") {
+			return nil
+		}
+		for _, suffix := range counterSuffixes {
+			if strings.HasSuffix(name, suffix) {
+				return nil
+			}
+		}
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+		return nil
+	})
+	return names
+}
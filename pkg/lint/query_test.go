@@ -0,0 +1,140 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubstituteVariables(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{
+			name:  "builtin variables get a value of their type",
+			query: `rate(up[$__rate_interval]) offset ${__range}`,
+			want:  `rate(up[5m]) offset 5m`,
+		},
+		{
+			name:  "dashboard variables outside strings get a number",
+			query: `topk($limit, up)`,
+			want:  `topk(1, up)`,
+		},
+		{
+			name:  "variables inside strings are kept",
+			query: `up{job=~"$job",instance='${instance:pipe}'}`,
+			want:  `up{job=~"$job",instance='${instance:pipe}'}`,
+		},
+		{
+			name:  "escaped quotes don't end strings",
+			query: `up{job="a\"$job"} * $factor`,
+			want:  `up{job="a\"$job"} * 1`,
+		},
+		{
+			name:  "label_replace replacements aren't variables",
+			query: `label_replace(up, "host", "$1", "instance", "(.*):.*")`,
+			want:  `label_replace(up, "host", "$1", "instance", "(.*):.*")`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, substituteVariables(tt.query))
+		})
+	}
+}
+
+func TestDroppedLabels(t *testing.T) {
+	tests := []struct {
+		name             string
+		query            string
+		seriesNameFormat string
+		want             []string
+	}{
+		{
+			name:             "aggregation without grouping",
+			query:            `sum(etcd_server_has_leader{job=~".*etcd.*"})`,
+			seriesNameFormat: "{{cluster}} {{namespace}}",
+			want:             []string{"cluster", "namespace"},
+		},
+		{
+			name:             "aggregation keeping the labels",
+			query:            `sum by (cluster, namespace) (etcd_server_has_leader)`,
+			seriesNameFormat: "{{ cluster }} {{ namespace }}",
+		},
+		{
+			name:             "selector keeps every label",
+			query:            `up{job="$job"}`,
+			seriesNameFormat: "{{instance}}",
+		},
+		{
+			name:             "aggregation without drops nothing that can be told",
+			query:            `sum without (instance) (up)`,
+			seriesNameFormat: "{{instance}}",
+		},
+		{
+			name:             "histogram_quantile drops le",
+			query:            `histogram_quantile(0.99, sum by (le, route) (rate(request_duration_seconds_bucket[$__rate_interval])))`,
+			seriesNameFormat: "{{route}} {{le}}",
+			want:             []string{"le"},
+		},
+		{
+			name:             "label_replace adds its destination label",
+			query:            `label_replace(sum by (instance) (up), "host", "$1", "instance", "(.*):.*")`,
+			seriesNameFormat: "{{host}} {{job}}",
+			want:             []string{"job"},
+		},
+		{
+			name:             "one-to-one matching on labels",
+			query:            `sum by (pod, container) (a) / on (pod) sum by (pod) (b)`,
+			seriesNameFormat: "{{pod}} {{container}}",
+			want:             []string{"container"},
+		},
+		{
+			name:             "many-to-one matching includes the group_left labels",
+			query:            `sum by (pod) (a) * on (pod) group_left (node) sum by (pod, node) (b)`,
+			seriesNameFormat: "{{pod}} {{node}}",
+		},
+		{
+			name:             "scalar operand keeps the labels of the vector",
+			query:            `100 * sum by (handler) (rate(http_requests_total[5m]))`,
+			seriesNameFormat: "{{handler}} {{method}}",
+			want:             []string{"method"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr, err := parseQuery(tt.query)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, droppedLabels(expr, tt.seriesNameFormat))
+		})
+	}
+}
+
+func TestNonCounterRates(t *testing.T) {
+	expr, err := parseQuery(`
+		rate(go_memstats_heap_alloc_bytes[$__rate_interval])
+		+ rate(http_requests_total[$__rate_interval])
+		+ increase(request_duration_seconds_bucket[1h])
+		+ irate(job:http_requests:rate5m[5m])
+		+ sum_over_time(workqueue_depth[5m])
+		+ rate({__name__="pilot_xds_pushes"}[5m])
+	`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"go_memstats_heap_alloc_bytes", "pilot_xds_pushes"}, nonCounterRates(expr))
+}
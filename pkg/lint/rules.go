@@ -0,0 +1,79 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"fmt"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+// requiredAnnotations are the annotations every alert must have, each with the alternative names it may go by.
+var requiredAnnotations = [][]string{
+	{"summary"},
+	{"description"},
+	{"runbook", "runbook_url"},
+}
+
+// lintRules lints the rule groups of a PrometheusRule of component.
+func lintRules(component string, rule *monitoringv1.PrometheusRule) []Finding {
+	var findings []Finding
+	report := func(severity Severity, location string, format string, args ...any) {
+		findings = append(findings, Finding{
+			Severity:  severity,
+			Component: component,
+			Resource:  rule.Name,
+			Location:  location,
+			Message:   fmt.Sprintf(format, args...),
+		})
+	}
+
+	for _, group := range rule.Spec.Groups {
+		for _, r := range group.Rules {
+			location := fmt.Sprintf("recording rule %q in group %q", r.Record, group.Name)
+			if r.Alert != "" {
+				location = fmt.Sprintf("alert %q in group %q", r.Alert, group.Name)
+			}
+
+			expr, err := parser.NewParser(parser.Options{}).ParseExpr(r.Expr.String())
+			if err != nil {
+				report(Error, location, "invalid query %q: %v", r.Expr.String(), err)
+			} else {
+				for _, name := range nonCounterRates(expr) {
+					report(Warning, location, "query applies a counter function to %s, whose name doesn't look like a counter's", name)
+				}
+			}
+
+			if r.Alert == "" {
+				continue
+			}
+			for _, names := range requiredAnnotations {
+				if !hasAnnotation(r.Annotations, names) {
+					report(Warning, location, "is missing the %s annotation", names[0])
+				}
+			}
+		}
+	}
+	return findings
+}
+
+func hasAnnotation(annotations map[string]string, names []string) bool {
+	for _, name := range names {
+		if annotations[name] != "" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,67 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"testing"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestLintRules(t *testing.T) {
+	rule := &monitoringv1.PrometheusRule{
+		ObjectMeta: metav1.ObjectMeta{Name: "etcd-rules"},
+		Spec: monitoringv1.PrometheusRuleSpec{
+			Groups: []monitoringv1.RuleGroup{
+				{
+					Name: "etcd",
+					Rules: []monitoringv1.Rule{
+						{
+							Alert: "etcdMembersDown",
+							Expr:  intstr.FromString(`max without (endpoint) (up{job=~".*etcd.*"} == bool 0)`),
+							Annotations: map[string]string{
+								"summary":     "etcd cluster members are down.",
+								"description": "etcd cluster has insufficient members.",
+								"runbook_url": "https://runbooks.example.com/etcdmembersdown",
+							},
+						},
+						{
+							Alert:       "etcdHighNumberOfLeaderChanges",
+							Expr:        intstr.FromString(`increase(etcd_server_leader_changes_seen[15m]) >= 4`),
+							Annotations: map[string]string{"summary": "etcd cluster has high number of leader changes."},
+						},
+						{
+							Record: "instance:etcd_disk_wal_fsync_duration_seconds:p99",
+							Expr:   intstr.FromString(`histogram_quantile(0.99, rate(etcd_disk_wal_fsync_duration_seconds_bucket[5m])`),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	var got []string
+	for _, f := range lintRules("etcd", rule) {
+		got = append(got, f.String())
+	}
+	assert.Equal(t, []string{
+		`warning: etcd/etcd-rules: alert "etcdHighNumberOfLeaderChanges" in group "etcd": query applies a counter function to etcd_server_leader_changes_seen, whose name doesn't look like a counter's`,
+		`warning: etcd/etcd-rules: alert "etcdHighNumberOfLeaderChanges" in group "etcd": is missing the description annotation`,
+		`warning: etcd/etcd-rules: alert "etcdHighNumberOfLeaderChanges" in group "etcd": is missing the runbook annotation`,
+		`error: etcd/etcd-rules: recording rule "instance:etcd_disk_wal_fsync_duration_seconds:p99" in group "etcd": invalid query "histogram_quantile(0.99, rate(etcd_disk_wal_fsync_duration_seconds_bucket[5m])": 1:79: parse error: unclosed left parenthesis`,
+	}, got)
+}
@@ -54,15 +54,12 @@ func Alerts(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					AlertmanagerCommonPanelQueries["Alerts"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} - Alertmanager - Alerts"),
-			),
+		promql.AddQueryFrom(
+			"AlertmanagerCommonPanelQueries", AlertmanagerCommonPanelQueries,
+			"Alerts",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} - Alertmanager - Alerts"),
 		),
 	)
 }
@@ -100,25 +97,19 @@ func AlertsReceiveRate(datasourceName string, labelMatchers ...*labels.Matcher)
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					AlertmanagerCommonPanelQueries["AlertsReceiveRate_received"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} - Alertmanager - Received"),
-			),
+		promql.AddQueryFrom(
+			"AlertmanagerCommonPanelQueries", AlertmanagerCommonPanelQueries,
+			"AlertsReceiveRate_received",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} - Alertmanager - Received"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					AlertmanagerCommonPanelQueries["AlertsReceiveRate_invalid"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} - Alertmanager - Invalid"),
-			),
+		promql.AddQueryFrom(
+			"AlertmanagerCommonPanelQueries", AlertmanagerCommonPanelQueries,
+			"AlertsReceiveRate_invalid",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} - Alertmanager - Invalid"),
 		),
 	)
 }
@@ -162,25 +153,19 @@ func NotificationsSendRate(datasourceName string, labelMatchers ...*labels.Match
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					AlertmanagerCommonPanelQueries["NotificationsSendRate_total"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} - {{integration}} - Total"),
-			),
+		promql.AddQueryFrom(
+			"AlertmanagerCommonPanelQueries", AlertmanagerCommonPanelQueries,
+			"NotificationsSendRate_total",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} - {{integration}} - Total"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					AlertmanagerCommonPanelQueries["NotificationsSendRate_failed"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} - {{integration}} - Failed"),
-			),
+		promql.AddQueryFrom(
+			"AlertmanagerCommonPanelQueries", AlertmanagerCommonPanelQueries,
+			"NotificationsSendRate_failed",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} - {{integration}} - Failed"),
 		),
 	)
 }
@@ -227,35 +212,26 @@ func NotificationDuration(datasourceName string, labelMatchers ...*labels.Matche
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					AlertmanagerCommonPanelQueries["NotificationDuration_p99"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} - {{integration}} - 99th Percentile"),
-			),
+		promql.AddQueryFrom(
+			"AlertmanagerCommonPanelQueries", AlertmanagerCommonPanelQueries,
+			"NotificationDuration_p99",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} - {{integration}} - 99th Percentile"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					AlertmanagerCommonPanelQueries["NotificationDuration_p50"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} - {{integration}} - Median"),
-			),
+		promql.AddQueryFrom(
+			"AlertmanagerCommonPanelQueries", AlertmanagerCommonPanelQueries,
+			"NotificationDuration_p50",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} - {{integration}} - Median"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					AlertmanagerCommonPanelQueries["NotificationDuration_avg"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} - {{integration}} - Average"),
-			),
+		promql.AddQueryFrom(
+			"AlertmanagerCommonPanelQueries", AlertmanagerCommonPanelQueries,
+			"NotificationDuration_avg",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} - {{integration}} - Average"),
 		),
 	)
 }
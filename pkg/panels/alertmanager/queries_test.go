@@ -0,0 +1,40 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"testing"
+
+	"github.com/perses/community-mixins/pkg/querytest"
+)
+
+func TestAlertmanagerCommonPanelQueries(t *testing.T) {
+	querytest.Suite{
+		SeriesFile: "testdata/series.test",
+		Queries:    AlertmanagerCommonPanelQueries,
+		Variables: map[string]string{
+			"job":         "alertmanager",
+			"integration": ".*",
+		},
+		Labels: map[string][]string{
+			"Alerts": {`{instance="alertmanager-0:9093"}`, `{instance="alertmanager-1:9093"}`},
+			"NotificationsSendRate_failed": {
+				`{integration="pagerduty", instance="alertmanager-0:9093"}`,
+				`{integration="webhook", instance="alertmanager-0:9093"}`,
+				`{integration="pagerduty", instance="alertmanager-1:9093"}`,
+				`{integration="webhook", instance="alertmanager-1:9093"}`,
+			},
+		},
+	}.Run(t)
+}
@@ -68,15 +68,12 @@ func ProbeStatusMap(datasourceName string, labelMatchers ...*labels.Matcher) pan
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					BlackboxCommonPanelQueries["BlackboxProbeSucess"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}}"),
-			),
+		promql.AddQueryFrom(
+			"BlackboxCommonPanelQueries", BlackboxCommonPanelQueries,
+			"BlackboxProbeSucess",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}}"),
 		),
 	)
 }
@@ -113,14 +110,11 @@ func ProbeSuccessCount(datasourceName string, labelMatchers ...*labels.Matcher)
 				DefaultColor: "green",
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					BlackboxCommonPanelQueries["BlackboxProbeSucessCount"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"BlackboxCommonPanelQueries", BlackboxCommonPanelQueries,
+			"BlackboxProbeSucessCount",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
@@ -166,14 +160,11 @@ func ProbeSuccessPercent(datasourceName string, labelMatchers ...*labels.Matcher
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					BlackboxCommonPanelQueries["BlackboxProbeSucessPercent"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"BlackboxCommonPanelQueries", BlackboxCommonPanelQueries,
+			"BlackboxProbeSucessPercent",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
@@ -220,14 +211,11 @@ func ProbeHTTPSSL(datasourceName string, labelMatchers ...*labels.Matcher) panel
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					BlackboxCommonPanelQueries["BlackboxProbeHTTPSSL"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"BlackboxCommonPanelQueries", BlackboxCommonPanelQueries,
+			"BlackboxProbeHTTPSSL",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
@@ -263,14 +251,11 @@ func ProbeAverageDuration(datasourceName string, labelMatchers ...*labels.Matche
 				DefaultColor: "green",
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					BlackboxCommonPanelQueries["BlackboxAvgProbeDuration"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"BlackboxCommonPanelQueries", BlackboxCommonPanelQueries,
+			"BlackboxAvgProbeDuration",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
@@ -317,15 +302,12 @@ func ProbeUptimeSuccess(datasourceName string, labelMatchers ...*labels.Matcher)
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					BlackboxCommonPanelQueries["BlackboxProbeUptime"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}}"),
-			),
+		promql.AddQueryFrom(
+			"BlackboxCommonPanelQueries", BlackboxCommonPanelQueries,
+			"BlackboxProbeUptime",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}}"),
 		),
 	)
 }
@@ -371,15 +353,12 @@ func ProbeUptimeMonthly(datasourceName string, labelMatchers ...*labels.Matcher)
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					BlackboxCommonPanelQueries["BlackboxProbeUptimeMonthly"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}}"),
-			),
+		promql.AddQueryFrom(
+			"BlackboxCommonPanelQueries", BlackboxCommonPanelQueries,
+			"BlackboxProbeUptimeMonthly",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}}"),
 		),
 	)
 }
@@ -422,25 +401,19 @@ func ProbeDurationSeconds(datasourceName string, labelMatchers ...*labels.Matche
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					BlackboxCommonPanelQueries["BlackboxProbeHttpDuration"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("HTTP duration"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					BlackboxCommonPanelQueries["BlackboxAvgProbeDurationSeconds"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Total probe duration"),
-			),
+		promql.AddQueryFrom(
+			"BlackboxCommonPanelQueries", BlackboxCommonPanelQueries,
+			"BlackboxProbeHttpDuration",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("HTTP duration"),
+		),
+		promql.AddQueryFrom(
+			"BlackboxCommonPanelQueries", BlackboxCommonPanelQueries,
+			"BlackboxAvgProbeDurationSeconds",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Total probe duration"),
 		),
 	)
 }
@@ -482,25 +455,19 @@ func ProbePhases(datasourceName string, labelMatchers ...*labels.Matcher) panelg
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					BlackboxCommonPanelQueries["BlackboxProbeHttpPhases"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{phase}}"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					BlackboxCommonPanelQueries["BlackboxProbeIcmpPhases"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{phase}}"),
-			),
+		promql.AddQueryFrom(
+			"BlackboxCommonPanelQueries", BlackboxCommonPanelQueries,
+			"BlackboxProbeHttpPhases",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{phase}}"),
+		),
+		promql.AddQueryFrom(
+			"BlackboxCommonPanelQueries", BlackboxCommonPanelQueries,
+			"BlackboxProbeIcmpPhases",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{phase}}"),
 		),
 	)
 }
@@ -551,15 +518,12 @@ func ProbeStatusCode(datasourceName string, labelMatchers ...*labels.Matcher) pa
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					BlackboxCommonPanelQueries["BlackboxProbeStatusCode"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}}"),
-			),
+		promql.AddQueryFrom(
+			"BlackboxCommonPanelQueries", BlackboxCommonPanelQueries,
+			"BlackboxProbeStatusCode",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}}"),
 		),
 	)
 }
@@ -595,15 +559,12 @@ func ProbeTLSVersion(datasourceName string, labelMatchers ...*labels.Matcher) pa
 				DefaultColor: "green",
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					BlackboxCommonPanelQueries["BlackboxProbeTLSVersion"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{version}}"),
-			),
+		promql.AddQueryFrom(
+			"BlackboxCommonPanelQueries", BlackboxCommonPanelQueries,
+			"BlackboxProbeTLSVersion",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{version}}"),
 		),
 	)
 }
@@ -639,14 +600,11 @@ func ProbeSSLExpiry(datasourceName string, labelMatchers ...*labels.Matcher) pan
 				DefaultColor: "green",
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					BlackboxCommonPanelQueries["BlackboxProbeSSLExpiry"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"BlackboxCommonPanelQueries", BlackboxCommonPanelQueries,
+			"BlackboxProbeSSLExpiry",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
@@ -695,14 +653,11 @@ func ProbeRedirects(datasourceName string, labelMatchers ...*labels.Matcher) pan
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					BlackboxCommonPanelQueries["BlackboxProbeRedirects"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"BlackboxCommonPanelQueries", BlackboxCommonPanelQueries,
+			"BlackboxProbeRedirects",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
@@ -738,15 +693,12 @@ func ProbeHTTPVersion(datasourceName string, labelMatchers ...*labels.Matcher) p
 				DefaultColor: "blue",
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					BlackboxCommonPanelQueries["BlackboxProbeHTTPVersion"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{version}}"),
-			),
+		promql.AddQueryFrom(
+			"BlackboxCommonPanelQueries", BlackboxCommonPanelQueries,
+			"BlackboxProbeHTTPVersion",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{version}}"),
 		),
 	)
 }
@@ -782,14 +734,11 @@ func ProbeAverageDurationInstance(datasourceName string, labelMatchers ...*label
 				DefaultColor: "green",
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					BlackboxCommonPanelQueries["BlackboxProbeAverageDuration"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"BlackboxCommonPanelQueries", BlackboxCommonPanelQueries,
+			"BlackboxProbeAverageDuration",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
@@ -825,14 +774,11 @@ func ProbeAverageDNSLookupPerInstance(datasourceName string, labelMatchers ...*l
 				DefaultColor: "green",
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					BlackboxCommonPanelQueries["BlackboxProbeAverageDNSLookupPerInstance"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"BlackboxCommonPanelQueries", BlackboxCommonPanelQueries,
+			"BlackboxProbeAverageDNSLookupPerInstance",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
@@ -25,6 +25,23 @@ import (
 	"github.com/prometheus/prometheus/model/labels"
 )
 
+// DefaultJobSelector is the job regexp matching the etcd targets.
+// It is shared by the etcd dashboard and the etcd rules.
+const DefaultJobSelector = ".*etcd.*"
+
+// JobMatcher returns the job label matcher for the given etcd job regexp.
+// An empty jobSelector falls back to DefaultJobSelector.
+func JobMatcher(jobSelector string) *labels.Matcher {
+	if jobSelector == "" {
+		jobSelector = DefaultJobSelector
+	}
+	return &labels.Matcher{
+		Name:  "job",
+		Value: jobSelector,
+		Type:  labels.MatchRegexp,
+	}
+}
+
 func EtcdUpStatus(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Up",
 		panel.Description("Shows the status of etcd."),
@@ -35,15 +52,12 @@ func EtcdUpStatus(datasourceName string, labelMatchers ...*labels.Matcher) panel
 			}),
 			statPanel.ValueFontSize(50),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					EtcdCommonPanelQueries["EtcdUpStatus"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{cluster}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"EtcdCommonPanelQueries", EtcdCommonPanelQueries,
+			"EtcdUpStatus",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{cluster}} {{namespace}}"),
 		),
 	)
 }
@@ -71,25 +85,19 @@ func RPCRate(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					EtcdCommonPanelQueries["EtcdgRPCRateStarted"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("RPC Rate"),
-			),
+		promql.AddQueryFrom(
+			"EtcdCommonPanelQueries", EtcdCommonPanelQueries,
+			"EtcdgRPCRateStarted",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("RPC Rate"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					EtcdCommonPanelQueries["EtcdgRPCRateTotal"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("RPC failed Rate"),
-			),
+		promql.AddQueryFrom(
+			"EtcdCommonPanelQueries", EtcdCommonPanelQueries,
+			"EtcdgRPCRateTotal",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("RPC failed Rate"),
 		),
 	)
 }
@@ -117,25 +125,19 @@ func ActiveStreams(datasourceName string, labelMatchers ...*labels.Matcher) pane
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					EtcdCommonPanelQueries["EtcdActiveStreamsWatch"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Watch Streams"),
-			),
+		promql.AddQueryFrom(
+			"EtcdCommonPanelQueries", EtcdCommonPanelQueries,
+			"EtcdActiveStreamsWatch",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Watch Streams"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					EtcdCommonPanelQueries["EtcdActiveStreamsLease"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Lease Streams"),
-			),
+		promql.AddQueryFrom(
+			"EtcdCommonPanelQueries", EtcdCommonPanelQueries,
+			"EtcdActiveStreamsLease",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Lease Streams"),
 		),
 	)
 }
@@ -162,15 +164,12 @@ func DBSize(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					EtcdCommonPanelQueries["EtcdDBSize"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} DB Size"),
-			),
+		promql.AddQueryFrom(
+			"EtcdCommonPanelQueries", EtcdCommonPanelQueries,
+			"EtcdDBSize",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} DB Size"),
 		),
 	)
 }
@@ -197,25 +196,19 @@ func DiskSyncDuration(datasourceName string, labelMatchers ...*labels.Matcher) p
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					EtcdCommonPanelQueries["EtcdDiskSyncWalFsyncDuration"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} WAL fsync"),
-			),
+		promql.AddQueryFrom(
+			"EtcdCommonPanelQueries", EtcdCommonPanelQueries,
+			"EtcdDiskSyncWalFsyncDuration",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} WAL fsync"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					EtcdCommonPanelQueries["EtcdDiskSyncBackendDuration"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} DB fsync"),
-			),
+		promql.AddQueryFrom(
+			"EtcdCommonPanelQueries", EtcdCommonPanelQueries,
+			"EtcdDiskSyncBackendDuration",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} DB fsync"),
 		),
 	)
 }
@@ -242,15 +235,12 @@ func ClientTrafficIn(datasourceName string, labelMatchers ...*labels.Matcher) pa
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					EtcdCommonPanelQueries["EtcdClientTrafficIn"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} client traffic in"),
-			),
+		promql.AddQueryFrom(
+			"EtcdCommonPanelQueries", EtcdCommonPanelQueries,
+			"EtcdClientTrafficIn",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} client traffic in"),
 		),
 	)
 }
@@ -277,15 +267,12 @@ func ClientTrafficOut(datasourceName string, labelMatchers ...*labels.Matcher) p
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					EtcdCommonPanelQueries["EtcdClientTrafficOut"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} client traffic out"),
-			),
+		promql.AddQueryFrom(
+			"EtcdCommonPanelQueries", EtcdCommonPanelQueries,
+			"EtcdClientTrafficOut",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} client traffic out"),
 		),
 	)
 }
@@ -312,15 +299,12 @@ func PeerTrafficIn(datasourceName string, labelMatchers ...*labels.Matcher) pane
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					EtcdCommonPanelQueries["EtcdPeerTrafficIn"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} peer traffic in"),
-			),
+		promql.AddQueryFrom(
+			"EtcdCommonPanelQueries", EtcdCommonPanelQueries,
+			"EtcdPeerTrafficIn",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} peer traffic in"),
 		),
 	)
 }
@@ -347,15 +331,12 @@ func PeerTrafficOut(datasourceName string, labelMatchers ...*labels.Matcher) pan
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					EtcdCommonPanelQueries["EtcdPeerTrafficOut"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} peer traffic out"),
-			),
+		promql.AddQueryFrom(
+			"EtcdCommonPanelQueries", EtcdCommonPanelQueries,
+			"EtcdPeerTrafficOut",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} peer traffic out"),
 		),
 	)
 }
@@ -382,15 +363,12 @@ func RaftProposals(datasourceName string, labelMatchers ...*labels.Matcher) pane
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					EtcdCommonPanelQueries["EtcdRaftProposals"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} total leader elections per day"),
-			),
+		promql.AddQueryFrom(
+			"EtcdCommonPanelQueries", EtcdCommonPanelQueries,
+			"EtcdRaftProposals",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} total leader elections per day"),
 		),
 	)
 }
@@ -417,15 +395,12 @@ func PeerRoundtripTime(datasourceName string, labelMatchers ...*labels.Matcher)
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					EtcdCommonPanelQueries["EtcdPeerRoundtripTime"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} peer roundtrip time"),
-			),
+		promql.AddQueryFrom(
+			"EtcdCommonPanelQueries", EtcdCommonPanelQueries,
+			"EtcdPeerRoundtripTime",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} peer roundtrip time"),
 		),
 	)
 }
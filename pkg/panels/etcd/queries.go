@@ -29,7 +29,7 @@ var EtcdCommonPanelQueries = map[string]parser.Expr{
 		vector.New(
 			vector.WithMetricName("etcd_server_has_leader"),
 			vector.WithLabelMatchers(
-				label.New("job").EqualRegexp(".*etcd.*"),
+				label.New("job").EqualRegexp(DefaultJobSelector),
 				label.New("cluster").Equal("$cluster"),
 			),
 		),
@@ -40,7 +40,7 @@ var EtcdCommonPanelQueries = map[string]parser.Expr{
 				vector.New(
 					vector.WithMetricName("grpc_server_started_total"),
 					vector.WithLabelMatchers(
-						label.New("job").EqualRegexp(".*etcd.*"),
+						label.New("job").EqualRegexp(DefaultJobSelector),
 						label.New("cluster").Equal("$cluster"),
 						label.New("grpc_type").Equal("unary"),
 					),
@@ -51,7 +51,7 @@ var EtcdCommonPanelQueries = map[string]parser.Expr{
 	),
 	"EtcdgRPCRateTotal": promql.SumRate(
 		"grpc_server_handled_total",
-		label.New("job").EqualRegexp(".*etcd.*"),
+		label.New("job").EqualRegexp(DefaultJobSelector),
 		label.New("cluster").Equal("$cluster"),
 		label.New("grpc_type").Equal("unary"),
 		label.New("grpc_code").EqualRegexp("Unknown|FailedPrecondition|ResourceExhausted|Internal|Unavailable|DataLoss|DeadlineExceeded"),
@@ -61,7 +61,7 @@ var EtcdCommonPanelQueries = map[string]parser.Expr{
 			vector.New(
 				vector.WithMetricName("grpc_server_started_total"),
 				vector.WithLabelMatchers(
-					label.New("job").EqualRegexp(".*etcd.*"),
+					label.New("job").EqualRegexp(DefaultJobSelector),
 					label.New("cluster").Equal("$cluster"),
 					label.New("grpc_service").Equal("etcdserverpb.Watch"),
 					label.New("grpc_type").Equal("bidi_stream"),
@@ -84,7 +84,7 @@ var EtcdCommonPanelQueries = map[string]parser.Expr{
 			vector.New(
 				vector.WithMetricName("grpc_server_started_total"),
 				vector.WithLabelMatchers(
-					label.New("job").EqualRegexp(".*etcd.*"),
+					label.New("job").EqualRegexp(DefaultJobSelector),
 					label.New("cluster").Equal("$cluster"),
 					label.New("grpc_service").Equal("etcdserverpb.Lease"),
 					label.New("grpc_type").Equal("bidi_stream"),
@@ -105,7 +105,7 @@ var EtcdCommonPanelQueries = map[string]parser.Expr{
 	"EtcdDBSize": vector.New(
 		vector.WithMetricName("etcd_mvcc_db_total_size_in_bytes"),
 		vector.WithLabelMatchers(
-			label.New("job").EqualRegexp(".*etcd.*"),
+			label.New("job").EqualRegexp(DefaultJobSelector),
 			label.New("cluster").Equal("$cluster"),
 		),
 	),
@@ -113,7 +113,7 @@ var EtcdCommonPanelQueries = map[string]parser.Expr{
 		promql.SumByRate(
 			"etcd_disk_wal_fsync_duration_seconds_bucket",
 			[]string{"instance", "le"},
-			label.New("job").EqualRegexp(".*etcd.*"),
+			label.New("job").EqualRegexp(DefaultJobSelector),
 			label.New("cluster").Equal("$cluster"),
 		),
 	),
@@ -121,7 +121,7 @@ var EtcdCommonPanelQueries = map[string]parser.Expr{
 		promql.SumByRate(
 			"etcd_disk_backend_commit_duration_seconds_bucket",
 			[]string{"instance", "le"},
-			label.New("job").EqualRegexp(".*etcd.*"),
+			label.New("job").EqualRegexp(DefaultJobSelector),
 			label.New("cluster").Equal("$cluster"),
 		),
 	),
@@ -130,7 +130,7 @@ var EtcdCommonPanelQueries = map[string]parser.Expr{
 			vector.New(
 				vector.WithMetricName("etcd_network_client_grpc_received_bytes_total"),
 				vector.WithLabelMatchers(
-					label.New("job").EqualRegexp(".*etcd.*"),
+					label.New("job").EqualRegexp(DefaultJobSelector),
 					label.New("cluster").Equal("$cluster"),
 				),
 			),
@@ -142,7 +142,7 @@ var EtcdCommonPanelQueries = map[string]parser.Expr{
 			vector.New(
 				vector.WithMetricName("etcd_network_client_grpc_sent_bytes_total"),
 				vector.WithLabelMatchers(
-					label.New("job").EqualRegexp(".*etcd.*"),
+					label.New("job").EqualRegexp(DefaultJobSelector),
 					label.New("cluster").Equal("$cluster"),
 				),
 			),
@@ -152,13 +152,13 @@ var EtcdCommonPanelQueries = map[string]parser.Expr{
 	"EtcdPeerTrafficIn": promql.SumByRate(
 		"etcd_network_peer_received_bytes_total",
 		[]string{"instance"},
-		label.New("job").EqualRegexp(".*etcd.*"),
+		label.New("job").EqualRegexp(DefaultJobSelector),
 		label.New("cluster").Equal("$cluster"),
 	),
 	"EtcdPeerTrafficOut": promql.SumByRate(
 		"etcd_network_peer_sent_bytes_total",
 		[]string{"instance"},
-		label.New("job").EqualRegexp(".*etcd.*"),
+		label.New("job").EqualRegexp(DefaultJobSelector),
 		label.New("cluster").Equal("$cluster"),
 	),
 	"EtcdRaftProposals": promqlbuilder.Changes(
@@ -166,7 +166,7 @@ var EtcdCommonPanelQueries = map[string]parser.Expr{
 			vector.New(
 				vector.WithMetricName("etcd_server_leader_changes_seen_total"),
 				vector.WithLabelMatchers(
-					label.New("job").EqualRegexp(".*etcd.*"),
+					label.New("job").EqualRegexp(DefaultJobSelector),
 					label.New("cluster").Equal("$cluster"),
 				),
 			),
@@ -177,7 +177,7 @@ var EtcdCommonPanelQueries = map[string]parser.Expr{
 		promql.SumByRate(
 			"etcd_network_peer_round_trip_time_seconds_bucket",
 			[]string{"instance", "le"},
-			label.New("job").EqualRegexp(".*etcd.*"),
+			label.New("job").EqualRegexp(DefaultJobSelector),
 			label.New("cluster").Equal("$cluster"),
 		),
 	),
@@ -0,0 +1,44 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcd
+
+import (
+	"testing"
+
+	"github.com/perses/community-mixins/pkg/querytest"
+)
+
+func TestEtcdCommonPanelQueries(t *testing.T) {
+	querytest.Suite{
+		SeriesFile: "testdata/series.test",
+		Queries:    EtcdCommonPanelQueries,
+		Variables:  map[string]string{"cluster": "cluster-a"},
+		Labels: map[string][]string{
+			"EtcdUpStatus": {`{}`},
+			"EtcdDBSize": {
+				`{__name__="etcd_mvcc_db_total_size_in_bytes", cluster="cluster-a", job="etcd", instance="etcd-0:2379"}`,
+				`{__name__="etcd_mvcc_db_total_size_in_bytes", cluster="cluster-a", job="etcd", instance="etcd-1:2379"}`,
+				`{__name__="etcd_mvcc_db_total_size_in_bytes", cluster="cluster-a", job="etcd", instance="etcd-2:2379"}`,
+			},
+			"EtcdRaftProposals": {
+				`{cluster="cluster-a", job="etcd", instance="etcd-0:2379"}`,
+				`{cluster="cluster-a", job="etcd", instance="etcd-1:2379"}`,
+				`{cluster="cluster-a", job="etcd", instance="etcd-2:2379"}`,
+			},
+			"EtcdPeerTrafficIn":            {`{instance="etcd-0:2379"}`, `{instance="etcd-1:2379"}`},
+			"EtcdPeerTrafficOut":           {`{instance="etcd-0:2379"}`, `{instance="etcd-1:2379"}`},
+			"EtcdDiskSyncWalFsyncDuration": {`{instance="etcd-0:2379"}`},
+		},
+	}.Run(t)
+}
@@ -48,75 +48,54 @@ func MemoryUsage(datasourceName string, seriesNameToUse string, labelMatchers ..
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					GoCommonPanelQueries["MemoryUsage_allocAll"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Alloc All {{"+seriesNameToUse+"}}"),
-			),
+		promql.AddQueryFrom(
+			"GoCommonPanelQueries", GoCommonPanelQueries,
+			"MemoryUsage_allocAll",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Alloc All {{"+seriesNameToUse+"}}"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					GoCommonPanelQueries["MemoryUsage_allocHeap"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Alloc Heap {{"+seriesNameToUse+"}}"),
-			),
+		promql.AddQueryFrom(
+			"GoCommonPanelQueries", GoCommonPanelQueries,
+			"MemoryUsage_allocHeap",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Alloc Heap {{"+seriesNameToUse+"}}"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					GoCommonPanelQueries["MemoryUsage_allocRateAll"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Alloc Rate All {{"+seriesNameToUse+"}}"),
-			),
+		promql.AddQueryFrom(
+			"GoCommonPanelQueries", GoCommonPanelQueries,
+			"MemoryUsage_allocRateAll",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Alloc Rate All {{"+seriesNameToUse+"}}"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					GoCommonPanelQueries["MemoryUsage_allocRateHeap"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Alloc Rate Heap {{"+seriesNameToUse+"}}"),
-			),
+		promql.AddQueryFrom(
+			"GoCommonPanelQueries", GoCommonPanelQueries,
+			"MemoryUsage_allocRateHeap",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Alloc Rate Heap {{"+seriesNameToUse+"}}"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					GoCommonPanelQueries["MemoryUsage_inuseStack"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Inuse Stack {{"+seriesNameToUse+"}}"),
-			),
+		promql.AddQueryFrom(
+			"GoCommonPanelQueries", GoCommonPanelQueries,
+			"MemoryUsage_inuseStack",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Inuse Stack {{"+seriesNameToUse+"}}"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					GoCommonPanelQueries["MemoryUsage_inuseHeap"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Inuse Heap {{"+seriesNameToUse+"}}"),
-			),
+		promql.AddQueryFrom(
+			"GoCommonPanelQueries", GoCommonPanelQueries,
+			"MemoryUsage_inuseHeap",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Inuse Heap {{"+seriesNameToUse+"}}"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					GoCommonPanelQueries["MemoryUsage_processResident"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Resident Memory {{"+seriesNameToUse+"}}"),
-			),
+		promql.AddQueryFrom(
+			"GoCommonPanelQueries", GoCommonPanelQueries,
+			"MemoryUsage_processResident",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Resident Memory {{"+seriesNameToUse+"}}"),
 		),
 	)
 }
@@ -143,15 +122,12 @@ func Goroutines(datasourceName string, seriesNameToUse string, labelMatchers ...
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					GoCommonPanelQueries["Goroutines"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{"+seriesNameToUse+"}}"),
-			),
+		promql.AddQueryFrom(
+			"GoCommonPanelQueries", GoCommonPanelQueries,
+			"Goroutines",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{"+seriesNameToUse+"}}"),
 		),
 	)
 }
@@ -178,15 +154,12 @@ func GarbageCollectionPauseTimeQuantiles(datasourceName string, seriesNameToUse
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					GoCommonPanelQueries["GarbageCollectionPauseTimeQuantiles"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{quantile}} - {{"+seriesNameToUse+"}}"),
-			),
+		promql.AddQueryFrom(
+			"GoCommonPanelQueries", GoCommonPanelQueries,
+			"GarbageCollectionPauseTimeQuantiles",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{quantile}} - {{"+seriesNameToUse+"}}"),
 		),
 	)
 }
@@ -213,15 +186,12 @@ func CPUUsage(datasourceName string, seriesNameToUse string, labelMatchers ...*l
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					GoCommonPanelQueries["CPUUsage"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{"+seriesNameToUse+"}}"),
-			),
+		promql.AddQueryFrom(
+			"GoCommonPanelQueries", GoCommonPanelQueries,
+			"CPUUsage",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{"+seriesNameToUse+"}}"),
 		),
 	)
 }
@@ -22,9 +22,10 @@ import (
 	"github.com/perses/plugins/prometheus/sdk/go/query"
 	timeSeriesPanel "github.com/perses/plugins/timeserieschart/sdk/go"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func PushSize(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func PushSize(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Push Size",
 		panel.Description("Size of each xDS push."),
 		timeSeriesPanel.Chart(
@@ -45,20 +46,17 @@ func PushSize(datasourceName string, labelMatchers ...*labels.Matcher) panelgrou
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioPushSize"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{le}}"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioPushSize",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{le}}"),
 		),
 	)
 }
 
-func PushTime(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func PushTime(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Push Time",
 		panel.Description("Count of active and pending proxies managed by each instance.\nPending is expected to converge to zero."),
 		timeSeriesPanel.Chart(
@@ -79,20 +77,17 @@ func PushTime(datasourceName string, labelMatchers ...*labels.Matcher) panelgrou
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioPushTime"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{le}}"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioPushTime",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{le}}"),
 		),
 	)
 }
 
-func Connections(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func Connections(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Connections",
 		panel.Description("Total number of XDS connections."),
 		timeSeriesPanel.Chart(
@@ -114,30 +109,24 @@ func Connections(datasourceName string, labelMatchers ...*labels.Matcher) panelg
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioConnectionsClientReported"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Connections (client reported)"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioConnections"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Connections ( reported)"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioConnectionsClientReported",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Connections (client reported)"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioConnections",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Connections ( reported)"),
 		),
 	)
 }
 
-func CPUUsage(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func CPUUsage(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("CPU Usage",
 		panel.Description("CPU usage of each running instance"),
 		timeSeriesPanel.Chart(
@@ -153,20 +142,17 @@ func CPUUsage(datasourceName string, labelMatchers ...*labels.Matcher) panelgrou
 				AreaOpacity:  0.1,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioCPUUsage"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Container ({{pod}})"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioCPUUsage",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Container ({{pod}})"),
 		),
 	)
 }
 
-func Events(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func Events(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Events",
 		panel.Description("Events from Kubernetes API server."),
 		timeSeriesPanel.Chart(
@@ -189,40 +175,31 @@ func Events(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioEventsReg"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{type}} {{event}}"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioEventsCfg"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{type}} {{event}}"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioEventsPilot"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{type}}"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioEventsReg",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{type}} {{event}}"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioEventsCfg",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{type}} {{event}}"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioEventsPilot",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{type}}"),
 		),
 	)
 }
 
-func Goroutines(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func Goroutines(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Goroutines",
 		panel.Description("Goroutine count for each running instance"),
 		timeSeriesPanel.Chart(
@@ -238,20 +215,17 @@ func Goroutines(datasourceName string, labelMatchers ...*labels.Matcher) panelgr
 				AreaOpacity:  0.1,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioGoroutines"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Goroutines ({{pod}})"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioGoroutines",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Goroutines ({{pod}})"),
 		),
 	)
 }
 
-func MemoryAllocations(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func MemoryAllocations(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Memory Allocations",
 		panel.Description("Details about memory allocations"),
 		timeSeriesPanel.Chart(
@@ -272,30 +246,24 @@ func MemoryAllocations(datasourceName string, labelMatchers ...*labels.Matcher)
 				AreaOpacity:  0.1,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioMemoryAllocationsBytesTotal"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Bytes ({{pod}})"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioMemoryAllocationsMallocsTotal"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Objects ({{pod}})"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioMemoryAllocationsBytesTotal",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Bytes ({{pod}})"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioMemoryAllocationsMallocsTotal",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Objects ({{pod}})"),
 		),
 	)
 }
 
-func MemoryUsage(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func MemoryUsage(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Memory Usage",
 		panel.Description("Memory usage of each running instance"),
 		timeSeriesPanel.Chart(
@@ -316,50 +284,38 @@ func MemoryUsage(datasourceName string, labelMatchers ...*labels.Matcher) panelg
 				AreaOpacity:  0.1,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioMemoryUsageWorkingSetBytes"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Container ({{pod}})"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioMemoryUsageInuseBytes"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Stack ({{pod}})"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioMemoryUsageHeapInuseBytes"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Heap (In Use) ({{pod}})"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioMemoryUsageHeapAllocBytes"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Heap (Allocated) ({{pod}})"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioMemoryUsageWorkingSetBytes",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Container ({{pod}})"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioMemoryUsageInuseBytes",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Stack ({{pod}})"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioMemoryUsageHeapInuseBytes",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Heap (In Use) ({{pod}})"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioMemoryUsageHeapAllocBytes",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Heap (Allocated) ({{pod}})"),
 		),
 	)
 }
 
-func PilotVersions(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func PilotVersions(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Pilot Versions",
 		panel.Description("Version number of each running instance."),
 		timeSeriesPanel.Chart(
@@ -381,20 +337,17 @@ func PilotVersions(datasourceName string, labelMatchers ...*labels.Matcher) pane
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioPilotVersions"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Version ({{tag}})"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioPilotVersions",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Version ({{tag}})"),
 		),
 	)
 }
 
-func PushErrors(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func PushErrors(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Push Errors",
 		panel.Description("Errors pushing to Envoy."),
 		timeSeriesPanel.Chart(
@@ -417,30 +370,24 @@ func PushErrors(datasourceName string, labelMatchers ...*labels.Matcher) panelgr
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioPushErrorsRejects"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Rejected: {{type}}"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioPushErrors"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Internal Error"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioPushErrorsRejects",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Rejected: {{type}}"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioPushErrors",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Internal Error"),
 		),
 	)
 }
 
-func Injection(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func Injection(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Injection",
 		panel.Description("Webhook injection success/failure rate."),
 		timeSeriesPanel.Chart(
@@ -463,30 +410,24 @@ func Injection(datasourceName string, labelMatchers ...*labels.Matcher) panelgro
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioInjectionSucessTotal"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Success"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioInjectionFailureTotal"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Failure"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioInjectionSucessTotal",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Success"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioInjectionFailureTotal",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Failure"),
 		),
 	)
 }
 
-func Validation(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func Validation(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Validation",
 		panel.Description("Webhook validation success/failure rate."),
 		timeSeriesPanel.Chart(
@@ -509,30 +450,24 @@ func Validation(datasourceName string, labelMatchers ...*labels.Matcher) panelgr
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioValidationPassed"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Success"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioValidationFailed"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Failure"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioValidationPassed",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Success"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioValidationFailed",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Failure"),
 		),
 	)
 }
 
-func XDSPushes(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func XDSPushes(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("XDS Pushes",
 		panel.Description("Rate of XDS pushes by type."),
 		timeSeriesPanel.Chart(
@@ -555,15 +490,12 @@ func XDSPushes(datasourceName string, labelMatchers ...*labels.Matcher) panelgro
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioXDSPushes"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{type}}"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioXDSPushes",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{type}}"),
 		),
 	)
 }
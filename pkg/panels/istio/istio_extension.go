@@ -17,14 +17,14 @@ import (
 	"github.com/perses/community-mixins/pkg/dashboards"
 	"github.com/perses/community-mixins/pkg/promql"
 	commonSdk "github.com/perses/perses/go-sdk/common"
-	"github.com/perses/perses/go-sdk/panel"
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
 	"github.com/perses/plugins/prometheus/sdk/go/query"
 	timeSeriesPanel "github.com/perses/plugins/timeserieschart/sdk/go"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func WasmVMActive(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func WasmVMActive(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Active",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
@@ -38,30 +38,24 @@ func WasmVMActive(datasourceName string, labelMatchers ...*labels.Matcher) panel
 				AreaOpacity:  0.1,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["WasmRuntimeNullActive"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("native"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"WasmRuntimeNullActive",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("native"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["WasmRuntimeV8Active"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("v8"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"WasmRuntimeV8Active",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("v8"),
 		),
 	)
 }
 
-func WasmVMCreated(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func WasmVMCreated(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Created",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
@@ -75,30 +69,24 @@ func WasmVMCreated(datasourceName string, labelMatchers ...*labels.Matcher) pane
 				AreaOpacity:  0.1,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["WasmRuntimeNullCreated"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("native"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"WasmRuntimeNullCreated",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("native"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["WasmRuntimeV8Created"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("v8"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"WasmRuntimeV8Created",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("v8"),
 		),
 	)
 }
 
-func WasmRemoteLoadCacheEntry(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func WasmRemoteLoadCacheEntry(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Cache Entry",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
@@ -112,20 +100,17 @@ func WasmRemoteLoadCacheEntry(datasourceName string, labelMatchers ...*labels.Ma
 				AreaOpacity:  0.1,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["WasmRemoteLoadCacheEntries"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("entries"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"WasmRemoteLoadCacheEntries",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("entries"),
 		),
 	)
 }
 
-func WasmRemoteLoadCacheVisit(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func WasmRemoteLoadCacheVisit(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Cache Visit",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
@@ -139,40 +124,31 @@ func WasmRemoteLoadCacheVisit(datasourceName string, labelMatchers ...*labels.Ma
 				AreaOpacity:  0.1,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["WasmRemoteLoadCacheHits"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("hits"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"WasmRemoteLoadCacheHits",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("hits"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["WasmRemoteLoadCacheMisses"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("misses"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"WasmRemoteLoadCacheMisses",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("misses"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["WasmRemoteLoadCacheNegativeHits"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("negative hits"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"WasmRemoteLoadCacheNegativeHits",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("negative hits"),
 		),
 	)
 }
 
-func WasmRemoteLoadFetch(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func WasmRemoteLoadFetch(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Remote Fetch",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
@@ -186,30 +162,24 @@ func WasmRemoteLoadFetch(datasourceName string, labelMatchers ...*labels.Matcher
 				AreaOpacity:  0.1,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["WasmRemoteLoadFetchFailures"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("failures"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"WasmRemoteLoadFetchFailures",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("failures"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["WasmRemoteLoadFetchSuccesses"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("successes"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"WasmRemoteLoadFetchSuccesses",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("successes"),
 		),
 	)
 }
 
-func WasmProxyMemory(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func WasmProxyMemory(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Memory",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
@@ -228,20 +198,17 @@ func WasmProxyMemory(datasourceName string, labelMatchers ...*labels.Matcher) pa
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioProxyMemory"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Total (k8s)"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioProxyMemory",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Total (k8s)"),
 		),
 	)
 }
 
-func WasmProxyVCPU(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func WasmProxyVCPU(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("vCPU",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
@@ -260,15 +227,12 @@ func WasmProxyVCPU(datasourceName string, labelMatchers ...*labels.Matcher) pane
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioProxyVCPU"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Total (k8s)"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioProxyVCPU",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Total (k8s)"),
 		),
 	)
 }
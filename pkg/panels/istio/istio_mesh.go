@@ -24,9 +24,10 @@ import (
 	tablePanel "github.com/perses/plugins/table/sdk/go"
 	timeSeriesPanel "github.com/perses/plugins/timeserieschart/sdk/go"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func HTTPGRPCWorkloads(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func HTTPGRPCWorkloads(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("HTTP/gRPC Workloads",
 		panel.Description("Request information for HTTP services"),
 		tablePanel.Table(
@@ -79,60 +80,45 @@ func HTTPGRPCWorkloads(datasourceName string, labelMatchers ...*labels.Matcher)
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioHTTPGRPCWorkloads"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload}}.{{ destination_workload_namespace }}"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioHTTPGRPCWorkloads",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload}}.{{ destination_workload_namespace }}"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioHTTPGRPCWorkloads50"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload}}.{{ destination_workload_namespace }}"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioHTTPGRPCWorkloads50",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload}}.{{ destination_workload_namespace }}"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioHTTPGRPCWorkloads90"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload}}.{{ destination_workload_namespace }}"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioHTTPGRPCWorkloads90",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload}}.{{ destination_workload_namespace }}"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioHTTPGRPCWorkloads99"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload}}.{{ destination_workload_namespace }}"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioHTTPGRPCWorkloads99",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload}}.{{ destination_workload_namespace }}"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioHTTPGRPCWorkloadsReqTotal"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload}}.{{ destination_workload_namespace }}"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioHTTPGRPCWorkloadsReqTotal",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload}}.{{ destination_workload_namespace }}"),
 		),
 	)
 }
 
-func TCPServices(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func TCPServices(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("TCP Workloads",
 		panel.Description("Bytes sent and recieived information for TCP services"),
 		tablePanel.Table(
@@ -173,30 +159,24 @@ func TCPServices(datasourceName string, labelMatchers ...*labels.Matcher) panelg
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioTCPServicesBytesRecv"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload}}.{{ destination_workload_namespace }}"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioTCPServicesBytesRecv",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload}}.{{ destination_workload_namespace }}"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioTCPServicesBytesSent"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload}}.{{ destination_workload_namespace }}"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioTCPServicesBytesSent",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload}}.{{ destination_workload_namespace }}"),
 		),
 	)
 }
 
-func GlobalRequestVolume(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func GlobalRequestVolume(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Traffic Volume",
 		panel.Description("Total requests in the cluster"),
 		statPanel.Chart(
@@ -216,19 +196,16 @@ func GlobalRequestVolume(datasourceName string, labelMatchers ...*labels.Matcher
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioGlobalRequestVolume"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioGlobalRequestVolume",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
 
-func GlobalSuccessRate(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func GlobalSuccessRate(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Success Rate",
 		panel.Description("Total success rate of requests in the cluster"),
 		statPanel.Chart(
@@ -248,19 +225,16 @@ func GlobalSuccessRate(datasourceName string, labelMatchers ...*labels.Matcher)
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstionGlobalSuccessRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstionGlobalSuccessRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
 
-func Global4xxRate(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func Global4xxRate(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("4xxs",
 		panel.Description("Total 4xx requests in in the cluster"),
 		statPanel.Chart(
@@ -280,19 +254,16 @@ func Global4xxRate(datasourceName string, labelMatchers ...*labels.Matcher) pane
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioGlobal4xxRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioGlobal4xxRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
 
-func Global5xxRate(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func Global5xxRate(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("5xxs",
 		panel.Description("Total 5xx requests in in the cluster"),
 		statPanel.Chart(
@@ -312,19 +283,16 @@ func Global5xxRate(datasourceName string, labelMatchers ...*labels.Matcher) pane
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioGlobal5xxRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioGlobal5xxRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
 
-func IstioComponentVersions(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func IstioComponentVersions(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Istio Component Versions",
 		panel.Description("Version number of each running instance"),
 		timeSeriesPanel.Chart(
@@ -339,15 +307,12 @@ func IstioComponentVersions(datasourceName string, labelMatchers ...*labels.Matc
 				AreaOpacity:  0.1,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioComponentVersions"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{component}} ({{tag}})"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioComponentVersions",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{component}} ({{tag}})"),
 		),
 	)
 }
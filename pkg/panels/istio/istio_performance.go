@@ -17,12 +17,12 @@ import (
 	"github.com/perses/community-mixins/pkg/dashboards"
 	"github.com/perses/community-mixins/pkg/promql"
 	commonSdk "github.com/perses/perses/go-sdk/common"
-	"github.com/perses/perses/go-sdk/panel"
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
 	markdownPanel "github.com/perses/plugins/markdown/sdk/go"
 	"github.com/perses/plugins/prometheus/sdk/go/query"
 	timeSeriesPanel "github.com/perses/plugins/timeserieschart/sdk/go"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
 func PerformanceDashboardReadme(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
@@ -40,7 +40,7 @@ func PerformanceDashboardReadme(datasourceName string, labelMatchers ...*labels.
 	)
 }
 
-func VCPUPer1kRPS(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func VCPUPer1kRPS(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("vCPU / 1k rps",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
@@ -59,30 +59,24 @@ func VCPUPer1kRPS(datasourceName string, labelMatchers ...*labels.Matcher) panel
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioVCPUPer1kRPSIngressGateway"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("istio-ingressgateway"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioVCPUPer1kRPSProxy"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("istio-proxy"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioVCPUPer1kRPSIngressGateway",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("istio-ingressgateway"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioVCPUPer1kRPSProxy",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("istio-proxy"),
 		),
 	)
 }
 
-func VCPU(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func VCPU(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("vCPU",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
@@ -101,30 +95,24 @@ func VCPU(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Op
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioVCPUIngressGateway"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("istio-ingressgateway"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioVCPUProxy"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("istio-proxy"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioVCPUIngressGateway",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("istio-ingressgateway"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioVCPUProxy",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("istio-proxy"),
 		),
 	)
 }
 
-func PerformanceMemoryUsage(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func PerformanceMemoryUsage(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Memory Usage",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
@@ -143,30 +131,24 @@ func PerformanceMemoryUsage(datasourceName string, labelMatchers ...*labels.Matc
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioPerformanceMemoryUsageIngressGateway"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("per istio-ingressgateway"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioPerformanceMemoryUsageProxy"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("per istio proxy"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioPerformanceMemoryUsageIngressGateway",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("per istio-ingressgateway"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioPerformanceMemoryUsageProxy",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("per istio proxy"),
 		),
 	)
 }
 
-func BytesTransferred(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func BytesTransferred(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Bytes transferred / sec",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
@@ -185,30 +167,24 @@ func BytesTransferred(datasourceName string, labelMatchers ...*labels.Matcher) p
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioBytesTransferredIngressGateway"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("istio-ingressgateway"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioBytesTransferredProxy"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("istio-proxy"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioBytesTransferredIngressGateway",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("istio-ingressgateway"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioBytesTransferredProxy",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("istio-proxy"),
 		),
 	)
 }
 
-func IstioComponentsByVersion(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func IstioComponentsByVersion(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Istio Components by Version",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
@@ -227,20 +203,17 @@ func IstioComponentsByVersion(datasourceName string, labelMatchers ...*labels.Ma
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioComponentsByVersion"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ component }}: {{ tag }}"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioComponentsByVersion",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ component }}: {{ tag }}"),
 		),
 	)
 }
 
-func ProxyMemory(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ProxyMemory(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Memory",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
@@ -259,20 +232,17 @@ func ProxyMemory(datasourceName string, labelMatchers ...*labels.Matcher) panelg
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioProxyMemory"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Total (k8s)"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioProxyMemory",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Total (k8s)"),
 		),
 	)
 }
 
-func ProxyVCPU(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ProxyVCPU(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("vCPU",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
@@ -291,20 +261,17 @@ func ProxyVCPU(datasourceName string, labelMatchers ...*labels.Matcher) panelgro
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioProxyVCPU"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Total (k8s)"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioProxyVCPU",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Total (k8s)"),
 		),
 	)
 }
 
-func ProxyDisk(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ProxyDisk(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Disk",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
@@ -323,20 +290,17 @@ func ProxyDisk(datasourceName string, labelMatchers ...*labels.Matcher) panelgro
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioProxyDisk"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Total (k8s)"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioProxyDisk",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Total (k8s)"),
 		),
 	)
 }
 
-func IstiodMemory(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func IstiodMemory(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Memory",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
@@ -355,100 +319,73 @@ func IstiodMemory(datasourceName string, labelMatchers ...*labels.Matcher) panel
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstiodMemoryVirtual"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Virtual Memory"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstiodMemoryResident"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Resident Memory"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstiodMemoryGoMemStats"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("heap sys"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstiodMemoryHeapAllocBytes"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("heap alloc"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstiodMemoryAllocBytes"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Alloc"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstiodMemoryInuseBytes"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Heap in-use"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstiodMemoryStackInuseBytes"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Stack in-use"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstiodMemoryContainerTotal"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Total (k8s)"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstiodMemoryContainer"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ container }} (k8s)"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstiodMemoryVirtual",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Virtual Memory"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstiodMemoryResident",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Resident Memory"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstiodMemoryGoMemStats",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("heap sys"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstiodMemoryHeapAllocBytes",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("heap alloc"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstiodMemoryAllocBytes",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Alloc"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstiodMemoryInuseBytes",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Heap in-use"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstiodMemoryStackInuseBytes",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Stack in-use"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstiodMemoryContainerTotal",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Total (k8s)"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstiodMemoryContainer",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ container }} (k8s)"),
 		),
 	)
 }
 
-func IstiodVCPU(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func IstiodVCPU(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("vCPU",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
@@ -467,40 +404,31 @@ func IstiodVCPU(datasourceName string, labelMatchers ...*labels.Matcher) panelgr
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstiodVCPUTotal"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Total (k8s)"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstiodVCPUContainer"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ container }} (k8s)"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstiodVCPUPilot"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("pilot (self-reported)"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstiodVCPUTotal",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Total (k8s)"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstiodVCPUContainer",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ container }} (k8s)"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstiodVCPUPilot",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("pilot (self-reported)"),
 		),
 	)
 }
 
-func IstiodDisk(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func IstiodDisk(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Disk",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
@@ -519,30 +447,24 @@ func IstiodDisk(datasourceName string, labelMatchers ...*labels.Matcher) panelgr
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstiodDiskOpenfds"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Open FDs (pilot)"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstiodDiskContainerFSUsage"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ container }}"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstiodDiskOpenfds",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Open FDs (pilot)"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstiodDiskContainerFSUsage",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ container }}"),
 		),
 	)
 }
 
-func IstiodGoroutines(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func IstiodGoroutines(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Goroutines",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithVisual(timeSeriesPanel.Visual{
@@ -557,15 +479,12 @@ func IstiodGoroutines(datasourceName string, labelMatchers ...*labels.Matcher) p
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstiodGoroutines"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Number of Goroutines"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstiodGoroutines",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Number of Goroutines"),
 		),
 	)
 }
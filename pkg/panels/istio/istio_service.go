@@ -23,9 +23,10 @@ import (
 	statPanel "github.com/perses/plugins/statchart/sdk/go"
 	timeSeriesPanel "github.com/perses/plugins/timeserieschart/sdk/go"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func ClientRequestVolume(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ClientRequestVolume(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Client Request Volume",
 		panel.Description("Request volume from client workloads to service"),
 		timeSeriesPanel.Chart(
@@ -46,20 +47,17 @@ func ClientRequestVolume(datasourceName string, labelMatchers ...*labels.Matcher
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ClientRequestVolume"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ source_workload }}.{{ source_workload_namespace }}"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ClientRequestVolume",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ source_workload }}.{{ source_workload_namespace }}"),
 		),
 	)
 }
 
-func ClientSuccessRate(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ClientSuccessRate(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Client Success Rate",
 		panel.Description("Success rate of requests from client workloads to service"),
 		timeSeriesPanel.Chart(
@@ -82,20 +80,17 @@ func ClientSuccessRate(datasourceName string, labelMatchers ...*labels.Matcher)
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ClientSuccessRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ source_workload }}.{{ source_workload_namespace }}"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ClientSuccessRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ source_workload }}.{{ source_workload_namespace }}"),
 		),
 	)
 }
 
-func ClientRequestDuration(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ClientRequestDuration(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Client Request Duration",
 		panel.Description("Request duration percentiles from client workloads to service"),
 		timeSeriesPanel.Chart(
@@ -116,40 +111,31 @@ func ClientRequestDuration(datasourceName string, labelMatchers ...*labels.Match
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ClientRequestDuration50"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("P50 {{ source_workload }}.{{ source_workload_namespace }}"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ClientRequestDuration90"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("P90 {{ source_workload }}.{{ source_workload_namespace }}"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ClientRequestDuration99"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("P99 {{ source_workload }}.{{ source_workload_namespace }}"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ClientRequestDuration50",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("P50 {{ source_workload }}.{{ source_workload_namespace }}"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ClientRequestDuration90",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("P90 {{ source_workload }}.{{ source_workload_namespace }}"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ClientRequestDuration99",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("P99 {{ source_workload }}.{{ source_workload_namespace }}"),
 		),
 	)
 }
 
-func ServerRequestVolume(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ServerRequestVolume(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Server Request Volume",
 		panel.Description("Request volume to service workloads"),
 		timeSeriesPanel.Chart(
@@ -170,20 +156,17 @@ func ServerRequestVolume(datasourceName string, labelMatchers ...*labels.Matcher
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ServerRequestVolume"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }}"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ServerRequestVolume",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }}"),
 		),
 	)
 }
 
-func ServiceTCPBytesReceived(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ServiceTCPBytesReceived(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("TCP Bytes Received",
 		panel.Description("TCP bytes received by service workloads"),
 		timeSeriesPanel.Chart(
@@ -204,20 +187,17 @@ func ServiceTCPBytesReceived(datasourceName string, labelMatchers ...*labels.Mat
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ServiceTCPBytesReceived"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }}"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ServiceTCPBytesReceived",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }}"),
 		),
 	)
 }
 
-func ServiceTCPBytesSent(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ServiceTCPBytesSent(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("TCP Bytes Sent",
 		panel.Description("TCP bytes sent from service workloads"),
 		timeSeriesPanel.Chart(
@@ -238,22 +218,19 @@ func ServiceTCPBytesSent(datasourceName string, labelMatchers ...*labels.Matcher
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ServiceTCPBytesSent"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }}"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ServiceTCPBytesSent",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }}"),
 		),
 	)
 }
 
 // ========== STAT PANELS (for General section) ==========
 
-func ClientRequestVolumeStat(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ClientRequestVolumeStat(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Client Request Volume",
 		statPanel.Chart(
 			statPanel.Calculation(commonSdk.LastCalculation),
@@ -269,19 +246,16 @@ func ClientRequestVolumeStat(datasourceName string, labelMatchers ...*labels.Mat
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ClientRequestVolumeStat"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ClientRequestVolumeStat",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
 
-func ClientSuccessRateStat(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ClientSuccessRateStat(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Client Success Rate (non-5xx responses)",
 		statPanel.Chart(
 			statPanel.Calculation(commonSdk.LastCalculation),
@@ -299,19 +273,16 @@ func ClientSuccessRateStat(datasourceName string, labelMatchers ...*labels.Match
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ClientSuccessRateStat"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ClientSuccessRateStat",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
 
-func ClientRequestDurationChart(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ClientRequestDurationChart(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Client Request Duration",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{
@@ -322,40 +293,31 @@ func ClientRequestDurationChart(datasourceName string, labelMatchers ...*labels.
 				Mode:     timeSeriesPanel.ListMode,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ClientRequestDurationChart50"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("P50"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ClientRequestDurationChart90"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("P90"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ClientRequestDurationChart99"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("P99"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ClientRequestDurationChart50",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("P50"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ClientRequestDurationChart90",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("P90"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ClientRequestDurationChart99",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("P99"),
 		),
 	)
 }
 
-func TCPReceivedBytesStat(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func TCPReceivedBytesStat(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("TCP Received Bytes",
 		statPanel.Chart(
 			statPanel.Calculation(commonSdk.MeanCalculation),
@@ -372,19 +334,16 @@ func TCPReceivedBytesStat(datasourceName string, labelMatchers ...*labels.Matche
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["TCPReceivedBytesStat"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"TCPReceivedBytesStat",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
 
-func ServerRequestVolumeStat(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ServerRequestVolumeStat(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Server Request Volume",
 		statPanel.Chart(
 			statPanel.Calculation(commonSdk.LastCalculation),
@@ -400,19 +359,16 @@ func ServerRequestVolumeStat(datasourceName string, labelMatchers ...*labels.Mat
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ServerRequestVolumeStat"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ServerRequestVolumeStat",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
 
-func ServerSuccessRateStat(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ServerSuccessRateStat(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Server Success Rate (non-5xx responses)",
 		statPanel.Chart(
 			statPanel.Calculation(commonSdk.LastCalculation),
@@ -430,19 +386,16 @@ func ServerSuccessRateStat(datasourceName string, labelMatchers ...*labels.Match
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ServerSuccessRateStat"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ServerSuccessRateStat",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
 
-func ServerRequestDurationChart(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ServerRequestDurationChart(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Server Request Duration",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{
@@ -453,40 +406,31 @@ func ServerRequestDurationChart(datasourceName string, labelMatchers ...*labels.
 				Mode:     timeSeriesPanel.ListMode,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ServerRequestDurationChart50"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("P50"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ServerRequestDurationChart90"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("P90"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ServerRequestDurationChart99"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("P99"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ServerRequestDurationChart50",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("P50"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ServerRequestDurationChart90",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("P90"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ServerRequestDurationChart99",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("P99"),
 		),
 	)
 }
 
-func TCPSentBytesStat(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func TCPSentBytesStat(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("TCP Sent Bytes",
 		statPanel.Chart(
 			statPanel.Calculation(commonSdk.MeanCalculation),
@@ -503,21 +447,18 @@ func TCPSentBytesStat(datasourceName string, labelMatchers ...*labels.Matcher) p
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["TCPSentBytesStat"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"TCPSentBytesStat",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
 
 // ========== CLIENT WORKLOAD PANELS ==========
 
-func IncomingRequestsByClient(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func IncomingRequestsByClient(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Incoming Requests By Source And Response Code",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{Min: 0}),
@@ -532,30 +473,24 @@ func IncomingRequestsByClient(datasourceName string, labelMatchers ...*labels.Ma
 				AreaOpacity:  0,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingRequestsByClient"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ source_workload }}.{{ source_workload_namespace }} : {{ response_code }} (🔐mTLS)"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingRequestsByClientNonmTLS"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ source_workload }}.{{ source_workload_namespace }} : {{ response_code }}"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingRequestsByClient",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ source_workload }}.{{ source_workload_namespace }} : {{ response_code }} (🔐mTLS)"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingRequestsByClientNonmTLS",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ source_workload }}.{{ source_workload_namespace }} : {{ response_code }}"),
 		),
 	)
 }
 
-func IncomingSuccessRateByClient(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func IncomingSuccessRateByClient(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Incoming Success Rate (non-5xx responses) By Source",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{
@@ -574,25 +509,19 @@ func IncomingSuccessRateByClient(datasourceName string, labelMatchers ...*labels
 				AreaOpacity:  0.1,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingSuccessRateByClient"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ source_workload }}.{{ source_workload_namespace }} (🔐mTLS)"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingSuccessRateByClientNonmTLS"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ source_workload }}.{{ source_workload_namespace }}"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingSuccessRateByClient",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ source_workload }}.{{ source_workload_namespace }} (🔐mTLS)"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingSuccessRateByClientNonmTLS",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ source_workload }}.{{ source_workload_namespace }}"),
 		),
 	)
 }
@@ -17,16 +17,16 @@ import (
 	"github.com/perses/community-mixins/pkg/dashboards"
 	"github.com/perses/community-mixins/pkg/promql"
 	commonSdk "github.com/perses/perses/go-sdk/common"
-	"github.com/perses/perses/go-sdk/panel"
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
 	"github.com/perses/plugins/prometheus/sdk/go/query"
 	timeSeriesPanel "github.com/perses/plugins/timeserieschart/sdk/go"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
 // ========== CLIENT WORKLOAD PANELS (continued) ==========
 
-func IncomingRequestDurationByClient(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func IncomingRequestDurationByClient(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Incoming Request Duration By Source",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{
@@ -45,91 +45,67 @@ func IncomingRequestDurationByClient(datasourceName string, labelMatchers ...*la
 			}),
 		),
 		// mTLS P50, P90, P95, P99
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingRequestDurationByClient50"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P50 (🔐mTLS)"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingRequestDurationByClient90"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P90 (🔐mTLS)"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingRequestDurationByClient95"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P95 (🔐mTLS)"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingRequestDurationByClient99"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P99 (🔐mTLS)"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingRequestDurationByClient50",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P50 (🔐mTLS)"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingRequestDurationByClient90",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P90 (🔐mTLS)"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingRequestDurationByClient95",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P95 (🔐mTLS)"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingRequestDurationByClient99",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P99 (🔐mTLS)"),
 		),
 		// Non-mTLS P50, P90, P95, P99
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingRequestDurationByClientNonmTLS50"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P50"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingRequestDurationByClientNonmTLS90"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P90"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingRequestDurationByClientNonmTLS95"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P95"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingRequestDurationByClientNonmTLS99"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P99"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingRequestDurationByClientNonmTLS50",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P50"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingRequestDurationByClientNonmTLS90",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P90"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingRequestDurationByClientNonmTLS95",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P95"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingRequestDurationByClientNonmTLS99",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P99"),
 		),
 	)
 }
 
-func IncomingRequestSizeByClient(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func IncomingRequestSizeByClient(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Incoming Request Size By Source",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{
@@ -148,91 +124,67 @@ func IncomingRequestSizeByClient(datasourceName string, labelMatchers ...*labels
 			}),
 		),
 		// mTLS P50, P90, P95, P99
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingRequestSizeByClient50"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P50 (🔐mTLS)"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingRequestSizeByClient90"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}}  P90 (🔐mTLS)"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingRequestSizeByClient95"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P95 (🔐mTLS)"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingRequestSizeByClient99"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}}  P99 (🔐mTLS)"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingRequestSizeByClient50",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P50 (🔐mTLS)"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingRequestSizeByClient90",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}}  P90 (🔐mTLS)"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingRequestSizeByClient95",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P95 (🔐mTLS)"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingRequestSizeByClient99",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}}  P99 (🔐mTLS)"),
 		),
 		// Non-mTLS P50, P90, P95, P99
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingRequestSizeByClientNonmTLS50"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P50"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingRequestSizeByClientNonmTLS90"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P90"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingRequestSizeByClientNonmTLS95"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P95"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingRequestSizeByClientNonmTLS99"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P99"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingRequestSizeByClientNonmTLS50",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P50"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingRequestSizeByClientNonmTLS90",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P90"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingRequestSizeByClientNonmTLS95",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P95"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingRequestSizeByClientNonmTLS99",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P99"),
 		),
 	)
 }
 
-func ResponseSizeByClient(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ResponseSizeByClient(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Response Size By Source",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{
@@ -251,91 +203,67 @@ func ResponseSizeByClient(datasourceName string, labelMatchers ...*labels.Matche
 			}),
 		),
 		// mTLS P50, P90, P95, P99
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioResponseSizeByClient50"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P50 (🔐mTLS)"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioResponseSizeByClient90"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}}  P90 (🔐mTLS)"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioResponseSizeByClient95"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P95 (🔐mTLS)"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioResponseSizeByClient95"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}}  P99 (🔐mTLS)"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioResponseSizeByClient50",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P50 (🔐mTLS)"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioResponseSizeByClient90",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}}  P90 (🔐mTLS)"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioResponseSizeByClient95",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P95 (🔐mTLS)"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioResponseSizeByClient95",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}}  P99 (🔐mTLS)"),
 		),
 		// Non-mTLS P50, P90, P95, P99
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioResponseSizeByClientNonmTLS50"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P50"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioResponseSizeByClientNonmTLS90"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P90"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioResponseSizeByClientNonmTLS95"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P95"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioResponseSizeByClientNonmTLS99"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P99"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioResponseSizeByClientNonmTLS50",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P50"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioResponseSizeByClientNonmTLS90",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P90"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioResponseSizeByClientNonmTLS95",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P95"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioResponseSizeByClientNonmTLS99",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{source_workload}}.{{source_workload_namespace}} P99"),
 		),
 	)
 }
 
-func BytesReceivedFromTCPClient(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func BytesReceivedFromTCPClient(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Bytes Received from Incoming TCP Connection",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{
@@ -353,30 +281,24 @@ func BytesReceivedFromTCPClient(datasourceName string, labelMatchers ...*labels.
 				AreaOpacity:  0.1,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["BytesReceivedFromTCPClient"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ source_workload }}.{{ source_workload_namespace}} (🔐mTLS)"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["BytesReceivedFromTCPClientNonmTLS"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ source_workload }}.{{ source_workload_namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"BytesReceivedFromTCPClient",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ source_workload }}.{{ source_workload_namespace}} (🔐mTLS)"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"BytesReceivedFromTCPClientNonmTLS",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ source_workload }}.{{ source_workload_namespace}}"),
 		),
 	)
 }
 
-func BytesSentToTCPClient(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func BytesSentToTCPClient(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Bytes Sent to Incoming TCP Connection",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{
@@ -394,25 +316,19 @@ func BytesSentToTCPClient(datasourceName string, labelMatchers ...*labels.Matche
 				AreaOpacity:  0.1,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["BytesSentToTCPClient"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ source_workload }}.{{ source_workload_namespace}} (🔐mTLS)"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["BytesSentToTCPClientNonmTLS"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ source_workload }}.{{ source_workload_namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"BytesSentToTCPClient",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ source_workload }}.{{ source_workload_namespace}} (🔐mTLS)"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"BytesSentToTCPClientNonmTLS",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ source_workload }}.{{ source_workload_namespace}}"),
 		),
 	)
 }
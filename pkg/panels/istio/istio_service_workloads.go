@@ -17,16 +17,16 @@ import (
 	"github.com/perses/community-mixins/pkg/dashboards"
 	"github.com/perses/community-mixins/pkg/promql"
 	commonSdk "github.com/perses/perses/go-sdk/common"
-	"github.com/perses/perses/go-sdk/panel"
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
 	"github.com/perses/plugins/prometheus/sdk/go/query"
 	timeSeriesPanel "github.com/perses/plugins/timeserieschart/sdk/go"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
 // ========== SERVICE WORKLOAD PANELS ==========
 
-func IncomingRequestsByService(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func IncomingRequestsByService(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Incoming Requests By Destination Workload And Response Code",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{Min: 0}),
@@ -41,30 +41,24 @@ func IncomingRequestsByService(datasourceName string, labelMatchers ...*labels.M
 				AreaOpacity:  0,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioIncomingRequestsByService"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} : {{ response_code }} (🔐mTLS)"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioIncomingRequestsByServiceNonmTLS"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} : {{ response_code }}"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioIncomingRequestsByService",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} : {{ response_code }} (🔐mTLS)"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioIncomingRequestsByServiceNonmTLS",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} : {{ response_code }}"),
 		),
 	)
 }
 
-func IncomingSuccessRateByService(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func IncomingSuccessRateByService(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Incoming Success Rate (non-5xx responses) By Destination Workload",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{
@@ -83,30 +77,24 @@ func IncomingSuccessRateByService(datasourceName string, labelMatchers ...*label
 				AreaOpacity:  0.1,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingSuccessRateByService"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} (🔐mTLS)"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IstioIncomingRequestsByServiceNonmTLS"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }}"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingSuccessRateByService",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} (🔐mTLS)"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IstioIncomingRequestsByServiceNonmTLS",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }}"),
 		),
 	)
 }
 
-func IncomingRequestDurationByService(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func IncomingRequestDurationByService(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Incoming Request Duration By Service Workload",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{
@@ -125,91 +113,67 @@ func IncomingRequestDurationByService(datasourceName string, labelMatchers ...*l
 			}),
 		),
 		// mTLS P50, P90, P95, P99
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingRequestDurationByService50"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P50 (🔐mTLS)"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingRequestDurationByService90"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P90 (🔐mTLS)"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingRequestDurationByService95"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P95 (🔐mTLS)"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingRequestDurationByService99"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P99 (🔐mTLS)"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingRequestDurationByService50",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P50 (🔐mTLS)"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingRequestDurationByService90",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P90 (🔐mTLS)"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingRequestDurationByService95",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P95 (🔐mTLS)"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingRequestDurationByService99",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P99 (🔐mTLS)"),
 		),
 		// Non-mTLS P50, P90, P95, P99
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingRequestDurationByServiceNonmTLS50"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P50"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingRequestDurationByServiceNonmTLS90"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P90"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingRequestDurationByServiceNonmTLS95"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P95"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingRequestDurationByServiceNonmTLS99"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P99"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingRequestDurationByServiceNonmTLS50",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P50"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingRequestDurationByServiceNonmTLS90",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P90"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingRequestDurationByServiceNonmTLS95",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P95"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingRequestDurationByServiceNonmTLS99",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P99"),
 		),
 	)
 }
 
-func IncomingRequestSizeByService(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func IncomingRequestSizeByService(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Incoming Request Size By Service Workload",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{
@@ -228,91 +192,67 @@ func IncomingRequestSizeByService(datasourceName string, labelMatchers ...*label
 			}),
 		),
 		// mTLS P50, P90, P95, P99
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingRequestSizeByService50"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P50 (🔐mTLS)"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingRequestSizeByService90"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }}  P90 (🔐mTLS)"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingRequestSizeByService95"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P95 (🔐mTLS)"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingRequestSizeByService99"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }}  P99 (🔐mTLS)"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingRequestSizeByService50",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P50 (🔐mTLS)"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingRequestSizeByService90",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }}  P90 (🔐mTLS)"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingRequestSizeByService95",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P95 (🔐mTLS)"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingRequestSizeByService99",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }}  P99 (🔐mTLS)"),
 		),
 		// Non-mTLS P50, P90, P95, P99
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingRequestSizeByServiceNonmTLS50"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P50"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingRequestSizeByServiceNonmTLS90"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P90"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingRequestSizeByServiceNonmTLS95"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P95"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingRequestSizeByServiceNonmTLS99"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P99"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingRequestSizeByServiceNonmTLS50",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P50"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingRequestSizeByServiceNonmTLS90",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P90"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingRequestSizeByServiceNonmTLS95",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P95"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingRequestSizeByServiceNonmTLS99",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P99"),
 		),
 	)
 }
 
-func ResponseSizeByService(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ResponseSizeByService(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Response Size By Service Workload",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{
@@ -331,91 +271,67 @@ func ResponseSizeByService(datasourceName string, labelMatchers ...*labels.Match
 			}),
 		),
 		// mTLS P50, P90, P95, P99
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ResponseSizeByService50"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P50 (🔐mTLS)"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ResponseSizeByService90"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }}  P90 (🔐mTLS)"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ResponseSizeByService95"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P95 (🔐mTLS)"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ResponseSizeByService99"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }}  P99 (🔐mTLS)"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ResponseSizeByService50",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P50 (🔐mTLS)"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ResponseSizeByService90",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }}  P90 (🔐mTLS)"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ResponseSizeByService95",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P95 (🔐mTLS)"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ResponseSizeByService99",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }}  P99 (🔐mTLS)"),
 		),
 		// Non-mTLS P50, P90, P95, P99
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ResponseSizeByServiceNonmTLS50"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P50"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ResponseSizeByServiceNonmTLS90"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P90"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ResponseSizeByServiceNonmTLS95"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P95"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ResponseSizeByServiceNonmTLS99"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P99"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ResponseSizeByServiceNonmTLS50",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P50"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ResponseSizeByServiceNonmTLS90",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P90"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ResponseSizeByServiceNonmTLS95",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P95"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ResponseSizeByServiceNonmTLS99",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace }} P99"),
 		),
 	)
 }
 
-func BytesReceivedFromTCPService(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func BytesReceivedFromTCPService(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Bytes Received from Incoming TCP Connection",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{
@@ -433,30 +349,24 @@ func BytesReceivedFromTCPService(datasourceName string, labelMatchers ...*labels
 				AreaOpacity:  0.1,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["BytesReceivedFromTCPService"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace}} (🔐mTLS)"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["BytesReceivedFromTCPServiceNonmTLS"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"BytesReceivedFromTCPService",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace}} (🔐mTLS)"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"BytesReceivedFromTCPServiceNonmTLS",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload }}.{{ destination_workload_namespace}}"),
 		),
 	)
 }
 
-func BytesSentToTCPService(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func BytesSentToTCPService(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Bytes Sent to Incoming TCP Connection",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{
@@ -474,25 +384,19 @@ func BytesSentToTCPService(datasourceName string, labelMatchers ...*labels.Match
 				AreaOpacity:  0.1,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["BytesSentToTCPService"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload }}.{{destination_workload_namespace }} (🔐mTLS)"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["BytesSentToTCPServiceNonmTLS"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ destination_workload }}.{{destination_workload_namespace }}"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"BytesSentToTCPService",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload }}.{{destination_workload_namespace }} (🔐mTLS)"),
+		),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"BytesSentToTCPServiceNonmTLS",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ destination_workload }}.{{destination_workload_namespace }}"),
 		),
 	)
 }
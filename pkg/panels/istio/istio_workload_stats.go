@@ -17,16 +17,16 @@ import (
 	"github.com/perses/community-mixins/pkg/dashboards"
 	"github.com/perses/community-mixins/pkg/promql"
 	commonSdk "github.com/perses/perses/go-sdk/common"
-	"github.com/perses/perses/go-sdk/panel"
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
 	"github.com/perses/plugins/prometheus/sdk/go/query"
 	statPanel "github.com/perses/plugins/statchart/sdk/go"
 	timeSeriesPanel "github.com/perses/plugins/timeserieschart/sdk/go"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
 // Stat panels for General section
-func IncomingRequestVolumeStat(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func IncomingRequestVolumeStat(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Incoming Request Volume",
 		statPanel.Chart(
 			statPanel.Calculation(commonSdk.LastCalculation),
@@ -42,19 +42,16 @@ func IncomingRequestVolumeStat(datasourceName string, labelMatchers ...*labels.M
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingRequestVolumeStat"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingRequestVolumeStat",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
 
-func IncomingSuccessRateStat(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func IncomingSuccessRateStat(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Incoming Success Rate (non-5xx responses)",
 		statPanel.Chart(
 			statPanel.Calculation(commonSdk.MeanCalculation),
@@ -72,19 +69,16 @@ func IncomingSuccessRateStat(datasourceName string, labelMatchers ...*labels.Mat
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["IncomingSuccessRateStat"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"IncomingSuccessRateStat",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
 
-func RequestDurationChart(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func RequestDurationChart(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Request Duration",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{
@@ -104,40 +98,31 @@ func RequestDurationChart(datasourceName string, labelMatchers ...*labels.Matche
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["RequestDurationChart50"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("P50"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"RequestDurationChart50",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("P50"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["RequestDurationChart90"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("P90"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"RequestDurationChart90",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("P90"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["RequestDurationChart99"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("P99"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"RequestDurationChart99",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("P99"),
 		),
 	)
 }
 
-func TCPServerTrafficStat(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func TCPServerTrafficStat(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("TCP Server Traffic",
 		statPanel.Chart(
 			statPanel.Calculation(commonSdk.MeanCalculation),
@@ -154,19 +139,16 @@ func TCPServerTrafficStat(datasourceName string, labelMatchers ...*labels.Matche
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["TCPServerTrafficStat"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"TCPServerTrafficStat",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
 
-func TCPClientTrafficStat(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func TCPClientTrafficStat(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("TCP Client Traffic",
 		statPanel.Chart(
 			statPanel.Calculation(commonSdk.MeanCalculation),
@@ -183,14 +165,11 @@ func TCPClientTrafficStat(datasourceName string, labelMatchers ...*labels.Matche
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["TCPClientTrafficStat"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"TCPClientTrafficStat",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
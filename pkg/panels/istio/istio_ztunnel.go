@@ -22,9 +22,10 @@ import (
 	"github.com/perses/plugins/prometheus/sdk/go/query"
 	timeSeriesPanel "github.com/perses/plugins/timeserieschart/sdk/go"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func ZtunnelBytesTransmitted(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ZtunnelBytesTransmitted(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Bytes Transmitted",
 		panel.Description("Bytes sent and received per instance"),
 		timeSeriesPanel.Chart(
@@ -45,30 +46,24 @@ func ZtunnelBytesTransmitted(datasourceName string, labelMatchers ...*labels.Mat
 				AreaOpacity:  0.1,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ZtunnelBytesTransmittedSent"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Sent ({{pod}})"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ZtunnelBytesTransmittedSent",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Sent ({{pod}})"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ZtunnelBytesTransmittedReceived"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Received ({{pod}})"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ZtunnelBytesTransmittedReceived",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Received ({{pod}})"),
 		),
 	)
 }
 
-func ZtunnelConnections(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ZtunnelConnections(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Connections",
 		panel.Description("Connections opened and closed per instance"),
 		timeSeriesPanel.Chart(
@@ -89,30 +84,24 @@ func ZtunnelConnections(datasourceName string, labelMatchers ...*labels.Matcher)
 				AreaOpacity:  0.1,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ZtunnelConnectionsOpened"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Opened ({{pod}})"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ZtunnelConnectionsOpened",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Opened ({{pod}})"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ZtunnelConnectionsClosed"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Closed ({{pod}})"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ZtunnelConnectionsClosed",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Closed ({{pod}})"),
 		),
 	)
 }
 
-func ZtunnelCPUUsage(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ZtunnelCPUUsage(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("CPU Usage",
 		panel.Description("CPU usage of each running instance"),
 		timeSeriesPanel.Chart(
@@ -128,20 +117,17 @@ func ZtunnelCPUUsage(datasourceName string, labelMatchers ...*labels.Matcher) pa
 				AreaOpacity:  0.1,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ZtunnelCPUUsage"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Container ({{pod}})"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ZtunnelCPUUsage",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Container ({{pod}})"),
 		),
 	)
 }
 
-func ZtunnelDNSRequest(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ZtunnelDNSRequest(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("DNS Request",
 		panel.Description("DNS queries received per instance"),
 		timeSeriesPanel.Chart(
@@ -157,20 +143,17 @@ func ZtunnelDNSRequest(datasourceName string, labelMatchers ...*labels.Matcher)
 				AreaOpacity:  0.1,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ZtunnelDNSRequest"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Request ({{pod}})"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ZtunnelDNSRequest",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Request ({{pod}})"),
 		),
 	)
 }
 
-func ZtunnelMemoryUsage(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ZtunnelMemoryUsage(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Memory Usage",
 		panel.Description("Memory usage of each running instance"),
 		timeSeriesPanel.Chart(
@@ -191,20 +174,17 @@ func ZtunnelMemoryUsage(datasourceName string, labelMatchers ...*labels.Matcher)
 				AreaOpacity:  0.1,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ZtunnelMemoryUsage"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Container ({{pod}})"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ZtunnelMemoryUsage",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Container ({{pod}})"),
 		),
 	)
 }
 
-func ZtunnelWorkloadManager(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ZtunnelWorkloadManager(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Workload Manager",
 		panel.Description("Count of active and pending proxies managed by each instance.\nPending is expected to converge to zero.\n"),
 		timeSeriesPanel.Chart(
@@ -220,30 +200,24 @@ func ZtunnelWorkloadManager(datasourceName string, labelMatchers ...*labels.Matc
 				AreaOpacity:  0.1,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ZtunnelWorkloadManagerActive"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Active Proxies ({{pod}})"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ZtunnelWorkloadManagerActive",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Active Proxies ({{pod}})"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ZtunnelWorkloadManagerPending"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Pending Proxies ({{pod}})"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ZtunnelWorkloadManagerPending",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Pending Proxies ({{pod}})"),
 		),
 	)
 }
 
-func ZtunnelXDSConnections(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ZtunnelXDSConnections(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("XDS Connections",
 		panel.Description("Count of XDS connection terminations.\nThis will typically spike every 30min for each instance.\n"),
 		timeSeriesPanel.Chart(
@@ -259,20 +233,17 @@ func ZtunnelXDSConnections(datasourceName string, labelMatchers ...*labels.Match
 				AreaOpacity:  0.1,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ZtunnelXDSConnections"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("XDS Connection Terminations ({{pod}})"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ZtunnelXDSConnections",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("XDS Connection Terminations ({{pod}})"),
 		),
 	)
 }
 
-func ZtunnelXDSPushes(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ZtunnelXDSPushes(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("XDS Pushes",
 		timeSeriesPanel.Chart(
 			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
@@ -288,20 +259,17 @@ func ZtunnelXDSPushes(datasourceName string, labelMatchers ...*labels.Matcher) p
 				Stack:        timeSeriesPanel.AllStack,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ZtunnelXDSPushes"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{url}}"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ZtunnelXDSPushes",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{url}}"),
 		),
 	)
 }
 
-func ZtunnelResourceUsage(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ZtunnelResourceUsage(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Resource Usage",
 		panel.Description("Active TCP connections, open file descriptors, and open sockets per instance"),
 		timeSeriesPanel.Chart(
@@ -317,40 +285,31 @@ func ZtunnelResourceUsage(datasourceName string, labelMatchers ...*labels.Matche
 				AreaOpacity:  0.1,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ZtunnelResourceUsageTCPConnections"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("TCP Connections ({{pod}})"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ZtunnelResourceUsageTCPConnections",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("TCP Connections ({{pod}})"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ZtunnelResourceUsageOpenFDs"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Open File Descriptors ({{pod}})"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ZtunnelResourceUsageOpenFDs",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Open File Descriptors ({{pod}})"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ZtunnelResourceUsageOpenSockets"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Open Sockets ({{pod}})"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ZtunnelResourceUsageOpenSockets",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Open Sockets ({{pod}})"),
 		),
 	)
 }
 
-func ZtunnelVersions(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ZtunnelVersions(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Ztunnel Versions",
 		panel.Description("Version number of each running instance"),
 		timeSeriesPanel.Chart(
@@ -366,15 +325,12 @@ func ZtunnelVersions(datasourceName string, labelMatchers ...*labels.Matcher) pa
 				AreaOpacity:  0.1,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					IstioCommonPanelQueries["ZtunnelVersions"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Version ({{tag}})"),
-			),
+		promql.AddQueryFrom(
+			"IstioCommonPanelQueries", queries,
+			"ZtunnelVersions",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Version ({{tag}})"),
 		),
 	)
 }
@@ -0,0 +1,83 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package istio
+
+import (
+	"testing"
+
+	"github.com/perses/community-mixins/pkg/querytest"
+)
+
+// sourceSecurityPolicy is why the outbound queries keeping the mTLS requests reported by the source return nothing:
+// Istio only knows the security policy of a connection at its destination, the source reports it as unknown. The
+// panels still show these requests through their non-mTLS queries.
+const sourceSecurityPolicy = "the source reports the connection_security_policy of its requests as unknown"
+
+func TestIstioCommonPanelQueries(t *testing.T) {
+	querytest.Suite{
+		SeriesFile: "testdata/series.test",
+		Queries:    IstioCommonPanelQueries,
+		Variables: map[string]string{
+			"namespace": "bookinfo",
+			"workload":  "productpage-v1",
+			"service":   "reviews.bookinfo.svc.cluster.local",
+			"qrep":      "destination",
+			// The variables allowing multiple values are set to all of them.
+			"srcns":  ".*",
+			"srcwl":  ".*",
+			"dstns":  ".*",
+			"dstwl":  ".*",
+			"dstsvc": ".*",
+		},
+		Labels: map[string][]string{
+			"IncomingRequestVolume": {
+				`{source_workload="istio-ingressgateway", source_workload_namespace="istio-system", response_code="200"}`,
+				`{source_workload="istio-ingressgateway", source_workload_namespace="istio-system", response_code="404"}`,
+				`{source_workload="istio-ingressgateway", source_workload_namespace="istio-system", response_code="503"}`,
+			},
+			"IncomingRequestVolumeNonmTLS": {
+				`{source_workload="sleep", source_workload_namespace="legacy", response_code="200"}`,
+				`{source_workload="sleep", source_workload_namespace="legacy", response_code="404"}`,
+				`{source_workload="sleep", source_workload_namespace="legacy", response_code="503"}`,
+			},
+			"ClientRequestVolume": {
+				`{source_workload="productpage-v1", source_workload_namespace="bookinfo"}`,
+				`{source_workload="sleep", source_workload_namespace="legacy"}`,
+			},
+			"ServerRequestVolume":    {`{destination_workload="reviews-v2", destination_workload_namespace="bookinfo"}`},
+			"IstioComponentVersions": {`{component="pilot", tag="1.27.0"}`, `{component="ztunnel", tag="1.27.0"}`},
+			"IstioXDSPushes":         {`{type="cds"}`, `{type="eds"}`},
+			"IstiodVCPUContainer":    {`{container="discovery"}`},
+			"ZtunnelVersions":        {`{tag="1.27.0"}`},
+		},
+		Skip: map[string]string{
+			"OutgoingRequestDuration50": sourceSecurityPolicy,
+			"OutgoingRequestDuration90": sourceSecurityPolicy,
+			"OutgoingRequestDuration95": sourceSecurityPolicy,
+			"OutgoingRequestDuration99": sourceSecurityPolicy,
+			"OutgoingRequestSize50":     sourceSecurityPolicy,
+			"OutgoingRequestSize90":     sourceSecurityPolicy,
+			"OutgoingRequestSize95":     sourceSecurityPolicy,
+			"OutgoingRequestSize99":     sourceSecurityPolicy,
+			"OutgoingResponseSize50":    sourceSecurityPolicy,
+			"OutgoingResponseSize90":    sourceSecurityPolicy,
+			"OutgoingResponseSize95":    sourceSecurityPolicy,
+			"OutgoingResponseSize99":    sourceSecurityPolicy,
+			"OutgoingSuccessRate":       sourceSecurityPolicy,
+			"TCPBytesReceived":          sourceSecurityPolicy,
+			"TCPBytesSent":              sourceSecurityPolicy,
+			"TCPBytesSentNonmTLS":       sourceSecurityPolicy,
+		},
+	}.Run(t)
+}
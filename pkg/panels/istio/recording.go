@@ -0,0 +1,146 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package istio
+
+import (
+	"slices"
+	"time"
+
+	promqlbuilder "github.com/perses/promql-builder"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+// RecordedMetrics lists the Istio standard metrics whose workload level rates are recorded by the Istio recording rules.
+var RecordedMetrics = []string{
+	"istio_requests_total",
+	"istio_request_duration_milliseconds_bucket",
+	"istio_request_duration_seconds_bucket",
+	"istio_request_bytes_bucket",
+	"istio_response_bytes_bucket",
+	"istio_tcp_sent_bytes_total",
+	"istio_tcp_received_bytes_total",
+	"istio_tcp_connections_opened_total",
+	"istio_tcp_connections_closed_total",
+}
+
+// AggregatedLabels lists the labels the Istio recording rules aggregate away.
+var AggregatedLabels = []string{"instance", "pod"}
+
+// RecordedRange is the range of the rates the Istio recording rules record for the RecordedMetrics.
+const RecordedRange = 5 * time.Minute
+
+// RecordedMetricName returns the name of the series recording the workload level 5m rate of the given metric.
+func RecordedMetricName(metricName string) string {
+	return "workload:" + metricName + ":rate5m"
+}
+
+// UseRecordedMetrics returns a copy of the query where the rates over RecordedRange of the recorded Istio metrics
+// are replaced by the series recorded by the Istio recording rules.
+// Queries relying on one of the AggregatedLabels (e.g. per pod breakdowns), or reading one of the
+// RecordedMetrics other than through a rate over RecordedRange (e.g. irate, or a rate over $__rate_interval), are
+// returned unchanged, as the recorded series don't carry these labels nor the raw counters.
+func UseRecordedMetrics(query parser.Expr) parser.Expr {
+	copy := promqlbuilder.DeepCopyExpr(query)
+	if usesAggregatedLabels(copy) {
+		return copy
+	}
+	copy = recordedRate(copy)
+	readsRawMetrics := false
+	promqlbuilder.Inspect(copy, func(node parser.Node, path []parser.Node) error {
+		switch n := node.(type) {
+		case *parser.Call:
+			for i, arg := range n.Args {
+				n.Args[i] = recordedRate(arg)
+			}
+		case *parser.AggregateExpr:
+			n.Expr = recordedRate(n.Expr)
+		case *parser.BinaryExpr:
+			n.LHS, n.RHS = recordedRate(n.LHS), recordedRate(n.RHS)
+		case *parser.ParenExpr:
+			n.Expr = recordedRate(n.Expr)
+		case *parser.UnaryExpr:
+			n.Expr = recordedRate(n.Expr)
+		case *parser.VectorSelector:
+			readsRawMetrics = readsRawMetrics || slices.Contains(RecordedMetrics, n.Name)
+		}
+		return nil
+	})
+	if readsRawMetrics {
+		return promqlbuilder.DeepCopyExpr(query)
+	}
+	return copy
+}
+
+// recordedRate returns the recorded series selector replacing expr when expr is the rate over RecordedRange of one
+// of the RecordedMetrics, and expr otherwise.
+func recordedRate(expr parser.Expr) parser.Expr {
+	call, ok := expr.(*parser.Call)
+	if !ok || call.Func.Name != "rate" || len(call.Args) != 1 {
+		return expr
+	}
+	matrixSelector, ok := call.Args[0].(*parser.MatrixSelector)
+	if !ok || matrixSelector.Range != RecordedRange {
+		return expr
+	}
+	selector, ok := matrixSelector.VectorSelector.(*parser.VectorSelector)
+	if !ok || !slices.Contains(RecordedMetrics, selector.Name) {
+		return expr
+	}
+	selector.Name = RecordedMetricName(selector.Name)
+	for i, l := range selector.LabelMatchers {
+		if l.Name == labels.MetricName {
+			selector.LabelMatchers[i] = labels.MustNewMatcher(labels.MatchEqual, labels.MetricName, selector.Name)
+		}
+	}
+	return selector
+}
+
+// PanelQueries returns IstioCommonPanelQueries,
+// pointed at the recorded series when useRecordingRules is set.
+// Only enable useRecordingRules when the Istio recording rules are loaded in the queried Prometheus.
+func PanelQueries(useRecordingRules bool) map[string]parser.Expr {
+	if !useRecordingRules {
+		return IstioCommonPanelQueries
+	}
+	queries := make(map[string]parser.Expr, len(IstioCommonPanelQueries))
+	for name, query := range IstioCommonPanelQueries {
+		queries[name] = UseRecordedMetrics(query)
+	}
+	return queries
+}
+
+// usesAggregatedLabels returns whether the query matches, groups or joins on one of the AggregatedLabels.
+func usesAggregatedLabels(query parser.Expr) bool {
+	var used []string
+	promqlbuilder.Inspect(query, func(node parser.Node, path []parser.Node) error {
+		switch n := node.(type) {
+		case *parser.VectorSelector:
+			for _, l := range n.LabelMatchers {
+				used = append(used, l.Name)
+			}
+		case *parser.AggregateExpr:
+			used = append(used, n.Grouping...)
+		case *parser.BinaryExpr:
+			if n.VectorMatching != nil {
+				used = append(used, n.VectorMatching.MatchingLabels...)
+				used = append(used, n.VectorMatching.Include...)
+			}
+		}
+		return nil
+	})
+	return slices.ContainsFunc(used, func(name string) bool {
+		return slices.Contains(AggregatedLabels, name)
+	})
+}
@@ -0,0 +1,127 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package istio
+
+import (
+	"testing"
+	"time"
+
+	promqlbuilder "github.com/perses/promql-builder"
+	"github.com/perses/promql-builder/label"
+	"github.com/perses/promql-builder/matrix"
+	"github.com/perses/promql-builder/vector"
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+func TestUseRecordedMetrics(t *testing.T) {
+	query := promqlbuilder.Sum(
+		promqlbuilder.Rate(
+			matrix.New(
+				vector.New(
+					vector.WithMetricName("istio_requests_total"),
+					vector.WithLabelMatchers(
+						label.New("reporter").Equal("destination"),
+					),
+				),
+				matrix.WithRange(5*time.Minute),
+			),
+		),
+	).By("destination_service")
+
+	want := `sum by (destination_service) (workload:istio_requests_total:rate5m{reporter="destination"})`
+	if got := UseRecordedMetrics(query).String(); got != want {
+		t.Errorf("UseRecordedMetrics() = %q, want %q", got, want)
+	}
+
+	original := `sum by (destination_service) (rate(istio_requests_total{reporter="destination"}[5m]))`
+	if got := query.String(); got != original {
+		t.Errorf("UseRecordedMetrics() modified the original query: got %q, want %q", got, original)
+	}
+}
+
+func TestUseRecordedMetricsKeepsOtherRates(t *testing.T) {
+	// The recording rules only record the rates over 5m, the other rates read the raw metrics.
+	requests := func(r time.Duration) *parser.MatrixSelector {
+		return matrix.New(
+			vector.New(
+				vector.WithMetricName("istio_requests_total"),
+				vector.WithLabelMatchers(
+					label.New("reporter").Equal("destination"),
+				),
+			),
+			matrix.WithRange(r),
+		)
+	}
+	for name, query := range map[string]parser.Expr{
+		"rate over another range": promqlbuilder.Sum(promqlbuilder.Rate(requests(time.Minute))).By("destination_service"),
+		"irate":                   promqlbuilder.Sum(promqlbuilder.IRate(requests(5 * time.Minute))).By("destination_service"),
+	} {
+		t.Run(name, func(t *testing.T) {
+			want := query.String()
+			if got := UseRecordedMetrics(query).String(); got != want {
+				t.Errorf("UseRecordedMetrics() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestUseRecordedMetricsIgnoresOtherMetrics(t *testing.T) {
+	query := vector.New(
+		vector.WithMetricName("pilot_total_xds_rejects"),
+	)
+
+	want := "pilot_total_xds_rejects"
+	if got := UseRecordedMetrics(query).String(); got != want {
+		t.Errorf("UseRecordedMetrics() = %q, want %q", got, want)
+	}
+}
+
+func TestUseRecordedMetricsKeepsPerPodQueries(t *testing.T) {
+	query := promqlbuilder.Sum(
+		vector.New(
+			vector.WithMetricName("istio_tcp_connections_opened_total"),
+			vector.WithLabelMatchers(
+				label.New("app").Equal("ztunnel"),
+			),
+		),
+	).By("pod")
+
+	want := `sum by (pod) (istio_tcp_connections_opened_total{app="ztunnel"})`
+	if got := UseRecordedMetrics(query).String(); got != want {
+		t.Errorf("UseRecordedMetrics() = %q, want %q", got, want)
+	}
+}
+
+func TestUseRecordedMetricsKeepsRawCounterQueries(t *testing.T) {
+	query := promqlbuilder.Sum(
+		vector.New(
+			vector.WithMetricName("istio_requests_total"),
+		),
+	).By("destination_service")
+
+	want := `sum by (destination_service) (istio_requests_total)`
+	if got := UseRecordedMetrics(query).String(); got != want {
+		t.Errorf("UseRecordedMetrics() = %q, want %q", got, want)
+	}
+}
+
+func TestPanelQueries(t *testing.T) {
+	if got, want := PanelQueries(false)["IstioGlobal5xxRate"].String(), IstioCommonPanelQueries["IstioGlobal5xxRate"].String(); got != want {
+		t.Errorf("PanelQueries(false) = %q, want %q", got, want)
+	}
+
+	if got, want := PanelQueries(true)["IstioGlobal5xxRate"].String(), UseRecordedMetrics(IstioCommonPanelQueries["IstioGlobal5xxRate"]).String(); got != want {
+		t.Errorf("PanelQueries(true) = %q, want %q", got, want)
+	}
+}
@@ -25,6 +25,7 @@ import (
 
 	commonSdk "github.com/perses/perses/go-sdk/common"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
 func APIServerSLONotice(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
@@ -34,7 +35,7 @@ func APIServerSLONotice(datasourceName string, labelMatchers ...*labels.Matcher)
 	)
 }
 
-func APIServerAvailability(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func APIServerAvailability(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Availability (30d) > 99.000%",
 		panel.Description("How many percent of requests (both read and write) in 30 days have been answered successfully and fast enough?"),
 		statPanel.Chart(
@@ -45,19 +46,16 @@ func APIServerAvailability(datasourceName string, labelMatchers ...*labels.Match
 			}),
 			statPanel.ValueFontSize(50),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["APIServerAvailability"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"APIServerAvailability",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
 
-func APIServerErrorBudget(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func APIServerErrorBudget(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("ErrorBudget (30d) > 99.000%",
 		panel.Description("How much error budget is left looking at our 0.990% availability guarantees?"),
 		timeSeriesPanel.Chart(
@@ -79,20 +77,17 @@ func APIServerErrorBudget(datasourceName string, labelMatchers ...*labels.Matche
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["APIServerErrorBudget"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("errorbudget"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"APIServerErrorBudget",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("errorbudget"),
 		),
 	)
 }
 
-func APIServerReadAvailability(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func APIServerReadAvailability(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Read Availability (30d)",
 		panel.Description("How many percent of read requests (LIST,GET) in 30 days have been answered successfully and fast enough?"),
 		statPanel.Chart(
@@ -103,19 +98,16 @@ func APIServerReadAvailability(datasourceName string, labelMatchers ...*labels.M
 			}),
 			statPanel.ValueFontSize(50),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["APIServerReadAvailability"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"APIServerReadAvailability",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
 
-func APIServerReadSLIRequests(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func APIServerReadSLIRequests(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Read SLI - Requests",
 		panel.Description("How many read requests (LIST,GET) per second do the apiservers get by code?"),
 		timeSeriesPanel.Chart(
@@ -138,20 +130,17 @@ func APIServerReadSLIRequests(datasourceName string, labelMatchers ...*labels.Ma
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["APIServerReadSLIRequests"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ code }}"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"APIServerReadSLIRequests",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ code }}"),
 		),
 	)
 }
 
-func APIServerReadSLIErrors(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func APIServerReadSLIErrors(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Read SLI - Errors",
 		panel.Description("How many percent of read requests (LIST,GET) per second are returned with errors (5xx)?"),
 		timeSeriesPanel.Chart(
@@ -173,20 +162,17 @@ func APIServerReadSLIErrors(datasourceName string, labelMatchers ...*labels.Matc
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["APIServerReadSLIErrors"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ resource }}"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"APIServerReadSLIErrors",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ resource }}"),
 		),
 	)
 }
 
-func APIServerReadSLIDuration(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func APIServerReadSLIDuration(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Read SLI - Duration",
 		panel.Description("How many seconds is the 99th percentile for reading (LIST|GET) a given resource?"),
 		timeSeriesPanel.Chart(
@@ -207,20 +193,17 @@ func APIServerReadSLIDuration(datasourceName string, labelMatchers ...*labels.Ma
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["APIServerReadSLIDuration"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ resource }}"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"APIServerReadSLIDuration",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ resource }}"),
 		),
 	)
 }
 
-func APIServerWriteAvailability(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func APIServerWriteAvailability(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Write Availability (30d)",
 		panel.Description("How many percent of write requests (POST|PUT|PATCH|DELETE) in 30 days have been answered successfully and fast enough?"),
 		statPanel.Chart(
@@ -231,19 +214,16 @@ func APIServerWriteAvailability(datasourceName string, labelMatchers ...*labels.
 			}),
 			statPanel.ValueFontSize(50),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["APIServerWriteAvailability"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"APIServerWriteAvailability",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
 
-func APIServerWriteSLIRequests(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func APIServerWriteSLIRequests(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Write SLI - Requests",
 		panel.Description("How many write requests (POST|PUT|PATCH|DELETE) per second do the apiservers get by code?"),
 		timeSeriesPanel.Chart(
@@ -266,20 +246,17 @@ func APIServerWriteSLIRequests(datasourceName string, labelMatchers ...*labels.M
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["APIServerWriteSLIRequests"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ code }}"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"APIServerWriteSLIRequests",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ code }}"),
 		),
 	)
 }
 
-func APIServerWriteSLIErrors(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func APIServerWriteSLIErrors(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Write SLI - Errors",
 		panel.Description("How many percent of write requests (POST|PUT|PATCH|DELETE) per second are returned with errors (5xx)?"),
 		timeSeriesPanel.Chart(
@@ -301,20 +278,17 @@ func APIServerWriteSLIErrors(datasourceName string, labelMatchers ...*labels.Mat
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["APIServerWriteSLIErrors"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ resource }}"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"APIServerWriteSLIErrors",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ resource }}"),
 		),
 	)
 }
 
-func APIServerWriteSLIDuration(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func APIServerWriteSLIDuration(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Write SLI - Duration",
 		panel.Description("How many seconds is the 99th percentile for writing (POST|PUT|PATCH|DELETE) a given resource?"),
 		timeSeriesPanel.Chart(
@@ -335,20 +309,17 @@ func APIServerWriteSLIDuration(datasourceName string, labelMatchers ...*labels.M
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["APIServerWriteSLIDuration"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{ resource }}"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"APIServerWriteSLIDuration",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{ resource }}"),
 		),
 	)
 }
 
-func APIServerWorkQueueAddRate(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func APIServerWorkQueueAddRate(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Work Queue Add Rate",
 		panel.Description("Shows the rate of work queue add events."),
 		timeSeriesPanel.Chart(
@@ -370,20 +341,17 @@ func APIServerWorkQueueAddRate(datasourceName string, labelMatchers ...*labels.M
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["APIServerWorkQueueAddRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} {{name}}"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"APIServerWorkQueueAddRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} {{name}}"),
 		),
 	)
 }
 
-func APIServerWorkQueueDepth(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func APIServerWorkQueueDepth(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Work Queue Depth",
 		panel.Description("Shows the depth of the work queue."),
 		timeSeriesPanel.Chart(
@@ -405,20 +373,17 @@ func APIServerWorkQueueDepth(datasourceName string, labelMatchers ...*labels.Mat
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["APIServerWorkQueueDepth"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} {{name}}"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"APIServerWorkQueueDepth",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} {{name}}"),
 		),
 	)
 }
 
-func APIServerWorkQueueLatency(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func APIServerWorkQueueLatency(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Work Queue Latency",
 		panel.Description("Shows the 99th percentile latency of items queued in the work queue"),
 		timeSeriesPanel.Chart(
@@ -440,15 +405,12 @@ func APIServerWorkQueueLatency(datasourceName string, labelMatchers ...*labels.M
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["APIServerWorkQueueLatency"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} {{name}}"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"APIServerWorkQueueLatency",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} {{name}}"),
 		),
 	)
 }
@@ -18,14 +18,14 @@ import (
 	"github.com/perses/community-mixins/pkg/promql"
 	"github.com/perses/perses/go-sdk/panel"
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
-	"github.com/perses/plugins/prometheus/sdk/go/query"
 
 	commonSdk "github.com/perses/perses/go-sdk/common"
 	tablePanel "github.com/perses/plugins/table/sdk/go"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func ClusterCPUUsageQuota(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ClusterCPUUsageQuota(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("CPU Quota",
 		panel.Description("Shows the CPU requests, limits, and usage of workloads by namespace in tabular format."),
 		tablePanel.Table(
@@ -110,73 +110,52 @@ func ClusterCPUUsageQuota(datasourceName string, labelMatchers ...*labels.Matche
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterCPUUsageQuotaPodOwn"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterCPUUsageQuotaPodOwn",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterCPUUsageQuotaNSwWorkload"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterCPUUsageQuotaNSwWorkload",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterCPUUsageQuotaNodeNSCPU"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterCPUUsageQuotaNodeNSCPU",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterCPUUsageQuotaNSKubePodContainer"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterCPUUsageQuotaNSKubePodContainer",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterCPUUsageQuotaNSKubePodContainerDiv"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterCPUUsageQuotaNSKubePodContainerDiv",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterCPUUsageQuotaNSCPUKubePodResources"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterCPUUsageQuotaNSCPUKubePodResources",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterCPUUsageQuotaNSCPUKubePodResourcesDiv"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterCPUUsageQuotaNSCPUKubePodResourcesDiv",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
 
-func ClusterMemoryUsageQuota(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ClusterMemoryUsageQuota(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Memory Requests by Namespace",
 		panel.Description("Shows the memory requests, limits, and usage of workloads by namespace in tabular format."),
 		tablePanel.Table(
@@ -258,73 +237,52 @@ func ClusterMemoryUsageQuota(datasourceName string, labelMatchers ...*labels.Mat
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterMemoryUsageQuotaPodOwner"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterMemoryUsageQuotaPodOwner",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterMemoryUsageQuotaNSWorkloadPodOwner"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterMemoryUsageQuotaNSWorkloadPodOwner",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterMemoryUsageQuotaContainerMem"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterMemoryUsageQuotaContainerMem",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterMemoryUsageQuotaContainerResourceReqSum"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterMemoryUsageQuotaContainerResourceReqSum",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterMemoryUsageQuotaContainerResourceReqSumDiv"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterMemoryUsageQuotaContainerResourceReqSumDiv",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterMemoryUsageQuotaContainerReqLimits"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterMemoryUsageQuotaContainerReqLimits",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterMemoryUsageQuotaContainerReqLimitsDiv"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterMemoryUsageQuotaContainerReqLimitsDiv",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
 
-func ClusterCurrentNetworkUsage(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ClusterCurrentNetworkUsage(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Current Network Usage",
 		panel.Description("Shows the current network usage of the cluster by namespace."),
 		tablePanel.Table(
@@ -396,64 +354,46 @@ func ClusterCurrentNetworkUsage(datasourceName string, labelMatchers ...*labels.
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterCurrentNetworkUsageBytesTotal"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterCurrentNetworkUsageBytesTotal",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterCurrentNetworkTransmitBytesTotal"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterCurrentNetworkTransmitBytesTotal",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterCurrentNetworkReceivedTotal"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterCurrentNetworkReceivedTotal",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterCurrentNetworkTransmitPacketsTotal"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterCurrentNetworkTransmitPacketsTotal",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterCurrentNetworkReceivedPacketsDroppedTotal"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterCurrentNetworkReceivedPacketsDroppedTotal",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterCurrentNetworkTransmitPacketsDroppedTotal"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterCurrentNetworkTransmitPacketsDroppedTotal",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
 
-func ClusterCurrentStorageIO(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ClusterCurrentStorageIO(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Current Storage IO",
 		panel.Description("Shows the current storage IO of the cluster in tabular form, by namespace."),
 		tablePanel.Table(
@@ -525,59 +465,41 @@ func ClusterCurrentStorageIO(datasourceName string, labelMatchers ...*labels.Mat
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterCurrentStorageIOFsReadsTotal"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterCurrentStorageIOFsReadsTotal",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterCurrentStorageIOFsWritesTotal"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterCurrentStorageIOFsWritesTotal",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterCurrentStorageIOFsReadsWritesTotal"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterCurrentStorageIOFsReadsWritesTotal",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterCurrentStorageIOFsReadsBytesTotal"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterCurrentStorageIOFsReadsBytesTotal",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterCurrentStorageIOFsWritesBytesTotal"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterCurrentStorageIOFsWritesBytesTotal",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterCurrentStorageIOFsReadsWritesBytesTotal"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterCurrentStorageIOFsReadsWritesBytesTotal",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
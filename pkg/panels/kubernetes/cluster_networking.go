@@ -24,9 +24,10 @@ import (
 	tablePanel "github.com/perses/plugins/table/sdk/go"
 	timeSeriesPanel "github.com/perses/plugins/timeserieschart/sdk/go"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func ClusterTCPRetransmitRate(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ClusterTCPRetransmitRate(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Rate of TCP Retransmits out of all sent segments",
 		panel.Description("Shows the rate of TCP retransmits out of all sent segments in a cluster."),
 		timeSeriesPanel.Chart(
@@ -48,20 +49,17 @@ func ClusterTCPRetransmitRate(datasourceName string, labelMatchers ...*labels.Ma
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterTCPRetransmitRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}}"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterTCPRetransmitRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}}"),
 		),
 	)
 }
 
-func ClusterTCPSYNRetransmitRate(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ClusterTCPSYNRetransmitRate(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Rate of TCP SYN Retransmits out of all sent segments",
 		panel.Description("Shows the rate of TCP SYN retransmits out of all sent segments in a cluster."),
 		timeSeriesPanel.Chart(
@@ -83,20 +81,17 @@ func ClusterTCPSYNRetransmitRate(datasourceName string, labelMatchers ...*labels
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterTCPSYNRetransmitRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}}"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterTCPSYNRetransmitRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}}"),
 		),
 	)
 }
 
-func ClusterNetworkingCurrentStatus(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ClusterNetworkingCurrentStatus(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Current Status",
 		panel.Description("Shows the current network status of the cluster by namespace."),
 		tablePanel.Table(
@@ -184,77 +179,53 @@ func ClusterNetworkingCurrentStatus(datasourceName string, labelMatchers ...*lab
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterNetworkingCurrentStatus1"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterNetworkingCurrentStatus1",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterNetworkingCurrentStatus2"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterNetworkingCurrentStatus2",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterNetworkingCurrentStatus3"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterNetworkingCurrentStatus3",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterNetworkingCurrentStatus4"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterNetworkingCurrentStatus4",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterNetworkingCurrentStatus5"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterNetworkingCurrentStatus5",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterNetworkingCurrentStatus6"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterNetworkingCurrentStatus6",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterNetworkingCurrentStatus7"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterNetworkingCurrentStatus7",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ClusterNetworkingCurrentStatus8"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ClusterNetworkingCurrentStatus8",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
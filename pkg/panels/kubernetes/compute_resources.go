@@ -24,67 +24,56 @@ import (
 	statPanel "github.com/perses/plugins/statchart/sdk/go"
 	timeSeriesPanel "github.com/perses/plugins/timeserieschart/sdk/go"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func KubernetesCPUUtilizationStat(granularity, datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func KubernetesCPUUtilizationStat(granularity, datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	var panelName, description string
-	var queries []panel.Option
+	var queryOptions []panel.Option
 
 	switch granularity {
 	case "multicluster":
 		panelName = "CPU Utilization"
 		description = "Shows the CPU utilization of all clusters."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesCPUUtilizationStatAll"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesCPUUtilizationStatAll",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
 			),
 		}
 	case "cluster":
 		panelName = "CPU Utilization"
 		description = "Shows the CPU utilization of the cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesCPUUtilizationStatCluster"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesCPUUtilizationStatCluster",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
 			),
 		}
 	case "namespace-requests":
 		panelName = "CPU Utilization (from requests)"
 		description = "Shows the CPU utilization of the namespace from pod CPU requests."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesCPUUtilizationStatNSPod"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesCPUUtilizationStatNSPod",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
 			),
 		}
 	case "namespace-limits":
 		panelName = "CPU Utilization (from limits)"
 		description = "Shows the CPU utilization of the namespace from pod CPU limits."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesCPUUtilizationStatNSPodLimits"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesCPUUtilizationStatNSPodLimits",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
 			),
 		}
 	}
@@ -99,40 +88,34 @@ func KubernetesCPUUtilizationStat(granularity, datasourceName string, labelMatch
 			statPanel.ValueFontSize(50),
 		),
 	}
-	panelOpts = append(panelOpts, queries...)
+	panelOpts = append(panelOpts, queryOptions...)
 
 	return panelgroup.AddPanel(panelName, panelOpts...)
 }
 
-func KubernetesCPURequestsCommitmentStat(granularity, datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func KubernetesCPURequestsCommitmentStat(granularity, datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	var description string
-	var queries []panel.Option
+	var queryOptions []panel.Option
 
 	switch granularity {
 	case "multicluster":
 		description = "Shows the CPU requests commitment of all clusters."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesCPURequestsCommitmentStatAllClusters"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesCPURequestsCommitmentStatAllClusters",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
 			),
 		}
 	case "cluster":
 		description = "Shows the CPU requests commitment of the cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesCPURequestsCommitmentStatReqClusters"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesCPURequestsCommitmentStatReqClusters",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
 			),
 		}
 	}
@@ -147,40 +130,34 @@ func KubernetesCPURequestsCommitmentStat(granularity, datasourceName string, lab
 			statPanel.ValueFontSize(50),
 		),
 	}
-	panelOpts = append(panelOpts, queries...)
+	panelOpts = append(panelOpts, queryOptions...)
 
 	return panelgroup.AddPanel("CPU Requests Commitment", panelOpts...)
 }
 
-func KubernetesCPULimitsCommitmentStat(granularity, datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func KubernetesCPULimitsCommitmentStat(granularity, datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	var description string
-	var queries []panel.Option
+	var queryOptions []panel.Option
 
 	switch granularity {
 	case "multicluster":
 		description = "Shows the CPU limits commitment of all clusters."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesCPULimitsCommitmentStatAllClusters"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesCPULimitsCommitmentStatAllClusters",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
 			),
 		}
 	case "cluster":
 		description = "Shows the CPU limits commitment of the cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesCPULimitsCommitmentStatReqClusters"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesCPULimitsCommitmentStatReqClusters",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
 			),
 		}
 	}
@@ -195,70 +172,58 @@ func KubernetesCPULimitsCommitmentStat(granularity, datasourceName string, label
 			statPanel.ValueFontSize(50),
 		),
 	}
-	panelOpts = append(panelOpts, queries...)
+	panelOpts = append(panelOpts, queryOptions...)
 
 	return panelgroup.AddPanel("CPU Limits Commitment", panelOpts...)
 }
 
-func KubernetesMemoryUtilizationStat(granularity, datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func KubernetesMemoryUtilizationStat(granularity, datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	var panelName, description string
-	var queries []panel.Option
+	var queryOptions []panel.Option
 
 	switch granularity {
 	case "multicluster":
 		panelName = "Memory Utilization"
 		description = "Shows the Memory utilization of all clusters."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesMemoryUtilizationStatMultiCluster"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesMemoryUtilizationStatMultiCluster",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
 			),
 		}
 	case "cluster":
 		panelName = "Memory Utilization"
 		description = "Shows the Memory utilization of the cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesMemoryUtilizationStatCluster"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesMemoryUtilizationStatCluster",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
 			),
 		}
 	case "namespace-requests":
 		panelName = "Memory Utilization (from requests)"
 		description = "Shows the Memory utilization of the namespace from pod memory requests."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesMemoryUtilizationNSRequests"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesMemoryUtilizationNSRequests",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
 			),
 		}
 	case "namespace-limits":
 		panelName = "Memory Utilization (from limits)"
 		description = "Shows the Memory utilization of the namespace from pod memory limits."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesMemoryUtilizationNSLimits"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesMemoryUtilizationNSLimits",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
 			),
 		}
 	}
@@ -273,40 +238,34 @@ func KubernetesMemoryUtilizationStat(granularity, datasourceName string, labelMa
 			statPanel.ValueFontSize(50),
 		),
 	}
-	panelOpts = append(panelOpts, queries...)
+	panelOpts = append(panelOpts, queryOptions...)
 
 	return panelgroup.AddPanel(panelName, panelOpts...)
 }
 
-func KubernetesMemoryRequestsCommitmentStat(granularity, datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func KubernetesMemoryRequestsCommitmentStat(granularity, datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	var description string
-	var queries []panel.Option
+	var queryOptions []panel.Option
 
 	switch granularity {
 	case "multicluster":
 		description = "Shows the Memory requests commitment of all clusters."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesMemoryRequestsCommitmentStat1"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesMemoryRequestsCommitmentStat1",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
 			),
 		}
 	case "cluster":
 		description = "Shows the Memory requests commitment of the cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesMemoryRequestsCommitmentStat2"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesMemoryRequestsCommitmentStat2",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
 			),
 		}
 	}
@@ -321,40 +280,34 @@ func KubernetesMemoryRequestsCommitmentStat(granularity, datasourceName string,
 			statPanel.ValueFontSize(50),
 		),
 	}
-	panelOpts = append(panelOpts, queries...)
+	panelOpts = append(panelOpts, queryOptions...)
 
 	return panelgroup.AddPanel("Memory Requests Commitment", panelOpts...)
 }
 
-func KubernetesMemoryLimitsCommitmentStat(granularity, datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func KubernetesMemoryLimitsCommitmentStat(granularity, datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	var description string
-	var queries []panel.Option
+	var queryOptions []panel.Option
 
 	switch granularity {
 	case "multicluster":
 		description = "Shows the Memory limits commitment of all clusters."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesMemoryLimitsCommitmentStat1"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesMemoryLimitsCommitmentStat1",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
 			),
 		}
 	case "cluster":
 		description = "Shows the Memory limits commitment of the cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesMemoryLimitsCommitmentStat2"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesMemoryLimitsCommitmentStat2",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
 			),
 		}
 	}
@@ -369,182 +322,140 @@ func KubernetesMemoryLimitsCommitmentStat(granularity, datasourceName string, la
 			statPanel.ValueFontSize(50),
 		),
 	}
-	panelOpts = append(panelOpts, queries...)
+	panelOpts = append(panelOpts, queryOptions...)
 
 	return panelgroup.AddPanel("Memory Limits Commitment", panelOpts...)
 }
 
-func KubernetesCPUUsage(granularity, datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
-	var queries []panel.Option
+func KubernetesCPUUsage(granularity, datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
+	var queryOptions []panel.Option
 	var description string
 
 	switch granularity {
 	case "multicluster":
 		description = "Shows the CPU usage of each cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesCPUUsage1"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{cluster}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesCPUUsage1",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{cluster}}"),
 			),
 		}
 	case "cluster":
 		description = "Shows the CPU usage of the cluster by namespace."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesCPUUsage2"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{namespace}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesCPUUsage2",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{namespace}}"),
 			),
 		}
 	case "node":
 		description = "Shows the CPU usage of the node by pod, and the CPU capacity of the node."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesCPUUsage3"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("max capacity"),
-				),
-			),
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesCPUUsage4"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesCPUUsage3",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("max capacity"),
+			),
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesCPUUsage4",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "namespace-pod":
 		description = "Shows the CPU usage of the namespace by pod, and the CPU resource quota of the namespace."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesCPUUsage5"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
-			),
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesCPUUsage6"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("quota - requests"),
-				),
-			),
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesCPUUsage7"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("quota - limits"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesCPUUsage5",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
+			),
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesCPUUsage6",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("quota - requests"),
+			),
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesCPUUsage7",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("quota - limits"),
 			),
 		}
 	case "namespace-workload":
 		description = "Shows the CPU usage of the namespace by workload, and the CPU resource quota of the namespace."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesCPUUsage8"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{workload}} - {{workload_type}}"),
-				),
-			),
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesCPUUsage9"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("quota - requests"),
-				),
-			),
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesCPUUsage10"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("quota - limits"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesCPUUsage8",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{workload}} - {{workload_type}}"),
+			),
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesCPUUsage9",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("quota - requests"),
+			),
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesCPUUsage10",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("quota - limits"),
 			),
 		}
 	case "workload":
 		description = "Shows the CPU usage of the workload (deployment, statefulset, job, cronjob, daemonset, etc.) by pod."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesCPUUsage11"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesCPUUsage11",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "pod":
 		description = "Shows the CPU usage of the pod by container, alongwith the requests and limits."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesCPUUsage12"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{container}}"),
-				),
-			),
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesCPUUsage13"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("requests"),
-				),
-			),
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesCPUUsage14"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("limits"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesCPUUsage12",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{container}}"),
+			),
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesCPUUsage13",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("requests"),
+			),
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesCPUUsage14",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("limits"),
 			),
 		}
 	}
@@ -571,206 +482,158 @@ func KubernetesCPUUsage(granularity, datasourceName string, labelMatchers ...*la
 			}),
 		),
 	}
-	panelOpts = append(panelOpts, queries...)
+	panelOpts = append(panelOpts, queryOptions...)
 
 	return panelgroup.AddPanel("CPU Usage", panelOpts...)
 }
 
-func KubernetesMemoryUsage(granularity, datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
-	var queries []panel.Option
+func KubernetesMemoryUsage(granularity, datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
+	var queryOptions []panel.Option
 	var description string
 
 	switch granularity {
 	case "multicluster":
 		description = "Shows memory usage w/o cache, for each cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesMemoryUsage1"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{cluster}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesMemoryUsage1",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{cluster}}"),
 			),
 		}
 	case "cluster":
 		description = "Shows the memory usage of the cluster by namespace."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesMemoryUsage2"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{namespace}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesMemoryUsage2",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{namespace}}"),
 			),
 		}
 	case "node-with-cache":
 		description = "Shows the memory usage of the node by pod, and the memory capacity of the node."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesMemoryUsage3"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("max capacity"),
-				),
-			),
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesMemoryUsage4"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesMemoryUsage3",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("max capacity"),
+			),
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesMemoryUsage4",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "node-without-cache":
 		description = "Shows the memory usage (RSS) of the node by pod, and the memory capacity of the node."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesMemoryUsage5"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("max capacity"),
-				),
-			),
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesMemoryUsage6"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesMemoryUsage5",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("max capacity"),
+			),
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesMemoryUsage6",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "namespace-pod":
 		description = "Shows the memory usage of the namespace by pod, and the memory resource quota of the namespace."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesMemoryUsage7"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
-			),
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesMemoryUsage8"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("quota - requests"),
-				),
-			),
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesMemoryUsage9"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("quota - limits"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesMemoryUsage7",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
+			),
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesMemoryUsage8",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("quota - requests"),
+			),
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesMemoryUsage9",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("quota - limits"),
 			),
 		}
 	case "namespace-workload":
 		description = "Shows the memory usage of the namespace by workload, and the memory resource quota of the namespace."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesMemoryUsage10"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{workload}} - {{workload_type}}"),
-				),
-			),
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesMemoryUsage11"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("quota - requests"),
-				),
-			),
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesMemoryUsage12"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("quota - limits"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesMemoryUsage10",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{workload}} - {{workload_type}}"),
+			),
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesMemoryUsage11",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("quota - requests"),
+			),
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesMemoryUsage12",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("quota - limits"),
 			),
 		}
 	case "workload":
 		description = "Shows the memory usage of the workload (deployment, statefulset, job, cronjob, daemonset, etc.) by pod."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesMemoryUsage13"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesMemoryUsage13",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "pod":
 		description = "Shows the memory usage (WSS) of the pod by container, alongwith the requests and limits."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesMemoryUsage14"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{container}}"),
-				),
-			),
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesMemoryUsage15"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("requests"),
-				),
-			),
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesMemoryUsage16"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("limits"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesMemoryUsage14",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{container}}"),
+			),
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesMemoryUsage15",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("requests"),
+			),
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesMemoryUsage16",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("limits"),
 			),
 		}
 	}
@@ -797,7 +660,7 @@ func KubernetesMemoryUsage(granularity, datasourceName string, labelMatchers ...
 			}),
 		),
 	}
-	panelOpts = append(panelOpts, queries...)
+	panelOpts = append(panelOpts, queryOptions...)
 
 	return panelgroup.AddPanel("Memory Usage", panelOpts...)
 }
@@ -24,9 +24,10 @@ import (
 	statPanel "github.com/perses/plugins/statchart/sdk/go"
 	timeSeriesPanel "github.com/perses/plugins/timeserieschart/sdk/go"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func ControllerManagerUpStatus(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ControllerManagerUpStatus(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Up",
 		panel.Description("Shows the status of the controller manager."),
 		statPanel.Chart(
@@ -36,19 +37,16 @@ func ControllerManagerUpStatus(datasourceName string, labelMatchers ...*labels.M
 			}),
 			statPanel.ValueFontSize(50),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ControllerManagerUpStatus"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ControllerManagerUpStatus",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
 
-func WorkQueueAddRate(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func WorkQueueAddRate(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Work Queue Add Rate",
 		panel.Description("Shows the rate of work queue add events."),
 		timeSeriesPanel.Chart(
@@ -70,20 +68,17 @@ func WorkQueueAddRate(datasourceName string, labelMatchers ...*labels.Matcher) p
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["WorkQueueAddRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{cluster}} {{instance}} {{name}}"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"WorkQueueAddRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{cluster}} {{instance}} {{name}}"),
 		),
 	)
 }
 
-func WorkQueueDepth(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func WorkQueueDepth(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Work Queue Depth",
 		panel.Description("Shows the depth of the work queue."),
 		timeSeriesPanel.Chart(
@@ -105,20 +100,17 @@ func WorkQueueDepth(datasourceName string, labelMatchers ...*labels.Matcher) pan
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["WorkQueueDepth"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{cluster}} {{instance}} {{name}}"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"WorkQueueDepth",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{cluster}} {{instance}} {{name}}"),
 		),
 	)
 }
 
-func WorkQueueLatency(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func WorkQueueLatency(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Work Queue Latency",
 		panel.Description("Shows the 99th percentile latency of items queued in the work queue."),
 		timeSeriesPanel.Chart(
@@ -140,15 +132,12 @@ func WorkQueueLatency(datasourceName string, labelMatchers ...*labels.Matcher) p
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["WorkQueueLatency"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{cluster}} {{instance}} {{name}}"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"WorkQueueLatency",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{cluster}} {{instance}} {{name}}"),
 		),
 	)
 }
@@ -0,0 +1,115 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"github.com/perses/promql-builder/label"
+	"github.com/prometheus/prometheus/model/labels"
+)
+
+// JobLabelValues holds the job label values selecting each Kubernetes component, to emulate
+// customization behaviors https://github.com/kubernetes-monitoring/kubernetes-mixin?tab=readme-ov-file#customising-the-mixin.
+// It is passed by value to the dashboard and rule builders, so that several flavours can be
+// built side by side.
+type JobLabelValues struct {
+	APIServer         string
+	Kubelet           string
+	NodeExporter      string
+	ControllerManager string
+	Scheduler         string
+	KubeProxy         string
+	KubeStateMetrics  string
+	CAdvisor          string
+}
+
+// DefaultJobLabelValues returns the job label values of the upstream kubernetes-mixin.
+func DefaultJobLabelValues() JobLabelValues {
+	return JobLabelValues{
+		APIServer:         "kube-apiserver",
+		Kubelet:           "kubelet",
+		NodeExporter:      "node-exporter",
+		ControllerManager: "kube-controller-manager",
+		Scheduler:         "kube-scheduler",
+		KubeProxy:         "kube-proxy",
+		KubeStateMetrics:  "kube-state-metrics",
+		CAdvisor:          "cadvisor",
+	}
+}
+
+// WithDefaults returns a copy of j where every empty value is replaced by its default.
+func (j JobLabelValues) WithDefaults() JobLabelValues {
+	defaults := DefaultJobLabelValues()
+	for _, field := range []struct {
+		value        *string
+		defaultValue string
+	}{
+		{&j.APIServer, defaults.APIServer},
+		{&j.Kubelet, defaults.Kubelet},
+		{&j.NodeExporter, defaults.NodeExporter},
+		{&j.ControllerManager, defaults.ControllerManager},
+		{&j.Scheduler, defaults.Scheduler},
+		{&j.KubeProxy, defaults.KubeProxy},
+		{&j.KubeStateMetrics, defaults.KubeStateMetrics},
+		{&j.CAdvisor, defaults.CAdvisor},
+	} {
+		if *field.value == "" {
+			*field.value = field.defaultValue
+		}
+	}
+	return j
+}
+
+func jobMatcher(labelValue string) *labels.Matcher {
+	return label.New("job").Equal(labelValue)
+}
+
+// CAdvisorMatcher returns the matcher for the cadvisor job.
+func (j JobLabelValues) CAdvisorMatcher() *labels.Matcher {
+	return jobMatcher(j.CAdvisor)
+}
+
+// KubeStateMetricsMatcher returns the matcher for the kube-state-metrics job.
+func (j JobLabelValues) KubeStateMetricsMatcher() *labels.Matcher {
+	return jobMatcher(j.KubeStateMetrics)
+}
+
+// KubeletMatcher returns the matcher for the kubelet job.
+func (j JobLabelValues) KubeletMatcher() *labels.Matcher {
+	return jobMatcher(j.Kubelet)
+}
+
+// APIServerMatcher returns the matcher for the api server job.
+func (j JobLabelValues) APIServerMatcher() *labels.Matcher {
+	return jobMatcher(j.APIServer)
+}
+
+// NodeExporterMatcher returns the matcher for the node-exporter job.
+func (j JobLabelValues) NodeExporterMatcher() *labels.Matcher {
+	return jobMatcher(j.NodeExporter)
+}
+
+// ControllerManagerMatcher returns the matcher for the controller-manager job.
+func (j JobLabelValues) ControllerManagerMatcher() *labels.Matcher {
+	return jobMatcher(j.ControllerManager)
+}
+
+// SchedulerMatcher returns the matcher for the scheduler job.
+func (j JobLabelValues) SchedulerMatcher() *labels.Matcher {
+	return jobMatcher(j.Scheduler)
+}
+
+// KubeProxyMatcher returns the matcher for the kube-proxy job.
+func (j JobLabelValues) KubeProxyMatcher() *labels.Matcher {
+	return jobMatcher(j.KubeProxy)
+}
@@ -0,0 +1,62 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/prometheus/prometheus/model/labels"
+)
+
+func TestJobLabelValuesMatchers(t *testing.T) {
+	tests := []struct {
+		name         string
+		matcher      func(JobLabelValues) *labels.Matcher
+		set          func(*JobLabelValues, string)
+		defaultValue string
+	}{
+		{"APIServer", JobLabelValues.APIServerMatcher, func(j *JobLabelValues, v string) { j.APIServer = v }, "kube-apiserver"},
+		{"Kubelet", JobLabelValues.KubeletMatcher, func(j *JobLabelValues, v string) { j.Kubelet = v }, "kubelet"},
+		{"NodeExporter", JobLabelValues.NodeExporterMatcher, func(j *JobLabelValues, v string) { j.NodeExporter = v }, "node-exporter"},
+		{"ControllerManager", JobLabelValues.ControllerManagerMatcher, func(j *JobLabelValues, v string) { j.ControllerManager = v }, "kube-controller-manager"},
+		{"Scheduler", JobLabelValues.SchedulerMatcher, func(j *JobLabelValues, v string) { j.Scheduler = v }, "kube-scheduler"},
+		{"KubeProxy", JobLabelValues.KubeProxyMatcher, func(j *JobLabelValues, v string) { j.KubeProxy = v }, "kube-proxy"},
+		{"KubeStateMetrics", JobLabelValues.KubeStateMetricsMatcher, func(j *JobLabelValues, v string) { j.KubeStateMetrics = v }, "kube-state-metrics"},
+		{"CAdvisor", JobLabelValues.CAdvisorMatcher, func(j *JobLabelValues, v string) { j.CAdvisor = v }, "cadvisor"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defaults := DefaultJobLabelValues()
+			wantDefault := `job="` + tt.defaultValue + `"`
+			if got := tt.matcher(defaults).String(); got != wantDefault {
+				t.Errorf("default matcher = %q, want %q", got, wantDefault)
+			}
+
+			custom := defaults
+			tt.set(&custom, "my-job")
+			if got := tt.matcher(custom).String(); got != `job="my-job"` {
+				t.Errorf("custom matcher = %q, want %q", got, `job="my-job"`)
+			}
+			if got := tt.matcher(defaults).String(); got != wantDefault {
+				t.Errorf("customizing a copy changed the defaults matcher to %q", got)
+			}
+
+			empty := JobLabelValues{}
+			if got := tt.matcher(empty.WithDefaults()).String(); got != wantDefault {
+				t.Errorf("WithDefaults() matcher = %q, want %q", got, wantDefault)
+			}
+		})
+	}
+}
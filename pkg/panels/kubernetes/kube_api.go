@@ -22,9 +22,10 @@ import (
 	"github.com/perses/plugins/prometheus/sdk/go/query"
 	timeSeriesPanel "github.com/perses/plugins/timeserieschart/sdk/go"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func KubeAPIRequestRate(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func KubeAPIRequestRate(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Kube API Request Rate",
 		panel.Description("Shows the rate of requests to the Kube API."),
 		timeSeriesPanel.Chart(
@@ -47,50 +48,38 @@ func KubeAPIRequestRate(datasourceName string, labelMatchers ...*labels.Matcher)
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["KubeAPIRequestRate1"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("2xx"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"KubeAPIRequestRate1",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("2xx"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["KubeAPIRequestRate2"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("3xx"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"KubeAPIRequestRate2",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("3xx"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["KubeAPIRequestRate3"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("4xx"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"KubeAPIRequestRate3",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("4xx"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["KubeAPIRequestRate4"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("5xx"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"KubeAPIRequestRate4",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("5xx"),
 		),
 	)
 }
 
-func PostRequestLatency(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func PostRequestLatency(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Post Request Latency 99th Quantile",
 		panel.Description("Shows the 99th quantile latency of post requests to the Kube API."),
 		timeSeriesPanel.Chart(
@@ -112,20 +101,17 @@ func PostRequestLatency(datasourceName string, labelMatchers ...*labels.Matcher)
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["PostRequestLatency"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{verb}}"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"PostRequestLatency",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{verb}}"),
 		),
 	)
 }
 
-func GetRequestLatency(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func GetRequestLatency(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Get Request Latency 99th Quantile",
 		panel.Description("Shows the 99th quantile latency of get requests to the Kube API."),
 		timeSeriesPanel.Chart(
@@ -147,15 +133,12 @@ func GetRequestLatency(datasourceName string, labelMatchers ...*labels.Matcher)
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["GetRequestLatency"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{verb}}"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"GetRequestLatency",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{verb}}"),
 		),
 	)
 }
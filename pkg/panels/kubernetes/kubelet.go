@@ -24,9 +24,10 @@ import (
 
 	commonSdk "github.com/perses/perses/go-sdk/common"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func RunningKubeletStat(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func RunningKubeletStat(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Running Kubelets",
 		panel.Description("Number of Running Kubelets Instances"),
 		statPanel.Chart(
@@ -36,19 +37,16 @@ func RunningKubeletStat(datasourceName string, labelMatchers ...*labels.Matcher)
 				DecimalPlaces: 0,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["RunningKubeletStat"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"RunningKubeletStat",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
 
-func RunningPodStat(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func RunningPodStat(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Running Pods",
 		panel.Description("Total Number of Running Pods"),
 		statPanel.Chart(
@@ -58,19 +56,16 @@ func RunningPodStat(datasourceName string, labelMatchers ...*labels.Matcher) pan
 				DecimalPlaces: 0,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["RunningPodStat"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"RunningPodStat",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
 
-func RunningContainersStat(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func RunningContainersStat(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Running Containers",
 		panel.Description("Total Number of Running Containers"),
 		statPanel.Chart(
@@ -80,19 +75,16 @@ func RunningContainersStat(datasourceName string, labelMatchers ...*labels.Match
 				DecimalPlaces: 0,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["RunningContainersStat"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"RunningContainersStat",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
 
-func ActVolumeCountStat(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ActVolumeCountStat(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Actual Volume Count",
 		panel.Description("Total Number of Volumes Currently Mounted"),
 		statPanel.Chart(
@@ -102,19 +94,16 @@ func ActVolumeCountStat(datasourceName string, labelMatchers ...*labels.Matcher)
 				DecimalPlaces: 0,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ActVolumeCountStat"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ActVolumeCountStat",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
 
-func DesiredVolumeCountStat(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func DesiredVolumeCountStat(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Desired Volume Count",
 		panel.Description("Total Number of Desired Volume Mounts"),
 		statPanel.Chart(
@@ -124,19 +113,16 @@ func DesiredVolumeCountStat(datasourceName string, labelMatchers ...*labels.Matc
 				DecimalPlaces: 0,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["DesiredVolumeCountStat"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"DesiredVolumeCountStat",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
 
-func ConfigErrorCountStat(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ConfigErrorCountStat(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Config Error Count",
 		panel.Description("Node Config Error Count Per Second"),
 		statPanel.Chart(
@@ -146,19 +132,16 @@ func ConfigErrorCountStat(datasourceName string, labelMatchers ...*labels.Matche
 				DecimalPlaces: 0,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ConfigErrorCountStat"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ConfigErrorCountStat",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
 
-func OperationRate(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func OperationRate(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Operation Rate",
 		panel.Description("Rate of Container Runtime Operations, grouped by the type of Operation and kubelet instance"),
 		timeSeriesPanel.Chart(
@@ -181,20 +164,17 @@ func OperationRate(datasourceName string, labelMatchers ...*labels.Matcher) pane
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["OperationRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} {{operation_type}}"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"OperationRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} {{operation_type}}"),
 		),
 	)
 }
 
-func OperationErrorRate(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func OperationErrorRate(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Operation Error Rate",
 		panel.Description("Rate of Container Runtime Operations Errors, grouped by the type of Operation and kubelet instance"),
 		timeSeriesPanel.Chart(
@@ -217,20 +197,17 @@ func OperationErrorRate(datasourceName string, labelMatchers ...*labels.Matcher)
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["OperationErrorRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} {{operation_type}}"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"OperationErrorRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} {{operation_type}}"),
 		),
 	)
 }
 
-func OperationDurationQuantile(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func OperationDurationQuantile(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Operation Duration 99th quantile",
 		panel.Description("99th percentile latency (in seconds) for each runtime operation"),
 		timeSeriesPanel.Chart(
@@ -252,20 +229,17 @@ func OperationDurationQuantile(datasourceName string, labelMatchers ...*labels.M
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["OperationDurationQuantile"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} {{operation_type}}"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"OperationDurationQuantile",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} {{operation_type}}"),
 		),
 	)
 }
 
-func PodStartRate(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func PodStartRate(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Pod Start Rate",
 		panel.Description("Rate of Starting Pods and Pod Worker Operations"),
 		timeSeriesPanel.Chart(
@@ -287,30 +261,24 @@ func PodStartRate(datasourceName string, labelMatchers ...*labels.Matcher) panel
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["PodStartRate1"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} pod"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["PodStartRate2"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} worker"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"PodStartRate1",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} pod"),
+		),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"PodStartRate2",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} worker"),
 		),
 	)
 }
 
-func PodStartDuration(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func PodStartDuration(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Pod Start Duration",
 		panel.Description("99th percentile Duration of Starting Pods and Pod Worker Operations"),
 		timeSeriesPanel.Chart(
@@ -332,30 +300,24 @@ func PodStartDuration(datasourceName string, labelMatchers ...*labels.Matcher) p
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["PodStartDuration1"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} pod"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["PodStartDuration2"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} worker"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"PodStartDuration1",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} pod"),
+		),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"PodStartDuration2",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} worker"),
 		),
 	)
 }
 
-func StorageOperationRate(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func StorageOperationRate(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Storage Operation Rate",
 		panel.Description("Rate of Storage Operations"),
 		timeSeriesPanel.Chart(
@@ -378,20 +340,17 @@ func StorageOperationRate(datasourceName string, labelMatchers ...*labels.Matche
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["StorageOperationRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} {{operation_name}} {{volume_plugin}}"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"StorageOperationRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} {{operation_name}} {{volume_plugin}}"),
 		),
 	)
 }
 
-func StorageOperationErrorRate(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func StorageOperationErrorRate(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Storage Operation Error Rate",
 		panel.Description("Rate of Storage Operators Errors"),
 		timeSeriesPanel.Chart(
@@ -414,20 +373,17 @@ func StorageOperationErrorRate(datasourceName string, labelMatchers ...*labels.M
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["StorageOperationErrorRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} {{operation_name}} {{volume_plugin}}"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"StorageOperationErrorRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} {{operation_name}} {{volume_plugin}}"),
 		),
 	)
 }
 
-func StorageOperationDuration(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func StorageOperationDuration(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Storage Operation Duration 99th quantile",
 		panel.Description("99th percentile Duration of Storage Operations"),
 		timeSeriesPanel.Chart(
@@ -449,20 +405,17 @@ func StorageOperationDuration(datasourceName string, labelMatchers ...*labels.Ma
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["StorageOperationDuration"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} {{operation_name}} {{volume_plugin}}"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"StorageOperationDuration",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} {{operation_name}} {{volume_plugin}}"),
 		),
 	)
 }
 
-func CgroupManagerOperationRate(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func CgroupManagerOperationRate(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Cgroup manager operation rate",
 		panel.Description("Rate of Operations from cgroup manager"),
 		timeSeriesPanel.Chart(
@@ -484,20 +437,17 @@ func CgroupManagerOperationRate(datasourceName string, labelMatchers ...*labels.
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["CgroupManagerOperationRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{operation_type}}"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"CgroupManagerOperationRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{operation_type}}"),
 		),
 	)
 }
 
-func CgroupManagerQuantile(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func CgroupManagerQuantile(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Cgroup manager 99th quantile",
 		panel.Description("99th percentile Duration of Cgroup manager"),
 		timeSeriesPanel.Chart(
@@ -519,20 +469,17 @@ func CgroupManagerQuantile(datasourceName string, labelMatchers ...*labels.Match
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["CgroupManagerQuantile"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} {{operation_type}}"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"CgroupManagerQuantile",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} {{operation_type}}"),
 		),
 	)
 }
 
-func PLEGRelistRate(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func PLEGRelistRate(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("PLEG relist rate",
 		panel.Description("Rate of PLEG performed operation"),
 		timeSeriesPanel.Chart(
@@ -554,20 +501,17 @@ func PLEGRelistRate(datasourceName string, labelMatchers ...*labels.Matcher) pan
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["PLEGRelistRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}}"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"PLEGRelistRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}}"),
 		),
 	)
 }
 
-func PLEGRelistInterval(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func PLEGRelistInterval(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("PLEG relist interval",
 		panel.Description("99th percentile time interval between PLEG relist cycles"),
 		timeSeriesPanel.Chart(
@@ -589,20 +533,17 @@ func PLEGRelistInterval(datasourceName string, labelMatchers ...*labels.Matcher)
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["PLEGRelistInterval"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}}"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"PLEGRelistInterval",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}}"),
 		),
 	)
 }
 
-func PLEGRelistDuration(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func PLEGRelistDuration(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("PLEG relist duration",
 		panel.Description("99th percentile of PLEG duration of relist operations"),
 		timeSeriesPanel.Chart(
@@ -624,20 +565,17 @@ func PLEGRelistDuration(datasourceName string, labelMatchers ...*labels.Matcher)
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["PLEGRelistDuration"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}}"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"PLEGRelistDuration",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}}"),
 		),
 	)
 }
 
-func RPCRate(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func RPCRate(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("RPC rate",
 		panel.Description("Total number of HTTP requests made by the Kubelet, by status code"),
 		timeSeriesPanel.Chart(
@@ -660,50 +598,38 @@ func RPCRate(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["RPCRate1"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("2xx"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["RPCRate2"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("3xx"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["RPCRate3"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("4xx"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["RPCRate4"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("5xx"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"RPCRate1",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("2xx"),
+		),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"RPCRate2",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("3xx"),
+		),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"RPCRate3",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("4xx"),
+		),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"RPCRate4",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("5xx"),
 		),
 	)
 }
 
-func RequestDurationQuantile(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func RequestDurationQuantile(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Request duration 99th quantile",
 		panel.Description("99th percentile of Request duration"),
 		timeSeriesPanel.Chart(
@@ -725,15 +651,12 @@ func RequestDurationQuantile(datasourceName string, labelMatchers ...*labels.Mat
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["RequestDurationQuantile"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} {{verb}}"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"RequestDurationQuantile",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} {{verb}}"),
 		),
 	)
 }
@@ -18,14 +18,14 @@ import (
 	"github.com/perses/community-mixins/pkg/promql"
 	"github.com/perses/perses/go-sdk/panel"
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
-	"github.com/perses/plugins/prometheus/sdk/go/query"
 
 	commonSdk "github.com/perses/perses/go-sdk/common"
 	tablePanel "github.com/perses/plugins/table/sdk/go"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func MultiClusterCPUUsageQuota(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func MultiClusterCPUUsageQuota(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("CPU Quota",
 		panel.Description("Shows the CPU requests, limits, and usage of clusters in tabular format."),
 		tablePanel.Table(
@@ -94,55 +94,40 @@ func MultiClusterCPUUsageQuota(datasourceName string, labelMatchers ...*labels.M
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["MultiClusterCPUUsageQuota1"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"MultiClusterCPUUsageQuota1",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["MultiClusterCPUUsageQuota2"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"MultiClusterCPUUsageQuota2",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["MultiClusterCPUUsageQuota3"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"MultiClusterCPUUsageQuota3",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["MultiClusterCPUUsageQuota4"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"MultiClusterCPUUsageQuota4",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["MultiClusterCPUUsageQuota5"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"MultiClusterCPUUsageQuota5",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
 
-func MultiClusterMemoryUsageQuota(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func MultiClusterMemoryUsageQuota(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Memory Requests by Cluster",
 		panel.Description("Shows the memory requests, limits, and usage of clusters in tabular format."),
 		tablePanel.Table(
@@ -208,50 +193,35 @@ func MultiClusterMemoryUsageQuota(datasourceName string, labelMatchers ...*label
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["MultiClusterMemoryUsageQuota1"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"MultiClusterMemoryUsageQuota1",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["MultiClusterMemoryUsageQuota2"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"MultiClusterMemoryUsageQuota2",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["MultiClusterMemoryUsageQuota3"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"MultiClusterMemoryUsageQuota3",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["MultiClusterMemoryUsageQuota4"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"MultiClusterMemoryUsageQuota4",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["MultiClusterMemoryUsageQuota5"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"MultiClusterMemoryUsageQuota5",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
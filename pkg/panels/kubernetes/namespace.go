@@ -18,14 +18,14 @@ import (
 	"github.com/perses/community-mixins/pkg/promql"
 	"github.com/perses/perses/go-sdk/panel"
 	panelgroup "github.com/perses/perses/go-sdk/panel-group"
-	"github.com/perses/plugins/prometheus/sdk/go/query"
 
 	commonSdk "github.com/perses/perses/go-sdk/common"
 	tablePanel "github.com/perses/plugins/table/sdk/go"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func NamespaceCPUUsageQuota(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func NamespaceCPUUsageQuota(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("CPU Quota",
 		panel.Description("Shows the CPU requests, limits, and usage of pods in a namespace in tabular format."),
 		tablePanel.Table(
@@ -94,55 +94,40 @@ func NamespaceCPUUsageQuota(datasourceName string, labelMatchers ...*labels.Matc
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["NamespaceCPUUsageQuota1"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"NamespaceCPUUsageQuota1",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["NamespaceCPUUsageQuota2"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"NamespaceCPUUsageQuota2",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["NamespaceCPUUsageQuota3"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"NamespaceCPUUsageQuota3",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["NamespaceCPUUsageQuota4"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"NamespaceCPUUsageQuota4",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["NamespaceCPUUsageQuota5"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"NamespaceCPUUsageQuota5",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
 
-func NamespaceMemoryUsageQuota(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func NamespaceMemoryUsageQuota(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Memory Quota",
 		panel.Description("Shows the memory requests, limits, and usage of pods in a namespace in tabular format."),
 		tablePanel.Table(
@@ -232,82 +217,58 @@ func NamespaceMemoryUsageQuota(datasourceName string, labelMatchers ...*labels.M
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["NamespaceMemoryUsageQuota1"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"NamespaceMemoryUsageQuota1",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["NamespaceMemoryUsageQuota2"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"NamespaceMemoryUsageQuota2",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["NamespaceMemoryUsageQuota3"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"NamespaceMemoryUsageQuota3",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["NamespaceMemoryUsageQuota4"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"NamespaceMemoryUsageQuota4",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["NamespaceMemoryUsageQuota5"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"NamespaceMemoryUsageQuota5",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["NamespaceMemoryUsageQuota6"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"NamespaceMemoryUsageQuota6",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["NamespaceMemoryUsageQuota7"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"NamespaceMemoryUsageQuota7",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["NamespaceMemoryUsageQuota8"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"NamespaceMemoryUsageQuota8",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
 
-func NamespaceCurrentNetworkUsage(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func NamespaceCurrentNetworkUsage(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Current Network Usage",
 		panel.Description("Shows the current network usage of the namespace by pods."),
 		tablePanel.Table(
@@ -379,64 +340,46 @@ func NamespaceCurrentNetworkUsage(datasourceName string, labelMatchers ...*label
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["NamespaceCurrentNetworkUsage1"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"NamespaceCurrentNetworkUsage1",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["NamespaceCurrentNetworkUsage2"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"NamespaceCurrentNetworkUsage2",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["NamespaceCurrentNetworkUsage3"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"NamespaceCurrentNetworkUsage3",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["NamespaceCurrentNetworkUsage4"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"NamespaceCurrentNetworkUsage4",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["NamespaceCurrentNetworkUsage5"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"NamespaceCurrentNetworkUsage5",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["NamespaceCurrentNetworkUsage6"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"NamespaceCurrentNetworkUsage6",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
 
-func NamespaceCurrentStorageIO(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func NamespaceCurrentStorageIO(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Current Storage IO",
 		panel.Description("Shows the current storage IO of the namespace in tabular form, by pod."),
 		tablePanel.Table(
@@ -508,59 +451,41 @@ func NamespaceCurrentStorageIO(datasourceName string, labelMatchers ...*labels.M
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["NamespaceCurrentStorageIO1"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"NamespaceCurrentStorageIO1",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["NamespaceCurrentStorageIO2"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"NamespaceCurrentStorageIO2",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["NamespaceCurrentStorageIO3"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"NamespaceCurrentStorageIO3",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["NamespaceCurrentStorageIO4"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"NamespaceCurrentStorageIO4",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["NamespaceCurrentStorageIO5"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"NamespaceCurrentStorageIO5",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["NamespaceCurrentStorageIO6"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"NamespaceCurrentStorageIO6",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
@@ -23,151 +23,122 @@ import (
 	commonSdk "github.com/perses/perses/go-sdk/common"
 	timeSeriesPanel "github.com/perses/plugins/timeserieschart/sdk/go"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func KubernetesReceiveBandwidth(granularity, datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
-	var queries []panel.Option
+func KubernetesReceiveBandwidth(granularity, datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
+	var queryOptions []panel.Option
 	var description string
 
 	switch granularity {
 	case "cluster":
 		description = "Shows the network receive bandwidth of the cluster by namespace."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesReceiveBandwidth1"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{namespace}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesReceiveBandwidth1",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{namespace}}"),
 			),
 		}
 	case "cluster-networking":
 		description = "Shows the network receive bandwidth of the cluster highlighting top pods."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesReceiveBandwidth2"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{namespace}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesReceiveBandwidth2",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{namespace}}"),
 			),
 		}
 	case "namespace-pod":
 		description = "Shows the network receive bandwidth of the namespace by pod."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesReceiveBandwidth3"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesReceiveBandwidth3",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "namespace-workload":
 		description = "Shows the network receive bandwidth of the namespace by workload."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesReceiveBandwidth4"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{workload}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesReceiveBandwidth4",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{workload}}"),
 			),
 		}
 	case "namespace-workload-networking":
 		description = "Shows the network receive bandwidth of the namespace by workload highlighting top pods."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesReceiveBandwidth5"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{workload}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesReceiveBandwidth5",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{workload}}"),
 			),
 		}
 	case "namespace-pod-networking":
 		description = "Shows the network receive bandwidth of the namespace by pod highlighting top pods."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesReceiveBandwidth6"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesReceiveBandwidth6",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "workload":
 		description = "Shows the network receive bandwidth of the workload."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesReceiveBandwidth7"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesReceiveBandwidth7",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "workload-networking":
 		description = "Shows the network receive bandwidth of the workload highlighting top pods."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesReceiveBandwidth8"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesReceiveBandwidth8",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "pod":
 		description = "Shows the network receive bandwidth of the pod."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesReceiveBandwidth9"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesReceiveBandwidth9",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "pod-networking":
 		description = "Shows the network receive bandwidth of the pod."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesReceiveBandwidth10"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesReceiveBandwidth10",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	}
@@ -195,154 +166,124 @@ func KubernetesReceiveBandwidth(granularity, datasourceName string, labelMatcher
 		),
 	}
 
-	panelOpts = append(panelOpts, queries...)
+	panelOpts = append(panelOpts, queryOptions...)
 
 	return panelgroup.AddPanel("Receive Bandwidth", panelOpts...)
 }
 
-func KubernetesTransmitBandwidth(granularity, datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
-	var queries []panel.Option
+func KubernetesTransmitBandwidth(granularity, datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
+	var queryOptions []panel.Option
 	var description string
 
 	switch granularity {
 	case "cluster":
 		description = "Shows the network transmit bandwidth of the cluster by namespace."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesTransmitBandwidth1"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{namespace}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesTransmitBandwidth1",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{namespace}}"),
 			),
 		}
 	case "cluster-networking":
 		description = "Shows the network transmit bandwidth of the cluster highlighting top pods."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesTransmitBandwidth2"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{namespace}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesTransmitBandwidth2",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{namespace}}"),
 			),
 		}
 	case "namespace-pod":
 		description = "Shows the network transmit bandwidth of the namespace by pod."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesTransmitBandwidth3"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesTransmitBandwidth3",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "namespace-workload":
 		description = "Shows the network transmit bandwidth of the namespace by workload."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesTransmitBandwidth4"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{workload}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesTransmitBandwidth4",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{workload}}"),
 			),
 		}
 	case "namespace-workload-networking":
 		description = "Shows the network transmit bandwidth of the namespace by workload highlighting top pods."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesTransmitBandwidth5"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{workload}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesTransmitBandwidth5",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{workload}}"),
 			),
 		}
 	case "namespace-pod-networking":
 		description = "Shows the network transmit bandwidth of the namespace by pod highlighting top pods."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesTransmitBandwidth6"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesTransmitBandwidth6",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "workload":
 		description = "Shows the network transmit bandwidth of the workload."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesTransmitBandwidth7"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesTransmitBandwidth7",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "workload-networking":
 		description = "Shows the network transmit bandwidth of the workload highlighting top pods."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesTransmitBandwidth8"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesTransmitBandwidth8",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "pod":
 		description = "Shows the network transmit bandwidth of the pod."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesTransmitBandwidth9"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesTransmitBandwidth9",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "pod-networking":
 		description = "Shows the network transmit bandwidth of the pod."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesTransmitBandwidth10"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesTransmitBandwidth10",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	}
@@ -370,74 +311,62 @@ func KubernetesTransmitBandwidth(granularity, datasourceName string, labelMatche
 		),
 	}
 
-	panelOpts = append(panelOpts, queries...)
+	panelOpts = append(panelOpts, queryOptions...)
 
 	return panelgroup.AddPanel("Transmit Bandwidth", panelOpts...)
 }
 
-func KubernetesAvgContainerBandwidthTransmitted(granularity, datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func KubernetesAvgContainerBandwidthTransmitted(granularity, datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	var panelName, description string
-	var queries []panel.Option
+	var queryOptions []panel.Option
 
 	switch granularity {
 	case "cluster":
 		panelName = "Average Container Bandwidth by Namespace: Transmitted"
 		description = "Shows the average network bandwidth transmitted in container by namespace."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesAvgContainerBandwidthTransmitted1"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{namespace}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesAvgContainerBandwidthTransmitted1",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{namespace}}"),
 			),
 		}
 	case "namespace-workload":
 		panelName = "Average Container Bandwidth by Workload: Transmitted"
 		description = "Shows the average network bandwidth transmitted in container by workload."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesAvgContainerBandwidthTransmitted2"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{workload}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesAvgContainerBandwidthTransmitted2",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{workload}}"),
 			),
 		}
 	case "namespace-workload-networking":
 		panelName = "Average Container Bandwidth by Workload: Transmitted"
 		description = "Shows the average network bandwidth transmitted in container by workload highlighting top pods."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesAvgContainerBandwidthTransmitted3"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{workload}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesAvgContainerBandwidthTransmitted3",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{workload}}"),
 			),
 		}
 	case "workload":
 		panelName = "Average Container Bandwidth by Pod: Transmitted"
 		description = "Shows the average network bandwidth transmitted by containers of a pod in a workload."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesAvgContainerBandwidthTransmitted4"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesAvgContainerBandwidthTransmitted4",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	}
@@ -464,74 +393,62 @@ func KubernetesAvgContainerBandwidthTransmitted(granularity, datasourceName stri
 			}),
 		),
 	}
-	panelOpts = append(panelOpts, queries...)
+	panelOpts = append(panelOpts, queryOptions...)
 
 	return panelgroup.AddPanel(panelName, panelOpts...)
 }
 
-func KubernetesAvgContainerBandwidthReceived(granularity, datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func KubernetesAvgContainerBandwidthReceived(granularity, datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	var panelName, description string
-	var queries []panel.Option
+	var queryOptions []panel.Option
 
 	switch granularity {
 	case "cluster":
 		panelName = "Average Container Bandwidth by Namespace: Received"
 		description = "Shows the average network bandwidth received in container by namespace."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesAvgContainerBandwidthReceived1"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{namespace}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesAvgContainerBandwidthReceived1",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{namespace}}"),
 			),
 		}
 	case "namespace-workload":
 		panelName = "Average Container Bandwidth by Workload: Received"
 		description = "Shows the average network bandwidth received in container by workload."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesAvgContainerBandwidthReceived2"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{workload}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesAvgContainerBandwidthReceived2",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{workload}}"),
 			),
 		}
 	case "namespace-workload-networking":
 		panelName = "Average Container Bandwidth by Workload: Received"
 		description = "Shows the average network bandwidth received in container by workload highlighting top pods."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesAvgContainerBandwidthReceived3"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{workload}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesAvgContainerBandwidthReceived3",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{workload}}"),
 			),
 		}
 	case "workload":
 		panelName = "Average Container Bandwidth by Pod: Received"
 		description = "Shows the average network bandwidth received by containers of a pod in a workload."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesAvgContainerBandwidthReceived4"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesAvgContainerBandwidthReceived4",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	}
@@ -558,154 +475,124 @@ func KubernetesAvgContainerBandwidthReceived(granularity, datasourceName string,
 			}),
 		),
 	}
-	panelOpts = append(panelOpts, queries...)
+	panelOpts = append(panelOpts, queryOptions...)
 
 	return panelgroup.AddPanel(panelName, panelOpts...)
 }
 
-func KubernetesReceivedPackets(granularity, datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func KubernetesReceivedPackets(granularity, datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	var description string
-	var queries []panel.Option
+	var queryOptions []panel.Option
 
 	switch granularity {
 	case "cluster":
 		description = "Shows the rate of received packets by namespace."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesReceivedPackets1"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{namespace}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesReceivedPackets1",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{namespace}}"),
 			),
 		}
 	case "namespace-pod":
 		description = "Shows the rate of received packets by pods in a namespace."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesReceivedPackets2"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesReceivedPackets2",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "pod":
 		description = "Shows the rate of received packets by a pod."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesReceivedPackets3"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesReceivedPackets3",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "workload":
 		description = "Shows the rate of received packets by pods in a workload."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesReceivedPackets4"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesReceivedPackets4",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "namespace-workload":
 		description = "Shows the rate of received packets by pods in a workload in a namespace."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesReceivedPackets5"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{workload}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesReceivedPackets5",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{workload}}"),
 			),
 		}
 	case "cluster-networking":
 		description = "Shows the rate of received packets by namespace in a cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesReceivedPackets6"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{namespace}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesReceivedPackets6",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{namespace}}"),
 			),
 		}
 	case "namespace-pod-networking":
 		description = "Shows the rate of received packets by pods in a namespace in a cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesReceivedPackets7"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesReceivedPackets7",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "namespace-workload-networking":
 		description = "Shows the rate of received packets by top pods in a workload in a namespace in a cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesReceivedPackets8"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{workload}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesReceivedPackets8",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{workload}}"),
 			),
 		}
 	case "workload-networking":
 		description = "Shows the rate of received packets by top pods in a workload in a cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesReceivedPackets9"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesReceivedPackets9",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "pod-networking":
 		description = "Shows the rate of received packets by a pod in a cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesReceivedPackets10"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesReceivedPackets10",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	}
@@ -732,154 +619,124 @@ func KubernetesReceivedPackets(granularity, datasourceName string, labelMatchers
 			}),
 		),
 	}
-	panelOpts = append(panelOpts, queries...)
+	panelOpts = append(panelOpts, queryOptions...)
 
 	return panelgroup.AddPanel("Rate of Received Packets", panelOpts...)
 }
 
-func KubernetesReceivedPacketsDropped(granularity, datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func KubernetesReceivedPacketsDropped(granularity, datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	var description string
-	var queries []panel.Option
+	var queryOptions []panel.Option
 
 	switch granularity {
 	case "cluster":
 		description = "Shows the rate of received packets dropped by namespace."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesReceivedPacketsDropped1"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{namespace}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesReceivedPacketsDropped1",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{namespace}}"),
 			),
 		}
 	case "namespace-pod":
 		description = "Shows the rate of received packets dropped by pods in a namespace."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesReceivedPacketsDropped2"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesReceivedPacketsDropped2",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "pod":
 		description = "Shows the rate of received packets dropped by a pod."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesReceivedPacketsDropped3"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesReceivedPacketsDropped3",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "workload":
 		description = "Shows the rate of received packets dropped by pods in a workload."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesReceivedPacketsDropped4"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesReceivedPacketsDropped4",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "namespace-workload":
 		description = "Shows the rate of received packets droppedby pods in a workload in a namespace."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesReceivedPacketsDropped5"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{workload}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesReceivedPacketsDropped5",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{workload}}"),
 			),
 		}
 	case "cluster-networking":
 		description = "Shows the rate of received packets dropped by namespace in a cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesReceivedPacketsDropped6"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{namespace}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesReceivedPacketsDropped6",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{namespace}}"),
 			),
 		}
 	case "namespace-pod-networking":
 		description = "Shows the rate of received packets dropped by pods in a namespace in a cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesReceivedPacketsDropped7"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesReceivedPacketsDropped7",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "namespace-workload-networking":
 		description = "Shows the rate of received packets dropped by top pods in a workload in a namespace in a cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesReceivedPacketsDropped8"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{workload}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesReceivedPacketsDropped8",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{workload}}"),
 			),
 		}
 	case "workload-networking":
 		description = "Shows the rate of received packets dropped by top pods in a workload in a cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesReceivedPacketsDropped9"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesReceivedPacketsDropped9",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "pod-networking":
 		description = "Shows the rate of received packets dropped by a pod in a cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesReceivedPacketsDropped10"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesReceivedPacketsDropped10",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	}
@@ -906,154 +763,124 @@ func KubernetesReceivedPacketsDropped(granularity, datasourceName string, labelM
 			}),
 		),
 	}
-	panelOpts = append(panelOpts, queries...)
+	panelOpts = append(panelOpts, queryOptions...)
 
 	return panelgroup.AddPanel("Rate of Received Packets Dropped", panelOpts...)
 }
 
-func KubernetesTransmittedPackets(granularity, datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func KubernetesTransmittedPackets(granularity, datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	var description string
-	var queries []panel.Option
+	var queryOptions []panel.Option
 
 	switch granularity {
 	case "cluster":
 		description = "Shows the rate of transmitted packets by namespace."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesTransmittedPackets1"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{namespace}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesTransmittedPackets1",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{namespace}}"),
 			),
 		}
 	case "namespace-pod":
 		description = "Shows the rate of transmitted packets by pods in a namespace."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesTransmittedPackets2"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesTransmittedPackets2",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "pod":
 		description = "Shows the rate of transmitted packets by a pod."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesTransmittedPackets3"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesTransmittedPackets3",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "workload":
 		description = "Shows the rate of transmitted packets by pods in a workload."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesTransmittedPackets4"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesTransmittedPackets4",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "namespace-workload":
 		description = "Shows the rate of transmitted packets by pods in a workload in a namespace."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesTransmittedPackets5"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{workload}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesTransmittedPackets5",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{workload}}"),
 			),
 		}
 	case "cluster-networking":
 		description = "Shows the rate of transmitted packets by namespace in a cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesTransmittedPackets6"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{namespace}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesTransmittedPackets6",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{namespace}}"),
 			),
 		}
 	case "namespace-pod-networking":
 		description = "Shows the rate of transmitted packets by pods in a namespace in a cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesTransmittedPackets7"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesTransmittedPackets7",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "namespace-workload-networking":
 		description = "Shows the rate of transmitted packets by top pods in a workload in a namespace in a cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesTransmittedPackets8"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{workload}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesTransmittedPackets8",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{workload}}"),
 			),
 		}
 	case "workload-networking":
 		description = "Shows the rate of transmitted packets by top pods in a workload in a cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesTransmittedPackets9"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesTransmittedPackets9",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "pod-networking":
 		description = "Shows the rate of transmitted packets by a pod in a cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesTransmittedPackets10"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesTransmittedPackets10",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	}
@@ -1080,154 +907,124 @@ func KubernetesTransmittedPackets(granularity, datasourceName string, labelMatch
 			}),
 		),
 	}
-	panelOpts = append(panelOpts, queries...)
+	panelOpts = append(panelOpts, queryOptions...)
 
 	return panelgroup.AddPanel("Rate of Transmitted Packets", panelOpts...)
 }
 
-func KubernetesTransmittedPacketsDropped(granularity, datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func KubernetesTransmittedPacketsDropped(granularity, datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	var description string
-	var queries []panel.Option
+	var queryOptions []panel.Option
 
 	switch granularity {
 	case "cluster":
 		description = "Shows the rate of transmitted packets dropped by namespace."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesTransmittedPacketsDropped1"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{namespace}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesTransmittedPacketsDropped1",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{namespace}}"),
 			),
 		}
 	case "namespace-pod":
 		description = "Shows the rate of transmitted packets dropped by pods in a namespace."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesTransmittedPacketsDropped2"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesTransmittedPacketsDropped2",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "pod":
 		description = "Shows the rate of transmitted packets droppedby a pod."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesTransmittedPacketsDropped3"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesTransmittedPacketsDropped3",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "workload":
 		description = "Shows the rate of transmitted packets dropped by pods in a workload."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesTransmittedPacketsDropped4"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesTransmittedPacketsDropped4",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "namespace-workload":
 		description = "Shows the rate of transmitted packets dropped by pods in a workload in a namespace."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesTransmittedPacketsDropped5"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{workload}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesTransmittedPacketsDropped5",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{workload}}"),
 			),
 		}
 	case "cluster-networking":
 		description = "Shows the rate of transmitted packets dropped by namespace in a cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesTransmittedPacketsDropped6"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{namespace}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesTransmittedPacketsDropped6",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{namespace}}"),
 			),
 		}
 	case "namespace-pod-networking":
 		description = "Shows the rate of transmitted packets dropped by pods in a namespace in a cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesTransmittedPacketsDropped7"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesTransmittedPacketsDropped7",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "namespace-workload-networking":
 		description = "Shows the rate of transmitted packets dropped by top pods in a workload in a namespace in a cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesTransmittedPacketsDropped8"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{workload}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesTransmittedPacketsDropped8",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{workload}}"),
 			),
 		}
 	case "workload-networking":
 		description = "Shows the rate of transmitted packets dropped by top pods in a workload in a cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesTransmittedPacketsDropped9"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesTransmittedPacketsDropped9",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "pod-networking":
 		description = "Shows the rate of transmitted packets dropped by a pod in a cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesTransmittedPacketsDropped10"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesTransmittedPacketsDropped10",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	}
@@ -1254,84 +1051,69 @@ func KubernetesTransmittedPacketsDropped(granularity, datasourceName string, lab
 			}),
 		),
 	}
-	panelOpts = append(panelOpts, queries...)
+	panelOpts = append(panelOpts, queryOptions...)
 
 	return panelgroup.AddPanel("Rate of Transmitted Packets Dropped", panelOpts...)
 }
 
-func KubernetesCurrentRateOfBytesReceived(granularity, datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func KubernetesCurrentRateOfBytesReceived(granularity, datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	var description string
-	var queries []panel.Option
+	var queryOptions []panel.Option
 
 	switch granularity {
 	case "cluster-networking":
 		description = "Shows the rate of bytes received by namespace in a cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesCurrentRateOfBytesReceived1"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{namespace}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesCurrentRateOfBytesReceived1",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{namespace}}"),
 			),
 		}
 	case "namespace-pod-networking":
 		description = "Shows the rate of bytes received by top pods in a namespace in a cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesCurrentRateOfBytesReceived2"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesCurrentRateOfBytesReceived2",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "namespace-workload-networking":
 		description = "Shows the rate of bytes received by top workload in a namespace in a cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesCurrentRateOfBytesReceived3"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{workload}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesCurrentRateOfBytesReceived3",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{workload}}"),
 			),
 		}
 	case "workload-networking":
 		description = "Shows the rate of bytes received by top pods in a workload in a cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesCurrentRateOfBytesReceived4"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesCurrentRateOfBytesReceived4",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "pod-networking":
 		description = "Shows the rate of bytes received by a pod in a cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesCurrentRateOfBytesReceived5"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesCurrentRateOfBytesReceived5",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	}
@@ -1358,84 +1140,69 @@ func KubernetesCurrentRateOfBytesReceived(granularity, datasourceName string, la
 			}),
 		),
 	}
-	panelOpts = append(panelOpts, queries...)
+	panelOpts = append(panelOpts, queryOptions...)
 
 	return panelgroup.AddPanel("Current Rate of Bytes Received", panelOpts...)
 }
 
-func KubernetesCurrentRateOfBytesTransmitted(granularity, datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func KubernetesCurrentRateOfBytesTransmitted(granularity, datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	var description string
-	var queries []panel.Option
+	var queryOptions []panel.Option
 
 	switch granularity {
 	case "cluster-networking":
 		description = "Shows the rate of bytes transmitted by namespace in a cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesCurrentRateOfBytesTransmitted1"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{namespace}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesCurrentRateOfBytesTransmitted1",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{namespace}}"),
 			),
 		}
 	case "namespace-pod-networking":
 		description = "Shows the rate of bytes transmitted by top pods in a namespace in a cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesCurrentRateOfBytesTransmitted2"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesCurrentRateOfBytesTransmitted2",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "namespace-workload-networking":
 		description = "Shows the rate of bytes transmitted by top workload in a namespace in a cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesCurrentRateOfBytesTransmitted3"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{workload}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesCurrentRateOfBytesTransmitted3",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{workload}}"),
 			),
 		}
 	case "workload-networking":
 		description = "Shows the rate of bytes transmitted by top pods in a workload in a cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesCurrentRateOfBytesTransmitted4"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesCurrentRateOfBytesTransmitted4",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "pod-networking":
 		description = "Shows the rate of bytes transmitted by a pod in a cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesCurrentRateOfBytesTransmitted5"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesCurrentRateOfBytesTransmitted5",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	}
@@ -1462,43 +1229,37 @@ func KubernetesCurrentRateOfBytesTransmitted(granularity, datasourceName string,
 			}),
 		),
 	}
-	panelOpts = append(panelOpts, queries...)
+	panelOpts = append(panelOpts, queryOptions...)
 
 	return panelgroup.AddPanel("Current Rate of Bytes Transmitted", panelOpts...)
 }
 
-func KubernetesAverageRateOfBytesReceived(granularity, datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func KubernetesAverageRateOfBytesReceived(granularity, datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	var description string
-	var queries []panel.Option
+	var queryOptions []panel.Option
 
 	switch granularity {
 	case "cluster-networking":
 		description = "Shows the average rate of bytes received by namespace in a cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesAverageRateOfBytesReceived1"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{namespace}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesAverageRateOfBytesReceived1",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{namespace}}"),
 			),
 		}
 
 	case "workload-networking":
 		description = "Shows the average rate of bytes received by top pods in a workload in a cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesAverageRateOfBytesReceived2"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesAverageRateOfBytesReceived2",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	}
@@ -1525,43 +1286,37 @@ func KubernetesAverageRateOfBytesReceived(granularity, datasourceName string, la
 			}),
 		),
 	}
-	panelOpts = append(panelOpts, queries...)
+	panelOpts = append(panelOpts, queryOptions...)
 
 	return panelgroup.AddPanel("Average Rate of Bytes Received", panelOpts...)
 }
 
-func KubernetesAverageRateOfBytesTransmitted(granularity, datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func KubernetesAverageRateOfBytesTransmitted(granularity, datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	var description string
-	var queries []panel.Option
+	var queryOptions []panel.Option
 
 	switch granularity {
 	case "cluster-networking":
 		description = "Shows the average rate of bytes transmitted by namespace in a cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesAverageRateOfBytesTransmitted1"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{namespace}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesAverageRateOfBytesTransmitted1",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{namespace}}"),
 			),
 		}
 
 	case "workload-networking":
 		description = "Shows the average rate of bytes transmitted by top pods in a workload in a cluster."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesAverageRateOfBytesTransmitted2"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesAverageRateOfBytesTransmitted2",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	}
@@ -1588,7 +1343,7 @@ func KubernetesAverageRateOfBytesTransmitted(granularity, datasourceName string,
 			}),
 		),
 	}
-	panelOpts = append(panelOpts, queries...)
+	panelOpts = append(panelOpts, queryOptions...)
 
 	return panelgroup.AddPanel("Average Rate of Bytes Received", panelOpts...)
 }
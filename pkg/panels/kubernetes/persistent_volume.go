@@ -24,9 +24,10 @@ import (
 	gaugePanel "github.com/perses/plugins/gaugechart/sdk/go"
 	timeSeriesPanel "github.com/perses/plugins/timeserieschart/sdk/go"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func VolumeSpaceUsage(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func VolumeSpaceUsage(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Volume Space Usage",
 		panel.Description("Shows the space usage of persistent volume in a namespace by a PV claim."),
 		timeSeriesPanel.Chart(
@@ -49,30 +50,24 @@ func VolumeSpaceUsage(datasourceName string, labelMatchers ...*labels.Matcher) p
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["VolumeSpaceUsage1"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Used Space"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"VolumeSpaceUsage1",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Used Space"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["VolumeSpaceUsage2"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Free Space"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"VolumeSpaceUsage2",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Free Space"),
 		),
 	)
 }
 
-func VolumeSpaceUsageGauge(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func VolumeSpaceUsageGauge(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Volume Space Usage",
 		panel.Description("Shows the space usage of persistent volume in a namespace by a PV claim."),
 		gaugePanel.Chart(
@@ -99,19 +94,16 @@ func VolumeSpaceUsageGauge(datasourceName string, labelMatchers ...*labels.Match
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["VolumeSpaceUsageGauge"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"VolumeSpaceUsageGauge",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
 
-func VolumeInodesUsage(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func VolumeInodesUsage(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Volume inodes Usage",
 		panel.Description("Shows the inodes usage of persistent volume in a namespace by a PV claim."),
 		timeSeriesPanel.Chart(
@@ -134,30 +126,24 @@ func VolumeInodesUsage(datasourceName string, labelMatchers ...*labels.Matcher)
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["VolumeInodesUsage1"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Used inodes"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"VolumeInodesUsage1",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Used inodes"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["VolumeInodesUsage2"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Free inodes"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"VolumeInodesUsage2",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Free inodes"),
 		),
 	)
 }
 
-func VolumeInodesUsageGauge(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func VolumeInodesUsageGauge(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Volume inodes Usage",
 		panel.Description("Shows the inodes usage of persistent volume in a namespace by a PV claim."),
 		gaugePanel.Chart(
@@ -184,14 +170,11 @@ func VolumeInodesUsageGauge(datasourceName string, labelMatchers ...*labels.Matc
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["VolumeInodesUsageGauge"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"VolumeInodesUsageGauge",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
@@ -24,9 +24,10 @@ import (
 	statPanel "github.com/perses/plugins/statchart/sdk/go"
 	timeSeriesPanel "github.com/perses/plugins/timeserieschart/sdk/go"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func ProxyUpStatus(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func ProxyUpStatus(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Up",
 		panel.Description("Shows the status of the proxy."),
 		statPanel.Chart(
@@ -36,19 +37,16 @@ func ProxyUpStatus(datasourceName string, labelMatchers ...*labels.Matcher) pane
 			}),
 			statPanel.ValueFontSize(50),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["ProxyUpStatus"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"ProxyUpStatus",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
 
-func RulesSyncRate(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func RulesSyncRate(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Rules Sync Rate",
 		panel.Description("Shows the rate of rules sync events."),
 		timeSeriesPanel.Chart(
@@ -70,20 +68,17 @@ func RulesSyncRate(datasourceName string, labelMatchers ...*labels.Matcher) pane
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["RulesSyncRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}}"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"RulesSyncRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}}"),
 		),
 	)
 }
 
-func RulesSyncLatency(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func RulesSyncLatency(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Rules Sync Latency 99th Quantile",
 		panel.Description("Shows the 99th quantile latency of rules sync events."),
 		timeSeriesPanel.Chart(
@@ -105,20 +100,17 @@ func RulesSyncLatency(datasourceName string, labelMatchers ...*labels.Matcher) p
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["RulesSyncLatency"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}}"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"RulesSyncLatency",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}}"),
 		),
 	)
 }
 
-func NetworkProgrammingRate(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func NetworkProgrammingRate(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Network Programming Rate",
 		panel.Description("Shows the rate of network programming events."),
 		timeSeriesPanel.Chart(
@@ -140,20 +132,17 @@ func NetworkProgrammingRate(datasourceName string, labelMatchers ...*labels.Matc
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["NetworkProgrammingRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}}"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"NetworkProgrammingRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}}"),
 		),
 	)
 }
 
-func NetworkProgrammingLatency(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func NetworkProgrammingLatency(datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Network Programming Latency 99th Quantile",
 		panel.Description("Shows the 99th quantile latency of network programming events."),
 		timeSeriesPanel.Chart(
@@ -175,15 +164,12 @@ func NetworkProgrammingLatency(datasourceName string, labelMatchers ...*labels.M
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					KubernetesCommonPanelQueries["NetworkProgrammingLatency"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}}"),
-			),
+		promql.AddQueryFrom(
+			"KubernetesCommonPanelQueries", queries,
+			"NetworkProgrammingLatency",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}}"),
 		),
 	)
 }
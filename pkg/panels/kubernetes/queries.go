@@ -23,110 +23,92 @@ import (
 	"github.com/prometheus/prometheus/promql/parser"
 )
 
-// KubernetesCommonPanelQueries holds every PromQL query used by the Kubernetes panels,
-// keyed by the panel function name (suffixed with the 1-based query index when a panel
-// emits more than one query).
-//
-// Job label values and recording rule names are intentionally inlined here rather than
-// read from the globals in globals.go: the map is evaluated at package initialisation,
-// so the Set*LabelValue setters would not be reflected in these expressions. Use
-// OverrideKubernetesPanelQueries to customise a query instead.
-var KubernetesCommonPanelQueries = map[string]parser.Expr{
-	// apiserver
-	"APIServerAvailability": vector.New(
-		vector.WithMetricName("apiserver_request:availability30d"),
-		vector.WithLabelMatchers(
-			label.New("verb").Equal("all"),
-			label.New("cluster").EqualRegexp("$cluster"),
-		),
-	),
-	"APIServerErrorBudget": promqlbuilder.Mul(
-		&parser.NumberLiteral{Val: 100},
-		promqlbuilder.Parenthesis(
-			promqlbuilder.Sub(
-				vector.New(
-					vector.WithMetricName("apiserver_request:availability30d"),
-					vector.WithLabelMatchers(
-						label.New("verb").Equal("all"),
-						label.New("cluster").EqualRegexp("$cluster"),
+// KubernetesCommonPanelQueries holds every PromQL query used by the Kubernetes panels with the default
+// job label values, keyed by the panel function name (suffixed with the 1-based query index when a panel
+// emits more than one query). It is kept for reference, the dashboards build their own queries with
+// KubernetesPanelQueries.
+var KubernetesCommonPanelQueries = KubernetesPanelQueries(DefaultJobLabelValues())
+
+// KubernetesPanelQueries returns every PromQL query used by the Kubernetes panels, keyed as
+// KubernetesCommonPanelQueries, selecting the jobs of jobLabelValues, empty values falling back to their defaults. Each call returns a new map.
+func KubernetesPanelQueries(jobLabelValues JobLabelValues) map[string]parser.Expr {
+	jobLabelValues = jobLabelValues.WithDefaults()
+	return map[string]parser.Expr{
+		// apiserver
+		"APIServerAvailability": vector.New(
+			vector.WithMetricName("apiserver_request:availability30d"),
+			vector.WithLabelMatchers(
+				label.New("verb").Equal("all"),
+				label.New("cluster").EqualRegexp("$cluster"),
+			),
+		),
+		"APIServerErrorBudget": promqlbuilder.Mul(
+			&parser.NumberLiteral{Val: 100},
+			promqlbuilder.Parenthesis(
+				promqlbuilder.Sub(
+					vector.New(
+						vector.WithMetricName("apiserver_request:availability30d"),
+						vector.WithLabelMatchers(
+							label.New("verb").Equal("all"),
+							label.New("cluster").EqualRegexp("$cluster"),
+						),
 					),
+					&parser.NumberLiteral{Val: 0.99},
 				),
-				&parser.NumberLiteral{Val: 0.99},
 			),
 		),
-	),
-	"APIServerReadAvailability": vector.New(
-		vector.WithMetricName("apiserver_request:availability30d"),
-		vector.WithLabelMatchers(
-			label.New("verb").Equal("read"),
-			label.New("cluster").EqualRegexp("$cluster"),
-		),
-	),
-	"APIServerReadSLIRequests": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("code_resource:apiserver_request_total:rate5m"),
+		"APIServerReadAvailability": vector.New(
+			vector.WithMetricName("apiserver_request:availability30d"),
 			vector.WithLabelMatchers(
 				label.New("verb").Equal("read"),
 				label.New("cluster").EqualRegexp("$cluster"),
 			),
 		),
-	).By("code"),
-	"APIServerReadSLIErrors": promqlbuilder.Div(
-		promqlbuilder.Sum(
+		"APIServerReadSLIRequests": promqlbuilder.Sum(
 			vector.New(
 				vector.WithMetricName("code_resource:apiserver_request_total:rate5m"),
 				vector.WithLabelMatchers(
 					label.New("verb").Equal("read"),
-					label.New("code").EqualRegexp("5.."),
 					label.New("cluster").EqualRegexp("$cluster"),
 				),
 			),
-		).By("resource"),
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("code_resource:apiserver_request_total:rate5m"),
-				vector.WithLabelMatchers(
-					label.New("verb").Equal("read"),
-					label.New("cluster").EqualRegexp("$cluster"),
+		).By("code"),
+		"APIServerReadSLIErrors": promqlbuilder.Div(
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("code_resource:apiserver_request_total:rate5m"),
+					vector.WithLabelMatchers(
+						label.New("verb").Equal("read"),
+						label.New("code").EqualRegexp("5.."),
+						label.New("cluster").EqualRegexp("$cluster"),
+					),
+				),
+			).By("resource"),
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("code_resource:apiserver_request_total:rate5m"),
+					vector.WithLabelMatchers(
+						label.New("verb").Equal("read"),
+						label.New("cluster").EqualRegexp("$cluster"),
+					),
 				),
+			).By("resource"),
+		),
+		"APIServerReadSLIDuration": vector.New(
+			vector.WithMetricName("cluster_quantile:apiserver_request_sli_duration_seconds:histogram_quantile"),
+			vector.WithLabelMatchers(
+				label.New("verb").Equal("read"),
+				label.New("cluster").EqualRegexp("$cluster"),
 			),
-		).By("resource"),
-	),
-	"APIServerReadSLIDuration": vector.New(
-		vector.WithMetricName("cluster_quantile:apiserver_request_sli_duration_seconds:histogram_quantile"),
-		vector.WithLabelMatchers(
-			label.New("verb").Equal("read"),
-			label.New("cluster").EqualRegexp("$cluster"),
-		),
-	),
-	"APIServerWriteAvailability": vector.New(
-		vector.WithMetricName("apiserver_request:availability30d"),
-		vector.WithLabelMatchers(
-			label.New("verb").Equal("write"),
-			label.New("cluster").EqualRegexp("$cluster"),
-		),
-	),
-	"APIServerWriteSLIRequests": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("code_resource:apiserver_request_total:rate5m"),
+		),
+		"APIServerWriteAvailability": vector.New(
+			vector.WithMetricName("apiserver_request:availability30d"),
 			vector.WithLabelMatchers(
 				label.New("verb").Equal("write"),
 				label.New("cluster").EqualRegexp("$cluster"),
 			),
 		),
-	).By("code"),
-	"APIServerWriteSLIErrors": promqlbuilder.Div(
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("code_resource:apiserver_request_total:rate5m"),
-				vector.WithLabelMatchers(
-					label.New("verb").Equal("write"),
-					label.New("code").EqualRegexp("5.."),
-					label.New("cluster").EqualRegexp("$cluster"),
-				),
-			),
-		).By("resource"),
-		promqlbuilder.Sum(
+		"APIServerWriteSLIRequests": promqlbuilder.Sum(
 			vector.New(
 				vector.WithMetricName("code_resource:apiserver_request_total:rate5m"),
 				vector.WithLabelMatchers(
@@ -134,127 +116,104 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 					label.New("cluster").EqualRegexp("$cluster"),
 				),
 			),
-		).By("resource"),
-	),
-	"APIServerWriteSLIDuration": vector.New(
-		vector.WithMetricName("cluster_quantile:apiserver_request_sli_duration_seconds:histogram_quantile"),
-		vector.WithLabelMatchers(
-			label.New("verb").Equal("write"),
-			label.New("cluster").EqualRegexp("$cluster"),
-		),
-	),
-	"APIServerWorkQueueAddRate": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
+		).By("code"),
+		"APIServerWriteSLIErrors": promqlbuilder.Div(
+			promqlbuilder.Sum(
 				vector.New(
-					vector.WithMetricName("workqueue_adds_total"),
+					vector.WithMetricName("code_resource:apiserver_request_total:rate5m"),
 					vector.WithLabelMatchers(
-						label.New("job").Equal("kube-apiserver"),
-						label.New("instance").EqualRegexp("$instance"),
+						label.New("verb").Equal("write"),
+						label.New("code").EqualRegexp("5.."),
 						label.New("cluster").EqualRegexp("$cluster"),
 					),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
-		),
-	).By("instance", "name"),
-	"APIServerWorkQueueDepth": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
+			).By("resource"),
+			promqlbuilder.Sum(
 				vector.New(
-					vector.WithMetricName("workqueue_depth"),
+					vector.WithMetricName("code_resource:apiserver_request_total:rate5m"),
 					vector.WithLabelMatchers(
-						label.New("job").Equal("kube-apiserver"),
-						label.New("instance").EqualRegexp("$instance"),
+						label.New("verb").Equal("write"),
 						label.New("cluster").EqualRegexp("$cluster"),
 					),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
+			).By("resource"),
+		),
+		"APIServerWriteSLIDuration": vector.New(
+			vector.WithMetricName("cluster_quantile:apiserver_request_sli_duration_seconds:histogram_quantile"),
+			vector.WithLabelMatchers(
+				label.New("verb").Equal("write"),
+				label.New("cluster").EqualRegexp("$cluster"),
 			),
 		),
-	).By("instance", "name"),
-	"APIServerWorkQueueLatency": promqlbuilder.HistogramQuantile(
-		0.99,
-		promqlbuilder.Sum(
+		"APIServerWorkQueueAddRate": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("workqueue_queue_duration_seconds_bucket"),
+						vector.WithMetricName("workqueue_adds_total"),
 						vector.WithLabelMatchers(
-							label.New("cluster").Equal("$cluster"),
-							label.New("job").Equal("kube-apiserver"),
+							jobLabelValues.APIServerMatcher(),
 							label.New("instance").EqualRegexp("$instance"),
+							label.New("cluster").EqualRegexp("$cluster"),
 						),
 					),
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-		).By("instance", "name", "le"),
-	),
-	// cluster
-	"ClusterCPUUsageQuotaPodOwn": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("kube_pod_owner"),
-			vector.WithLabelMatchers(
-				label.New("job").Equal("kube-state-metrics"),
-				label.New("cluster").Equal("$cluster"),
-			),
-		),
-	).By("namespace"),
-	"ClusterCPUUsageQuotaNSwWorkload": promqlbuilder.Count(
-		promqlbuilder.Avg(
-			vector.New(
-				vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-				vector.WithLabelMatchers(
-					label.New("cluster").Equal("$cluster"),
+		).By("instance", "name"),
+		"APIServerWorkQueueDepth": promqlbuilder.Sum(
+			promqlbuilder.Rate(
+				matrix.New(
+					vector.New(
+						vector.WithMetricName("workqueue_depth"),
+						vector.WithLabelMatchers(
+							jobLabelValues.APIServerMatcher(),
+							label.New("instance").EqualRegexp("$instance"),
+							label.New("cluster").EqualRegexp("$cluster"),
+						),
+					),
+					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-		).By("workload", "namespace"),
-	).By("namespace"),
-	"ClusterCPUUsageQuotaNodeNSCPU": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-			),
-		),
-	).By("namespace"),
-	"ClusterCPUUsageQuotaNSKubePodContainer": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("namespace_cpu:kube_pod_container_resource_requests:sum"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-			),
+		).By("instance", "name"),
+		"APIServerWorkQueueLatency": promqlbuilder.HistogramQuantile(
+			0.99,
+			promqlbuilder.Sum(
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("workqueue_queue_duration_seconds_bucket"),
+							vector.WithLabelMatchers(
+								label.New("cluster").Equal("$cluster"),
+								jobLabelValues.APIServerMatcher(),
+								label.New("instance").EqualRegexp("$instance"),
+							),
+						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
+					),
+				),
+			).By("instance", "name", "le"),
 		),
-	).By("namespace"),
-	"ClusterCPUUsageQuotaNSKubePodContainerDiv": promqlbuilder.Div(
-		promqlbuilder.Sum(
+		// cluster
+		"ClusterCPUUsageQuotaPodOwn": promqlbuilder.Sum(
 			vector.New(
-				vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
+				vector.WithMetricName("kube_pod_owner"),
 				vector.WithLabelMatchers(
+					jobLabelValues.KubeStateMetricsMatcher(),
 					label.New("cluster").Equal("$cluster"),
 				),
 			),
 		).By("namespace"),
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("namespace_cpu:kube_pod_container_resource_requests:sum"),
-				vector.WithLabelMatchers(
-					label.New("cluster").Equal("$cluster"),
+		"ClusterCPUUsageQuotaNSwWorkload": promqlbuilder.Count(
+			promqlbuilder.Avg(
+				vector.New(
+					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+					),
 				),
-			),
+			).By("workload", "namespace"),
 		).By("namespace"),
-	),
-	"ClusterCPUUsageQuotaNSCPUKubePodResources": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("namespace_cpu:kube_pod_container_resource_limits:sum"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-			),
-		),
-	).By("namespace"),
-	"ClusterCPUUsageQuotaNSCPUKubePodResourcesDiv": promqlbuilder.Div(
-		promqlbuilder.Sum(
+		"ClusterCPUUsageQuotaNodeNSCPU": promqlbuilder.Sum(
 			vector.New(
 				vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
 				vector.WithLabelMatchers(
@@ -262,375 +221,279 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 				),
 			),
 		).By("namespace"),
-		promqlbuilder.Sum(
+		"ClusterCPUUsageQuotaNSKubePodContainer": promqlbuilder.Sum(
 			vector.New(
-				vector.WithMetricName("namespace_cpu:kube_pod_container_resource_limits:sum"),
+				vector.WithMetricName("namespace_cpu:kube_pod_container_resource_requests:sum"),
 				vector.WithLabelMatchers(
 					label.New("cluster").Equal("$cluster"),
 				),
 			),
 		).By("namespace"),
-	),
-	"ClusterMemoryUsageQuotaPodOwner": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("kube_pod_owner"),
-			vector.WithLabelMatchers(
-				label.New("job").Equal("kube-state-metrics"),
-				label.New("cluster").Equal("$cluster"),
-			),
+		"ClusterCPUUsageQuotaNSKubePodContainerDiv": promqlbuilder.Div(
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+					),
+				),
+			).By("namespace"),
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("namespace_cpu:kube_pod_container_resource_requests:sum"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+					),
+				),
+			).By("namespace"),
 		),
-	).By("namespace"),
-	"ClusterMemoryUsageQuotaNSWorkloadPodOwner": promqlbuilder.Count(
-		promqlbuilder.Avg(
+		"ClusterCPUUsageQuotaNSCPUKubePodResources": promqlbuilder.Sum(
 			vector.New(
-				vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+				vector.WithMetricName("namespace_cpu:kube_pod_container_resource_limits:sum"),
 				vector.WithLabelMatchers(
 					label.New("cluster").Equal("$cluster"),
 				),
 			),
-		).By("workload", "namespace"),
-	).By("namespace"),
-	"ClusterMemoryUsageQuotaContainerMem": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("container_memory_rss"),
-			vector.WithLabelMatchers(
-				label.New("job").Equal("cadvisor"),
-				label.New("cluster").Equal("$cluster"),
-				label.New("container").NotEqual(""),
-			),
-		),
-	).By("namespace"),
-	"ClusterMemoryUsageQuotaContainerResourceReqSum": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("namespace_memory:kube_pod_container_resource_requests:sum"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-			),
+		).By("namespace"),
+		"ClusterCPUUsageQuotaNSCPUKubePodResourcesDiv": promqlbuilder.Div(
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+					),
+				),
+			).By("namespace"),
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("namespace_cpu:kube_pod_container_resource_limits:sum"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+					),
+				),
+			).By("namespace"),
 		),
-	).By("namespace"),
-	"ClusterMemoryUsageQuotaContainerResourceReqSumDiv": promqlbuilder.Div(
-		promqlbuilder.Sum(
+		"ClusterMemoryUsageQuotaPodOwner": promqlbuilder.Sum(
 			vector.New(
-				vector.WithMetricName("container_memory_rss"),
+				vector.WithMetricName("kube_pod_owner"),
 				vector.WithLabelMatchers(
-					label.New("job").Equal("cadvisor"),
+					jobLabelValues.KubeStateMetricsMatcher(),
 					label.New("cluster").Equal("$cluster"),
-					label.New("container").NotEqual(""),
 				),
 			),
 		).By("namespace"),
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("namespace_memory:kube_pod_container_resource_requests:sum"),
-				vector.WithLabelMatchers(
-					label.New("cluster").Equal("$cluster"),
+		"ClusterMemoryUsageQuotaNSWorkloadPodOwner": promqlbuilder.Count(
+			promqlbuilder.Avg(
+				vector.New(
+					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+					),
 				),
-			),
+			).By("workload", "namespace"),
 		).By("namespace"),
-	),
-	"ClusterMemoryUsageQuotaContainerReqLimits": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("namespace_memory:kube_pod_container_resource_limits:sum"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-			),
-		),
-	).By("namespace"),
-	"ClusterMemoryUsageQuotaContainerReqLimitsDiv": promqlbuilder.Div(
-		promqlbuilder.Sum(
+		"ClusterMemoryUsageQuotaContainerMem": promqlbuilder.Sum(
 			vector.New(
 				vector.WithMetricName("container_memory_rss"),
 				vector.WithLabelMatchers(
-					label.New("job").Equal("cadvisor"),
+					jobLabelValues.CAdvisorMatcher(),
 					label.New("cluster").Equal("$cluster"),
 					label.New("container").NotEqual(""),
 				),
 			),
 		).By("namespace"),
-		promqlbuilder.Sum(
+		"ClusterMemoryUsageQuotaContainerResourceReqSum": promqlbuilder.Sum(
 			vector.New(
-				vector.WithMetricName("namespace_memory:kube_pod_container_resource_limits:sum"),
+				vector.WithMetricName("namespace_memory:kube_pod_container_resource_requests:sum"),
 				vector.WithLabelMatchers(
 					label.New("cluster").Equal("$cluster"),
 				),
 			),
 		).By("namespace"),
-	),
-	"ClusterCurrentNetworkUsageBytesTotal": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_network_receive_bytes_total"),
-					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").EqualRegexp(".+"),
-					),
-				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
-		),
-	).By("namespace"),
-	"ClusterCurrentNetworkTransmitBytesTotal": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_network_transmit_bytes_total"),
-					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").EqualRegexp(".+"),
-					),
-				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
-		),
-	).By("namespace"),
-	"ClusterCurrentNetworkReceivedTotal": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
+		"ClusterMemoryUsageQuotaContainerResourceReqSumDiv": promqlbuilder.Div(
+			promqlbuilder.Sum(
 				vector.New(
-					vector.WithMetricName("container_network_receive_packets_total"),
+					vector.WithMetricName("container_memory_rss"),
 					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
+						jobLabelValues.CAdvisorMatcher(),
 						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").EqualRegexp(".+"),
+						label.New("container").NotEqual(""),
 					),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
-		),
-	).By("namespace"),
-	"ClusterCurrentNetworkTransmitPacketsTotal": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
+			).By("namespace"),
+			promqlbuilder.Sum(
 				vector.New(
-					vector.WithMetricName("container_network_transmit_packets_total"),
+					vector.WithMetricName("namespace_memory:kube_pod_container_resource_requests:sum"),
 					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
 						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").EqualRegexp(".+"),
 					),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
+			).By("namespace"),
 		),
-	).By("namespace"),
-	"ClusterCurrentNetworkReceivedPacketsDroppedTotal": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_network_receive_packets_dropped_total"),
-					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").EqualRegexp(".+"),
-					),
+		"ClusterMemoryUsageQuotaContainerReqLimits": promqlbuilder.Sum(
+			vector.New(
+				vector.WithMetricName("namespace_memory:kube_pod_container_resource_limits:sum"),
+				vector.WithLabelMatchers(
+					label.New("cluster").Equal("$cluster"),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
 			),
-		),
-	).By("namespace"),
-	"ClusterCurrentNetworkTransmitPacketsDroppedTotal": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
+		).By("namespace"),
+		"ClusterMemoryUsageQuotaContainerReqLimitsDiv": promqlbuilder.Div(
+			promqlbuilder.Sum(
 				vector.New(
-					vector.WithMetricName("container_network_transmit_packets_dropped_total"),
+					vector.WithMetricName("container_memory_rss"),
 					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
+						jobLabelValues.CAdvisorMatcher(),
 						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").EqualRegexp(".+"),
-					),
-				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
-		),
-	).By("namespace"),
-	"ClusterCurrentStorageIOFsReadsTotal": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_fs_reads_total"),
-					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
-						label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
 						label.New("container").NotEqual(""),
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").NotEqual(""),
 					),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
-		),
-	).By("namespace"),
-	"ClusterCurrentStorageIOFsWritesTotal": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
+			).By("namespace"),
+			promqlbuilder.Sum(
 				vector.New(
-					vector.WithMetricName("container_fs_writes_total"),
+					vector.WithMetricName("namespace_memory:kube_pod_container_resource_limits:sum"),
 					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
-						label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
-						label.New("container").NotEqual(""),
 						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").NotEqual(""),
 					),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
+			).By("namespace"),
 		),
-	).By("namespace"),
-	"ClusterCurrentStorageIOFsReadsWritesTotal": promqlbuilder.Sum(
-		promqlbuilder.Add(
+		"ClusterCurrentNetworkUsageBytesTotal": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("container_fs_reads_total"),
+						vector.WithMetricName("container_network_receive_bytes_total"),
 						vector.WithLabelMatchers(
-							label.New("job").Equal("cadvisor"),
-							label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
-							label.New("container").NotEqual(""),
+							jobLabelValues.CAdvisorMatcher(),
 							label.New("cluster").Equal("$cluster"),
-							label.New("namespace").NotEqual(""),
+							label.New("namespace").EqualRegexp(".+"),
 						),
 					),
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
+		).By("namespace"),
+		"ClusterCurrentNetworkTransmitBytesTotal": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("container_fs_writes_total"),
+						vector.WithMetricName("container_network_transmit_bytes_total"),
 						vector.WithLabelMatchers(
-							label.New("job").Equal("cadvisor"),
-							label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
-							label.New("container").NotEqual(""),
+							jobLabelValues.CAdvisorMatcher(),
 							label.New("cluster").Equal("$cluster"),
-							label.New("namespace").NotEqual(""),
+							label.New("namespace").EqualRegexp(".+"),
 						),
 					),
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-		),
-	).By("namespace"),
-	"ClusterCurrentStorageIOFsReadsBytesTotal": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_fs_reads_bytes_total"),
-					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
-						label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
-						label.New("container").NotEqual(""),
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").NotEqual(""),
-					),
-				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
-		),
-	).By("namespace"),
-	"ClusterCurrentStorageIOFsWritesBytesTotal": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_fs_writes_bytes_total"),
-					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
-						label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
-						label.New("container").NotEqual(""),
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").NotEqual(""),
+		).By("namespace"),
+		"ClusterCurrentNetworkReceivedTotal": promqlbuilder.Sum(
+			promqlbuilder.Rate(
+				matrix.New(
+					vector.New(
+						vector.WithMetricName("container_network_receive_packets_total"),
+						vector.WithLabelMatchers(
+							jobLabelValues.CAdvisorMatcher(),
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").EqualRegexp(".+"),
+						),
 					),
+					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
 			),
-		),
-	).By("namespace"),
-	"ClusterCurrentStorageIOFsReadsWritesBytesTotal": promqlbuilder.Sum(
-		promqlbuilder.Add(
+		).By("namespace"),
+		"ClusterCurrentNetworkTransmitPacketsTotal": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("container_fs_reads_bytes_total"),
+						vector.WithMetricName("container_network_transmit_packets_total"),
 						vector.WithLabelMatchers(
-							label.New("job").Equal("cadvisor"),
-							label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
-							label.New("container").NotEqual(""),
+							jobLabelValues.CAdvisorMatcher(),
 							label.New("cluster").Equal("$cluster"),
-							label.New("namespace").NotEqual(""),
+							label.New("namespace").EqualRegexp(".+"),
 						),
 					),
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
+		).By("namespace"),
+		"ClusterCurrentNetworkReceivedPacketsDroppedTotal": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("container_fs_writes_bytes_total"),
+						vector.WithMetricName("container_network_receive_packets_dropped_total"),
 						vector.WithLabelMatchers(
-							label.New("job").Equal("cadvisor"),
-							label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
-							label.New("container").NotEqual(""),
+							jobLabelValues.CAdvisorMatcher(),
 							label.New("cluster").Equal("$cluster"),
-							label.New("namespace").NotEqual(""),
+							label.New("namespace").EqualRegexp(".+"),
 						),
 					),
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-		),
-	).By("namespace"),
-	// cluster_networking
-	"ClusterTCPRetransmitRate": promqlbuilder.Sum(
-		promqlbuilder.Mul(
-			promqlbuilder.Div(
-				promqlbuilder.Rate(
-					matrix.New(
-						vector.New(
-							vector.WithMetricName("node_netstat_Tcp_RetransSegs"),
-							vector.WithLabelMatchers(
-								label.New("cluster").Equal("$cluster"),
-							),
+		).By("namespace"),
+		"ClusterCurrentNetworkTransmitPacketsDroppedTotal": promqlbuilder.Sum(
+			promqlbuilder.Rate(
+				matrix.New(
+					vector.New(
+						vector.WithMetricName("container_network_transmit_packets_dropped_total"),
+						vector.WithLabelMatchers(
+							jobLabelValues.CAdvisorMatcher(),
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").EqualRegexp(".+"),
 						),
-						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
+					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
-				promqlbuilder.Rate(
-					matrix.New(
-						vector.New(
-							vector.WithMetricName("node_netstat_Tcp_OutSegs"),
-							vector.WithLabelMatchers(
-								label.New("cluster").Equal("$cluster"),
-							),
+			),
+		).By("namespace"),
+		"ClusterCurrentStorageIOFsReadsTotal": promqlbuilder.Sum(
+			promqlbuilder.Rate(
+				matrix.New(
+					vector.New(
+						vector.WithMetricName("container_fs_reads_total"),
+						vector.WithLabelMatchers(
+							jobLabelValues.CAdvisorMatcher(),
+							label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
+							label.New("container").NotEqual(""),
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").NotEqual(""),
 						),
-						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
+					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-			promqlbuilder.TopK(
-				promqlbuilder.Max(
+		).By("namespace"),
+		"ClusterCurrentStorageIOFsWritesTotal": promqlbuilder.Sum(
+			promqlbuilder.Rate(
+				matrix.New(
 					vector.New(
-						vector.WithMetricName("kube_pod_info"),
+						vector.WithMetricName("container_fs_writes_total"),
 						vector.WithLabelMatchers(
-							label.New("host_network").Equal("false"),
+							jobLabelValues.CAdvisorMatcher(),
+							label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
+							label.New("container").NotEqual(""),
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").NotEqual(""),
 						),
 					),
-				).By("cluster", "namespace", "pod"),
-				1,
-			).By("cluster", "namespace", "pod"),
-		).On("cluster", "namespace", "pod").GroupLeft(),
-	).By("instance"),
-	"ClusterTCPSYNRetransmitRate": promqlbuilder.Sum(
-		promqlbuilder.Mul(
-			promqlbuilder.Div(
+					matrix.WithRangeAsVariable("$__rate_interval"),
+				),
+			),
+		).By("namespace"),
+		"ClusterCurrentStorageIOFsReadsWritesTotal": promqlbuilder.Sum(
+			promqlbuilder.Add(
 				promqlbuilder.Rate(
 					matrix.New(
 						vector.New(
-							vector.WithMetricName("node_netstat_TcpExt_TCPSynRetrans"),
+							vector.WithMetricName("container_fs_reads_total"),
 							vector.WithLabelMatchers(
+								jobLabelValues.CAdvisorMatcher(),
+								label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
+								label.New("container").NotEqual(""),
 								label.New("cluster").Equal("$cluster"),
+								label.New("namespace").NotEqual(""),
 							),
 						),
 						matrix.WithRangeAsVariable("$__rate_interval"),
@@ -639,35 +502,29 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 				promqlbuilder.Rate(
 					matrix.New(
 						vector.New(
-							vector.WithMetricName("node_netstat_Tcp_RetransSegs"),
+							vector.WithMetricName("container_fs_writes_total"),
 							vector.WithLabelMatchers(
+								jobLabelValues.CAdvisorMatcher(),
+								label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
+								label.New("container").NotEqual(""),
 								label.New("cluster").Equal("$cluster"),
+								label.New("namespace").NotEqual(""),
 							),
 						),
 						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
 			),
-			promqlbuilder.TopK(
-				promqlbuilder.Max(
-					vector.New(
-						vector.WithMetricName("kube_pod_info"),
-						vector.WithLabelMatchers(
-							label.New("host_network").Equal("false"),
-						),
-					),
-				).By("cluster", "namespace", "pod"),
-				1,
-			).By("cluster", "namespace", "pod"),
-		).On("cluster", "namespace", "pod").GroupLeft(),
-	).By("instance"),
-	"ClusterNetworkingCurrentStatus1": promqlbuilder.Sum(
-		promqlbuilder.Mul(
+		).By("namespace"),
+		"ClusterCurrentStorageIOFsReadsBytesTotal": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("container_network_receive_bytes_total"),
+						vector.WithMetricName("container_fs_reads_bytes_total"),
 						vector.WithLabelMatchers(
+							jobLabelValues.CAdvisorMatcher(),
+							label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
+							label.New("container").NotEqual(""),
 							label.New("cluster").Equal("$cluster"),
 							label.New("namespace").NotEqual(""),
 						),
@@ -675,26 +532,16 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-			promqlbuilder.TopK(
-				promqlbuilder.Max(
-					vector.New(
-						vector.WithMetricName("kube_pod_info"),
-						vector.WithLabelMatchers(
-							label.New("host_network").Equal("false"),
-						),
-					),
-				).By("cluster", "namespace", "pod"),
-				1,
-			).By("cluster", "namespace", "pod"),
-		).On("cluster", "namespace", "pod").GroupLeft(),
-	).By("namespace"),
-	"ClusterNetworkingCurrentStatus2": promqlbuilder.Sum(
-		promqlbuilder.Mul(
+		).By("namespace"),
+		"ClusterCurrentStorageIOFsWritesBytesTotal": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("container_network_transmit_bytes_total"),
+						vector.WithMetricName("container_fs_writes_bytes_total"),
 						vector.WithLabelMatchers(
+							jobLabelValues.CAdvisorMatcher(),
+							label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
+							label.New("container").NotEqual(""),
 							label.New("cluster").Equal("$cluster"),
 							label.New("namespace").NotEqual(""),
 						),
@@ -702,1550 +549,1686 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-			promqlbuilder.TopK(
-				promqlbuilder.Max(
-					vector.New(
-						vector.WithMetricName("kube_pod_info"),
-						vector.WithLabelMatchers(
-							label.New("host_network").Equal("false"),
+		).By("namespace"),
+		"ClusterCurrentStorageIOFsReadsWritesBytesTotal": promqlbuilder.Sum(
+			promqlbuilder.Add(
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("container_fs_reads_bytes_total"),
+							vector.WithLabelMatchers(
+								jobLabelValues.CAdvisorMatcher(),
+								label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
+								label.New("container").NotEqual(""),
+								label.New("cluster").Equal("$cluster"),
+								label.New("namespace").NotEqual(""),
+							),
 						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
-				).By("cluster", "namespace", "pod"),
-				1,
-			).By("cluster", "namespace", "pod"),
-		).On("cluster", "namespace", "pod").GroupLeft(),
-	).By("namespace"),
-	"ClusterNetworkingCurrentStatus3": promqlbuilder.Avg(
-		promqlbuilder.Mul(
-			promqlbuilder.Rate(
-				matrix.New(
-					vector.New(
-						vector.WithMetricName("container_network_receive_bytes_total"),
-						vector.WithLabelMatchers(
-							label.New("cluster").Equal("$cluster"),
-							label.New("namespace").NotEqual(""),
+				),
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("container_fs_writes_bytes_total"),
+							vector.WithLabelMatchers(
+								jobLabelValues.CAdvisorMatcher(),
+								label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
+								label.New("container").NotEqual(""),
+								label.New("cluster").Equal("$cluster"),
+								label.New("namespace").NotEqual(""),
+							),
 						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
-					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-			promqlbuilder.TopK(
-				promqlbuilder.Max(
-					vector.New(
-						vector.WithMetricName("kube_pod_info"),
-						vector.WithLabelMatchers(
-							label.New("host_network").Equal("false"),
+		).By("namespace"),
+		// cluster_networking
+		"ClusterTCPRetransmitRate": promqlbuilder.Sum(
+			promqlbuilder.Mul(
+				promqlbuilder.Div(
+					promqlbuilder.Rate(
+						matrix.New(
+							vector.New(
+								vector.WithMetricName("node_netstat_Tcp_RetransSegs"),
+								vector.WithLabelMatchers(
+									label.New("cluster").Equal("$cluster"),
+								),
+							),
+							matrix.WithRangeAsVariable("$__rate_interval"),
 						),
 					),
-				).By("cluster", "namespace", "pod"),
-				1,
-			).By("cluster", "namespace", "pod"),
-		).On("cluster", "namespace", "pod").GroupLeft(),
-	).By("namespace"),
-	"ClusterNetworkingCurrentStatus4": promqlbuilder.Avg(
-		promqlbuilder.Mul(
-			promqlbuilder.Rate(
-				matrix.New(
-					vector.New(
-						vector.WithMetricName("container_network_transmit_bytes_total"),
-						vector.WithLabelMatchers(
-							label.New("cluster").Equal("$cluster"),
-							label.New("namespace").NotEqual(""),
+					promqlbuilder.Rate(
+						matrix.New(
+							vector.New(
+								vector.WithMetricName("node_netstat_Tcp_OutSegs"),
+								vector.WithLabelMatchers(
+									label.New("cluster").Equal("$cluster"),
+								),
+							),
+							matrix.WithRangeAsVariable("$__rate_interval"),
 						),
 					),
-					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
-			),
-			promqlbuilder.TopK(
-				promqlbuilder.Max(
-					vector.New(
-						vector.WithMetricName("kube_pod_info"),
-						vector.WithLabelMatchers(
-							label.New("host_network").Equal("false"),
+				promqlbuilder.TopK(
+					promqlbuilder.Max(
+						vector.New(
+							vector.WithMetricName("kube_pod_info"),
+							vector.WithLabelMatchers(
+								label.New("host_network").Equal("false"),
+							),
 						),
-					),
+					).By("cluster", "namespace", "pod"),
+					1,
 				).By("cluster", "namespace", "pod"),
-				1,
-			).By("cluster", "namespace", "pod"),
-		).On("cluster", "namespace", "pod").GroupLeft(),
-	).By("namespace"),
-	"ClusterNetworkingCurrentStatus5": promqlbuilder.Sum(
-		promqlbuilder.Mul(
-			promqlbuilder.Rate(
-				matrix.New(
-					vector.New(
-						vector.WithMetricName("container_network_receive_packets_total"),
-						vector.WithLabelMatchers(
-							label.New("cluster").Equal("$cluster"),
-							label.New("namespace").NotEqual(""),
+			).On("cluster", "namespace", "pod").GroupLeft(),
+		).By("instance"),
+		"ClusterTCPSYNRetransmitRate": promqlbuilder.Sum(
+			promqlbuilder.Mul(
+				promqlbuilder.Div(
+					promqlbuilder.Rate(
+						matrix.New(
+							vector.New(
+								vector.WithMetricName("node_netstat_TcpExt_TCPSynRetrans"),
+								vector.WithLabelMatchers(
+									label.New("cluster").Equal("$cluster"),
+								),
+							),
+							matrix.WithRangeAsVariable("$__rate_interval"),
 						),
 					),
-					matrix.WithRangeAsVariable("$__rate_interval"),
-				),
-			),
-			promqlbuilder.TopK(
-				promqlbuilder.Max(
-					vector.New(
-						vector.WithMetricName("kube_pod_info"),
-						vector.WithLabelMatchers(
-							label.New("host_network").Equal("false"),
+					promqlbuilder.Rate(
+						matrix.New(
+							vector.New(
+								vector.WithMetricName("node_netstat_Tcp_RetransSegs"),
+								vector.WithLabelMatchers(
+									label.New("cluster").Equal("$cluster"),
+								),
+							),
+							matrix.WithRangeAsVariable("$__rate_interval"),
 						),
 					),
+				),
+				promqlbuilder.TopK(
+					promqlbuilder.Max(
+						vector.New(
+							vector.WithMetricName("kube_pod_info"),
+							vector.WithLabelMatchers(
+								label.New("host_network").Equal("false"),
+							),
+						),
+					).By("cluster", "namespace", "pod"),
+					1,
 				).By("cluster", "namespace", "pod"),
-				1,
-			).By("cluster", "namespace", "pod"),
-		).On("cluster", "namespace", "pod").GroupLeft(),
-	).By("namespace"),
-	"ClusterNetworkingCurrentStatus6": promqlbuilder.Sum(
-		promqlbuilder.Mul(
-			promqlbuilder.Rate(
-				matrix.New(
-					vector.New(
-						vector.WithMetricName("container_network_transmit_packets_total"),
-						vector.WithLabelMatchers(
-							label.New("cluster").Equal("$cluster"),
-							label.New("namespace").NotEqual(""),
+			).On("cluster", "namespace", "pod").GroupLeft(),
+		).By("instance"),
+		"ClusterNetworkingCurrentStatus1": promqlbuilder.Sum(
+			promqlbuilder.Mul(
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("container_network_receive_bytes_total"),
+							vector.WithLabelMatchers(
+								label.New("cluster").Equal("$cluster"),
+								label.New("namespace").NotEqual(""),
+							),
 						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
-					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
-			),
-			promqlbuilder.TopK(
-				promqlbuilder.Max(
-					vector.New(
-						vector.WithMetricName("kube_pod_info"),
-						vector.WithLabelMatchers(
-							label.New("host_network").Equal("false"),
+				promqlbuilder.TopK(
+					promqlbuilder.Max(
+						vector.New(
+							vector.WithMetricName("kube_pod_info"),
+							vector.WithLabelMatchers(
+								label.New("host_network").Equal("false"),
+							),
 						),
-					),
+					).By("cluster", "namespace", "pod"),
+					1,
 				).By("cluster", "namespace", "pod"),
-				1,
-			).By("cluster", "namespace", "pod"),
-		).On("cluster", "namespace", "pod").GroupLeft(),
-	).By("namespace"),
-	"ClusterNetworkingCurrentStatus7": promqlbuilder.Sum(
-		promqlbuilder.Mul(
-			promqlbuilder.Rate(
-				matrix.New(
-					vector.New(
-						vector.WithMetricName("container_network_receive_packets_dropped_total"),
-						vector.WithLabelMatchers(
-							label.New("cluster").Equal("$cluster"),
-							label.New("namespace").NotEqual(""),
+			).On("cluster", "namespace", "pod").GroupLeft(),
+		).By("namespace"),
+		"ClusterNetworkingCurrentStatus2": promqlbuilder.Sum(
+			promqlbuilder.Mul(
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("container_network_transmit_bytes_total"),
+							vector.WithLabelMatchers(
+								label.New("cluster").Equal("$cluster"),
+								label.New("namespace").NotEqual(""),
+							),
 						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
-					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
-			),
-			promqlbuilder.TopK(
-				promqlbuilder.Max(
-					vector.New(
-						vector.WithMetricName("kube_pod_info"),
-						vector.WithLabelMatchers(
-							label.New("host_network").Equal("false"),
+				promqlbuilder.TopK(
+					promqlbuilder.Max(
+						vector.New(
+							vector.WithMetricName("kube_pod_info"),
+							vector.WithLabelMatchers(
+								label.New("host_network").Equal("false"),
+							),
 						),
-					),
+					).By("cluster", "namespace", "pod"),
+					1,
 				).By("cluster", "namespace", "pod"),
-				1,
-			).By("cluster", "namespace", "pod"),
-		).On("cluster", "namespace", "pod").GroupLeft(),
-	).By("namespace"),
-	"ClusterNetworkingCurrentStatus8": promqlbuilder.Sum(
-		promqlbuilder.Mul(
-			promqlbuilder.Rate(
-				matrix.New(
-					vector.New(
-						vector.WithMetricName("container_network_transmit_packets_dropped_total"),
-						vector.WithLabelMatchers(
-							label.New("cluster").Equal("$cluster"),
-							label.New("namespace").NotEqual(""),
+			).On("cluster", "namespace", "pod").GroupLeft(),
+		).By("namespace"),
+		"ClusterNetworkingCurrentStatus3": promqlbuilder.Avg(
+			promqlbuilder.Mul(
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("container_network_receive_bytes_total"),
+							vector.WithLabelMatchers(
+								label.New("cluster").Equal("$cluster"),
+								label.New("namespace").NotEqual(""),
+							),
 						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
-					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
-			),
-			promqlbuilder.TopK(
-				promqlbuilder.Max(
-					vector.New(
-						vector.WithMetricName("kube_pod_info"),
-						vector.WithLabelMatchers(
-							label.New("host_network").Equal("false"),
+				promqlbuilder.TopK(
+					promqlbuilder.Max(
+						vector.New(
+							vector.WithMetricName("kube_pod_info"),
+							vector.WithLabelMatchers(
+								label.New("host_network").Equal("false"),
+							),
 						),
-					),
+					).By("cluster", "namespace", "pod"),
+					1,
 				).By("cluster", "namespace", "pod"),
-				1,
-			).By("cluster", "namespace", "pod"),
-		).On("cluster", "namespace", "pod").GroupLeft(),
-	).By("namespace"),
-	// compute_resources
-	"KubernetesCPUUtilizationStatAll": promqlbuilder.Div(
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("cluster:node_cpu:ratio_rate5m"),
-			),
-		),
-		promqlbuilder.Count(
-			vector.New(
-				vector.WithMetricName("cluster:node_cpu:ratio_rate5m"),
-			),
-		),
-	),
-	"KubernetesCPUUtilizationStatCluster": vector.New(
-		vector.WithMetricName("cluster:node_cpu:ratio_rate5m"),
-		vector.WithLabelMatchers(
-			label.New("cluster").Equal("$cluster"),
-		),
-	),
-	"KubernetesCPUUtilizationStatNSPod": promqlbuilder.Div(
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
-				vector.WithLabelMatchers(
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-				),
-			),
-		),
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("kube_pod_container_resource_requests"),
-				vector.WithLabelMatchers(
-					label.New("job").Equal("kube-state-metrics"),
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("resource").Equal("cpu"),
-				),
-			),
-		),
-	),
-	"KubernetesCPUUtilizationStatNSPodLimits": promqlbuilder.Div(
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
-				vector.WithLabelMatchers(
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-				),
-			),
-		),
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("kube_pod_container_resource_limits"),
-				vector.WithLabelMatchers(
-					label.New("job").Equal("kube-state-metrics"),
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("resource").Equal("cpu"),
-				),
-			),
-		),
-	),
-	"KubernetesCPURequestsCommitmentStatAllClusters": promqlbuilder.Div(
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("kube_pod_container_resource_requests"),
-				vector.WithLabelMatchers(
-					label.New("job").Equal("kube-state-metrics"),
-					label.New("resource").Equal("cpu"),
+			).On("cluster", "namespace", "pod").GroupLeft(),
+		).By("namespace"),
+		"ClusterNetworkingCurrentStatus4": promqlbuilder.Avg(
+			promqlbuilder.Mul(
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("container_network_transmit_bytes_total"),
+							vector.WithLabelMatchers(
+								label.New("cluster").Equal("$cluster"),
+								label.New("namespace").NotEqual(""),
+							),
+						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
+					),
 				),
-			),
-		),
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("kube_node_status_allocatable"),
-				vector.WithLabelMatchers(
-					label.New("job").Equal("kube-state-metrics"),
-					label.New("resource").Equal("cpu"),
+				promqlbuilder.TopK(
+					promqlbuilder.Max(
+						vector.New(
+							vector.WithMetricName("kube_pod_info"),
+							vector.WithLabelMatchers(
+								label.New("host_network").Equal("false"),
+							),
+						),
+					).By("cluster", "namespace", "pod"),
+					1,
+				).By("cluster", "namespace", "pod"),
+			).On("cluster", "namespace", "pod").GroupLeft(),
+		).By("namespace"),
+		"ClusterNetworkingCurrentStatus5": promqlbuilder.Sum(
+			promqlbuilder.Mul(
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("container_network_receive_packets_total"),
+							vector.WithLabelMatchers(
+								label.New("cluster").Equal("$cluster"),
+								label.New("namespace").NotEqual(""),
+							),
+						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
+					),
 				),
-			),
-		),
-	),
-	"KubernetesCPURequestsCommitmentStatReqClusters": promqlbuilder.Div(
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("namespace_cpu:kube_pod_container_resource_requests:sum"),
-				vector.WithLabelMatchers(
-					label.New("cluster").Equal("$cluster"),
+				promqlbuilder.TopK(
+					promqlbuilder.Max(
+						vector.New(
+							vector.WithMetricName("kube_pod_info"),
+							vector.WithLabelMatchers(
+								label.New("host_network").Equal("false"),
+							),
+						),
+					).By("cluster", "namespace", "pod"),
+					1,
+				).By("cluster", "namespace", "pod"),
+			).On("cluster", "namespace", "pod").GroupLeft(),
+		).By("namespace"),
+		"ClusterNetworkingCurrentStatus6": promqlbuilder.Sum(
+			promqlbuilder.Mul(
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("container_network_transmit_packets_total"),
+							vector.WithLabelMatchers(
+								label.New("cluster").Equal("$cluster"),
+								label.New("namespace").NotEqual(""),
+							),
+						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
+					),
 				),
-			),
-		),
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("kube_node_status_allocatable"),
-				vector.WithLabelMatchers(
-					label.New("job").Equal("kube-state-metrics"),
-					label.New("resource").Equal("cpu"),
-					label.New("cluster").Equal("$cluster"),
+				promqlbuilder.TopK(
+					promqlbuilder.Max(
+						vector.New(
+							vector.WithMetricName("kube_pod_info"),
+							vector.WithLabelMatchers(
+								label.New("host_network").Equal("false"),
+							),
+						),
+					).By("cluster", "namespace", "pod"),
+					1,
+				).By("cluster", "namespace", "pod"),
+			).On("cluster", "namespace", "pod").GroupLeft(),
+		).By("namespace"),
+		"ClusterNetworkingCurrentStatus7": promqlbuilder.Sum(
+			promqlbuilder.Mul(
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("container_network_receive_packets_dropped_total"),
+							vector.WithLabelMatchers(
+								label.New("cluster").Equal("$cluster"),
+								label.New("namespace").NotEqual(""),
+							),
+						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
+					),
 				),
-			),
-		),
-	),
-	"KubernetesCPULimitsCommitmentStatAllClusters": promqlbuilder.Div(
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("kube_pod_container_resource_limits"),
-				vector.WithLabelMatchers(
-					label.New("job").Equal("kube-state-metrics"),
-					label.New("resource").Equal("cpu"),
+				promqlbuilder.TopK(
+					promqlbuilder.Max(
+						vector.New(
+							vector.WithMetricName("kube_pod_info"),
+							vector.WithLabelMatchers(
+								label.New("host_network").Equal("false"),
+							),
+						),
+					).By("cluster", "namespace", "pod"),
+					1,
+				).By("cluster", "namespace", "pod"),
+			).On("cluster", "namespace", "pod").GroupLeft(),
+		).By("namespace"),
+		"ClusterNetworkingCurrentStatus8": promqlbuilder.Sum(
+			promqlbuilder.Mul(
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("container_network_transmit_packets_dropped_total"),
+							vector.WithLabelMatchers(
+								label.New("cluster").Equal("$cluster"),
+								label.New("namespace").NotEqual(""),
+							),
+						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
+					),
 				),
-			),
-		),
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("kube_node_status_allocatable"),
-				vector.WithLabelMatchers(
-					label.New("job").Equal("kube-state-metrics"),
-					label.New("resource").Equal("cpu"),
+				promqlbuilder.TopK(
+					promqlbuilder.Max(
+						vector.New(
+							vector.WithMetricName("kube_pod_info"),
+							vector.WithLabelMatchers(
+								label.New("host_network").Equal("false"),
+							),
+						),
+					).By("cluster", "namespace", "pod"),
+					1,
+				).By("cluster", "namespace", "pod"),
+			).On("cluster", "namespace", "pod").GroupLeft(),
+		).By("namespace"),
+		// compute_resources
+		"KubernetesCPUUtilizationStatAll": promqlbuilder.Div(
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("cluster:node_cpu:ratio_rate5m"),
 				),
 			),
-		),
-	),
-	"KubernetesCPULimitsCommitmentStatReqClusters": promqlbuilder.Div(
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("namespace_cpu:kube_pod_container_resource_limits:sum"),
-				vector.WithLabelMatchers(
-					label.New("cluster").Equal("$cluster"),
+			promqlbuilder.Count(
+				vector.New(
+					vector.WithMetricName("cluster:node_cpu:ratio_rate5m"),
 				),
 			),
 		),
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("kube_node_status_allocatable"),
-				vector.WithLabelMatchers(
-					label.New("job").Equal("kube-state-metrics"),
-					label.New("resource").Equal("cpu"),
-					label.New("cluster").Equal("$cluster"),
-				),
+		"KubernetesCPUUtilizationStatCluster": vector.New(
+			vector.WithMetricName("cluster:node_cpu:ratio_rate5m"),
+			vector.WithLabelMatchers(
+				label.New("cluster").Equal("$cluster"),
 			),
 		),
-	),
-	"KubernetesMemoryUtilizationStatMultiCluster": promqlbuilder.Sub(
-		&parser.NumberLiteral{Val: 1},
-		promqlbuilder.Div(
+		"KubernetesCPUUtilizationStatNSPod": promqlbuilder.Div(
 			promqlbuilder.Sum(
 				vector.New(
-					vector.WithMetricName(":node_memory_MemAvailable_bytes:sum"),
+					vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
+					),
 				),
 			),
 			promqlbuilder.Sum(
 				vector.New(
-					vector.WithMetricName("node_memory_MemTotal_bytes"),
+					vector.WithMetricName("kube_pod_container_resource_requests"),
 					vector.WithLabelMatchers(
-						label.New("job").Equal("node-exporter"),
+						jobLabelValues.KubeStateMetricsMatcher(),
+						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
+						label.New("resource").Equal("cpu"),
 					),
 				),
 			),
 		),
-	),
-	"KubernetesMemoryUtilizationStatCluster": promqlbuilder.Sub(
-		&parser.NumberLiteral{Val: 1},
-		promqlbuilder.Div(
+		"KubernetesCPUUtilizationStatNSPodLimits": promqlbuilder.Div(
 			promqlbuilder.Sum(
 				vector.New(
-					vector.WithMetricName(":node_memory_MemAvailable_bytes:sum"),
+					vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
 					vector.WithLabelMatchers(
 						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
 					),
 				),
 			),
 			promqlbuilder.Sum(
 				vector.New(
-					vector.WithMetricName("node_memory_MemTotal_bytes"),
+					vector.WithMetricName("kube_pod_container_resource_limits"),
 					vector.WithLabelMatchers(
-						label.New("job").Equal("node-exporter"),
+						jobLabelValues.KubeStateMetricsMatcher(),
 						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
+						label.New("resource").Equal("cpu"),
 					),
 				),
 			),
 		),
-	),
-	"KubernetesMemoryUtilizationNSRequests": promqlbuilder.Div(
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("container_memory_working_set_bytes"),
-				vector.WithLabelMatchers(
-					label.New("job").Equal("cadvisor"),
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("container").NotEqual(""),
-					label.New("image").NotEqual(""),
-				),
-			),
-		),
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("kube_pod_container_resource_requests"),
-				vector.WithLabelMatchers(
-					label.New("job").Equal("kube-state-metrics"),
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("resource").Equal("memory"),
+		"KubernetesCPURequestsCommitmentStatAllClusters": promqlbuilder.Div(
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("kube_pod_container_resource_requests"),
+					vector.WithLabelMatchers(
+						jobLabelValues.KubeStateMetricsMatcher(),
+						label.New("resource").Equal("cpu"),
+					),
 				),
 			),
-		),
-	),
-	"KubernetesMemoryUtilizationNSLimits": promqlbuilder.Div(
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("container_memory_working_set_bytes"),
-				vector.WithLabelMatchers(
-					label.New("job").Equal("cadvisor"),
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("container").NotEqual(""),
-					label.New("image").NotEqual(""),
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("kube_node_status_allocatable"),
+					vector.WithLabelMatchers(
+						jobLabelValues.KubeStateMetricsMatcher(),
+						label.New("resource").Equal("cpu"),
+					),
 				),
 			),
 		),
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("kube_pod_container_resource_limits"),
-				vector.WithLabelMatchers(
-					label.New("job").Equal("kube-state-metrics"),
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("resource").Equal("memory"),
+		"KubernetesCPURequestsCommitmentStatReqClusters": promqlbuilder.Div(
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("namespace_cpu:kube_pod_container_resource_requests:sum"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+					),
 				),
 			),
-		),
-	),
-	"KubernetesMemoryRequestsCommitmentStat1": promqlbuilder.Div(
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("kube_pod_container_resource_requests"),
-				vector.WithLabelMatchers(
-					label.New("job").Equal("kube-state-metrics"),
-					label.New("resource").Equal("memory"),
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("kube_node_status_allocatable"),
+					vector.WithLabelMatchers(
+						jobLabelValues.KubeStateMetricsMatcher(),
+						label.New("resource").Equal("cpu"),
+						label.New("cluster").Equal("$cluster"),
+					),
 				),
 			),
 		),
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("kube_node_status_allocatable"),
-				vector.WithLabelMatchers(
-					label.New("job").Equal("kube-state-metrics"),
-					label.New("resource").Equal("memory"),
+		"KubernetesCPULimitsCommitmentStatAllClusters": promqlbuilder.Div(
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("kube_pod_container_resource_limits"),
+					vector.WithLabelMatchers(
+						jobLabelValues.KubeStateMetricsMatcher(),
+						label.New("resource").Equal("cpu"),
+					),
 				),
 			),
-		),
-	),
-	"KubernetesMemoryRequestsCommitmentStat2": promqlbuilder.Div(
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("namespace_memory:kube_pod_container_resource_requests:sum"),
-				vector.WithLabelMatchers(
-					label.New("cluster").Equal("$cluster"),
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("kube_node_status_allocatable"),
+					vector.WithLabelMatchers(
+						jobLabelValues.KubeStateMetricsMatcher(),
+						label.New("resource").Equal("cpu"),
+					),
 				),
 			),
 		),
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("kube_node_status_allocatable"),
-				vector.WithLabelMatchers(
-					label.New("job").Equal("kube-state-metrics"),
-					label.New("resource").Equal("memory"),
-					label.New("cluster").Equal("$cluster"),
+		"KubernetesCPULimitsCommitmentStatReqClusters": promqlbuilder.Div(
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("namespace_cpu:kube_pod_container_resource_limits:sum"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+					),
 				),
 			),
-		),
-	),
-	"KubernetesMemoryLimitsCommitmentStat1": promqlbuilder.Div(
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("kube_pod_container_resource_limits"),
-				vector.WithLabelMatchers(
-					label.New("job").Equal("kube-state-metrics"),
-					label.New("resource").Equal("memory"),
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("kube_node_status_allocatable"),
+					vector.WithLabelMatchers(
+						jobLabelValues.KubeStateMetricsMatcher(),
+						label.New("resource").Equal("cpu"),
+						label.New("cluster").Equal("$cluster"),
+					),
 				),
 			),
 		),
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("kube_node_status_allocatable"),
-				vector.WithLabelMatchers(
-					label.New("job").Equal("kube-state-metrics"),
-					label.New("resource").Equal("memory"),
+		"KubernetesMemoryUtilizationStatMultiCluster": promqlbuilder.Sub(
+			&parser.NumberLiteral{Val: 1},
+			promqlbuilder.Div(
+				promqlbuilder.Sum(
+					vector.New(
+						vector.WithMetricName(":node_memory_MemAvailable_bytes:sum"),
+					),
+				),
+				promqlbuilder.Sum(
+					vector.New(
+						vector.WithMetricName("node_memory_MemTotal_bytes"),
+						vector.WithLabelMatchers(
+							jobLabelValues.NodeExporterMatcher(),
+						),
+					),
 				),
 			),
 		),
-	),
-	"KubernetesMemoryLimitsCommitmentStat2": promqlbuilder.Div(
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("namespace_memory:kube_pod_container_resource_limits:sum"),
-				vector.WithLabelMatchers(
-					label.New("cluster").Equal("$cluster"),
+		"KubernetesMemoryUtilizationStatCluster": promqlbuilder.Sub(
+			&parser.NumberLiteral{Val: 1},
+			promqlbuilder.Div(
+				promqlbuilder.Sum(
+					vector.New(
+						vector.WithMetricName(":node_memory_MemAvailable_bytes:sum"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+						),
+					),
+				),
+				promqlbuilder.Sum(
+					vector.New(
+						vector.WithMetricName("node_memory_MemTotal_bytes"),
+						vector.WithLabelMatchers(
+							jobLabelValues.NodeExporterMatcher(),
+							label.New("cluster").Equal("$cluster"),
+						),
+					),
 				),
 			),
 		),
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("kube_node_status_allocatable"),
-				vector.WithLabelMatchers(
-					label.New("job").Equal("kube-state-metrics"),
-					label.New("resource").Equal("memory"),
-					label.New("cluster").Equal("$cluster"),
+		"KubernetesMemoryUtilizationNSRequests": promqlbuilder.Div(
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("container_memory_working_set_bytes"),
+					vector.WithLabelMatchers(
+						jobLabelValues.CAdvisorMatcher(),
+						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
+						label.New("container").NotEqual(""),
+						label.New("image").NotEqual(""),
+					),
 				),
 			),
-		),
-	),
-	"KubernetesCPUUsage1": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
-		),
-	).By("cluster"),
-	"KubernetesCPUUsage2": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("kube_pod_container_resource_requests"),
+					vector.WithLabelMatchers(
+						jobLabelValues.KubeStateMetricsMatcher(),
+						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
+						label.New("resource").Equal("memory"),
+					),
+				),
 			),
 		),
-	).By("namespace"),
-	"KubernetesCPUUsage3": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("kube_node_status_capacity"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-				label.New("job").Equal("kube-state-metrics"),
-				label.New("node").EqualRegexp("$node"),
-				label.New("resource").Equal("cpu"),
+		"KubernetesMemoryUtilizationNSLimits": promqlbuilder.Div(
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("container_memory_working_set_bytes"),
+					vector.WithLabelMatchers(
+						jobLabelValues.CAdvisorMatcher(),
+						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
+						label.New("container").NotEqual(""),
+						label.New("image").NotEqual(""),
+					),
+				),
 			),
-		),
-	),
-	"KubernetesCPUUsage4": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-				label.New("node").EqualRegexp("$node"),
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("kube_pod_container_resource_limits"),
+					vector.WithLabelMatchers(
+						jobLabelValues.KubeStateMetricsMatcher(),
+						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
+						label.New("resource").Equal("memory"),
+					),
+				),
 			),
 		),
-	).By("pod"),
-	"KubernetesCPUUsage5": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-				label.New("namespace").Equal("$namespace"),
+		"KubernetesMemoryRequestsCommitmentStat1": promqlbuilder.Div(
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("kube_pod_container_resource_requests"),
+					vector.WithLabelMatchers(
+						jobLabelValues.KubeStateMetricsMatcher(),
+						label.New("resource").Equal("memory"),
+					),
+				),
+			),
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("kube_node_status_allocatable"),
+					vector.WithLabelMatchers(
+						jobLabelValues.KubeStateMetricsMatcher(),
+						label.New("resource").Equal("memory"),
+					),
+				),
 			),
 		),
-	).By("pod"),
-	"KubernetesCPUUsage6": promqlbuilder.Scalar(
-		promqlbuilder.Max(
-			vector.New(
-				vector.WithMetricName("kube_resourcequota"),
-				vector.WithLabelMatchers(
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("type").Equal("hard"),
-					label.New("resource").Equal("requests.cpu"),
+		"KubernetesMemoryRequestsCommitmentStat2": promqlbuilder.Div(
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("namespace_memory:kube_pod_container_resource_requests:sum"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+					),
+				),
+			),
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("kube_node_status_allocatable"),
+					vector.WithLabelMatchers(
+						jobLabelValues.KubeStateMetricsMatcher(),
+						label.New("resource").Equal("memory"),
+						label.New("cluster").Equal("$cluster"),
+					),
 				),
 			),
 		),
-	),
-	"KubernetesCPUUsage7": promqlbuilder.Scalar(
-		promqlbuilder.Max(
-			vector.New(
-				vector.WithMetricName("kube_resourcequota"),
-				vector.WithLabelMatchers(
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("type").Equal("hard"),
-					label.New("resource").Equal("limits.cpu"),
+		"KubernetesMemoryLimitsCommitmentStat1": promqlbuilder.Div(
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("kube_pod_container_resource_limits"),
+					vector.WithLabelMatchers(
+						jobLabelValues.KubeStateMetricsMatcher(),
+						label.New("resource").Equal("memory"),
+					),
+				),
+			),
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("kube_node_status_allocatable"),
+					vector.WithLabelMatchers(
+						jobLabelValues.KubeStateMetricsMatcher(),
+						label.New("resource").Equal("memory"),
+					),
 				),
 			),
 		),
-	),
-	"KubernetesCPUUsage8": promqlbuilder.Sum(
-		promqlbuilder.Mul(
-			vector.New(
-				vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
-				vector.WithLabelMatchers(
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
+		"KubernetesMemoryLimitsCommitmentStat2": promqlbuilder.Div(
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("namespace_memory:kube_pod_container_resource_limits:sum"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+					),
 				),
 			),
-			vector.New(
-				vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-				vector.WithLabelMatchers(
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("workload_type").EqualRegexp("$type"),
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("kube_node_status_allocatable"),
+					vector.WithLabelMatchers(
+						jobLabelValues.KubeStateMetricsMatcher(),
+						label.New("resource").Equal("memory"),
+						label.New("cluster").Equal("$cluster"),
+					),
 				),
 			),
-		).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-	).By("workload", "workload_type"),
-	"KubernetesCPUUsage9": promqlbuilder.Scalar(
-		promqlbuilder.Max(
+		),
+		"KubernetesCPUUsage1": promqlbuilder.Sum(
+			vector.New(
+				vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
+			),
+		).By("cluster"),
+		"KubernetesCPUUsage2": promqlbuilder.Sum(
 			vector.New(
-				vector.WithMetricName("kube_resourcequota"),
+				vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
 				vector.WithLabelMatchers(
 					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("type").Equal("hard"),
-					label.New("resource").Equal("requests.cpu"),
 				),
 			),
-		),
-	),
-	"KubernetesCPUUsage10": promqlbuilder.Scalar(
-		promqlbuilder.Max(
+		).By("namespace"),
+		"KubernetesCPUUsage3": promqlbuilder.Sum(
 			vector.New(
-				vector.WithMetricName("kube_resourcequota"),
+				vector.WithMetricName("kube_node_status_capacity"),
 				vector.WithLabelMatchers(
 					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("type").Equal("hard"),
-					label.New("resource").Equal("limits.cpu"),
+					jobLabelValues.KubeStateMetricsMatcher(),
+					label.New("node").EqualRegexp("$node"),
+					label.New("resource").Equal("cpu"),
 				),
 			),
 		),
-	),
-	"KubernetesCPUUsage11": promqlbuilder.Sum(
-		promqlbuilder.Mul(
+		"KubernetesCPUUsage4": promqlbuilder.Sum(
 			vector.New(
 				vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
 				vector.WithLabelMatchers(
 					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
+					label.New("node").EqualRegexp("$node"),
 				),
 			),
+		).By("pod"),
+		"KubernetesCPUUsage5": promqlbuilder.Sum(
 			vector.New(
-				vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+				vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
 				vector.WithLabelMatchers(
 					label.New("cluster").Equal("$cluster"),
 					label.New("namespace").Equal("$namespace"),
-					label.New("workload").Equal("$workload"),
-					label.New("workload_type").EqualRegexp("$type"),
 				),
 			),
-		).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-	).By("pod"),
-	"KubernetesCPUUsage12": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
-			vector.WithLabelMatchers(
-				label.New("namespace").Equal("$namespace"),
-				label.New("pod").Equal("$pod"),
-				label.New("cluster").Equal("$cluster"),
-				label.New("container").NotEqual(""),
-			),
-		),
-	).By("container"),
-	"KubernetesCPUUsage13": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("kube_pod_container_resource_requests"),
-			vector.WithLabelMatchers(
-				label.New("job").Equal("kube-state-metrics"),
-				label.New("cluster").Equal("$cluster"),
-				label.New("namespace").Equal("$namespace"),
-				label.New("pod").Equal("$pod"),
-				label.New("resource").Equal("cpu"),
-			),
-		),
-	),
-	"KubernetesCPUUsage14": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("kube_pod_container_resource_limits"),
-			vector.WithLabelMatchers(
-				label.New("job").Equal("kube-state-metrics"),
-				label.New("cluster").Equal("$cluster"),
-				label.New("namespace").Equal("$namespace"),
-				label.New("pod").Equal("$pod"),
-				label.New("resource").Equal("cpu"),
-			),
-		),
-	),
-	"KubernetesMemoryUsage1": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("container_memory_rss"),
-			vector.WithLabelMatchers(
-				label.New("job").Equal("cadvisor"),
-				label.New("container").NotEqual(""),
-			),
-		),
-	).By("cluster"),
-	"KubernetesMemoryUsage2": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("container_memory_rss"),
-			vector.WithLabelMatchers(
-				label.New("job").Equal("cadvisor"),
-				label.New("cluster").Equal("$cluster"),
-				label.New("container").NotEqual(""),
-			),
-		),
-	).By("namespace"),
-	"KubernetesMemoryUsage3": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("kube_node_status_capacity"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-				label.New("job").Equal("kube-state-metrics"),
-				label.New("node").EqualRegexp("$node"),
-				label.New("resource").Equal("memory"),
+		).By("pod"),
+		"KubernetesCPUUsage6": promqlbuilder.Scalar(
+			promqlbuilder.Max(
+				vector.New(
+					vector.WithMetricName("kube_resourcequota"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
+						label.New("type").Equal("hard"),
+						label.New("resource").Equal("requests.cpu"),
+					),
+				),
 			),
 		),
-	),
-	"KubernetesMemoryUsage4": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("node_namespace_pod_container:container_memory_working_set_bytes"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-				label.New("node").EqualRegexp("$node"),
-				label.New("container").NotEqual(""),
+		"KubernetesCPUUsage7": promqlbuilder.Scalar(
+			promqlbuilder.Max(
+				vector.New(
+					vector.WithMetricName("kube_resourcequota"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
+						label.New("type").Equal("hard"),
+						label.New("resource").Equal("limits.cpu"),
+					),
+				),
 			),
 		),
-	).By("pod"),
-	"KubernetesMemoryUsage5": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("kube_node_status_capacity"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-				label.New("job").Equal("kube-state-metrics"),
-				label.New("node").EqualRegexp("$node"),
-				label.New("resource").Equal("memory"),
+		"KubernetesCPUUsage8": promqlbuilder.Sum(
+			promqlbuilder.Mul(
+				vector.New(
+					vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
+					),
+				),
+				vector.New(
+					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
+						label.New("workload_type").EqualRegexp("$type"),
+					),
+				),
+			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+		).By("workload", "workload_type"),
+		"KubernetesCPUUsage9": promqlbuilder.Scalar(
+			promqlbuilder.Max(
+				vector.New(
+					vector.WithMetricName("kube_resourcequota"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
+						label.New("type").Equal("hard"),
+						label.New("resource").Equal("requests.cpu"),
+					),
+				),
 			),
 		),
-	),
-	"KubernetesMemoryUsage6": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("node_namespace_pod_container:container_memory_rss"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-				label.New("node").EqualRegexp("$node"),
-				label.New("container").NotEqual(""),
+		"KubernetesCPUUsage10": promqlbuilder.Scalar(
+			promqlbuilder.Max(
+				vector.New(
+					vector.WithMetricName("kube_resourcequota"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
+						label.New("type").Equal("hard"),
+						label.New("resource").Equal("limits.cpu"),
+					),
+				),
 			),
 		),
-	).By("pod"),
-	"KubernetesMemoryUsage7": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("container_memory_working_set_bytes"),
-			vector.WithLabelMatchers(
-				label.New("job").Equal("cadvisor"),
-				label.New("cluster").Equal("$cluster"),
-				label.New("namespace").Equal("$namespace"),
-				label.New("container").NotEqual(""),
-				label.New("image").NotEqual(""),
+		"KubernetesCPUUsage11": promqlbuilder.Sum(
+			promqlbuilder.Mul(
+				vector.New(
+					vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
+					),
+				),
+				vector.New(
+					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
+						label.New("workload").Equal("$workload"),
+						label.New("workload_type").EqualRegexp("$type"),
+					),
+				),
+			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+		).By("pod"),
+		"KubernetesCPUUsage12": promqlbuilder.Sum(
+			vector.New(
+				vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
+				vector.WithLabelMatchers(
+					label.New("namespace").Equal("$namespace"),
+					label.New("pod").Equal("$pod"),
+					label.New("cluster").Equal("$cluster"),
+					label.New("container").NotEqual(""),
+				),
 			),
-		),
-	).By("pod"),
-	"KubernetesMemoryUsage8": promqlbuilder.Scalar(
-		promqlbuilder.Max(
+		).By("container"),
+		"KubernetesCPUUsage13": promqlbuilder.Sum(
 			vector.New(
-				vector.WithMetricName("kube_resourcequota"),
+				vector.WithMetricName("kube_pod_container_resource_requests"),
 				vector.WithLabelMatchers(
+					jobLabelValues.KubeStateMetricsMatcher(),
 					label.New("cluster").Equal("$cluster"),
 					label.New("namespace").Equal("$namespace"),
-					label.New("type").Equal("hard"),
-					label.New("resource").Equal("requests.memory"),
+					label.New("pod").Equal("$pod"),
+					label.New("resource").Equal("cpu"),
 				),
 			),
 		),
-	),
-	"KubernetesMemoryUsage9": promqlbuilder.Scalar(
-		promqlbuilder.Max(
+		"KubernetesCPUUsage14": promqlbuilder.Sum(
 			vector.New(
-				vector.WithMetricName("kube_resourcequota"),
+				vector.WithMetricName("kube_pod_container_resource_limits"),
 				vector.WithLabelMatchers(
+					jobLabelValues.KubeStateMetricsMatcher(),
 					label.New("cluster").Equal("$cluster"),
 					label.New("namespace").Equal("$namespace"),
-					label.New("type").Equal("hard"),
-					label.New("resource").Equal("limits.memory"),
+					label.New("pod").Equal("$pod"),
+					label.New("resource").Equal("cpu"),
 				),
 			),
 		),
-	),
-	"KubernetesMemoryUsage10": promqlbuilder.Sum(
-		promqlbuilder.Mul(
+		"KubernetesMemoryUsage1": promqlbuilder.Sum(
 			vector.New(
-				vector.WithMetricName("container_memory_working_set_bytes"),
+				vector.WithMetricName("container_memory_rss"),
 				vector.WithLabelMatchers(
-					label.New("job").Equal("cadvisor"),
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
+					jobLabelValues.CAdvisorMatcher(),
 					label.New("container").NotEqual(""),
-					label.New("image").NotEqual(""),
 				),
 			),
+		).By("cluster"),
+		"KubernetesMemoryUsage2": promqlbuilder.Sum(
 			vector.New(
-				vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+				vector.WithMetricName("container_memory_rss"),
 				vector.WithLabelMatchers(
+					jobLabelValues.CAdvisorMatcher(),
 					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("workload_type").EqualRegexp("$type"),
+					label.New("container").NotEqual(""),
 				),
 			),
-		).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-	).By("workload", "workload_type"),
-	"KubernetesMemoryUsage11": promqlbuilder.Scalar(
-		promqlbuilder.Max(
+		).By("namespace"),
+		"KubernetesMemoryUsage3": promqlbuilder.Sum(
 			vector.New(
-				vector.WithMetricName("kube_resourcequota"),
+				vector.WithMetricName("kube_node_status_capacity"),
 				vector.WithLabelMatchers(
 					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("type").Equal("hard"),
-					label.New("resource").EqualRegexp("requests.memory|memory"),
+					jobLabelValues.KubeStateMetricsMatcher(),
+					label.New("node").EqualRegexp("$node"),
+					label.New("resource").Equal("memory"),
 				),
 			),
 		),
-	),
-	"KubernetesMemoryUsage12": promqlbuilder.Scalar(
-		promqlbuilder.Max(
+		"KubernetesMemoryUsage4": promqlbuilder.Sum(
 			vector.New(
-				vector.WithMetricName("kube_resourcequota"),
+				vector.WithMetricName("node_namespace_pod_container:container_memory_working_set_bytes"),
 				vector.WithLabelMatchers(
 					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("type").Equal("hard"),
-					label.New("resource").EqualRegexp("limits.memory"),
+					label.New("node").EqualRegexp("$node"),
+					label.New("container").NotEqual(""),
+				),
+			),
+		).By("pod"),
+		"KubernetesMemoryUsage5": promqlbuilder.Sum(
+			vector.New(
+				vector.WithMetricName("kube_node_status_capacity"),
+				vector.WithLabelMatchers(
+					label.New("cluster").Equal("$cluster"),
+					jobLabelValues.KubeStateMetricsMatcher(),
+					label.New("node").EqualRegexp("$node"),
+					label.New("resource").Equal("memory"),
 				),
 			),
 		),
-	),
-	"KubernetesMemoryUsage13": promqlbuilder.Sum(
-		promqlbuilder.Mul(
+		"KubernetesMemoryUsage6": promqlbuilder.Sum(
 			vector.New(
-				vector.WithMetricName("container_memory_working_set_bytes"),
+				vector.WithMetricName("node_namespace_pod_container:container_memory_rss"),
 				vector.WithLabelMatchers(
 					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
+					label.New("node").EqualRegexp("$node"),
 					label.New("container").NotEqual(""),
-					label.New("image").NotEqual(""),
 				),
 			),
+		).By("pod"),
+		"KubernetesMemoryUsage7": promqlbuilder.Sum(
 			vector.New(
-				vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+				vector.WithMetricName("container_memory_working_set_bytes"),
 				vector.WithLabelMatchers(
+					jobLabelValues.CAdvisorMatcher(),
 					label.New("cluster").Equal("$cluster"),
 					label.New("namespace").Equal("$namespace"),
-					label.New("workload").Equal("$workload"),
-					label.New("workload_type").EqualRegexp("$type"),
+					label.New("container").NotEqual(""),
+					label.New("image").NotEqual(""),
 				),
 			),
-		).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-	).By("pod"),
-	"KubernetesMemoryUsage14": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("container_memory_working_set_bytes"),
-			vector.WithLabelMatchers(
-				label.New("job").Equal("cadvisor"),
-				label.New("cluster").Equal("$cluster"),
-				label.New("namespace").Equal("$namespace"),
-				label.New("pod").Equal("$pod"),
-				label.New("container").NotEqual(""),
-				label.New("image").NotEqual(""),
-			),
-		),
-	).By("container"),
-	"KubernetesMemoryUsage15": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("kube_pod_container_resource_requests"),
-			vector.WithLabelMatchers(
-				label.New("job").Equal("kube-state-metrics"),
-				label.New("cluster").Equal("$cluster"),
-				label.New("namespace").Equal("$namespace"),
-				label.New("pod").Equal("$pod"),
-				label.New("resource").Equal("memory"),
-			),
-		),
-	),
-	"KubernetesMemoryUsage16": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("kube_pod_container_resource_limits"),
-			vector.WithLabelMatchers(
-				label.New("job").Equal("kube-state-metrics"),
-				label.New("cluster").Equal("$cluster"),
-				label.New("namespace").Equal("$namespace"),
-				label.New("pod").Equal("$pod"),
-				label.New("resource").Equal("memory"),
-			),
-		),
-	),
-	// controller_manager
-	"ControllerManagerUpStatus": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("up"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-				label.New("job").Equal("kube-controller-manager"),
-			),
-		),
-	),
-	"WorkQueueAddRate": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
+		).By("pod"),
+		"KubernetesMemoryUsage8": promqlbuilder.Scalar(
+			promqlbuilder.Max(
 				vector.New(
-					vector.WithMetricName("workqueue_adds_total"),
+					vector.WithMetricName("kube_resourcequota"),
 					vector.WithLabelMatchers(
 						label.New("cluster").Equal("$cluster"),
-						label.New("job").Equal("kube-controller-manager"),
-						label.New("instance").EqualRegexp("$instance"),
+						label.New("namespace").Equal("$namespace"),
+						label.New("type").Equal("hard"),
+						label.New("resource").Equal("requests.memory"),
 					),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
 			),
 		),
-	).By("cluster", "instance", "name"),
-	"WorkQueueDepth": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
+		"KubernetesMemoryUsage9": promqlbuilder.Scalar(
+			promqlbuilder.Max(
 				vector.New(
-					vector.WithMetricName("workqueue_depth"),
+					vector.WithMetricName("kube_resourcequota"),
 					vector.WithLabelMatchers(
 						label.New("cluster").Equal("$cluster"),
-						label.New("job").Equal("kube-controller-manager"),
-						label.New("instance").EqualRegexp("$instance"),
+						label.New("namespace").Equal("$namespace"),
+						label.New("type").Equal("hard"),
+						label.New("resource").Equal("limits.memory"),
 					),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
 			),
 		),
-	).By("cluster", "instance", "name"),
-	"WorkQueueLatency": promqlbuilder.HistogramQuantile(
-		0.99,
-		promqlbuilder.Sum(
-			promqlbuilder.Rate(
-				matrix.New(
-					vector.New(
-						vector.WithMetricName("workqueue_queue_duration_seconds_bucket"),
-						vector.WithLabelMatchers(
-							label.New("cluster").Equal("$cluster"),
-							label.New("job").Equal("kube-controller-manager"),
-							label.New("instance").EqualRegexp("$instance"),
-						),
+		"KubernetesMemoryUsage10": promqlbuilder.Sum(
+			promqlbuilder.Mul(
+				vector.New(
+					vector.WithMetricName("container_memory_working_set_bytes"),
+					vector.WithLabelMatchers(
+						jobLabelValues.CAdvisorMatcher(),
+						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
+						label.New("container").NotEqual(""),
+						label.New("image").NotEqual(""),
 					),
-					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
-			),
-		).By("cluster", "instance", "name", "le"),
-	),
-	// kube_api
-	"KubeAPIRequestRate1": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
 				vector.New(
-					vector.WithMetricName("rest_client_requests_total"),
+					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
 					vector.WithLabelMatchers(
-						label.New("code").EqualRegexp("2.."),
+						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
+						label.New("workload_type").EqualRegexp("$type"),
 					),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
-		),
-	),
-	"KubeAPIRequestRate2": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
+			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+		).By("workload", "workload_type"),
+		"KubernetesMemoryUsage11": promqlbuilder.Scalar(
+			promqlbuilder.Max(
 				vector.New(
-					vector.WithMetricName("rest_client_requests_total"),
+					vector.WithMetricName("kube_resourcequota"),
 					vector.WithLabelMatchers(
-						label.New("code").EqualRegexp("3.."),
+						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
+						label.New("type").Equal("hard"),
+						label.New("resource").EqualRegexp("requests.memory|memory"),
 					),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
 			),
 		),
-	),
-	"KubeAPIRequestRate3": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
+		"KubernetesMemoryUsage12": promqlbuilder.Scalar(
+			promqlbuilder.Max(
 				vector.New(
-					vector.WithMetricName("rest_client_requests_total"),
+					vector.WithMetricName("kube_resourcequota"),
 					vector.WithLabelMatchers(
-						label.New("code").EqualRegexp("4.."),
+						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
+						label.New("type").Equal("hard"),
+						label.New("resource").EqualRegexp("limits.memory"),
 					),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
 			),
 		),
-	),
-	"KubeAPIRequestRate4": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
+		"KubernetesMemoryUsage13": promqlbuilder.Sum(
+			promqlbuilder.Mul(
+				vector.New(
+					vector.WithMetricName("container_memory_working_set_bytes"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
+						label.New("container").NotEqual(""),
+						label.New("image").NotEqual(""),
+					),
+				),
 				vector.New(
-					vector.WithMetricName("rest_client_requests_total"),
+					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
 					vector.WithLabelMatchers(
-						label.New("code").EqualRegexp("5.."),
+						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
+						label.New("workload").Equal("$workload"),
+						label.New("workload_type").EqualRegexp("$type"),
 					),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
+			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+		).By("pod"),
+		"KubernetesMemoryUsage14": promqlbuilder.Sum(
+			vector.New(
+				vector.WithMetricName("container_memory_working_set_bytes"),
+				vector.WithLabelMatchers(
+					jobLabelValues.CAdvisorMatcher(),
+					label.New("cluster").Equal("$cluster"),
+					label.New("namespace").Equal("$namespace"),
+					label.New("pod").Equal("$pod"),
+					label.New("container").NotEqual(""),
+					label.New("image").NotEqual(""),
+				),
+			),
+		).By("container"),
+		"KubernetesMemoryUsage15": promqlbuilder.Sum(
+			vector.New(
+				vector.WithMetricName("kube_pod_container_resource_requests"),
+				vector.WithLabelMatchers(
+					jobLabelValues.KubeStateMetricsMatcher(),
+					label.New("cluster").Equal("$cluster"),
+					label.New("namespace").Equal("$namespace"),
+					label.New("pod").Equal("$pod"),
+					label.New("resource").Equal("memory"),
+				),
+			),
+		),
+		"KubernetesMemoryUsage16": promqlbuilder.Sum(
+			vector.New(
+				vector.WithMetricName("kube_pod_container_resource_limits"),
+				vector.WithLabelMatchers(
+					jobLabelValues.KubeStateMetricsMatcher(),
+					label.New("cluster").Equal("$cluster"),
+					label.New("namespace").Equal("$namespace"),
+					label.New("pod").Equal("$pod"),
+					label.New("resource").Equal("memory"),
+				),
+			),
+		),
+		// controller_manager
+		"ControllerManagerUpStatus": promqlbuilder.Sum(
+			vector.New(
+				vector.WithMetricName("up"),
+				vector.WithLabelMatchers(
+					label.New("cluster").Equal("$cluster"),
+					jobLabelValues.ControllerManagerMatcher(),
+				),
 			),
 		),
-	),
-	"PostRequestLatency": promqlbuilder.HistogramQuantile(
-		0.99,
-		promqlbuilder.Sum(
+		"WorkQueueAddRate": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("rest_client_request_duration_seconds_bucket"),
+						vector.WithMetricName("workqueue_adds_total"),
 						vector.WithLabelMatchers(
-							label.New("verb").Equal("POST"),
+							label.New("cluster").Equal("$cluster"),
+							jobLabelValues.ControllerManagerMatcher(),
+							label.New("instance").EqualRegexp("$instance"),
 						),
 					),
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-		).By("verb", "le"),
-	),
-	"GetRequestLatency": promqlbuilder.HistogramQuantile(
-		0.99,
-		promqlbuilder.Sum(
+		).By("cluster", "instance", "name"),
+		"WorkQueueDepth": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("rest_client_request_duration_seconds_bucket"),
+						vector.WithMetricName("workqueue_depth"),
 						vector.WithLabelMatchers(
-							label.New("verb").Equal("GET"),
+							label.New("cluster").Equal("$cluster"),
+							jobLabelValues.ControllerManagerMatcher(),
+							label.New("instance").EqualRegexp("$instance"),
 						),
 					),
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-		).By("verb", "le"),
-	),
-	// kubelet
-	"RunningKubeletStat": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("kubelet_node_name"),
-			vector.WithLabelMatchers(
-				label.New("cluster").EqualRegexp("$cluster"),
-				label.New("job").Equal("kubelet"),
-			),
-		),
-	),
-	"RunningPodStat": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("kubelet_running_pods"),
-			vector.WithLabelMatchers(
-				label.New("cluster").EqualRegexp("$cluster"),
-				label.New("job").Equal("kubelet"),
-				label.New("instance").EqualRegexp("$instance"),
-			),
-		),
-	),
-	"RunningContainersStat": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("kubelet_running_containers"),
-			vector.WithLabelMatchers(
-				label.New("cluster").EqualRegexp("$cluster"),
-				label.New("job").Equal("kubelet"),
-				label.New("instance").EqualRegexp("$instance"),
-			),
+		).By("cluster", "instance", "name"),
+		"WorkQueueLatency": promqlbuilder.HistogramQuantile(
+			0.99,
+			promqlbuilder.Sum(
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("workqueue_queue_duration_seconds_bucket"),
+							vector.WithLabelMatchers(
+								label.New("cluster").Equal("$cluster"),
+								jobLabelValues.ControllerManagerMatcher(),
+								label.New("instance").EqualRegexp("$instance"),
+							),
+						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
+					),
+				),
+			).By("cluster", "instance", "name", "le"),
 		),
-	),
-	"ActVolumeCountStat": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("volume_manager_total_volumes"),
-			vector.WithLabelMatchers(
-				label.New("cluster").EqualRegexp("$cluster"),
-				label.New("job").Equal("kubelet"),
-				label.New("instance").EqualRegexp("$instance"),
-				label.New("state").Equal("actual_state_of_world"),
+		// kube_api
+		"KubeAPIRequestRate1": promqlbuilder.Sum(
+			promqlbuilder.Rate(
+				matrix.New(
+					vector.New(
+						vector.WithMetricName("rest_client_requests_total"),
+						vector.WithLabelMatchers(
+							label.New("code").EqualRegexp("2.."),
+						),
+					),
+					matrix.WithRangeAsVariable("$__rate_interval"),
+				),
 			),
 		),
-	),
-	"DesiredVolumeCountStat": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("volume_manager_total_volumes"),
-			vector.WithLabelMatchers(
-				label.New("cluster").EqualRegexp("$cluster"),
-				label.New("job").Equal("kubelet"),
-				label.New("instance").EqualRegexp("$instance"),
-				label.New("state").Equal("desired_state_of_world"),
+		"KubeAPIRequestRate2": promqlbuilder.Sum(
+			promqlbuilder.Rate(
+				matrix.New(
+					vector.New(
+						vector.WithMetricName("rest_client_requests_total"),
+						vector.WithLabelMatchers(
+							label.New("code").EqualRegexp("3.."),
+						),
+					),
+					matrix.WithRangeAsVariable("$__rate_interval"),
+				),
 			),
 		),
-	),
-	"ConfigErrorCountStat": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("kubelet_node_config_error"),
-					vector.WithLabelMatchers(
-						label.New("cluster").EqualRegexp("$cluster"),
-						label.New("job").Equal("kubelet"),
-						label.New("instance").EqualRegexp("$instance"),
+		"KubeAPIRequestRate3": promqlbuilder.Sum(
+			promqlbuilder.Rate(
+				matrix.New(
+					vector.New(
+						vector.WithMetricName("rest_client_requests_total"),
+						vector.WithLabelMatchers(
+							label.New("code").EqualRegexp("4.."),
+						),
 					),
+					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
 			),
 		),
-	),
-	"OperationRate": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("kubelet_runtime_operations_total"),
-					vector.WithLabelMatchers(
-						label.New("cluster").EqualRegexp("$cluster"),
-						label.New("job").Equal("kubelet"),
-						label.New("instance").EqualRegexp("$instance"),
+		"KubeAPIRequestRate4": promqlbuilder.Sum(
+			promqlbuilder.Rate(
+				matrix.New(
+					vector.New(
+						vector.WithMetricName("rest_client_requests_total"),
+						vector.WithLabelMatchers(
+							label.New("code").EqualRegexp("5.."),
+						),
 					),
+					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
 			),
 		),
-	).By("operation_type", "instance"),
-	"OperationErrorRate": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("kubelet_runtime_operations_errors_total"),
-					vector.WithLabelMatchers(
-						label.New("cluster").EqualRegexp("$cluster"),
-						label.New("job").Equal("kubelet"),
-						label.New("instance").EqualRegexp("$instance"),
+		"PostRequestLatency": promqlbuilder.HistogramQuantile(
+			0.99,
+			promqlbuilder.Sum(
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("rest_client_request_duration_seconds_bucket"),
+							vector.WithLabelMatchers(
+								label.New("verb").Equal("POST"),
+							),
+						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
+					),
+				),
+			).By("verb", "le"),
+		),
+		"GetRequestLatency": promqlbuilder.HistogramQuantile(
+			0.99,
+			promqlbuilder.Sum(
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("rest_client_request_duration_seconds_bucket"),
+							vector.WithLabelMatchers(
+								label.New("verb").Equal("GET"),
+							),
+						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
+			).By("verb", "le"),
+		),
+		// kubelet
+		"RunningKubeletStat": promqlbuilder.Sum(
+			vector.New(
+				vector.WithMetricName("kubelet_node_name"),
+				vector.WithLabelMatchers(
+					label.New("cluster").EqualRegexp("$cluster"),
+					jobLabelValues.KubeletMatcher(),
+				),
+			),
+		),
+		"RunningPodStat": promqlbuilder.Sum(
+			vector.New(
+				vector.WithMetricName("kubelet_running_pods"),
+				vector.WithLabelMatchers(
+					label.New("cluster").EqualRegexp("$cluster"),
+					jobLabelValues.KubeletMatcher(),
+					label.New("instance").EqualRegexp("$instance"),
+				),
+			),
+		),
+		"RunningContainersStat": promqlbuilder.Sum(
+			vector.New(
+				vector.WithMetricName("kubelet_running_containers"),
+				vector.WithLabelMatchers(
+					label.New("cluster").EqualRegexp("$cluster"),
+					jobLabelValues.KubeletMatcher(),
+					label.New("instance").EqualRegexp("$instance"),
+				),
+			),
+		),
+		"ActVolumeCountStat": promqlbuilder.Sum(
+			vector.New(
+				vector.WithMetricName("volume_manager_total_volumes"),
+				vector.WithLabelMatchers(
+					label.New("cluster").EqualRegexp("$cluster"),
+					jobLabelValues.KubeletMatcher(),
+					label.New("instance").EqualRegexp("$instance"),
+					label.New("state").Equal("actual_state_of_world"),
+				),
 			),
 		),
-	).By("instance", "operation_type"),
-	"OperationDurationQuantile": promqlbuilder.HistogramQuantile(
-		0.99,
-		promqlbuilder.Sum(
+		"DesiredVolumeCountStat": promqlbuilder.Sum(
+			vector.New(
+				vector.WithMetricName("volume_manager_total_volumes"),
+				vector.WithLabelMatchers(
+					label.New("cluster").EqualRegexp("$cluster"),
+					jobLabelValues.KubeletMatcher(),
+					label.New("instance").EqualRegexp("$instance"),
+					label.New("state").Equal("desired_state_of_world"),
+				),
+			),
+		),
+		"ConfigErrorCountStat": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("kubelet_runtime_operations_duration_seconds_bucket"),
+						vector.WithMetricName("kubelet_node_config_error"),
 						vector.WithLabelMatchers(
 							label.New("cluster").EqualRegexp("$cluster"),
-							label.New("job").Equal("kubelet"),
+							jobLabelValues.KubeletMatcher(),
 							label.New("instance").EqualRegexp("$instance"),
 						),
 					),
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-		).By("instance", "operation_type", "le"),
-	),
-	"PodStartRate1": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("kubelet_pod_start_duration_seconds_count"),
-					vector.WithLabelMatchers(
-						label.New("cluster").EqualRegexp("$cluster"),
-						label.New("job").Equal("kubelet"),
-						label.New("instance").EqualRegexp("$instance"),
+		),
+		"OperationRate": promqlbuilder.Sum(
+			promqlbuilder.Rate(
+				matrix.New(
+					vector.New(
+						vector.WithMetricName("kubelet_runtime_operations_total"),
+						vector.WithLabelMatchers(
+							label.New("cluster").EqualRegexp("$cluster"),
+							jobLabelValues.KubeletMatcher(),
+							label.New("instance").EqualRegexp("$instance"),
+						),
 					),
+					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
 			),
-		),
-	).By("instance"),
-	"PodStartRate2": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("kubelet_pod_worker_duration_seconds_count"),
-					vector.WithLabelMatchers(
-						label.New("cluster").EqualRegexp("$cluster"),
-						label.New("job").Equal("kubelet"),
-						label.New("instance").EqualRegexp("$instance"),
+		).By("operation_type", "instance"),
+		"OperationErrorRate": promqlbuilder.Sum(
+			promqlbuilder.Rate(
+				matrix.New(
+					vector.New(
+						vector.WithMetricName("kubelet_runtime_operations_errors_total"),
+						vector.WithLabelMatchers(
+							label.New("cluster").EqualRegexp("$cluster"),
+							jobLabelValues.KubeletMatcher(),
+							label.New("instance").EqualRegexp("$instance"),
+						),
 					),
+					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
 			),
+		).By("instance", "operation_type"),
+		"OperationDurationQuantile": promqlbuilder.HistogramQuantile(
+			0.99,
+			promqlbuilder.Sum(
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("kubelet_runtime_operations_duration_seconds_bucket"),
+							vector.WithLabelMatchers(
+								label.New("cluster").EqualRegexp("$cluster"),
+								jobLabelValues.KubeletMatcher(),
+								label.New("instance").EqualRegexp("$instance"),
+							),
+						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
+					),
+				),
+			).By("instance", "operation_type", "le"),
 		),
-	).By("instance"),
-	"PodStartDuration1": promqlbuilder.HistogramQuantile(
-		0.99,
-		promqlbuilder.Sum(
+		"PodStartRate1": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("kubelet_pod_start_duration_seconds_bucket"),
+						vector.WithMetricName("kubelet_pod_start_duration_seconds_count"),
 						vector.WithLabelMatchers(
 							label.New("cluster").EqualRegexp("$cluster"),
-							label.New("job").Equal("kubelet"),
+							jobLabelValues.KubeletMatcher(),
 							label.New("instance").EqualRegexp("$instance"),
 						),
 					),
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-		).By("instance", "le"),
-	),
-	"PodStartDuration2": promqlbuilder.HistogramQuantile(
-		0.99,
-		promqlbuilder.Sum(
+		).By("instance"),
+		"PodStartRate2": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("kubelet_pod_worker_duration_seconds_bucket"),
+						vector.WithMetricName("kubelet_pod_worker_duration_seconds_count"),
 						vector.WithLabelMatchers(
 							label.New("cluster").EqualRegexp("$cluster"),
-							label.New("job").Equal("kubelet"),
+							jobLabelValues.KubeletMatcher(),
 							label.New("instance").EqualRegexp("$instance"),
 						),
 					),
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-		).By("instance", "le"),
-	),
-	"StorageOperationRate": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("storage_operation_duration_seconds_count"),
-					vector.WithLabelMatchers(
-						label.New("cluster").EqualRegexp("$cluster"),
-						label.New("job").Equal("kubelet"),
-						label.New("instance").EqualRegexp("$instance"),
+		).By("instance"),
+		"PodStartDuration1": promqlbuilder.HistogramQuantile(
+			0.99,
+			promqlbuilder.Sum(
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("kubelet_pod_start_duration_seconds_bucket"),
+							vector.WithLabelMatchers(
+								label.New("cluster").EqualRegexp("$cluster"),
+								jobLabelValues.KubeletMatcher(),
+								label.New("instance").EqualRegexp("$instance"),
+							),
+						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
+			).By("instance", "le"),
 		),
-	).By("instance", "operation_name", "volume_plugin"),
-	"StorageOperationErrorRate": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("storage_operation_errors_total"),
-					vector.WithLabelMatchers(
-						label.New("cluster").EqualRegexp("$cluster"),
-						label.New("job").Equal("kubelet"),
-						label.New("instance").EqualRegexp("$instance"),
+		"PodStartDuration2": promqlbuilder.HistogramQuantile(
+			0.99,
+			promqlbuilder.Sum(
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("kubelet_pod_worker_duration_seconds_bucket"),
+							vector.WithLabelMatchers(
+								label.New("cluster").EqualRegexp("$cluster"),
+								jobLabelValues.KubeletMatcher(),
+								label.New("instance").EqualRegexp("$instance"),
+							),
+						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
+			).By("instance", "le"),
 		),
-	).By("instance", "operation_name", "volume_plugin"),
-	"StorageOperationDuration": promqlbuilder.HistogramQuantile(
-		0.99,
-		promqlbuilder.Sum(
+		"StorageOperationRate": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("storage_operation_duration_seconds_bucket"),
+						vector.WithMetricName("storage_operation_duration_seconds_count"),
 						vector.WithLabelMatchers(
 							label.New("cluster").EqualRegexp("$cluster"),
-							label.New("job").Equal("kubelet"),
+							jobLabelValues.KubeletMatcher(),
 							label.New("instance").EqualRegexp("$instance"),
 						),
 					),
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-		).By("instance", "operation_name", "volume_plugin", "le"),
-	),
-	"CgroupManagerOperationRate": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("kubelet_cgroup_manager_duration_seconds_count"),
-					vector.WithLabelMatchers(
-						label.New("cluster").EqualRegexp("$cluster"),
-						label.New("job").Equal("kubelet"),
-						label.New("instance").EqualRegexp("$instance"),
-					),
-				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
-		),
-	).By("instance", "operation_type"),
-	"CgroupManagerQuantile": promqlbuilder.HistogramQuantile(
-		0.99,
-		promqlbuilder.Sum(
+		).By("instance", "operation_name", "volume_plugin"),
+		"StorageOperationErrorRate": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("kubelet_cgroup_manager_duration_seconds_bucket"),
+						vector.WithMetricName("storage_operation_errors_total"),
 						vector.WithLabelMatchers(
 							label.New("cluster").EqualRegexp("$cluster"),
-							label.New("job").Equal("kubelet"),
+							jobLabelValues.KubeletMatcher(),
 							label.New("instance").EqualRegexp("$instance"),
 						),
 					),
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-		).By("instance", "operation_type", "le"),
-	),
-	"PLEGRelistRate": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("kubelet_pleg_relist_duration_seconds_count"),
-					vector.WithLabelMatchers(
-						label.New("cluster").EqualRegexp("$cluster"),
-						label.New("job").Equal("kubelet"),
-						label.New("instance").EqualRegexp("$instance"),
+		).By("instance", "operation_name", "volume_plugin"),
+		"StorageOperationDuration": promqlbuilder.HistogramQuantile(
+			0.99,
+			promqlbuilder.Sum(
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("storage_operation_duration_seconds_bucket"),
+							vector.WithLabelMatchers(
+								label.New("cluster").EqualRegexp("$cluster"),
+								jobLabelValues.KubeletMatcher(),
+								label.New("instance").EqualRegexp("$instance"),
+							),
+						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
+			).By("instance", "operation_name", "volume_plugin", "le"),
 		),
-	).By("instance"),
-	"PLEGRelistInterval": promqlbuilder.HistogramQuantile(
-		0.99,
-		promqlbuilder.Sum(
+		"CgroupManagerOperationRate": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("kubelet_pleg_relist_interval_seconds_bucket"),
+						vector.WithMetricName("kubelet_cgroup_manager_duration_seconds_count"),
 						vector.WithLabelMatchers(
 							label.New("cluster").EqualRegexp("$cluster"),
-							label.New("job").Equal("kubelet"),
+							jobLabelValues.KubeletMatcher(),
 							label.New("instance").EqualRegexp("$instance"),
 						),
 					),
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-		).By("instance", "le"),
-	),
-	"PLEGRelistDuration": promqlbuilder.HistogramQuantile(
-		0.99,
-		promqlbuilder.Sum(
+		).By("instance", "operation_type"),
+		"CgroupManagerQuantile": promqlbuilder.HistogramQuantile(
+			0.99,
+			promqlbuilder.Sum(
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("kubelet_cgroup_manager_duration_seconds_bucket"),
+							vector.WithLabelMatchers(
+								label.New("cluster").EqualRegexp("$cluster"),
+								jobLabelValues.KubeletMatcher(),
+								label.New("instance").EqualRegexp("$instance"),
+							),
+						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
+					),
+				),
+			).By("instance", "operation_type", "le"),
+		),
+		"PLEGRelistRate": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("kubelet_pleg_relist_duration_seconds_bucket"),
+						vector.WithMetricName("kubelet_pleg_relist_duration_seconds_count"),
 						vector.WithLabelMatchers(
 							label.New("cluster").EqualRegexp("$cluster"),
-							label.New("job").Equal("kubelet"),
+							jobLabelValues.KubeletMatcher(),
 							label.New("instance").EqualRegexp("$instance"),
 						),
 					),
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-		).By("instance", "le"),
-	),
-	"RPCRate1": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("rest_client_requests_total"),
-					vector.WithLabelMatchers(
-						label.New("cluster").EqualRegexp("$cluster"),
-						label.New("job").Equal("kubelet"),
-						label.New("instance").EqualRegexp("$instance"),
-						label.New("code").EqualRegexp("2.."),
+		).By("instance"),
+		"PLEGRelistInterval": promqlbuilder.HistogramQuantile(
+			0.99,
+			promqlbuilder.Sum(
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("kubelet_pleg_relist_interval_seconds_bucket"),
+							vector.WithLabelMatchers(
+								label.New("cluster").EqualRegexp("$cluster"),
+								jobLabelValues.KubeletMatcher(),
+								label.New("instance").EqualRegexp("$instance"),
+							),
+						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
+			).By("instance", "le"),
 		),
-	),
-	"RPCRate2": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("rest_client_requests_total"),
-					vector.WithLabelMatchers(
-						label.New("cluster").EqualRegexp("$cluster"),
-						label.New("job").Equal("kubelet"),
-						label.New("instance").EqualRegexp("$instance"),
-						label.New("code").EqualRegexp("3.."),
+		"PLEGRelistDuration": promqlbuilder.HistogramQuantile(
+			0.99,
+			promqlbuilder.Sum(
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("kubelet_pleg_relist_duration_seconds_bucket"),
+							vector.WithLabelMatchers(
+								label.New("cluster").EqualRegexp("$cluster"),
+								jobLabelValues.KubeletMatcher(),
+								label.New("instance").EqualRegexp("$instance"),
+							),
+						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
+			).By("instance", "le"),
 		),
-	),
-	"RPCRate3": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("rest_client_requests_total"),
-					vector.WithLabelMatchers(
-						label.New("cluster").EqualRegexp("$cluster"),
-						label.New("job").Equal("kubelet"),
-						label.New("instance").EqualRegexp("$instance"),
-						label.New("code").EqualRegexp("4.."),
+		"RPCRate1": promqlbuilder.Sum(
+			promqlbuilder.Rate(
+				matrix.New(
+					vector.New(
+						vector.WithMetricName("rest_client_requests_total"),
+						vector.WithLabelMatchers(
+							label.New("cluster").EqualRegexp("$cluster"),
+							jobLabelValues.KubeletMatcher(),
+							label.New("instance").EqualRegexp("$instance"),
+							label.New("code").EqualRegexp("2.."),
+						),
 					),
+					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
 			),
 		),
-	),
-	"RPCRate4": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("rest_client_requests_total"),
-					vector.WithLabelMatchers(
-						label.New("cluster").EqualRegexp("$cluster"),
-						label.New("job").Equal("kubelet"),
-						label.New("instance").EqualRegexp("$instance"),
-						label.New("code").EqualRegexp("5.."),
+		"RPCRate2": promqlbuilder.Sum(
+			promqlbuilder.Rate(
+				matrix.New(
+					vector.New(
+						vector.WithMetricName("rest_client_requests_total"),
+						vector.WithLabelMatchers(
+							label.New("cluster").EqualRegexp("$cluster"),
+							jobLabelValues.KubeletMatcher(),
+							label.New("instance").EqualRegexp("$instance"),
+							label.New("code").EqualRegexp("3.."),
+						),
 					),
+					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
 			),
 		),
-	),
-	"RequestDurationQuantile": promqlbuilder.HistogramQuantile(
-		0.99,
-		promqlbuilder.Sum(
+		"RPCRate3": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("rest_client_request_duration_seconds_bucket"),
+						vector.WithMetricName("rest_client_requests_total"),
 						vector.WithLabelMatchers(
 							label.New("cluster").EqualRegexp("$cluster"),
-							label.New("job").Equal("kubelet"),
+							jobLabelValues.KubeletMatcher(),
 							label.New("instance").EqualRegexp("$instance"),
+							label.New("code").EqualRegexp("4.."),
 						),
 					),
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-		).By("instance", "verb", "le"),
-	),
-	// multicluster
-	"MultiClusterCPUUsageQuota1": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
 		),
-	).By("cluster"),
-	"MultiClusterCPUUsageQuota2": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("kube_pod_container_resource_requests"),
-			vector.WithLabelMatchers(
-				label.New("job").Equal("kube-state-metrics"),
-				label.New("resource").Equal("cpu"),
+		"RPCRate4": promqlbuilder.Sum(
+			promqlbuilder.Rate(
+				matrix.New(
+					vector.New(
+						vector.WithMetricName("rest_client_requests_total"),
+						vector.WithLabelMatchers(
+							label.New("cluster").EqualRegexp("$cluster"),
+							jobLabelValues.KubeletMatcher(),
+							label.New("instance").EqualRegexp("$instance"),
+							label.New("code").EqualRegexp("5.."),
+						),
+					),
+					matrix.WithRangeAsVariable("$__rate_interval"),
+				),
 			),
 		),
-	).By("cluster"),
-	"MultiClusterCPUUsageQuota3": promqlbuilder.Div(
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
-			),
-		).By("cluster"),
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("kube_pod_container_resource_requests"),
-				vector.WithLabelMatchers(
-					label.New("job").Equal("kube-state-metrics"),
-					label.New("resource").Equal("cpu"),
+		"RequestDurationQuantile": promqlbuilder.HistogramQuantile(
+			0.99,
+			promqlbuilder.Sum(
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("rest_client_request_duration_seconds_bucket"),
+							vector.WithLabelMatchers(
+								label.New("cluster").EqualRegexp("$cluster"),
+								jobLabelValues.KubeletMatcher(),
+								label.New("instance").EqualRegexp("$instance"),
+							),
+						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
+					),
 				),
-			),
-		).By("cluster"),
-	),
-	"MultiClusterCPUUsageQuota4": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("kube_pod_container_resource_limits"),
-			vector.WithLabelMatchers(
-				label.New("job").Equal("kube-state-metrics"),
-				label.New("resource").Equal("cpu"),
-			),
+			).By("instance", "verb", "le"),
 		),
-	).By("cluster"),
-	"MultiClusterCPUUsageQuota5": promqlbuilder.Div(
-		promqlbuilder.Sum(
+		// multicluster
+		"MultiClusterCPUUsageQuota1": promqlbuilder.Sum(
 			vector.New(
 				vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
 			),
 		).By("cluster"),
-		promqlbuilder.Sum(
+		"MultiClusterCPUUsageQuota2": promqlbuilder.Sum(
 			vector.New(
-				vector.WithMetricName("kube_pod_container_resource_limits"),
+				vector.WithMetricName("kube_pod_container_resource_requests"),
 				vector.WithLabelMatchers(
-					label.New("job").Equal("kube-state-metrics"),
+					jobLabelValues.KubeStateMetricsMatcher(),
 					label.New("resource").Equal("cpu"),
 				),
 			),
 		).By("cluster"),
-	),
-	"MultiClusterMemoryUsageQuota1": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("container_memory_rss"),
-			vector.WithLabelMatchers(
-				label.New("job").Equal("cadvisor"),
-				label.New("container").NotEqual(""),
-			),
+		"MultiClusterCPUUsageQuota3": promqlbuilder.Div(
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
+				),
+			).By("cluster"),
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("kube_pod_container_resource_requests"),
+					vector.WithLabelMatchers(
+						jobLabelValues.KubeStateMetricsMatcher(),
+						label.New("resource").Equal("cpu"),
+					),
+				),
+			).By("cluster"),
 		),
-	).By("cluster"),
-	"MultiClusterMemoryUsageQuota2": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("kube_pod_container_resource_requests"),
-			vector.WithLabelMatchers(
-				label.New("job").Equal("kube-state-metrics"),
-				label.New("resource").Equal("memory"),
+		"MultiClusterCPUUsageQuota4": promqlbuilder.Sum(
+			vector.New(
+				vector.WithMetricName("kube_pod_container_resource_limits"),
+				vector.WithLabelMatchers(
+					jobLabelValues.KubeStateMetricsMatcher(),
+					label.New("resource").Equal("cpu"),
+				),
 			),
+		).By("cluster"),
+		"MultiClusterCPUUsageQuota5": promqlbuilder.Div(
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
+				),
+			).By("cluster"),
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("kube_pod_container_resource_limits"),
+					vector.WithLabelMatchers(
+						jobLabelValues.KubeStateMetricsMatcher(),
+						label.New("resource").Equal("cpu"),
+					),
+				),
+			).By("cluster"),
 		),
-	).By("cluster"),
-	"MultiClusterMemoryUsageQuota3": promqlbuilder.Div(
-		promqlbuilder.Sum(
+		"MultiClusterMemoryUsageQuota1": promqlbuilder.Sum(
 			vector.New(
 				vector.WithMetricName("container_memory_rss"),
 				vector.WithLabelMatchers(
-					label.New("job").Equal("cadvisor"),
+					jobLabelValues.CAdvisorMatcher(),
 					label.New("container").NotEqual(""),
 				),
 			),
 		).By("cluster"),
-		promqlbuilder.Sum(
+		"MultiClusterMemoryUsageQuota2": promqlbuilder.Sum(
 			vector.New(
 				vector.WithMetricName("kube_pod_container_resource_requests"),
 				vector.WithLabelMatchers(
-					label.New("job").Equal("kube-state-metrics"),
+					jobLabelValues.KubeStateMetricsMatcher(),
 					label.New("resource").Equal("memory"),
 				),
 			),
 		).By("cluster"),
-	),
-	"MultiClusterMemoryUsageQuota4": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("kube_pod_container_resource_limits"),
-			vector.WithLabelMatchers(
-				label.New("job").Equal("kube-state-metrics"),
-				label.New("resource").Equal("memory"),
-			),
-		),
-	).By("cluster"),
-	"MultiClusterMemoryUsageQuota5": promqlbuilder.Div(
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("container_memory_rss"),
-				vector.WithLabelMatchers(
-					label.New("job").Equal("cadvisor"),
-					label.New("container").NotEqual(""),
+		"MultiClusterMemoryUsageQuota3": promqlbuilder.Div(
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("container_memory_rss"),
+					vector.WithLabelMatchers(
+						jobLabelValues.CAdvisorMatcher(),
+						label.New("container").NotEqual(""),
+					),
 				),
-			),
-		).By("cluster"),
-		promqlbuilder.Sum(
+			).By("cluster"),
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("kube_pod_container_resource_requests"),
+					vector.WithLabelMatchers(
+						jobLabelValues.KubeStateMetricsMatcher(),
+						label.New("resource").Equal("memory"),
+					),
+				),
+			).By("cluster"),
+		),
+		"MultiClusterMemoryUsageQuota4": promqlbuilder.Sum(
 			vector.New(
 				vector.WithMetricName("kube_pod_container_resource_limits"),
 				vector.WithLabelMatchers(
-					label.New("job").Equal("kube-state-metrics"),
+					jobLabelValues.KubeStateMetricsMatcher(),
 					label.New("resource").Equal("memory"),
 				),
 			),
 		).By("cluster"),
-	),
-	// namespace
-	"NamespaceCPUUsageQuota1": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-				label.New("namespace").Equal("$namespace"),
-			),
-		),
-	).By("pod"),
-	"NamespaceCPUUsageQuota2": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("cluster:namespace:pod_cpu:active:kube_pod_container_resource_requests"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-				label.New("namespace").Equal("$namespace"),
-			),
+		"MultiClusterMemoryUsageQuota5": promqlbuilder.Div(
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("container_memory_rss"),
+					vector.WithLabelMatchers(
+						jobLabelValues.CAdvisorMatcher(),
+						label.New("container").NotEqual(""),
+					),
+				),
+			).By("cluster"),
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("kube_pod_container_resource_limits"),
+					vector.WithLabelMatchers(
+						jobLabelValues.KubeStateMetricsMatcher(),
+						label.New("resource").Equal("memory"),
+					),
+				),
+			).By("cluster"),
 		),
-	).By("pod"),
-	"NamespaceCPUUsageQuota3": promqlbuilder.Div(
-		promqlbuilder.Sum(
+		// namespace
+		"NamespaceCPUUsageQuota1": promqlbuilder.Sum(
 			vector.New(
 				vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
 				vector.WithLabelMatchers(
@@ -2254,7 +2237,7 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 				),
 			),
 		).By("pod"),
-		promqlbuilder.Sum(
+		"NamespaceCPUUsageQuota2": promqlbuilder.Sum(
 			vector.New(
 				vector.WithMetricName("cluster:namespace:pod_cpu:active:kube_pod_container_resource_requests"),
 				vector.WithLabelMatchers(
@@ -2263,95 +2246,60 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 				),
 			),
 		).By("pod"),
-	),
-	"NamespaceCPUUsageQuota4": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("cluster:namespace:pod_cpu:active:kube_pod_container_resource_limits"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-				label.New("namespace").Equal("$namespace"),
-			),
-		),
-	).By("pod"),
-	"NamespaceCPUUsageQuota5": promqlbuilder.Div(
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
-				vector.WithLabelMatchers(
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
+		"NamespaceCPUUsageQuota3": promqlbuilder.Div(
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
+					),
 				),
-			),
-		).By("pod"),
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("cluster:namespace:pod_cpu:active:kube_pod_container_resource_limits"),
-				vector.WithLabelMatchers(
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
+			).By("pod"),
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("cluster:namespace:pod_cpu:active:kube_pod_container_resource_requests"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
+					),
 				),
-			),
-		).By("pod"),
-	),
-	"NamespaceMemoryUsageQuota1": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("container_memory_working_set_bytes"),
-			vector.WithLabelMatchers(
-				label.New("job").Equal("cadvisor"),
-				label.New("cluster").Equal("$cluster"),
-				label.New("namespace").Equal("$namespace"),
-				label.New("container").NotEqual(""),
-				label.New("image").NotEqual(""),
-			),
-		),
-	).By("pod"),
-	"NamespaceMemoryUsageQuota2": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("cluster:namespace:pod_memory:active:kube_pod_container_resource_requests"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-				label.New("namespace").Equal("$namespace"),
-			),
+			).By("pod"),
 		),
-	).By("pod"),
-	"NamespaceMemoryUsageQuota3": promqlbuilder.Div(
-		promqlbuilder.Sum(
+		"NamespaceCPUUsageQuota4": promqlbuilder.Sum(
 			vector.New(
-				vector.WithMetricName("container_memory_working_set_bytes"),
+				vector.WithMetricName("cluster:namespace:pod_cpu:active:kube_pod_container_resource_limits"),
 				vector.WithLabelMatchers(
-					label.New("job").Equal("cadvisor"),
 					label.New("cluster").Equal("$cluster"),
 					label.New("namespace").Equal("$namespace"),
-					label.New("container").NotEqual(""),
-					label.New("image").NotEqual(""),
 				),
 			),
 		).By("pod"),
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("cluster:namespace:pod_memory:active:kube_pod_container_resource_requests"),
-				vector.WithLabelMatchers(
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
+		"NamespaceCPUUsageQuota5": promqlbuilder.Div(
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
+					),
 				),
-			),
-		).By("pod"),
-	),
-	"NamespaceMemoryUsageQuota4": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("cluster:namespace:pod_memory:active:kube_pod_container_resource_limits"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-				label.New("namespace").Equal("$namespace"),
-			),
+			).By("pod"),
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("cluster:namespace:pod_cpu:active:kube_pod_container_resource_limits"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
+					),
+				),
+			).By("pod"),
 		),
-	).By("pod"),
-	"NamespaceMemoryUsageQuota5": promqlbuilder.Div(
-		promqlbuilder.Sum(
+		"NamespaceMemoryUsageQuota1": promqlbuilder.Sum(
 			vector.New(
 				vector.WithMetricName("container_memory_working_set_bytes"),
 				vector.WithLabelMatchers(
-					label.New("job").Equal("cadvisor"),
+					jobLabelValues.CAdvisorMatcher(),
 					label.New("cluster").Equal("$cluster"),
 					label.New("namespace").Equal("$namespace"),
 					label.New("container").NotEqual(""),
@@ -2359,183 +2307,125 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 				),
 			),
 		).By("pod"),
-		promqlbuilder.Sum(
+		"NamespaceMemoryUsageQuota2": promqlbuilder.Sum(
 			vector.New(
-				vector.WithMetricName("cluster:namespace:pod_memory:active:kube_pod_container_resource_limits"),
+				vector.WithMetricName("cluster:namespace:pod_memory:active:kube_pod_container_resource_requests"),
 				vector.WithLabelMatchers(
 					label.New("cluster").Equal("$cluster"),
 					label.New("namespace").Equal("$namespace"),
 				),
 			),
 		).By("pod"),
-	),
-	"NamespaceMemoryUsageQuota6": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("container_memory_rss"),
-			vector.WithLabelMatchers(
-				label.New("job").Equal("cadvisor"),
-				label.New("cluster").Equal("$cluster"),
-				label.New("namespace").Equal("$namespace"),
-				label.New("container").NotEqual(""),
-			),
-		),
-	).By("pod"),
-	"NamespaceMemoryUsageQuota7": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("container_memory_cache"),
-			vector.WithLabelMatchers(
-				label.New("job").Equal("cadvisor"),
-				label.New("cluster").Equal("$cluster"),
-				label.New("namespace").Equal("$namespace"),
-				label.New("container").NotEqual(""),
-			),
-		),
-	).By("pod"),
-	"NamespaceMemoryUsageQuota8": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("container_memory_swap"),
-			vector.WithLabelMatchers(
-				label.New("job").Equal("cadvisor"),
-				label.New("cluster").Equal("$cluster"),
-				label.New("namespace").Equal("$namespace"),
-				label.New("container").NotEqual(""),
-			),
-		),
-	).By("pod"),
-	"NamespaceCurrentNetworkUsage1": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
+		"NamespaceMemoryUsageQuota3": promqlbuilder.Div(
+			promqlbuilder.Sum(
 				vector.New(
-					vector.WithMetricName("container_network_receive_bytes_total"),
+					vector.WithMetricName("container_memory_working_set_bytes"),
 					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
+						jobLabelValues.CAdvisorMatcher(),
 						label.New("cluster").Equal("$cluster"),
 						label.New("namespace").Equal("$namespace"),
+						label.New("container").NotEqual(""),
+						label.New("image").NotEqual(""),
 					),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
-		),
-	).By("pod"),
-	"NamespaceCurrentNetworkUsage2": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
+			).By("pod"),
+			promqlbuilder.Sum(
 				vector.New(
-					vector.WithMetricName("container_network_transmit_bytes_total"),
+					vector.WithMetricName("cluster:namespace:pod_memory:active:kube_pod_container_resource_requests"),
 					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
 						label.New("cluster").Equal("$cluster"),
 						label.New("namespace").Equal("$namespace"),
 					),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
+			).By("pod"),
 		),
-	).By("pod"),
-	"NamespaceCurrentNetworkUsage3": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_network_receive_packets_total"),
-					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-					),
+		"NamespaceMemoryUsageQuota4": promqlbuilder.Sum(
+			vector.New(
+				vector.WithMetricName("cluster:namespace:pod_memory:active:kube_pod_container_resource_limits"),
+				vector.WithLabelMatchers(
+					label.New("cluster").Equal("$cluster"),
+					label.New("namespace").Equal("$namespace"),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
 			),
-		),
-	).By("pod"),
-	"NamespaceCurrentNetworkUsage4": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
+		).By("pod"),
+		"NamespaceMemoryUsageQuota5": promqlbuilder.Div(
+			promqlbuilder.Sum(
 				vector.New(
-					vector.WithMetricName("container_network_transmit_packets_total"),
+					vector.WithMetricName("container_memory_working_set_bytes"),
 					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
+						jobLabelValues.CAdvisorMatcher(),
 						label.New("cluster").Equal("$cluster"),
 						label.New("namespace").Equal("$namespace"),
+						label.New("container").NotEqual(""),
+						label.New("image").NotEqual(""),
 					),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
-		),
-	).By("pod"),
-	"NamespaceCurrentNetworkUsage5": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
+			).By("pod"),
+			promqlbuilder.Sum(
 				vector.New(
-					vector.WithMetricName("container_network_receive_packets_dropped_total"),
+					vector.WithMetricName("cluster:namespace:pod_memory:active:kube_pod_container_resource_limits"),
 					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
 						label.New("cluster").Equal("$cluster"),
 						label.New("namespace").Equal("$namespace"),
 					),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
+			).By("pod"),
 		),
-	).By("pod"),
-	"NamespaceCurrentNetworkUsage6": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_network_transmit_packets_dropped_total"),
-					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-					),
+		"NamespaceMemoryUsageQuota6": promqlbuilder.Sum(
+			vector.New(
+				vector.WithMetricName("container_memory_rss"),
+				vector.WithLabelMatchers(
+					jobLabelValues.CAdvisorMatcher(),
+					label.New("cluster").Equal("$cluster"),
+					label.New("namespace").Equal("$namespace"),
+					label.New("container").NotEqual(""),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
 			),
-		),
-	).By("pod"),
-	"NamespaceCurrentStorageIO1": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_fs_reads_total"),
-					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
-						label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
-						label.New("container").NotEqual(""),
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-					),
+		).By("pod"),
+		"NamespaceMemoryUsageQuota7": promqlbuilder.Sum(
+			vector.New(
+				vector.WithMetricName("container_memory_cache"),
+				vector.WithLabelMatchers(
+					jobLabelValues.CAdvisorMatcher(),
+					label.New("cluster").Equal("$cluster"),
+					label.New("namespace").Equal("$namespace"),
+					label.New("container").NotEqual(""),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
 			),
-		),
-	).By("pod"),
-	"NamespaceCurrentStorageIO2": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_fs_writes_total"),
-					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
-						label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
-						label.New("container").NotEqual(""),
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
+		).By("pod"),
+		"NamespaceMemoryUsageQuota8": promqlbuilder.Sum(
+			vector.New(
+				vector.WithMetricName("container_memory_swap"),
+				vector.WithLabelMatchers(
+					jobLabelValues.CAdvisorMatcher(),
+					label.New("cluster").Equal("$cluster"),
+					label.New("namespace").Equal("$namespace"),
+					label.New("container").NotEqual(""),
+				),
+			),
+		).By("pod"),
+		"NamespaceCurrentNetworkUsage1": promqlbuilder.Sum(
+			promqlbuilder.Rate(
+				matrix.New(
+					vector.New(
+						vector.WithMetricName("container_network_receive_bytes_total"),
+						vector.WithLabelMatchers(
+							jobLabelValues.CAdvisorMatcher(),
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+						),
 					),
+					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
 			),
-		),
-	).By("pod"),
-	"NamespaceCurrentStorageIO3": promqlbuilder.Sum(
-		promqlbuilder.Add(
+		).By("pod"),
+		"NamespaceCurrentNetworkUsage2": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("container_fs_reads_total"),
+						vector.WithMetricName("container_network_transmit_bytes_total"),
 						vector.WithLabelMatchers(
-							label.New("job").Equal("cadvisor"),
-							label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
-							label.New("container").NotEqual(""),
+							jobLabelValues.CAdvisorMatcher(),
 							label.New("cluster").Equal("$cluster"),
 							label.New("namespace").Equal("$namespace"),
 						),
@@ -2543,14 +2433,14 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
+		).By("pod"),
+		"NamespaceCurrentNetworkUsage3": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("container_fs_writes_total"),
+						vector.WithMetricName("container_network_receive_packets_total"),
 						vector.WithLabelMatchers(
-							label.New("job").Equal("cadvisor"),
-							label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
-							label.New("container").NotEqual(""),
+							jobLabelValues.CAdvisorMatcher(),
 							label.New("cluster").Equal("$cluster"),
 							label.New("namespace").Equal("$namespace"),
 						),
@@ -2558,52 +2448,14 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-		),
-	).By("pod"),
-	"NamespaceCurrentStorageIO4": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_fs_reads_bytes_total"),
-					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
-						label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
-						label.New("container").NotEqual(""),
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-					),
-				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
-		),
-	).By("pod"),
-	"NamespaceCurrentStorageIO5": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_fs_writes_bytes_total"),
-					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
-						label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
-						label.New("container").NotEqual(""),
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-					),
-				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
-		),
-	).By("pod"),
-	"NamespaceCurrentStorageIO6": promqlbuilder.Sum(
-		promqlbuilder.Add(
+		).By("pod"),
+		"NamespaceCurrentNetworkUsage4": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("container_fs_reads_bytes_total"),
+						vector.WithMetricName("container_network_transmit_packets_total"),
 						vector.WithLabelMatchers(
-							label.New("job").Equal("cadvisor"),
-							label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
-							label.New("container").NotEqual(""),
+							jobLabelValues.CAdvisorMatcher(),
 							label.New("cluster").Equal("$cluster"),
 							label.New("namespace").Equal("$namespace"),
 						),
@@ -2611,14 +2463,14 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
+		).By("pod"),
+		"NamespaceCurrentNetworkUsage5": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("container_fs_writes_bytes_total"),
+						vector.WithMetricName("container_network_receive_packets_dropped_total"),
 						vector.WithLabelMatchers(
-							label.New("job").Equal("cadvisor"),
-							label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
-							label.New("container").NotEqual(""),
+							jobLabelValues.CAdvisorMatcher(),
 							label.New("cluster").Equal("$cluster"),
 							label.New("namespace").Equal("$namespace"),
 						),
@@ -2626,74 +2478,66 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-		),
-	).By("pod"),
-	// networking
-	"KubernetesReceiveBandwidth1": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_network_receive_bytes_total"),
-					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").EqualRegexp(".+"),
+		).By("pod"),
+		"NamespaceCurrentNetworkUsage6": promqlbuilder.Sum(
+			promqlbuilder.Rate(
+				matrix.New(
+					vector.New(
+						vector.WithMetricName("container_network_transmit_packets_dropped_total"),
+						vector.WithLabelMatchers(
+							jobLabelValues.CAdvisorMatcher(),
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+						),
 					),
+					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
 			),
-		),
-	).By("namespace"),
-	"KubernetesReceiveBandwidth2": promqlbuilder.Sum(
-		promqlbuilder.Mul(
+		).By("pod"),
+		"NamespaceCurrentStorageIO1": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("container_network_receive_bytes_total"),
+						vector.WithMetricName("container_fs_reads_total"),
 						vector.WithLabelMatchers(
+							jobLabelValues.CAdvisorMatcher(),
+							label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
+							label.New("container").NotEqual(""),
 							label.New("cluster").Equal("$cluster"),
-							label.New("namespace").NotEqual(""),
+							label.New("namespace").Equal("$namespace"),
 						),
 					),
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-			promqlbuilder.TopK(
-				promqlbuilder.Max(
+		).By("pod"),
+		"NamespaceCurrentStorageIO2": promqlbuilder.Sum(
+			promqlbuilder.Rate(
+				matrix.New(
 					vector.New(
-						vector.WithMetricName("kube_pod_info"),
+						vector.WithMetricName("container_fs_writes_total"),
 						vector.WithLabelMatchers(
-							label.New("host_network").Equal("false"),
+							jobLabelValues.CAdvisorMatcher(),
+							label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
+							label.New("container").NotEqual(""),
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
 						),
 					),
-				).By("cluster", "namespace", "pod"),
-				1,
-			).By("cluster", "namespace", "pod"),
-		).On("cluster", "namespace", "pod").GroupLeft(),
-	).By("namespace"),
-	"KubernetesReceiveBandwidth3": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_network_receive_bytes_total"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-					),
+					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
 			),
-		),
-	).By("pod"),
-	"KubernetesReceiveBandwidth4": promqlbuilder.Parenthesis(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
+		).By("pod"),
+		"NamespaceCurrentStorageIO3": promqlbuilder.Sum(
+			promqlbuilder.Add(
 				promqlbuilder.Rate(
 					matrix.New(
 						vector.New(
-							vector.WithMetricName("container_network_receive_bytes_total"),
+							vector.WithMetricName("container_fs_reads_total"),
 							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
+								jobLabelValues.CAdvisorMatcher(),
+								label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
+								label.New("container").NotEqual(""),
 								label.New("cluster").Equal("$cluster"),
 								label.New("namespace").Equal("$namespace"),
 							),
@@ -2701,94 +2545,67 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp(".+"),
-						label.New("workload_type").EqualRegexp("$type"),
-					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("workload"),
-	),
-	"KubernetesReceiveBandwidth5": promqlbuilder.SortDesc(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
-				promqlbuilder.Mul(
-					promqlbuilder.Rate(
-						matrix.New(
-							vector.New(
-								vector.WithMetricName("container_network_receive_bytes_total"),
-								vector.WithLabelMatchers(
-									label.New("cluster").Equal("$cluster"),
-									label.New("namespace").Equal("$namespace"),
-								),
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("container_fs_writes_total"),
+							vector.WithLabelMatchers(
+								jobLabelValues.CAdvisorMatcher(),
+								label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
+								label.New("container").NotEqual(""),
+								label.New("cluster").Equal("$cluster"),
+								label.New("namespace").Equal("$namespace"),
 							),
-							matrix.WithRangeAsVariable("$__rate_interval"),
 						),
-					),
-					promqlbuilder.TopK(
-						promqlbuilder.Max(
-							vector.New(
-								vector.WithMetricName("kube_pod_info"),
-								vector.WithLabelMatchers(
-									label.New("host_network").Equal("false"),
-								),
-							),
-						).By("cluster", "namespace", "pod"),
-						1,
-					).By("cluster", "namespace", "pod"),
-				).On("cluster", "namespace", "pod").GroupLeft(),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp(".+"),
-						label.New("workload_type").EqualRegexp("$type"),
+						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-			).On("cluster", "namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("workload"),
-	),
-	"KubernetesReceiveBandwidth6": promqlbuilder.Sum(
-		promqlbuilder.Mul(
+			),
+		).By("pod"),
+		"NamespaceCurrentStorageIO4": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("container_network_receive_bytes_total"),
+						vector.WithMetricName("container_fs_reads_bytes_total"),
 						vector.WithLabelMatchers(
+							jobLabelValues.CAdvisorMatcher(),
+							label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
+							label.New("container").NotEqual(""),
 							label.New("cluster").Equal("$cluster"),
-							label.New("namespace").EqualRegexp("$namespace"),
+							label.New("namespace").Equal("$namespace"),
 						),
 					),
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-			promqlbuilder.TopK(
-				promqlbuilder.Max(
+		).By("pod"),
+		"NamespaceCurrentStorageIO5": promqlbuilder.Sum(
+			promqlbuilder.Rate(
+				matrix.New(
 					vector.New(
-						vector.WithMetricName("kube_pod_info"),
+						vector.WithMetricName("container_fs_writes_bytes_total"),
 						vector.WithLabelMatchers(
-							label.New("host_network").Equal("false"),
+							jobLabelValues.CAdvisorMatcher(),
+							label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
+							label.New("container").NotEqual(""),
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
 						),
 					),
-				).By("cluster", "namespace", "pod"),
-				1,
-			).By("cluster", "namespace", "pod"),
-		).On("cluster", "namespace", "pod").GroupLeft(),
-	).By("pod"),
-	"KubernetesReceiveBandwidth7": promqlbuilder.Parenthesis(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
+					matrix.WithRangeAsVariable("$__rate_interval"),
+				),
+			),
+		).By("pod"),
+		"NamespaceCurrentStorageIO6": promqlbuilder.Sum(
+			promqlbuilder.Add(
 				promqlbuilder.Rate(
 					matrix.New(
 						vector.New(
-							vector.WithMetricName("container_network_receive_bytes_total"),
+							vector.WithMetricName("container_fs_reads_bytes_total"),
 							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
+								jobLabelValues.CAdvisorMatcher(),
+								label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
+								label.New("container").NotEqual(""),
 								label.New("cluster").Equal("$cluster"),
 								label.New("namespace").Equal("$namespace"),
 							),
@@ -2796,170 +2613,89 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp("$workload"),
-						label.New("workload_type").EqualRegexp("$type"),
-					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("pod"),
-	),
-	"KubernetesReceiveBandwidth8": promqlbuilder.SortDesc(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
 				promqlbuilder.Rate(
 					matrix.New(
 						vector.New(
-							vector.WithMetricName("container_network_receive_bytes_total"),
+							vector.WithMetricName("container_fs_writes_bytes_total"),
 							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
+								jobLabelValues.CAdvisorMatcher(),
+								label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
+								label.New("container").NotEqual(""),
 								label.New("cluster").Equal("$cluster"),
-								label.New("namespace").EqualRegexp("$namespace"),
+								label.New("namespace").Equal("$namespace"),
 							),
 						),
 						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").EqualRegexp("$namespace"),
-						label.New("workload").EqualRegexp("$workload"),
-						label.New("workload_type").EqualRegexp("$type"),
-					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("pod"),
-	),
-	"KubernetesReceiveBandwidth9": promqlbuilder.Sum(
-		promqlbuilder.IRate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_network_receive_bytes_total"),
-					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("pod").EqualRegexp("$pod"),
-					),
-				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
-		),
-	).By("pod"),
-	"KubernetesReceiveBandwidth10": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_network_receive_bytes_total"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").EqualRegexp("$namespace"),
-						label.New("pod").EqualRegexp("$pod"),
-					),
-				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
-		),
-	).By("pod"),
-	"KubernetesTransmitBandwidth1": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_network_transmit_bytes_total"),
-					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").EqualRegexp(".+"),
-					),
-				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
 			),
-		),
-	).By("namespace"),
-	"KubernetesTransmitBandwidth2": promqlbuilder.Sum(
-		promqlbuilder.Mul(
+		).By("pod"),
+		// networking
+		"KubernetesReceiveBandwidth1": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("container_network_transmit_bytes_total"),
+						vector.WithMetricName("container_network_receive_bytes_total"),
 						vector.WithLabelMatchers(
+							jobLabelValues.CAdvisorMatcher(),
 							label.New("cluster").Equal("$cluster"),
-							label.New("namespace").NotEqual(""),
+							label.New("namespace").EqualRegexp(".+"),
 						),
 					),
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-			promqlbuilder.TopK(
-				promqlbuilder.Max(
-					vector.New(
-						vector.WithMetricName("kube_pod_info"),
-						vector.WithLabelMatchers(
-							label.New("host_network").Equal("false"),
-						),
-					),
-				).By("cluster", "namespace", "pod"),
-				1,
-			).By("cluster", "namespace", "pod"),
-		).On("cluster", "namespace", "pod").GroupLeft(),
-	).By("namespace"),
-	"KubernetesTransmitBandwidth3": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_network_transmit_bytes_total"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-					),
-				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
-		),
-	).By("pod"),
-	"KubernetesTransmitBandwidth4": promqlbuilder.Parenthesis(
-		promqlbuilder.Sum(
+		).By("namespace"),
+		"KubernetesReceiveBandwidth2": promqlbuilder.Sum(
 			promqlbuilder.Mul(
 				promqlbuilder.Rate(
 					matrix.New(
 						vector.New(
-							vector.WithMetricName("container_network_transmit_bytes_total"),
+							vector.WithMetricName("container_network_receive_bytes_total"),
 							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
 								label.New("cluster").Equal("$cluster"),
-								label.New("namespace").Equal("$namespace"),
+								label.New("namespace").NotEqual(""),
 							),
 						),
 						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp(".+"),
-						label.New("workload_type").EqualRegexp("$type"),
+				promqlbuilder.TopK(
+					promqlbuilder.Max(
+						vector.New(
+							vector.WithMetricName("kube_pod_info"),
+							vector.WithLabelMatchers(
+								label.New("host_network").Equal("false"),
+							),
+						),
+					).By("cluster", "namespace", "pod"),
+					1,
+				).By("cluster", "namespace", "pod"),
+			).On("cluster", "namespace", "pod").GroupLeft(),
+		).By("namespace"),
+		"KubernetesReceiveBandwidth3": promqlbuilder.Sum(
+			promqlbuilder.Rate(
+				matrix.New(
+					vector.New(
+						vector.WithMetricName("container_network_receive_bytes_total"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+						),
 					),
+					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("workload"),
-	),
-	"KubernetesTransmitBandwidth5": promqlbuilder.SortDesc(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
+			),
+		).By("pod"),
+		"KubernetesReceiveBandwidth4": promqlbuilder.Parenthesis(
+			promqlbuilder.Sum(
 				promqlbuilder.Mul(
 					promqlbuilder.Rate(
 						matrix.New(
 							vector.New(
-								vector.WithMetricName("container_network_transmit_bytes_total"),
+								vector.WithMetricName("container_network_receive_bytes_total"),
 								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
 									label.New("cluster").Equal("$cluster"),
 									label.New("namespace").Equal("$namespace"),
 								),
@@ -2967,196 +2703,237 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 							matrix.WithRangeAsVariable("$__rate_interval"),
 						),
 					),
-					promqlbuilder.TopK(
-						promqlbuilder.Max(
-							vector.New(
-								vector.WithMetricName("kube_pod_info"),
-								vector.WithLabelMatchers(
-									label.New("host_network").Equal("false"),
-								),
-							),
-						).By("cluster", "namespace", "pod"),
-						1,
-					).By("cluster", "namespace", "pod"),
-				).On("cluster", "namespace", "pod").GroupLeft(),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp(".+"),
-						label.New("workload_type").EqualRegexp("$type"),
-					),
-				),
-			).On("cluster", "namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("workload"),
-	),
-	"KubernetesTransmitBandwidth6": promqlbuilder.Sum(
-		promqlbuilder.Mul(
-			promqlbuilder.Rate(
-				matrix.New(
 					vector.New(
-						vector.WithMetricName("container_network_transmit_bytes_total"),
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
 						vector.WithLabelMatchers(
 							label.New("cluster").Equal("$cluster"),
-							label.New("namespace").EqualRegexp("$namespace"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp(".+"),
+							label.New("workload_type").EqualRegexp("$type"),
 						),
 					),
-					matrix.WithRangeAsVariable("$__rate_interval"),
-				),
-			),
-			promqlbuilder.TopK(
-				promqlbuilder.Max(
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload"),
+		),
+		"KubernetesReceiveBandwidth5": promqlbuilder.SortDesc(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					promqlbuilder.Mul(
+						promqlbuilder.Rate(
+							matrix.New(
+								vector.New(
+									vector.WithMetricName("container_network_receive_bytes_total"),
+									vector.WithLabelMatchers(
+										label.New("cluster").Equal("$cluster"),
+										label.New("namespace").Equal("$namespace"),
+									),
+								),
+								matrix.WithRangeAsVariable("$__rate_interval"),
+							),
+						),
+						promqlbuilder.TopK(
+							promqlbuilder.Max(
+								vector.New(
+									vector.WithMetricName("kube_pod_info"),
+									vector.WithLabelMatchers(
+										label.New("host_network").Equal("false"),
+									),
+								),
+							).By("cluster", "namespace", "pod"),
+							1,
+						).By("cluster", "namespace", "pod"),
+					).On("cluster", "namespace", "pod").GroupLeft(),
 					vector.New(
-						vector.WithMetricName("kube_pod_info"),
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
 						vector.WithLabelMatchers(
-							label.New("host_network").Equal("false"),
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp(".+"),
+							label.New("workload_type").EqualRegexp("$type"),
 						),
 					),
-				).By("cluster", "namespace", "pod"),
-				1,
-			).By("cluster", "namespace", "pod"),
-		).On("cluster", "namespace", "pod").GroupLeft(),
-	).By("pod"),
-	"KubernetesTransmitBandwidth7": promqlbuilder.Parenthesis(
-		promqlbuilder.Sum(
+				).On("cluster", "namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload"),
+		),
+		"KubernetesReceiveBandwidth6": promqlbuilder.Sum(
 			promqlbuilder.Mul(
 				promqlbuilder.Rate(
 					matrix.New(
 						vector.New(
-							vector.WithMetricName("container_network_transmit_bytes_total"),
+							vector.WithMetricName("container_network_receive_bytes_total"),
 							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
 								label.New("cluster").Equal("$cluster"),
-								label.New("namespace").Equal("$namespace"),
+								label.New("namespace").EqualRegexp("$namespace"),
 							),
 						),
 						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp("$workload"),
-						label.New("workload_type").EqualRegexp("$type"),
-					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("pod"),
-	),
-	"KubernetesTransmitBandwidth8": promqlbuilder.SortDesc(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
-				promqlbuilder.Rate(
-					matrix.New(
+				promqlbuilder.TopK(
+					promqlbuilder.Max(
 						vector.New(
-							vector.WithMetricName("container_network_transmit_bytes_total"),
+							vector.WithMetricName("kube_pod_info"),
 							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
-								label.New("cluster").Equal("$cluster"),
-								label.New("namespace").EqualRegexp("$namespace"),
+								label.New("host_network").Equal("false"),
 							),
 						),
-						matrix.WithRangeAsVariable("$__rate_interval"),
+					).By("cluster", "namespace", "pod"),
+					1,
+				).By("cluster", "namespace", "pod"),
+			).On("cluster", "namespace", "pod").GroupLeft(),
+		).By("pod"),
+		"KubernetesReceiveBandwidth7": promqlbuilder.Parenthesis(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					promqlbuilder.Rate(
+						matrix.New(
+							vector.New(
+								vector.WithMetricName("container_network_receive_bytes_total"),
+								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
+									label.New("cluster").Equal("$cluster"),
+									label.New("namespace").Equal("$namespace"),
+								),
+							),
+							matrix.WithRangeAsVariable("$__rate_interval"),
+						),
 					),
-				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").EqualRegexp("$namespace"),
-						label.New("workload").EqualRegexp("$workload"),
-						label.New("workload_type").EqualRegexp("$type"),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp("$workload"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
 					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("pod"),
-	),
-	"KubernetesTransmitBandwidth9": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_network_transmit_bytes_total"),
-					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("pod").EqualRegexp("$pod"),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("pod"),
+		),
+		"KubernetesReceiveBandwidth8": promqlbuilder.SortDesc(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					promqlbuilder.Rate(
+						matrix.New(
+							vector.New(
+								vector.WithMetricName("container_network_receive_bytes_total"),
+								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
+									label.New("cluster").Equal("$cluster"),
+									label.New("namespace").EqualRegexp("$namespace"),
+								),
+							),
+							matrix.WithRangeAsVariable("$__rate_interval"),
+						),
+					),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").EqualRegexp("$namespace"),
+							label.New("workload").EqualRegexp("$workload"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("pod"),
+		),
+		"KubernetesReceiveBandwidth9": promqlbuilder.Sum(
+			promqlbuilder.IRate(
+				matrix.New(
+					vector.New(
+						vector.WithMetricName("container_network_receive_bytes_total"),
+						vector.WithLabelMatchers(
+							jobLabelValues.CAdvisorMatcher(),
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("pod").EqualRegexp("$pod"),
+						),
 					),
+					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
 			),
-		),
-	).By("pod"),
-	"KubernetesTransmitBandwidth10": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_network_transmit_bytes_total"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").EqualRegexp("$namespace"),
-						label.New("pod").EqualRegexp("$pod"),
+		).By("pod"),
+		"KubernetesReceiveBandwidth10": promqlbuilder.Sum(
+			promqlbuilder.Rate(
+				matrix.New(
+					vector.New(
+						vector.WithMetricName("container_network_receive_bytes_total"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").EqualRegexp("$namespace"),
+							label.New("pod").EqualRegexp("$pod"),
+						),
 					),
+					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
 			),
-		),
-	).By("pod"),
-	"KubernetesAvgContainerBandwidthTransmitted1": promqlbuilder.Avg(
-		promqlbuilder.IRate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_network_transmit_bytes_total"),
-					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").EqualRegexp(".+"),
+		).By("pod"),
+		"KubernetesTransmitBandwidth1": promqlbuilder.Sum(
+			promqlbuilder.Rate(
+				matrix.New(
+					vector.New(
+						vector.WithMetricName("container_network_transmit_bytes_total"),
+						vector.WithLabelMatchers(
+							jobLabelValues.CAdvisorMatcher(),
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").EqualRegexp(".+"),
+						),
 					),
+					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
 			),
-		),
-	).By("namespace"),
-	"KubernetesAvgContainerBandwidthTransmitted2": promqlbuilder.Parenthesis(
-		promqlbuilder.Avg(
+		).By("namespace"),
+		"KubernetesTransmitBandwidth2": promqlbuilder.Sum(
 			promqlbuilder.Mul(
 				promqlbuilder.Rate(
 					matrix.New(
 						vector.New(
 							vector.WithMetricName("container_network_transmit_bytes_total"),
 							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
 								label.New("cluster").Equal("$cluster"),
-								label.New("namespace").Equal("$namespace"),
+								label.New("namespace").NotEqual(""),
 							),
 						),
 						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp(".+"),
-						label.New("workload_type").EqualRegexp("$type"),
+				promqlbuilder.TopK(
+					promqlbuilder.Max(
+						vector.New(
+							vector.WithMetricName("kube_pod_info"),
+							vector.WithLabelMatchers(
+								label.New("host_network").Equal("false"),
+							),
+						),
+					).By("cluster", "namespace", "pod"),
+					1,
+				).By("cluster", "namespace", "pod"),
+			).On("cluster", "namespace", "pod").GroupLeft(),
+		).By("namespace"),
+		"KubernetesTransmitBandwidth3": promqlbuilder.Sum(
+			promqlbuilder.Rate(
+				matrix.New(
+					vector.New(
+						vector.WithMetricName("container_network_transmit_bytes_total"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+						),
 					),
+					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("workload"),
-	),
-	"KubernetesAvgContainerBandwidthTransmitted3": promqlbuilder.SortDesc(
-		promqlbuilder.Avg(
-			promqlbuilder.Mul(
+			),
+		).By("pod"),
+		"KubernetesTransmitBandwidth4": promqlbuilder.Parenthesis(
+			promqlbuilder.Sum(
 				promqlbuilder.Mul(
 					promqlbuilder.Rate(
 						matrix.New(
 							vector.New(
 								vector.WithMetricName("container_network_transmit_bytes_total"),
 								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
 									label.New("cluster").Equal("$cluster"),
 									label.New("namespace").Equal("$namespace"),
 								),
@@ -3164,110 +2941,94 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 							matrix.WithRangeAsVariable("$__rate_interval"),
 						),
 					),
-					promqlbuilder.TopK(
-						promqlbuilder.Max(
-							vector.New(
-								vector.WithMetricName("kube_pod_info"),
-								vector.WithLabelMatchers(
-									label.New("host_network").Equal("false"),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp(".+"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload"),
+		),
+		"KubernetesTransmitBandwidth5": promqlbuilder.SortDesc(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					promqlbuilder.Mul(
+						promqlbuilder.Rate(
+							matrix.New(
+								vector.New(
+									vector.WithMetricName("container_network_transmit_bytes_total"),
+									vector.WithLabelMatchers(
+										label.New("cluster").Equal("$cluster"),
+										label.New("namespace").Equal("$namespace"),
+									),
 								),
+								matrix.WithRangeAsVariable("$__rate_interval"),
 							),
+						),
+						promqlbuilder.TopK(
+							promqlbuilder.Max(
+								vector.New(
+									vector.WithMetricName("kube_pod_info"),
+									vector.WithLabelMatchers(
+										label.New("host_network").Equal("false"),
+									),
+								),
+							).By("cluster", "namespace", "pod"),
+							1,
 						).By("cluster", "namespace", "pod"),
-						1,
-					).By("cluster", "namespace", "pod"),
-				).On("cluster", "namespace", "pod").GroupLeft(),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp(".+"),
-						label.New("workload_type").EqualRegexp("$type"),
+					).On("cluster", "namespace", "pod").GroupLeft(),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp(".+"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
 					),
-				),
-			).On("cluster", "namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("workload"),
-	),
-	"KubernetesAvgContainerBandwidthTransmitted4": promqlbuilder.Parenthesis(
-		promqlbuilder.Avg(
+				).On("cluster", "namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload"),
+		),
+		"KubernetesTransmitBandwidth6": promqlbuilder.Sum(
 			promqlbuilder.Mul(
 				promqlbuilder.Rate(
 					matrix.New(
 						vector.New(
 							vector.WithMetricName("container_network_transmit_bytes_total"),
 							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
 								label.New("cluster").Equal("$cluster"),
-								label.New("namespace").Equal("$namespace"),
+								label.New("namespace").EqualRegexp("$namespace"),
 							),
 						),
 						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp("$workload"),
-						label.New("workload_type").EqualRegexp("$type"),
-					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("pod"),
-	),
-	"KubernetesAvgContainerBandwidthReceived1": promqlbuilder.Avg(
-		promqlbuilder.IRate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_network_receive_bytes_total"),
-					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").EqualRegexp(".+"),
-					),
-				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
-		),
-	).By("namespace"),
-	"KubernetesAvgContainerBandwidthReceived2": promqlbuilder.Parenthesis(
-		promqlbuilder.Avg(
-			promqlbuilder.Mul(
-				promqlbuilder.Rate(
-					matrix.New(
+				promqlbuilder.TopK(
+					promqlbuilder.Max(
 						vector.New(
-							vector.WithMetricName("container_network_receive_bytes_total"),
+							vector.WithMetricName("kube_pod_info"),
 							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
-								label.New("cluster").Equal("$cluster"),
-								label.New("namespace").Equal("$namespace"),
+								label.New("host_network").Equal("false"),
 							),
 						),
-						matrix.WithRangeAsVariable("$__rate_interval"),
-					),
-				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp(".+"),
-						label.New("workload_type").EqualRegexp("$type"),
-					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("workload"),
-	),
-	"KubernetesAvgContainerBandwidthReceived3": promqlbuilder.SortDesc(
-		promqlbuilder.Avg(
-			promqlbuilder.Mul(
+					).By("cluster", "namespace", "pod"),
+					1,
+				).By("cluster", "namespace", "pod"),
+			).On("cluster", "namespace", "pod").GroupLeft(),
+		).By("pod"),
+		"KubernetesTransmitBandwidth7": promqlbuilder.Parenthesis(
+			promqlbuilder.Sum(
 				promqlbuilder.Mul(
 					promqlbuilder.Rate(
 						matrix.New(
 							vector.New(
-								vector.WithMetricName("container_network_receive_bytes_total"),
+								vector.WithMetricName("container_network_transmit_bytes_total"),
 								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
 									label.New("cluster").Equal("$cluster"),
 									label.New("namespace").Equal("$namespace"),
 								),
@@ -3275,222 +3036,101 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 							matrix.WithRangeAsVariable("$__rate_interval"),
 						),
 					),
-					promqlbuilder.TopK(
-						promqlbuilder.Max(
-							vector.New(
-								vector.WithMetricName("kube_pod_info"),
-								vector.WithLabelMatchers(
-									label.New("host_network").Equal("false"),
-								),
-							),
-						).By("cluster", "namespace", "pod"),
-						1,
-					).By("cluster", "namespace", "pod"),
-				).On("cluster", "namespace", "pod").GroupLeft(),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp(".+"),
-						label.New("workload_type").EqualRegexp("$type"),
-					),
-				),
-			).On("cluster", "namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("workload"),
-	),
-	"KubernetesAvgContainerBandwidthReceived4": promqlbuilder.Parenthesis(
-		promqlbuilder.Avg(
-			promqlbuilder.Mul(
-				promqlbuilder.Rate(
-					matrix.New(
-						vector.New(
-							vector.WithMetricName("container_network_receive_bytes_total"),
-							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
-								label.New("cluster").Equal("$cluster"),
-								label.New("namespace").Equal("$namespace"),
-							),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp("$workload"),
+							label.New("workload_type").EqualRegexp("$type"),
 						),
-						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
-				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp("$workload"),
-						label.New("workload_type").EqualRegexp("$type"),
-					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("pod"),
-	),
-	"KubernetesReceivedPackets1": promqlbuilder.Sum(
-		promqlbuilder.IRate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_network_receive_packets_total"),
-					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").EqualRegexp(".+"),
-					),
-				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
-		),
-	).By("namespace"),
-	"KubernetesReceivedPackets2": promqlbuilder.Sum(
-		promqlbuilder.IRate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_network_receive_packets_total"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-					),
-				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
-		),
-	).By("pod"),
-	"KubernetesReceivedPackets3": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_network_receive_packets_total"),
-					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("pod").EqualRegexp("$pod"),
-					),
-				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("pod"),
 		),
-	).By("pod"),
-	"KubernetesReceivedPackets4": promqlbuilder.Parenthesis(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
-				promqlbuilder.Rate(
-					matrix.New(
-						vector.New(
-							vector.WithMetricName("container_network_receive_packets_total"),
-							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
-								label.New("cluster").Equal("$cluster"),
-								label.New("namespace").Equal("$namespace"),
+		"KubernetesTransmitBandwidth8": promqlbuilder.SortDesc(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					promqlbuilder.Rate(
+						matrix.New(
+							vector.New(
+								vector.WithMetricName("container_network_transmit_bytes_total"),
+								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
+									label.New("cluster").Equal("$cluster"),
+									label.New("namespace").EqualRegexp("$namespace"),
+								),
 							),
+							matrix.WithRangeAsVariable("$__rate_interval"),
 						),
-						matrix.WithRangeAsVariable("$__rate_interval"),
-					),
-				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp("$workload"),
-						label.New("workload_type").EqualRegexp("$type"),
 					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("pod"),
-	),
-	"KubernetesReceivedPackets5": promqlbuilder.Parenthesis(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
-				promqlbuilder.Rate(
-					matrix.New(
-						vector.New(
-							vector.WithMetricName("container_network_receive_packets_total"),
-							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
-								label.New("cluster").Equal("$cluster"),
-								label.New("namespace").Equal("$namespace"),
-							),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").EqualRegexp("$namespace"),
+							label.New("workload").EqualRegexp("$workload"),
+							label.New("workload_type").EqualRegexp("$type"),
 						),
-						matrix.WithRangeAsVariable("$__rate_interval"),
-					),
-				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp(".+"),
-						label.New("workload_type").EqualRegexp("$type"),
 					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("workload"),
-	),
-	"KubernetesReceivedPackets6": promqlbuilder.Sum(
-		promqlbuilder.Mul(
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("pod"),
+		),
+		"KubernetesTransmitBandwidth9": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("container_network_receive_packets_total"),
+						vector.WithMetricName("container_network_transmit_bytes_total"),
 						vector.WithLabelMatchers(
+							jobLabelValues.CAdvisorMatcher(),
 							label.New("cluster").Equal("$cluster"),
-							label.New("namespace").NotEqual(""),
+							label.New("namespace").Equal("$namespace"),
+							label.New("pod").EqualRegexp("$pod"),
 						),
 					),
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-			promqlbuilder.TopK(
-				promqlbuilder.Max(
-					vector.New(
-						vector.WithMetricName("kube_pod_info"),
-						vector.WithLabelMatchers(
-							label.New("host_network").Equal("false"),
-						),
-					),
-				).By("cluster", "namespace", "pod"),
-				1,
-			).By("cluster", "namespace", "pod"),
-		).On("cluster", "namespace", "pod").GroupLeft(),
-	).By("namespace"),
-	"KubernetesReceivedPackets7": promqlbuilder.Sum(
-		promqlbuilder.Mul(
+		).By("pod"),
+		"KubernetesTransmitBandwidth10": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("container_network_receive_packets_total"),
+						vector.WithMetricName("container_network_transmit_bytes_total"),
 						vector.WithLabelMatchers(
 							label.New("cluster").Equal("$cluster"),
 							label.New("namespace").EqualRegexp("$namespace"),
+							label.New("pod").EqualRegexp("$pod"),
 						),
 					),
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-			promqlbuilder.TopK(
-				promqlbuilder.Max(
+		).By("pod"),
+		"KubernetesAvgContainerBandwidthTransmitted1": promqlbuilder.Avg(
+			promqlbuilder.IRate(
+				matrix.New(
 					vector.New(
-						vector.WithMetricName("kube_pod_info"),
+						vector.WithMetricName("container_network_transmit_bytes_total"),
 						vector.WithLabelMatchers(
-							label.New("host_network").Equal("false"),
+							jobLabelValues.CAdvisorMatcher(),
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").EqualRegexp(".+"),
 						),
 					),
-				).By("cluster", "namespace", "pod"),
-				1,
-			).By("cluster", "namespace", "pod"),
-		).On("cluster", "namespace", "pod").GroupLeft(),
-	).By("pod"),
-	"KubernetesReceivedPackets8": promqlbuilder.SortDesc(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
+					matrix.WithRangeAsVariable("$__rate_interval"),
+				),
+			),
+		).By("namespace"),
+		"KubernetesAvgContainerBandwidthTransmitted2": promqlbuilder.Parenthesis(
+			promqlbuilder.Avg(
 				promqlbuilder.Mul(
 					promqlbuilder.Rate(
 						matrix.New(
 							vector.New(
-								vector.WithMetricName("container_network_receive_packets_total"),
+								vector.WithMetricName("container_network_transmit_bytes_total"),
 								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
 									label.New("cluster").Equal("$cluster"),
 									label.New("namespace").Equal("$namespace"),
 								),
@@ -3498,237 +3138,251 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 							matrix.WithRangeAsVariable("$__rate_interval"),
 						),
 					),
-					promqlbuilder.TopK(
-						promqlbuilder.Max(
-							vector.New(
-								vector.WithMetricName("kube_pod_info"),
-								vector.WithLabelMatchers(
-									label.New("host_network").Equal("false"),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp(".+"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload"),
+		),
+		"KubernetesAvgContainerBandwidthTransmitted3": promqlbuilder.SortDesc(
+			promqlbuilder.Avg(
+				promqlbuilder.Mul(
+					promqlbuilder.Mul(
+						promqlbuilder.Rate(
+							matrix.New(
+								vector.New(
+									vector.WithMetricName("container_network_transmit_bytes_total"),
+									vector.WithLabelMatchers(
+										label.New("cluster").Equal("$cluster"),
+										label.New("namespace").Equal("$namespace"),
+									),
 								),
+								matrix.WithRangeAsVariable("$__rate_interval"),
 							),
+						),
+						promqlbuilder.TopK(
+							promqlbuilder.Max(
+								vector.New(
+									vector.WithMetricName("kube_pod_info"),
+									vector.WithLabelMatchers(
+										label.New("host_network").Equal("false"),
+									),
+								),
+							).By("cluster", "namespace", "pod"),
+							1,
 						).By("cluster", "namespace", "pod"),
-						1,
-					).By("cluster", "namespace", "pod"),
-				).On("cluster", "namespace", "pod").GroupLeft(),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp(".+"),
-						label.New("workload_type").EqualRegexp("$type"),
+					).On("cluster", "namespace", "pod").GroupLeft(),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp(".+"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
 					),
-				),
-			).On("cluster", "namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("workload"),
-	),
-	"KubernetesReceivedPackets9": promqlbuilder.SortDesc(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
-				promqlbuilder.Rate(
-					matrix.New(
-						vector.New(
-							vector.WithMetricName("container_network_receive_packets_total"),
-							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
-								label.New("cluster").Equal("$cluster"),
-								label.New("namespace").EqualRegexp("$namespace"),
+				).On("cluster", "namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload"),
+		),
+		"KubernetesAvgContainerBandwidthTransmitted4": promqlbuilder.Parenthesis(
+			promqlbuilder.Avg(
+				promqlbuilder.Mul(
+					promqlbuilder.Rate(
+						matrix.New(
+							vector.New(
+								vector.WithMetricName("container_network_transmit_bytes_total"),
+								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
+									label.New("cluster").Equal("$cluster"),
+									label.New("namespace").Equal("$namespace"),
+								),
 							),
+							matrix.WithRangeAsVariable("$__rate_interval"),
 						),
-						matrix.WithRangeAsVariable("$__rate_interval"),
-					),
-				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").EqualRegexp("$namespace"),
-						label.New("workload").EqualRegexp("$workload"),
-						label.New("workload_type").EqualRegexp("$type"),
 					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("pod"),
-	),
-	"KubernetesReceivedPackets10": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_network_receive_packets_total"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").EqualRegexp("$namespace"),
-						label.New("pod").EqualRegexp("$pod"),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp("$workload"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
 					),
-				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("pod"),
 		),
-	).By("pod"),
-	"KubernetesReceivedPacketsDropped1": promqlbuilder.Sum(
-		promqlbuilder.IRate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_network_receive_packets_dropped_total"),
-					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").EqualRegexp(".+"),
+		"KubernetesAvgContainerBandwidthReceived1": promqlbuilder.Avg(
+			promqlbuilder.IRate(
+				matrix.New(
+					vector.New(
+						vector.WithMetricName("container_network_receive_bytes_total"),
+						vector.WithLabelMatchers(
+							jobLabelValues.CAdvisorMatcher(),
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").EqualRegexp(".+"),
+						),
 					),
+					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
 			),
-		),
-	).By("namespace"),
-	"KubernetesReceivedPacketsDropped2": promqlbuilder.Sum(
-		promqlbuilder.IRate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_network_receive_packets_dropped_total"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
+		).By("namespace"),
+		"KubernetesAvgContainerBandwidthReceived2": promqlbuilder.Parenthesis(
+			promqlbuilder.Avg(
+				promqlbuilder.Mul(
+					promqlbuilder.Rate(
+						matrix.New(
+							vector.New(
+								vector.WithMetricName("container_network_receive_bytes_total"),
+								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
+									label.New("cluster").Equal("$cluster"),
+									label.New("namespace").Equal("$namespace"),
+								),
+							),
+							matrix.WithRangeAsVariable("$__rate_interval"),
+						),
 					),
-				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp(".+"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload"),
 		),
-	).By("pod"),
-	"KubernetesReceivedPacketsDropped3": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_network_receive_packets_dropped_total"),
-					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("pod").EqualRegexp("$pod"),
-					),
-				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
-		),
-	).By("pod"),
-	"KubernetesReceivedPacketsDropped4": promqlbuilder.Parenthesis(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
-				promqlbuilder.Rate(
-					matrix.New(
-						vector.New(
-							vector.WithMetricName("container_network_receive_packets_dropped_total"),
-							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
-								label.New("cluster").Equal("$cluster"),
-								label.New("namespace").Equal("$namespace"),
+		"KubernetesAvgContainerBandwidthReceived3": promqlbuilder.SortDesc(
+			promqlbuilder.Avg(
+				promqlbuilder.Mul(
+					promqlbuilder.Mul(
+						promqlbuilder.Rate(
+							matrix.New(
+								vector.New(
+									vector.WithMetricName("container_network_receive_bytes_total"),
+									vector.WithLabelMatchers(
+										label.New("cluster").Equal("$cluster"),
+										label.New("namespace").Equal("$namespace"),
+									),
+								),
+								matrix.WithRangeAsVariable("$__rate_interval"),
 							),
 						),
-						matrix.WithRangeAsVariable("$__rate_interval"),
-					),
-				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp("$workload"),
-						label.New("workload_type").EqualRegexp("$type"),
+						promqlbuilder.TopK(
+							promqlbuilder.Max(
+								vector.New(
+									vector.WithMetricName("kube_pod_info"),
+									vector.WithLabelMatchers(
+										label.New("host_network").Equal("false"),
+									),
+								),
+							).By("cluster", "namespace", "pod"),
+							1,
+						).By("cluster", "namespace", "pod"),
+					).On("cluster", "namespace", "pod").GroupLeft(),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp(".+"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
 					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("pod"),
-	),
-	"KubernetesReceivedPacketsDropped5": promqlbuilder.Parenthesis(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
-				promqlbuilder.Rate(
-					matrix.New(
-						vector.New(
-							vector.WithMetricName("container_network_receive_packets_dropped_total"),
-							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
-								label.New("cluster").Equal("$cluster"),
-								label.New("namespace").Equal("$namespace"),
+				).On("cluster", "namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload"),
+		),
+		"KubernetesAvgContainerBandwidthReceived4": promqlbuilder.Parenthesis(
+			promqlbuilder.Avg(
+				promqlbuilder.Mul(
+					promqlbuilder.Rate(
+						matrix.New(
+							vector.New(
+								vector.WithMetricName("container_network_receive_bytes_total"),
+								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
+									label.New("cluster").Equal("$cluster"),
+									label.New("namespace").Equal("$namespace"),
+								),
 							),
+							matrix.WithRangeAsVariable("$__rate_interval"),
 						),
-						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
-				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp(".+"),
-						label.New("workload_type").EqualRegexp("$type"),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp("$workload"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
 					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("workload"),
-	),
-	"KubernetesReceivedPacketsDropped6": promqlbuilder.Sum(
-		promqlbuilder.Mul(
-			promqlbuilder.Rate(
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("pod"),
+		),
+		"KubernetesReceivedPackets1": promqlbuilder.Sum(
+			promqlbuilder.IRate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("container_network_receive_packets_dropped_total"),
+						vector.WithMetricName("container_network_receive_packets_total"),
 						vector.WithLabelMatchers(
+							jobLabelValues.CAdvisorMatcher(),
 							label.New("cluster").Equal("$cluster"),
-							label.New("namespace").NotEqual(""),
+							label.New("namespace").EqualRegexp(".+"),
 						),
 					),
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-			promqlbuilder.TopK(
-				promqlbuilder.Max(
+		).By("namespace"),
+		"KubernetesReceivedPackets2": promqlbuilder.Sum(
+			promqlbuilder.IRate(
+				matrix.New(
 					vector.New(
-						vector.WithMetricName("kube_pod_info"),
+						vector.WithMetricName("container_network_receive_packets_total"),
 						vector.WithLabelMatchers(
-							label.New("host_network").Equal("false"),
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
 						),
 					),
-				).By("cluster", "namespace", "pod"),
-				1,
-			).By("cluster", "namespace", "pod"),
-		).On("cluster", "namespace", "pod").GroupLeft(),
-	).By("namespace"),
-	"KubernetesReceivedPacketsDropped7": promqlbuilder.Sum(
-		promqlbuilder.Mul(
+					matrix.WithRangeAsVariable("$__rate_interval"),
+				),
+			),
+		).By("pod"),
+		"KubernetesReceivedPackets3": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("container_network_receive_packets_dropped_total"),
+						vector.WithMetricName("container_network_receive_packets_total"),
 						vector.WithLabelMatchers(
+							jobLabelValues.CAdvisorMatcher(),
 							label.New("cluster").Equal("$cluster"),
-							label.New("namespace").NotEqual(""),
+							label.New("namespace").Equal("$namespace"),
+							label.New("pod").EqualRegexp("$pod"),
 						),
 					),
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-			promqlbuilder.TopK(
-				promqlbuilder.Max(
-					vector.New(
-						vector.WithMetricName("kube_pod_info"),
-						vector.WithLabelMatchers(
-							label.New("host_network").Equal("false"),
-						),
-					),
-				).By("cluster", "namespace", "pod"),
-				1,
-			).By("cluster", "namespace", "pod"),
-		).On("cluster", "namespace", "pod").GroupLeft(),
-	).By("pod"),
-	"KubernetesReceivedPacketsDropped8": promqlbuilder.SortDesc(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
+		).By("pod"),
+		"KubernetesReceivedPackets4": promqlbuilder.Parenthesis(
+			promqlbuilder.Sum(
 				promqlbuilder.Mul(
 					promqlbuilder.Rate(
 						matrix.New(
 							vector.New(
-								vector.WithMetricName("container_network_receive_packets_dropped_total"),
+								vector.WithMetricName("container_network_receive_packets_total"),
 								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
 									label.New("cluster").Equal("$cluster"),
 									label.New("namespace").Equal("$namespace"),
 								),
@@ -3736,237 +3390,237 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 							matrix.WithRangeAsVariable("$__rate_interval"),
 						),
 					),
-					promqlbuilder.TopK(
-						promqlbuilder.Max(
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp("$workload"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("pod"),
+		),
+		"KubernetesReceivedPackets5": promqlbuilder.Parenthesis(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					promqlbuilder.Rate(
+						matrix.New(
 							vector.New(
-								vector.WithMetricName("kube_pod_info"),
+								vector.WithMetricName("container_network_receive_packets_total"),
 								vector.WithLabelMatchers(
-									label.New("host_network").Equal("false"),
+									jobLabelValues.CAdvisorMatcher(),
+									label.New("cluster").Equal("$cluster"),
+									label.New("namespace").Equal("$namespace"),
 								),
 							),
-						).By("cluster", "namespace", "pod"),
-						1,
-					).By("cluster", "namespace", "pod"),
-				).On("cluster", "namespace", "pod").GroupLeft(),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp(".+"),
-						label.New("workload_type").EqualRegexp("$type"),
+							matrix.WithRangeAsVariable("$__rate_interval"),
+						),
 					),
-				),
-			).On("cluster", "namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("workload"),
-	),
-	"KubernetesReceivedPacketsDropped9": promqlbuilder.SortDesc(
-		promqlbuilder.Sum(
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp(".+"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload"),
+		),
+		"KubernetesReceivedPackets6": promqlbuilder.Sum(
 			promqlbuilder.Mul(
 				promqlbuilder.Rate(
 					matrix.New(
 						vector.New(
-							vector.WithMetricName("container_network_receive_packets_dropped_total"),
+							vector.WithMetricName("container_network_receive_packets_total"),
 							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
 								label.New("cluster").Equal("$cluster"),
-								label.New("namespace").EqualRegexp("$namespace"),
+								label.New("namespace").NotEqual(""),
 							),
 						),
 						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").EqualRegexp("$namespace"),
-						label.New("workload").EqualRegexp("$workload"),
-						label.New("workload_type").EqualRegexp("$type"),
-					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("pod"),
-	),
-	"KubernetesReceivedPacketsDropped10": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_network_receive_packets_dropped_total"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").EqualRegexp("$namespace"),
-						label.New("pod").EqualRegexp("$pod"),
-					),
-				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
-		),
-	).By("pod"),
-	"KubernetesTransmittedPackets1": promqlbuilder.Sum(
-		promqlbuilder.IRate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_network_transmit_packets_total"),
-					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").EqualRegexp(".+"),
-					),
-				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
-		),
-	).By("namespace"),
-	"KubernetesTransmittedPackets2": promqlbuilder.Sum(
-		promqlbuilder.IRate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_network_transmit_packets_total"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-					),
-				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
-		),
-	).By("pod"),
-	"KubernetesTransmittedPackets3": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_network_transmit_packets_total"),
-					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("pod").EqualRegexp("$pod"),
-					),
-				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
-		),
-	).By("pod"),
-	"KubernetesTransmittedPackets4": promqlbuilder.Parenthesis(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
-				promqlbuilder.Rate(
-					matrix.New(
+				promqlbuilder.TopK(
+					promqlbuilder.Max(
 						vector.New(
-							vector.WithMetricName("container_network_transmit_packets_total"),
+							vector.WithMetricName("kube_pod_info"),
 							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
-								label.New("cluster").Equal("$cluster"),
-								label.New("namespace").Equal("$namespace"),
+								label.New("host_network").Equal("false"),
 							),
 						),
-						matrix.WithRangeAsVariable("$__rate_interval"),
-					),
-				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp("$workload"),
-						label.New("workload_type").EqualRegexp("$type"),
-					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("pod"),
-	),
-	"KubernetesTransmittedPackets5": promqlbuilder.Parenthesis(
-		promqlbuilder.Sum(
+					).By("cluster", "namespace", "pod"),
+					1,
+				).By("cluster", "namespace", "pod"),
+			).On("cluster", "namespace", "pod").GroupLeft(),
+		).By("namespace"),
+		"KubernetesReceivedPackets7": promqlbuilder.Sum(
 			promqlbuilder.Mul(
 				promqlbuilder.Rate(
 					matrix.New(
 						vector.New(
-							vector.WithMetricName("container_network_transmit_packets_total"),
+							vector.WithMetricName("container_network_receive_packets_total"),
 							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
 								label.New("cluster").Equal("$cluster"),
-								label.New("namespace").Equal("$namespace"),
+								label.New("namespace").EqualRegexp("$namespace"),
 							),
 						),
 						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp(".+"),
-						label.New("workload_type").EqualRegexp("$type"),
-					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("workload"),
-	),
-	"KubernetesTransmittedPackets6": promqlbuilder.Sum(
-		promqlbuilder.Mul(
-			promqlbuilder.Rate(
-				matrix.New(
-					vector.New(
-						vector.WithMetricName("container_network_transmit_packets_total"),
-						vector.WithLabelMatchers(
-							label.New("cluster").Equal("$cluster"),
-							label.New("namespace").NotEqual(""),
-						),
-					),
-					matrix.WithRangeAsVariable("$__rate_interval"),
-				),
-			),
-			promqlbuilder.TopK(
-				promqlbuilder.Max(
-					vector.New(
-						vector.WithMetricName("kube_pod_info"),
-						vector.WithLabelMatchers(
-							label.New("host_network").Equal("false"),
+				promqlbuilder.TopK(
+					promqlbuilder.Max(
+						vector.New(
+							vector.WithMetricName("kube_pod_info"),
+							vector.WithLabelMatchers(
+								label.New("host_network").Equal("false"),
+							),
 						),
-					),
+					).By("cluster", "namespace", "pod"),
+					1,
 				).By("cluster", "namespace", "pod"),
-				1,
-			).By("cluster", "namespace", "pod"),
-		).On("cluster", "namespace", "pod").GroupLeft(),
-	).By("namespace"),
-	"KubernetesTransmittedPackets7": promqlbuilder.Sum(
-		promqlbuilder.Mul(
-			promqlbuilder.Rate(
-				matrix.New(
+			).On("cluster", "namespace", "pod").GroupLeft(),
+		).By("pod"),
+		"KubernetesReceivedPackets8": promqlbuilder.SortDesc(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					promqlbuilder.Mul(
+						promqlbuilder.Rate(
+							matrix.New(
+								vector.New(
+									vector.WithMetricName("container_network_receive_packets_total"),
+									vector.WithLabelMatchers(
+										label.New("cluster").Equal("$cluster"),
+										label.New("namespace").Equal("$namespace"),
+									),
+								),
+								matrix.WithRangeAsVariable("$__rate_interval"),
+							),
+						),
+						promqlbuilder.TopK(
+							promqlbuilder.Max(
+								vector.New(
+									vector.WithMetricName("kube_pod_info"),
+									vector.WithLabelMatchers(
+										label.New("host_network").Equal("false"),
+									),
+								),
+							).By("cluster", "namespace", "pod"),
+							1,
+						).By("cluster", "namespace", "pod"),
+					).On("cluster", "namespace", "pod").GroupLeft(),
 					vector.New(
-						vector.WithMetricName("container_network_transmit_packets_total"),
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp(".+"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("cluster", "namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload"),
+		),
+		"KubernetesReceivedPackets9": promqlbuilder.SortDesc(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					promqlbuilder.Rate(
+						matrix.New(
+							vector.New(
+								vector.WithMetricName("container_network_receive_packets_total"),
+								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
+									label.New("cluster").Equal("$cluster"),
+									label.New("namespace").EqualRegexp("$namespace"),
+								),
+							),
+							matrix.WithRangeAsVariable("$__rate_interval"),
+						),
+					),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").EqualRegexp("$namespace"),
+							label.New("workload").EqualRegexp("$workload"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("pod"),
+		),
+		"KubernetesReceivedPackets10": promqlbuilder.Sum(
+			promqlbuilder.Rate(
+				matrix.New(
+					vector.New(
+						vector.WithMetricName("container_network_receive_packets_total"),
 						vector.WithLabelMatchers(
 							label.New("cluster").Equal("$cluster"),
 							label.New("namespace").EqualRegexp("$namespace"),
+							label.New("pod").EqualRegexp("$pod"),
 						),
 					),
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-			promqlbuilder.TopK(
-				promqlbuilder.Max(
+		).By("pod"),
+		"KubernetesReceivedPacketsDropped1": promqlbuilder.Sum(
+			promqlbuilder.IRate(
+				matrix.New(
 					vector.New(
-						vector.WithMetricName("kube_pod_info"),
+						vector.WithMetricName("container_network_receive_packets_dropped_total"),
 						vector.WithLabelMatchers(
-							label.New("host_network").Equal("false"),
+							jobLabelValues.CAdvisorMatcher(),
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").EqualRegexp(".+"),
 						),
 					),
-				).By("cluster", "namespace", "pod"),
-				1,
-			).By("cluster", "namespace", "pod"),
-		).On("cluster", "namespace", "pod").GroupLeft(),
-	).By("pod"),
-	"KubernetesTransmittedPackets8": promqlbuilder.SortDesc(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
+					matrix.WithRangeAsVariable("$__rate_interval"),
+				),
+			),
+		).By("namespace"),
+		"KubernetesReceivedPacketsDropped2": promqlbuilder.Sum(
+			promqlbuilder.IRate(
+				matrix.New(
+					vector.New(
+						vector.WithMetricName("container_network_receive_packets_dropped_total"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+						),
+					),
+					matrix.WithRangeAsVariable("$__rate_interval"),
+				),
+			),
+		).By("pod"),
+		"KubernetesReceivedPacketsDropped3": promqlbuilder.Sum(
+			promqlbuilder.Rate(
+				matrix.New(
+					vector.New(
+						vector.WithMetricName("container_network_receive_packets_dropped_total"),
+						vector.WithLabelMatchers(
+							jobLabelValues.CAdvisorMatcher(),
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("pod").EqualRegexp("$pod"),
+						),
+					),
+					matrix.WithRangeAsVariable("$__rate_interval"),
+				),
+			),
+		).By("pod"),
+		"KubernetesReceivedPacketsDropped4": promqlbuilder.Parenthesis(
+			promqlbuilder.Sum(
 				promqlbuilder.Mul(
 					promqlbuilder.Rate(
 						matrix.New(
 							vector.New(
-								vector.WithMetricName("container_network_transmit_packets_total"),
+								vector.WithMetricName("container_network_receive_packets_dropped_total"),
 								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
 									label.New("cluster").Equal("$cluster"),
 									label.New("namespace").Equal("$namespace"),
 								),
@@ -3974,237 +3628,237 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 							matrix.WithRangeAsVariable("$__rate_interval"),
 						),
 					),
-					promqlbuilder.TopK(
-						promqlbuilder.Max(
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp("$workload"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("pod"),
+		),
+		"KubernetesReceivedPacketsDropped5": promqlbuilder.Parenthesis(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					promqlbuilder.Rate(
+						matrix.New(
 							vector.New(
-								vector.WithMetricName("kube_pod_info"),
+								vector.WithMetricName("container_network_receive_packets_dropped_total"),
 								vector.WithLabelMatchers(
-									label.New("host_network").Equal("false"),
+									jobLabelValues.CAdvisorMatcher(),
+									label.New("cluster").Equal("$cluster"),
+									label.New("namespace").Equal("$namespace"),
 								),
 							),
-						).By("cluster", "namespace", "pod"),
-						1,
-					).By("cluster", "namespace", "pod"),
-				).On("cluster", "namespace", "pod").GroupLeft(),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp(".+"),
-						label.New("workload_type").EqualRegexp("$type"),
+							matrix.WithRangeAsVariable("$__rate_interval"),
+						),
 					),
-				),
-			).On("cluster", "namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("workload"),
-	),
-	"KubernetesTransmittedPackets9": promqlbuilder.SortDesc(
-		promqlbuilder.Sum(
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp(".+"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload"),
+		),
+		"KubernetesReceivedPacketsDropped6": promqlbuilder.Sum(
 			promqlbuilder.Mul(
 				promqlbuilder.Rate(
 					matrix.New(
 						vector.New(
-							vector.WithMetricName("container_network_transmit_packets_total"),
+							vector.WithMetricName("container_network_receive_packets_dropped_total"),
 							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
 								label.New("cluster").Equal("$cluster"),
-								label.New("namespace").EqualRegexp("$namespace"),
+								label.New("namespace").NotEqual(""),
 							),
 						),
 						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").EqualRegexp("$namespace"),
-						label.New("workload").EqualRegexp("$workload"),
-						label.New("workload_type").EqualRegexp("$type"),
-					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("pod"),
-	),
-	"KubernetesTransmittedPackets10": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_network_transmit_packets_total"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").EqualRegexp("$namespace"),
-						label.New("pod").EqualRegexp("$pod"),
-					),
-				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
-		),
-	).By("pod"),
-	"KubernetesTransmittedPacketsDropped1": promqlbuilder.Sum(
-		promqlbuilder.IRate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_network_transmit_packets_dropped_total"),
-					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").EqualRegexp(".+"),
-					),
-				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
-		),
-	).By("namespace"),
-	"KubernetesTransmittedPacketsDropped2": promqlbuilder.Sum(
-		promqlbuilder.IRate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_network_transmit_packets_dropped_total"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-					),
-				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
-		),
-	).By("pod"),
-	"KubernetesTransmittedPacketsDropped3": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_network_transmit_packets_dropped_total"),
-					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("pod").EqualRegexp("$pod"),
-					),
-				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
-		),
-	).By("pod"),
-	"KubernetesTransmittedPacketsDropped4": promqlbuilder.Parenthesis(
-		promqlbuilder.Sum(
+				promqlbuilder.TopK(
+					promqlbuilder.Max(
+						vector.New(
+							vector.WithMetricName("kube_pod_info"),
+							vector.WithLabelMatchers(
+								label.New("host_network").Equal("false"),
+							),
+						),
+					).By("cluster", "namespace", "pod"),
+					1,
+				).By("cluster", "namespace", "pod"),
+			).On("cluster", "namespace", "pod").GroupLeft(),
+		).By("namespace"),
+		"KubernetesReceivedPacketsDropped7": promqlbuilder.Sum(
 			promqlbuilder.Mul(
 				promqlbuilder.Rate(
 					matrix.New(
 						vector.New(
-							vector.WithMetricName("container_network_transmit_packets_dropped_total"),
+							vector.WithMetricName("container_network_receive_packets_dropped_total"),
 							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
 								label.New("cluster").Equal("$cluster"),
-								label.New("namespace").Equal("$namespace"),
+								label.New("namespace").NotEqual(""),
 							),
 						),
 						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp("$workload"),
-						label.New("workload_type").EqualRegexp("$type"),
-					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("pod"),
-	),
-	"KubernetesTransmittedPacketsDropped5": promqlbuilder.Parenthesis(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
-				promqlbuilder.Rate(
-					matrix.New(
+				promqlbuilder.TopK(
+					promqlbuilder.Max(
 						vector.New(
-							vector.WithMetricName("container_network_transmit_packets_dropped_total"),
+							vector.WithMetricName("kube_pod_info"),
 							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
-								label.New("cluster").Equal("$cluster"),
-								label.New("namespace").Equal("$namespace"),
+								label.New("host_network").Equal("false"),
 							),
 						),
-						matrix.WithRangeAsVariable("$__rate_interval"),
+					).By("cluster", "namespace", "pod"),
+					1,
+				).By("cluster", "namespace", "pod"),
+			).On("cluster", "namespace", "pod").GroupLeft(),
+		).By("pod"),
+		"KubernetesReceivedPacketsDropped8": promqlbuilder.SortDesc(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					promqlbuilder.Mul(
+						promqlbuilder.Rate(
+							matrix.New(
+								vector.New(
+									vector.WithMetricName("container_network_receive_packets_dropped_total"),
+									vector.WithLabelMatchers(
+										label.New("cluster").Equal("$cluster"),
+										label.New("namespace").Equal("$namespace"),
+									),
+								),
+								matrix.WithRangeAsVariable("$__rate_interval"),
+							),
+						),
+						promqlbuilder.TopK(
+							promqlbuilder.Max(
+								vector.New(
+									vector.WithMetricName("kube_pod_info"),
+									vector.WithLabelMatchers(
+										label.New("host_network").Equal("false"),
+									),
+								),
+							).By("cluster", "namespace", "pod"),
+							1,
+						).By("cluster", "namespace", "pod"),
+					).On("cluster", "namespace", "pod").GroupLeft(),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp(".+"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
 					),
-				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp(".+"),
-						label.New("workload_type").EqualRegexp("$type"),
+				).On("cluster", "namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload"),
+		),
+		"KubernetesReceivedPacketsDropped9": promqlbuilder.SortDesc(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					promqlbuilder.Rate(
+						matrix.New(
+							vector.New(
+								vector.WithMetricName("container_network_receive_packets_dropped_total"),
+								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
+									label.New("cluster").Equal("$cluster"),
+									label.New("namespace").EqualRegexp("$namespace"),
+								),
+							),
+							matrix.WithRangeAsVariable("$__rate_interval"),
+						),
 					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("workload"),
-	),
-	"KubernetesTransmittedPacketsDropped6": promqlbuilder.Sum(
-		promqlbuilder.Mul(
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").EqualRegexp("$namespace"),
+							label.New("workload").EqualRegexp("$workload"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("pod"),
+		),
+		"KubernetesReceivedPacketsDropped10": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("container_network_transmit_packets_dropped_total"),
+						vector.WithMetricName("container_network_receive_packets_dropped_total"),
 						vector.WithLabelMatchers(
 							label.New("cluster").Equal("$cluster"),
-							label.New("namespace").NotEqual(""),
+							label.New("namespace").EqualRegexp("$namespace"),
+							label.New("pod").EqualRegexp("$pod"),
 						),
 					),
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-			promqlbuilder.TopK(
-				promqlbuilder.Max(
+		).By("pod"),
+		"KubernetesTransmittedPackets1": promqlbuilder.Sum(
+			promqlbuilder.IRate(
+				matrix.New(
 					vector.New(
-						vector.WithMetricName("kube_pod_info"),
+						vector.WithMetricName("container_network_transmit_packets_total"),
 						vector.WithLabelMatchers(
-							label.New("host_network").Equal("false"),
+							jobLabelValues.CAdvisorMatcher(),
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").EqualRegexp(".+"),
 						),
 					),
-				).By("cluster", "namespace", "pod"),
-				1,
-			).By("cluster", "namespace", "pod"),
-		).On("cluster", "namespace", "pod").GroupLeft(),
-	).By("namespace"),
-	"KubernetesTransmittedPacketsDropped7": promqlbuilder.Sum(
-		promqlbuilder.Mul(
-			promqlbuilder.Rate(
+					matrix.WithRangeAsVariable("$__rate_interval"),
+				),
+			),
+		).By("namespace"),
+		"KubernetesTransmittedPackets2": promqlbuilder.Sum(
+			promqlbuilder.IRate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("container_network_transmit_packets_dropped_total"),
+						vector.WithMetricName("container_network_transmit_packets_total"),
 						vector.WithLabelMatchers(
 							label.New("cluster").Equal("$cluster"),
-							label.New("namespace").EqualRegexp("$namespace"),
+							label.New("namespace").Equal("$namespace"),
 						),
 					),
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-			promqlbuilder.TopK(
-				promqlbuilder.Max(
+		).By("pod"),
+		"KubernetesTransmittedPackets3": promqlbuilder.Sum(
+			promqlbuilder.Rate(
+				matrix.New(
 					vector.New(
-						vector.WithMetricName("kube_pod_info"),
+						vector.WithMetricName("container_network_transmit_packets_total"),
 						vector.WithLabelMatchers(
-							label.New("host_network").Equal("false"),
+							jobLabelValues.CAdvisorMatcher(),
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("pod").EqualRegexp("$pod"),
 						),
 					),
-				).By("cluster", "namespace", "pod"),
-				1,
-			).By("cluster", "namespace", "pod"),
-		).On("cluster", "namespace", "pod").GroupLeft(),
-	).By("pod"),
-	"KubernetesTransmittedPacketsDropped8": promqlbuilder.SortDesc(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
+					matrix.WithRangeAsVariable("$__rate_interval"),
+				),
+			),
+		).By("pod"),
+		"KubernetesTransmittedPackets4": promqlbuilder.Parenthesis(
+			promqlbuilder.Sum(
 				promqlbuilder.Mul(
 					promqlbuilder.Rate(
 						matrix.New(
 							vector.New(
-								vector.WithMetricName("container_network_transmit_packets_dropped_total"),
+								vector.WithMetricName("container_network_transmit_packets_total"),
 								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
 									label.New("cluster").Equal("$cluster"),
 									label.New("namespace").Equal("$namespace"),
 								),
@@ -4212,273 +3866,237 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 							matrix.WithRangeAsVariable("$__rate_interval"),
 						),
 					),
-					promqlbuilder.TopK(
-						promqlbuilder.Max(
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp("$workload"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("pod"),
+		),
+		"KubernetesTransmittedPackets5": promqlbuilder.Parenthesis(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					promqlbuilder.Rate(
+						matrix.New(
 							vector.New(
-								vector.WithMetricName("kube_pod_info"),
+								vector.WithMetricName("container_network_transmit_packets_total"),
 								vector.WithLabelMatchers(
-									label.New("host_network").Equal("false"),
+									jobLabelValues.CAdvisorMatcher(),
+									label.New("cluster").Equal("$cluster"),
+									label.New("namespace").Equal("$namespace"),
 								),
 							),
-						).By("cluster", "namespace", "pod"),
-						1,
-					).By("cluster", "namespace", "pod"),
-				).On("cluster", "namespace", "pod").GroupLeft(),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp(".+"),
-						label.New("workload_type").EqualRegexp("$type"),
+							matrix.WithRangeAsVariable("$__rate_interval"),
+						),
 					),
-				),
-			).On("cluster", "namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("workload"),
-	),
-	"KubernetesTransmittedPacketsDropped9": promqlbuilder.SortDesc(
-		promqlbuilder.Sum(
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp(".+"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload"),
+		),
+		"KubernetesTransmittedPackets6": promqlbuilder.Sum(
 			promqlbuilder.Mul(
 				promqlbuilder.Rate(
 					matrix.New(
 						vector.New(
-							vector.WithMetricName("container_network_transmit_packets_dropped_total"),
+							vector.WithMetricName("container_network_transmit_packets_total"),
 							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
 								label.New("cluster").Equal("$cluster"),
-								label.New("namespace").EqualRegexp("$namespace"),
+								label.New("namespace").NotEqual(""),
 							),
 						),
 						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").EqualRegexp("$namespace"),
-						label.New("workload").EqualRegexp("$workload"),
-						label.New("workload_type").EqualRegexp("$type"),
-					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("pod"),
-	),
-	"KubernetesTransmittedPacketsDropped10": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_network_transmit_packets_dropped_total"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").EqualRegexp("$namespace"),
-						label.New("pod").EqualRegexp("$pod"),
-					),
-				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
-		),
-	).By("pod"),
-	"KubernetesCurrentRateOfBytesReceived1": promqlbuilder.Sum(
-		promqlbuilder.Mul(
-			promqlbuilder.Rate(
-				matrix.New(
-					vector.New(
-						vector.WithMetricName("container_network_receive_bytes_total"),
-						vector.WithLabelMatchers(
-							label.New("cluster").Equal("$cluster"),
-							label.New("namespace").NotEqual(""),
+				promqlbuilder.TopK(
+					promqlbuilder.Max(
+						vector.New(
+							vector.WithMetricName("kube_pod_info"),
+							vector.WithLabelMatchers(
+								label.New("host_network").Equal("false"),
+							),
+						),
+					).By("cluster", "namespace", "pod"),
+					1,
+				).By("cluster", "namespace", "pod"),
+			).On("cluster", "namespace", "pod").GroupLeft(),
+		).By("namespace"),
+		"KubernetesTransmittedPackets7": promqlbuilder.Sum(
+			promqlbuilder.Mul(
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("container_network_transmit_packets_total"),
+							vector.WithLabelMatchers(
+								label.New("cluster").Equal("$cluster"),
+								label.New("namespace").EqualRegexp("$namespace"),
+							),
 						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
-					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
-			),
-			promqlbuilder.TopK(
-				promqlbuilder.Max(
-					vector.New(
-						vector.WithMetricName("kube_pod_info"),
-						vector.WithLabelMatchers(
-							label.New("host_network").Equal("false"),
+				promqlbuilder.TopK(
+					promqlbuilder.Max(
+						vector.New(
+							vector.WithMetricName("kube_pod_info"),
+							vector.WithLabelMatchers(
+								label.New("host_network").Equal("false"),
+							),
 						),
-					),
+					).By("cluster", "namespace", "pod"),
+					1,
 				).By("cluster", "namespace", "pod"),
-				1,
-			).By("cluster", "namespace", "pod"),
-		).On("cluster", "namespace", "pod").GroupLeft(),
-	).By("namespace"),
-	"KubernetesCurrentRateOfBytesReceived2": promqlbuilder.Sum(
-		promqlbuilder.Mul(
-			promqlbuilder.Rate(
-				matrix.New(
-					vector.New(
-						vector.WithMetricName("container_network_receive_bytes_total"),
-						vector.WithLabelMatchers(
-							label.New("cluster").Equal("$cluster"),
-							label.New("namespace").EqualRegexp("$namespace"),
+			).On("cluster", "namespace", "pod").GroupLeft(),
+		).By("pod"),
+		"KubernetesTransmittedPackets8": promqlbuilder.SortDesc(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					promqlbuilder.Mul(
+						promqlbuilder.Rate(
+							matrix.New(
+								vector.New(
+									vector.WithMetricName("container_network_transmit_packets_total"),
+									vector.WithLabelMatchers(
+										label.New("cluster").Equal("$cluster"),
+										label.New("namespace").Equal("$namespace"),
+									),
+								),
+								matrix.WithRangeAsVariable("$__rate_interval"),
+							),
 						),
-					),
-					matrix.WithRangeAsVariable("$__rate_interval"),
-				),
-			),
-			promqlbuilder.TopK(
-				promqlbuilder.Max(
+						promqlbuilder.TopK(
+							promqlbuilder.Max(
+								vector.New(
+									vector.WithMetricName("kube_pod_info"),
+									vector.WithLabelMatchers(
+										label.New("host_network").Equal("false"),
+									),
+								),
+							).By("cluster", "namespace", "pod"),
+							1,
+						).By("cluster", "namespace", "pod"),
+					).On("cluster", "namespace", "pod").GroupLeft(),
 					vector.New(
-						vector.WithMetricName("kube_pod_info"),
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
 						vector.WithLabelMatchers(
-							label.New("host_network").Equal("false"),
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp(".+"),
+							label.New("workload_type").EqualRegexp("$type"),
 						),
 					),
-				).By("cluster", "namespace", "pod"),
-				1,
-			).By("cluster", "namespace", "pod"),
-		).On("cluster", "namespace", "pod").GroupLeft(),
-	),
-	"KubernetesCurrentRateOfBytesReceived3": promqlbuilder.SortDesc(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
+				).On("cluster", "namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload"),
+		),
+		"KubernetesTransmittedPackets9": promqlbuilder.SortDesc(
+			promqlbuilder.Sum(
 				promqlbuilder.Mul(
 					promqlbuilder.Rate(
 						matrix.New(
 							vector.New(
-								vector.WithMetricName("container_network_receive_bytes_total"),
+								vector.WithMetricName("container_network_transmit_packets_total"),
 								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
 									label.New("cluster").Equal("$cluster"),
-									label.New("namespace").Equal("$namespace"),
+									label.New("namespace").EqualRegexp("$namespace"),
 								),
 							),
 							matrix.WithRangeAsVariable("$__rate_interval"),
 						),
 					),
-					promqlbuilder.TopK(
-						promqlbuilder.Max(
-							vector.New(
-								vector.WithMetricName("kube_pod_info"),
-								vector.WithLabelMatchers(
-									label.New("host_network").Equal("false"),
-								),
-							),
-						).By("cluster", "namespace", "pod"),
-						1,
-					).By("cluster", "namespace", "pod"),
-				).On("cluster", "namespace", "pod").GroupLeft(),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp(".+"),
-						label.New("workload_type").EqualRegexp("$type"),
-					),
-				),
-			).On("cluster", "namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("workload"),
-	),
-	"KubernetesCurrentRateOfBytesReceived4": promqlbuilder.SortDesc(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
-				promqlbuilder.Rate(
-					matrix.New(
-						vector.New(
-							vector.WithMetricName("container_network_receive_bytes_total"),
-							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
-								label.New("cluster").Equal("$cluster"),
-								label.New("namespace").EqualRegexp("$namespace"),
-							),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").EqualRegexp("$namespace"),
+							label.New("workload").EqualRegexp("$workload"),
+							label.New("workload_type").EqualRegexp("$type"),
 						),
-						matrix.WithRangeAsVariable("$__rate_interval"),
-					),
-				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").EqualRegexp("$namespace"),
-						label.New("workload").EqualRegexp("$workload"),
-						label.New("workload_type").EqualRegexp("$type"),
-					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("pod"),
-	),
-	"KubernetesCurrentRateOfBytesReceived5": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_network_receive_bytes_total"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").EqualRegexp("$namespace"),
-						label.New("pod").EqualRegexp("$pod"),
 					),
-				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("pod"),
 		),
-	),
-	"KubernetesCurrentRateOfBytesTransmitted1": promqlbuilder.Sum(
-		promqlbuilder.Mul(
+		"KubernetesTransmittedPackets10": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("container_network_transmit_bytes_total"),
+						vector.WithMetricName("container_network_transmit_packets_total"),
 						vector.WithLabelMatchers(
 							label.New("cluster").Equal("$cluster"),
-							label.New("namespace").NotEqual(""),
+							label.New("namespace").EqualRegexp("$namespace"),
+							label.New("pod").EqualRegexp("$pod"),
 						),
 					),
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-			promqlbuilder.TopK(
-				promqlbuilder.Max(
+		).By("pod"),
+		"KubernetesTransmittedPacketsDropped1": promqlbuilder.Sum(
+			promqlbuilder.IRate(
+				matrix.New(
 					vector.New(
-						vector.WithMetricName("kube_pod_info"),
+						vector.WithMetricName("container_network_transmit_packets_dropped_total"),
 						vector.WithLabelMatchers(
-							label.New("host_network").Equal("false"),
+							jobLabelValues.CAdvisorMatcher(),
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").EqualRegexp(".+"),
 						),
 					),
-				).By("cluster", "namespace", "pod"),
-				1,
-			).By("cluster", "namespace", "pod"),
-		).On("cluster", "namespace", "pod").GroupLeft(),
-	).By("namespace"),
-	"KubernetesCurrentRateOfBytesTransmitted2": promqlbuilder.Sum(
-		promqlbuilder.Mul(
-			promqlbuilder.Rate(
+					matrix.WithRangeAsVariable("$__rate_interval"),
+				),
+			),
+		).By("namespace"),
+		"KubernetesTransmittedPacketsDropped2": promqlbuilder.Sum(
+			promqlbuilder.IRate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("container_network_transmit_bytes_total"),
+						vector.WithMetricName("container_network_transmit_packets_dropped_total"),
 						vector.WithLabelMatchers(
 							label.New("cluster").Equal("$cluster"),
-							label.New("namespace").EqualRegexp("$namespace"),
+							label.New("namespace").Equal("$namespace"),
 						),
 					),
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-			promqlbuilder.TopK(
-				promqlbuilder.Max(
+		).By("pod"),
+		"KubernetesTransmittedPacketsDropped3": promqlbuilder.Sum(
+			promqlbuilder.Rate(
+				matrix.New(
 					vector.New(
-						vector.WithMetricName("kube_pod_info"),
+						vector.WithMetricName("container_network_transmit_packets_dropped_total"),
 						vector.WithLabelMatchers(
-							label.New("host_network").Equal("false"),
+							jobLabelValues.CAdvisorMatcher(),
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("pod").EqualRegexp("$pod"),
 						),
 					),
-				).By("cluster", "namespace", "pod"),
-				1,
-			).By("cluster", "namespace", "pod"),
-		).On("cluster", "namespace", "pod").GroupLeft(),
-	),
-	"KubernetesCurrentRateOfBytesTransmitted3": promqlbuilder.SortDesc(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
+					matrix.WithRangeAsVariable("$__rate_interval"),
+				),
+			),
+		).By("pod"),
+		"KubernetesTransmittedPacketsDropped4": promqlbuilder.Parenthesis(
+			promqlbuilder.Sum(
 				promqlbuilder.Mul(
 					promqlbuilder.Rate(
 						matrix.New(
 							vector.New(
-								vector.WithMetricName("container_network_transmit_bytes_total"),
+								vector.WithMetricName("container_network_transmit_packets_dropped_total"),
 								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
 									label.New("cluster").Equal("$cluster"),
 									label.New("namespace").Equal("$namespace"),
 								),
@@ -4486,109 +4104,217 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 							matrix.WithRangeAsVariable("$__rate_interval"),
 						),
 					),
-					promqlbuilder.TopK(
-						promqlbuilder.Max(
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp("$workload"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("pod"),
+		),
+		"KubernetesTransmittedPacketsDropped5": promqlbuilder.Parenthesis(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					promqlbuilder.Rate(
+						matrix.New(
 							vector.New(
-								vector.WithMetricName("kube_pod_info"),
+								vector.WithMetricName("container_network_transmit_packets_dropped_total"),
 								vector.WithLabelMatchers(
-									label.New("host_network").Equal("false"),
+									jobLabelValues.CAdvisorMatcher(),
+									label.New("cluster").Equal("$cluster"),
+									label.New("namespace").Equal("$namespace"),
 								),
 							),
-						).By("cluster", "namespace", "pod"),
-						1,
-					).By("cluster", "namespace", "pod"),
-				).On("cluster", "namespace", "pod").GroupLeft(),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp(".+"),
-						label.New("workload_type").EqualRegexp("$type"),
+							matrix.WithRangeAsVariable("$__rate_interval"),
+						),
 					),
-				),
-			).On("cluster", "namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("workload"),
-	),
-	"KubernetesCurrentRateOfBytesTransmitted4": promqlbuilder.SortDesc(
-		promqlbuilder.Sum(
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp(".+"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload"),
+		),
+		"KubernetesTransmittedPacketsDropped6": promqlbuilder.Sum(
 			promqlbuilder.Mul(
 				promqlbuilder.Rate(
 					matrix.New(
 						vector.New(
-							vector.WithMetricName("container_network_transmit_bytes_total"),
+							vector.WithMetricName("container_network_transmit_packets_dropped_total"),
 							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
 								label.New("cluster").Equal("$cluster"),
-								label.New("namespace").EqualRegexp("$namespace"),
+								label.New("namespace").NotEqual(""),
 							),
 						),
 						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").EqualRegexp("$namespace"),
-						label.New("workload").EqualRegexp("$workload"),
-						label.New("workload_type").EqualRegexp("$type"),
+				promqlbuilder.TopK(
+					promqlbuilder.Max(
+						vector.New(
+							vector.WithMetricName("kube_pod_info"),
+							vector.WithLabelMatchers(
+								label.New("host_network").Equal("false"),
+							),
+						),
+					).By("cluster", "namespace", "pod"),
+					1,
+				).By("cluster", "namespace", "pod"),
+			).On("cluster", "namespace", "pod").GroupLeft(),
+		).By("namespace"),
+		"KubernetesTransmittedPacketsDropped7": promqlbuilder.Sum(
+			promqlbuilder.Mul(
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("container_network_transmit_packets_dropped_total"),
+							vector.WithLabelMatchers(
+								label.New("cluster").Equal("$cluster"),
+								label.New("namespace").EqualRegexp("$namespace"),
+							),
+						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+				promqlbuilder.TopK(
+					promqlbuilder.Max(
+						vector.New(
+							vector.WithMetricName("kube_pod_info"),
+							vector.WithLabelMatchers(
+								label.New("host_network").Equal("false"),
+							),
+						),
+					).By("cluster", "namespace", "pod"),
+					1,
+				).By("cluster", "namespace", "pod"),
+			).On("cluster", "namespace", "pod").GroupLeft(),
 		).By("pod"),
-	),
-	"KubernetesCurrentRateOfBytesTransmitted5": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_network_transmit_bytes_total"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").EqualRegexp("$namespace"),
-						label.New("pod").EqualRegexp("$pod"),
+		"KubernetesTransmittedPacketsDropped8": promqlbuilder.SortDesc(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					promqlbuilder.Mul(
+						promqlbuilder.Rate(
+							matrix.New(
+								vector.New(
+									vector.WithMetricName("container_network_transmit_packets_dropped_total"),
+									vector.WithLabelMatchers(
+										label.New("cluster").Equal("$cluster"),
+										label.New("namespace").Equal("$namespace"),
+									),
+								),
+								matrix.WithRangeAsVariable("$__rate_interval"),
+							),
+						),
+						promqlbuilder.TopK(
+							promqlbuilder.Max(
+								vector.New(
+									vector.WithMetricName("kube_pod_info"),
+									vector.WithLabelMatchers(
+										label.New("host_network").Equal("false"),
+									),
+								),
+							).By("cluster", "namespace", "pod"),
+							1,
+						).By("cluster", "namespace", "pod"),
+					).On("cluster", "namespace", "pod").GroupLeft(),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp(".+"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
 					),
-				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
+				).On("cluster", "namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload"),
+		),
+		"KubernetesTransmittedPacketsDropped9": promqlbuilder.SortDesc(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					promqlbuilder.Rate(
+						matrix.New(
+							vector.New(
+								vector.WithMetricName("container_network_transmit_packets_dropped_total"),
+								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
+									label.New("cluster").Equal("$cluster"),
+									label.New("namespace").EqualRegexp("$namespace"),
+								),
+							),
+							matrix.WithRangeAsVariable("$__rate_interval"),
+						),
+					),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").EqualRegexp("$namespace"),
+							label.New("workload").EqualRegexp("$workload"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("pod"),
 		),
-	),
-	"KubernetesAverageRateOfBytesReceived1": promqlbuilder.Avg(
-		promqlbuilder.Mul(
+		"KubernetesTransmittedPacketsDropped10": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("container_network_receive_bytes_total"),
+						vector.WithMetricName("container_network_transmit_packets_dropped_total"),
 						vector.WithLabelMatchers(
 							label.New("cluster").Equal("$cluster"),
-							label.New("namespace").NotEqual(""),
+							label.New("namespace").EqualRegexp("$namespace"),
+							label.New("pod").EqualRegexp("$pod"),
 						),
 					),
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-			promqlbuilder.TopK(
-				promqlbuilder.Max(
-					vector.New(
-						vector.WithMetricName("kube_pod_info"),
-						vector.WithLabelMatchers(
-							label.New("host_network").Equal("false"),
+		).By("pod"),
+		"KubernetesCurrentRateOfBytesReceived1": promqlbuilder.Sum(
+			promqlbuilder.Mul(
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("container_network_receive_bytes_total"),
+							vector.WithLabelMatchers(
+								label.New("cluster").Equal("$cluster"),
+								label.New("namespace").NotEqual(""),
+							),
 						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
+				),
+				promqlbuilder.TopK(
+					promqlbuilder.Max(
+						vector.New(
+							vector.WithMetricName("kube_pod_info"),
+							vector.WithLabelMatchers(
+								label.New("host_network").Equal("false"),
+							),
+						),
+					).By("cluster", "namespace", "pod"),
+					1,
 				).By("cluster", "namespace", "pod"),
-				1,
-			).By("cluster", "namespace", "pod"),
-		).On("cluster", "namespace", "pod").GroupLeft(),
-	).By("namespace"),
-	"KubernetesAverageRateOfBytesReceived2": promqlbuilder.SortDesc(
-		promqlbuilder.Avg(
+			).On("cluster", "namespace", "pod").GroupLeft(),
+		).By("namespace"),
+		"KubernetesCurrentRateOfBytesReceived2": promqlbuilder.Sum(
 			promqlbuilder.Mul(
 				promqlbuilder.Rate(
 					matrix.New(
 						vector.New(
 							vector.WithMetricName("container_network_receive_bytes_total"),
 							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
 								label.New("cluster").Equal("$cluster"),
 								label.New("namespace").EqualRegexp("$namespace"),
 							),
@@ -4596,54 +4322,136 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").EqualRegexp("$namespace"),
-						label.New("workload").EqualRegexp("$workload"),
-						label.New("workload_type").EqualRegexp("$type"),
+				promqlbuilder.TopK(
+					promqlbuilder.Max(
+						vector.New(
+							vector.WithMetricName("kube_pod_info"),
+							vector.WithLabelMatchers(
+								label.New("host_network").Equal("false"),
+							),
+						),
+					).By("cluster", "namespace", "pod"),
+					1,
+				).By("cluster", "namespace", "pod"),
+			).On("cluster", "namespace", "pod").GroupLeft(),
+		),
+		"KubernetesCurrentRateOfBytesReceived3": promqlbuilder.SortDesc(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					promqlbuilder.Mul(
+						promqlbuilder.Rate(
+							matrix.New(
+								vector.New(
+									vector.WithMetricName("container_network_receive_bytes_total"),
+									vector.WithLabelMatchers(
+										label.New("cluster").Equal("$cluster"),
+										label.New("namespace").Equal("$namespace"),
+									),
+								),
+								matrix.WithRangeAsVariable("$__rate_interval"),
+							),
+						),
+						promqlbuilder.TopK(
+							promqlbuilder.Max(
+								vector.New(
+									vector.WithMetricName("kube_pod_info"),
+									vector.WithLabelMatchers(
+										label.New("host_network").Equal("false"),
+									),
+								),
+							).By("cluster", "namespace", "pod"),
+							1,
+						).By("cluster", "namespace", "pod"),
+					).On("cluster", "namespace", "pod").GroupLeft(),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp(".+"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
 					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("pod"),
-	),
-	"KubernetesAverageRateOfBytesTransmitted1": promqlbuilder.Avg(
-		promqlbuilder.Mul(
+				).On("cluster", "namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload"),
+		),
+		"KubernetesCurrentRateOfBytesReceived4": promqlbuilder.SortDesc(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					promqlbuilder.Rate(
+						matrix.New(
+							vector.New(
+								vector.WithMetricName("container_network_receive_bytes_total"),
+								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
+									label.New("cluster").Equal("$cluster"),
+									label.New("namespace").EqualRegexp("$namespace"),
+								),
+							),
+							matrix.WithRangeAsVariable("$__rate_interval"),
+						),
+					),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").EqualRegexp("$namespace"),
+							label.New("workload").EqualRegexp("$workload"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("pod"),
+		),
+		"KubernetesCurrentRateOfBytesReceived5": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("container_network_transmit_bytes_total"),
+						vector.WithMetricName("container_network_receive_bytes_total"),
 						vector.WithLabelMatchers(
 							label.New("cluster").Equal("$cluster"),
-							label.New("namespace").NotEqual(""),
+							label.New("namespace").EqualRegexp("$namespace"),
+							label.New("pod").EqualRegexp("$pod"),
 						),
 					),
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-			promqlbuilder.TopK(
-				promqlbuilder.Max(
-					vector.New(
-						vector.WithMetricName("kube_pod_info"),
-						vector.WithLabelMatchers(
-							label.New("host_network").Equal("false"),
+		),
+		"KubernetesCurrentRateOfBytesTransmitted1": promqlbuilder.Sum(
+			promqlbuilder.Mul(
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("container_network_transmit_bytes_total"),
+							vector.WithLabelMatchers(
+								label.New("cluster").Equal("$cluster"),
+								label.New("namespace").NotEqual(""),
+							),
 						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
+				),
+				promqlbuilder.TopK(
+					promqlbuilder.Max(
+						vector.New(
+							vector.WithMetricName("kube_pod_info"),
+							vector.WithLabelMatchers(
+								label.New("host_network").Equal("false"),
+							),
+						),
+					).By("cluster", "namespace", "pod"),
+					1,
 				).By("cluster", "namespace", "pod"),
-				1,
-			).By("cluster", "namespace", "pod"),
-		).On("cluster", "namespace", "pod").GroupLeft(),
-	).By("namespace"),
-	"KubernetesAverageRateOfBytesTransmitted2": promqlbuilder.SortDesc(
-		promqlbuilder.Avg(
+			).On("cluster", "namespace", "pod").GroupLeft(),
+		).By("namespace"),
+		"KubernetesCurrentRateOfBytesTransmitted2": promqlbuilder.Sum(
 			promqlbuilder.Mul(
 				promqlbuilder.Rate(
 					matrix.New(
 						vector.New(
 							vector.WithMetricName("container_network_transmit_bytes_total"),
 							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
 								label.New("cluster").Equal("$cluster"),
 								label.New("namespace").EqualRegexp("$namespace"),
 							),
@@ -4651,77 +4459,252 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").EqualRegexp("$namespace"),
-						label.New("workload").EqualRegexp("$workload"),
-						label.New("workload_type").EqualRegexp("$type"),
+				promqlbuilder.TopK(
+					promqlbuilder.Max(
+						vector.New(
+							vector.WithMetricName("kube_pod_info"),
+							vector.WithLabelMatchers(
+								label.New("host_network").Equal("false"),
+							),
+						),
+					).By("cluster", "namespace", "pod"),
+					1,
+				).By("cluster", "namespace", "pod"),
+			).On("cluster", "namespace", "pod").GroupLeft(),
+		),
+		"KubernetesCurrentRateOfBytesTransmitted3": promqlbuilder.SortDesc(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					promqlbuilder.Mul(
+						promqlbuilder.Rate(
+							matrix.New(
+								vector.New(
+									vector.WithMetricName("container_network_transmit_bytes_total"),
+									vector.WithLabelMatchers(
+										label.New("cluster").Equal("$cluster"),
+										label.New("namespace").Equal("$namespace"),
+									),
+								),
+								matrix.WithRangeAsVariable("$__rate_interval"),
+							),
+						),
+						promqlbuilder.TopK(
+							promqlbuilder.Max(
+								vector.New(
+									vector.WithMetricName("kube_pod_info"),
+									vector.WithLabelMatchers(
+										label.New("host_network").Equal("false"),
+									),
+								),
+							).By("cluster", "namespace", "pod"),
+							1,
+						).By("cluster", "namespace", "pod"),
+					).On("cluster", "namespace", "pod").GroupLeft(),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp(".+"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
 					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("pod"),
-	),
-	// node_resources
-	"CPUUsageQuota1": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-				label.New("node").EqualRegexp("$node"),
-			),
+				).On("cluster", "namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload"),
 		),
-	).By("pod"),
-	"CPUUsageQuota2": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("cluster:namespace:pod_cpu:active:kube_pod_container_resource_requests"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-				label.New("node").EqualRegexp("$node"),
-			),
+		"KubernetesCurrentRateOfBytesTransmitted4": promqlbuilder.SortDesc(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					promqlbuilder.Rate(
+						matrix.New(
+							vector.New(
+								vector.WithMetricName("container_network_transmit_bytes_total"),
+								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
+									label.New("cluster").Equal("$cluster"),
+									label.New("namespace").EqualRegexp("$namespace"),
+								),
+							),
+							matrix.WithRangeAsVariable("$__rate_interval"),
+						),
+					),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").EqualRegexp("$namespace"),
+							label.New("workload").EqualRegexp("$workload"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("pod"),
 		),
-	).By("pod"),
-	"CPUUsageQuota3": promqlbuilder.Div(
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
-				vector.WithLabelMatchers(
-					label.New("cluster").Equal("$cluster"),
-					label.New("node").EqualRegexp("$node"),
+		"KubernetesCurrentRateOfBytesTransmitted5": promqlbuilder.Sum(
+			promqlbuilder.Rate(
+				matrix.New(
+					vector.New(
+						vector.WithMetricName("container_network_transmit_bytes_total"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").EqualRegexp("$namespace"),
+							label.New("pod").EqualRegexp("$pod"),
+						),
+					),
+					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-		).By("pod"),
-		promqlbuilder.Sum(
+		),
+		"KubernetesAverageRateOfBytesReceived1": promqlbuilder.Avg(
+			promqlbuilder.Mul(
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("container_network_receive_bytes_total"),
+							vector.WithLabelMatchers(
+								label.New("cluster").Equal("$cluster"),
+								label.New("namespace").NotEqual(""),
+							),
+						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
+					),
+				),
+				promqlbuilder.TopK(
+					promqlbuilder.Max(
+						vector.New(
+							vector.WithMetricName("kube_pod_info"),
+							vector.WithLabelMatchers(
+								label.New("host_network").Equal("false"),
+							),
+						),
+					).By("cluster", "namespace", "pod"),
+					1,
+				).By("cluster", "namespace", "pod"),
+			).On("cluster", "namespace", "pod").GroupLeft(),
+		).By("namespace"),
+		"KubernetesAverageRateOfBytesReceived2": promqlbuilder.SortDesc(
+			promqlbuilder.Avg(
+				promqlbuilder.Mul(
+					promqlbuilder.Rate(
+						matrix.New(
+							vector.New(
+								vector.WithMetricName("container_network_receive_bytes_total"),
+								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
+									label.New("cluster").Equal("$cluster"),
+									label.New("namespace").EqualRegexp("$namespace"),
+								),
+							),
+							matrix.WithRangeAsVariable("$__rate_interval"),
+						),
+					),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").EqualRegexp("$namespace"),
+							label.New("workload").EqualRegexp("$workload"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("pod"),
+		),
+		"KubernetesAverageRateOfBytesTransmitted1": promqlbuilder.Avg(
+			promqlbuilder.Mul(
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("container_network_transmit_bytes_total"),
+							vector.WithLabelMatchers(
+								label.New("cluster").Equal("$cluster"),
+								label.New("namespace").NotEqual(""),
+							),
+						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
+					),
+				),
+				promqlbuilder.TopK(
+					promqlbuilder.Max(
+						vector.New(
+							vector.WithMetricName("kube_pod_info"),
+							vector.WithLabelMatchers(
+								label.New("host_network").Equal("false"),
+							),
+						),
+					).By("cluster", "namespace", "pod"),
+					1,
+				).By("cluster", "namespace", "pod"),
+			).On("cluster", "namespace", "pod").GroupLeft(),
+		).By("namespace"),
+		"KubernetesAverageRateOfBytesTransmitted2": promqlbuilder.SortDesc(
+			promqlbuilder.Avg(
+				promqlbuilder.Mul(
+					promqlbuilder.Rate(
+						matrix.New(
+							vector.New(
+								vector.WithMetricName("container_network_transmit_bytes_total"),
+								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
+									label.New("cluster").Equal("$cluster"),
+									label.New("namespace").EqualRegexp("$namespace"),
+								),
+							),
+							matrix.WithRangeAsVariable("$__rate_interval"),
+						),
+					),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").EqualRegexp("$namespace"),
+							label.New("workload").EqualRegexp("$workload"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("pod"),
+		),
+		// node_resources
+		"CPUUsageQuota1": promqlbuilder.Sum(
 			vector.New(
-				vector.WithMetricName("cluster:namespace:pod_cpu:active:kube_pod_container_resource_requests"),
+				vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
 				vector.WithLabelMatchers(
 					label.New("cluster").Equal("$cluster"),
 					label.New("node").EqualRegexp("$node"),
 				),
 			),
 		).By("pod"),
-	),
-	"CPUUsageQuota4": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("cluster:namespace:pod_cpu:active:kube_pod_container_resource_limits"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-				label.New("node").EqualRegexp("$node"),
-			),
-		),
-	).By("pod"),
-	"CPUUsageQuota5": promqlbuilder.Div(
-		promqlbuilder.Sum(
+		"CPUUsageQuota2": promqlbuilder.Sum(
 			vector.New(
-				vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
+				vector.WithMetricName("cluster:namespace:pod_cpu:active:kube_pod_container_resource_requests"),
 				vector.WithLabelMatchers(
 					label.New("cluster").Equal("$cluster"),
 					label.New("node").EqualRegexp("$node"),
 				),
 			),
 		).By("pod"),
-		promqlbuilder.Sum(
+		"CPUUsageQuota3": promqlbuilder.Div(
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+						label.New("node").EqualRegexp("$node"),
+					),
+				),
+			).By("pod"),
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("cluster:namespace:pod_cpu:active:kube_pod_container_resource_requests"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+						label.New("node").EqualRegexp("$node"),
+					),
+				),
+			).By("pod"),
+		),
+		"CPUUsageQuota4": promqlbuilder.Sum(
 			vector.New(
 				vector.WithMetricName("cluster:namespace:pod_cpu:active:kube_pod_container_resource_limits"),
 				vector.WithLabelMatchers(
@@ -4730,28 +4713,27 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 				),
 			),
 		).By("pod"),
-	),
-	"MemoryQuota1": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("node_namespace_pod_container:container_memory_working_set_bytes"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-				label.New("node").EqualRegexp("$node"),
-				label.New("container").NotEqual(""),
-			),
-		),
-	).By("pod"),
-	"MemoryQuota2": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("cluster:namespace:pod_memory:active:kube_pod_container_resource_requests"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-				label.New("node").EqualRegexp("$node"),
-			),
+		"CPUUsageQuota5": promqlbuilder.Div(
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+						label.New("node").EqualRegexp("$node"),
+					),
+				),
+			).By("pod"),
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("cluster:namespace:pod_cpu:active:kube_pod_container_resource_limits"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+						label.New("node").EqualRegexp("$node"),
+					),
+				),
+			).By("pod"),
 		),
-	).By("pod"),
-	"MemoryQuota3": promqlbuilder.Div(
-		promqlbuilder.Sum(
+		"MemoryQuota1": promqlbuilder.Sum(
 			vector.New(
 				vector.WithMetricName("node_namespace_pod_container:container_memory_working_set_bytes"),
 				vector.WithLabelMatchers(
@@ -4761,7 +4743,7 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 				),
 			),
 		).By("pod"),
-		promqlbuilder.Sum(
+		"MemoryQuota2": promqlbuilder.Sum(
 			vector.New(
 				vector.WithMetricName("cluster:namespace:pod_memory:active:kube_pod_container_resource_requests"),
 				vector.WithLabelMatchers(
@@ -4770,28 +4752,28 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 				),
 			),
 		).By("pod"),
-	),
-	"MemoryQuota4": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("cluster:namespace:pod_memory:active:kube_pod_container_resource_limits"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-				label.New("node").EqualRegexp("$node"),
-			),
-		),
-	).By("pod"),
-	"MemoryQuota5": promqlbuilder.Div(
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("node_namespace_pod_container:container_memory_working_set_bytes"),
-				vector.WithLabelMatchers(
-					label.New("cluster").Equal("$cluster"),
-					label.New("node").EqualRegexp("$node"),
-					label.New("container").NotEqual(""),
+		"MemoryQuota3": promqlbuilder.Div(
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("node_namespace_pod_container:container_memory_working_set_bytes"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+						label.New("node").EqualRegexp("$node"),
+						label.New("container").NotEqual(""),
+					),
 				),
-			),
-		).By("pod"),
-		promqlbuilder.Sum(
+			).By("pod"),
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("cluster:namespace:pod_memory:active:kube_pod_container_resource_requests"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+						label.New("node").EqualRegexp("$node"),
+					),
+				),
+			).By("pod"),
+		),
+		"MemoryQuota4": promqlbuilder.Sum(
 			vector.New(
 				vector.WithMetricName("cluster:namespace:pod_memory:active:kube_pod_container_resource_limits"),
 				vector.WithLabelMatchers(
@@ -4800,281 +4782,278 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 				),
 			),
 		).By("pod"),
-	),
-	"MemoryQuota6": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("node_namespace_pod_container:container_memory_rss"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-				label.New("node").EqualRegexp("$node"),
-				label.New("container").NotEqual(""),
-			),
-		),
-	).By("pod"),
-	"MemoryQuota7": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("node_namespace_pod_container:container_memory_cache"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-				label.New("node").EqualRegexp("$node"),
-				label.New("container").NotEqual(""),
-			),
-		),
-	).By("pod"),
-	"MemoryQuota8": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("node_namespace_pod_container:container_memory_swap"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-				label.New("node").EqualRegexp("$node"),
-				label.New("container").NotEqual(""),
-			),
-		),
-	).By("pod"),
-	// persistent_volume
-	"VolumeSpaceUsage1": promqlbuilder.Parenthesis(
-		promqlbuilder.Sub(
+		"MemoryQuota5": promqlbuilder.Div(
 			promqlbuilder.Sum(
-				promqlbuilder.TopK(
-					promqlbuilder.Parenthesis(
-						vector.New(
-							vector.WithMetricName("kubelet_volume_stats_capacity_bytes"),
-							vector.WithLabelMatchers(
-								label.New("cluster").Equal("$cluster"),
-								label.New("job").Equal("kubelet"),
-								label.New("namespace").Equal("$namespace"),
-								label.New("persistentvolumeclaim").Equal("$volume"),
-							),
-						),
+				vector.New(
+					vector.WithMetricName("node_namespace_pod_container:container_memory_working_set_bytes"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+						label.New("node").EqualRegexp("$node"),
+						label.New("container").NotEqual(""),
 					),
-					1,
 				),
-			).Without("instance", "node"),
+			).By("pod"),
 			promqlbuilder.Sum(
-				promqlbuilder.TopK(
-					promqlbuilder.Parenthesis(
-						vector.New(
-							vector.WithMetricName("kubelet_volume_stats_available_bytes"),
-							vector.WithLabelMatchers(
-								label.New("cluster").Equal("$cluster"),
-								label.New("job").Equal("kubelet"),
-								label.New("namespace").Equal("$namespace"),
-								label.New("persistentvolumeclaim").Equal("$volume"),
-							),
-						),
-					),
-					1,
-				),
-			).Without("instance", "node"),
-		),
-	),
-	"VolumeSpaceUsage2": promqlbuilder.Sum(
-		promqlbuilder.TopK(
-			promqlbuilder.Parenthesis(
 				vector.New(
-					vector.WithMetricName("kubelet_volume_stats_available_bytes"),
+					vector.WithMetricName("cluster:namespace:pod_memory:active:kube_pod_container_resource_limits"),
 					vector.WithLabelMatchers(
 						label.New("cluster").Equal("$cluster"),
-						label.New("job").Equal("kubelet"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("persistentvolumeclaim").Equal("$volume"),
+						label.New("node").EqualRegexp("$node"),
 					),
 				),
-			),
-			1,
+			).By("pod"),
 		),
-	).Without("instance", "node"),
-	"VolumeSpaceUsageGauge": promqlbuilder.Max(
-		promqlbuilder.Mul(
-			promqlbuilder.Div(
-				promqlbuilder.Parenthesis(
-					promqlbuilder.Sub(
-						promqlbuilder.TopK(
+		"MemoryQuota6": promqlbuilder.Sum(
+			vector.New(
+				vector.WithMetricName("node_namespace_pod_container:container_memory_rss"),
+				vector.WithLabelMatchers(
+					label.New("cluster").Equal("$cluster"),
+					label.New("node").EqualRegexp("$node"),
+					label.New("container").NotEqual(""),
+				),
+			),
+		).By("pod"),
+		"MemoryQuota7": promqlbuilder.Sum(
+			vector.New(
+				vector.WithMetricName("node_namespace_pod_container:container_memory_cache"),
+				vector.WithLabelMatchers(
+					label.New("cluster").Equal("$cluster"),
+					label.New("node").EqualRegexp("$node"),
+					label.New("container").NotEqual(""),
+				),
+			),
+		).By("pod"),
+		"MemoryQuota8": promqlbuilder.Sum(
+			vector.New(
+				vector.WithMetricName("node_namespace_pod_container:container_memory_swap"),
+				vector.WithLabelMatchers(
+					label.New("cluster").Equal("$cluster"),
+					label.New("node").EqualRegexp("$node"),
+					label.New("container").NotEqual(""),
+				),
+			),
+		).By("pod"),
+		// persistent_volume
+		"VolumeSpaceUsage1": promqlbuilder.Parenthesis(
+			promqlbuilder.Sub(
+				promqlbuilder.Sum(
+					promqlbuilder.TopK(
+						promqlbuilder.Parenthesis(
 							vector.New(
 								vector.WithMetricName("kubelet_volume_stats_capacity_bytes"),
 								vector.WithLabelMatchers(
 									label.New("cluster").Equal("$cluster"),
-									label.New("job").Equal("kubelet"),
+									jobLabelValues.KubeletMatcher(),
 									label.New("namespace").Equal("$namespace"),
 									label.New("persistentvolumeclaim").Equal("$volume"),
 								),
 							),
-							1,
 						),
-						promqlbuilder.TopK(
+						1,
+					),
+				).Without("instance", "node"),
+				promqlbuilder.Sum(
+					promqlbuilder.TopK(
+						promqlbuilder.Parenthesis(
 							vector.New(
 								vector.WithMetricName("kubelet_volume_stats_available_bytes"),
 								vector.WithLabelMatchers(
 									label.New("cluster").Equal("$cluster"),
-									label.New("job").Equal("kubelet"),
+									jobLabelValues.KubeletMatcher(),
 									label.New("namespace").Equal("$namespace"),
 									label.New("persistentvolumeclaim").Equal("$volume"),
 								),
 							),
-							1,
 						),
+						1,
 					),
-				),
-				promqlbuilder.TopK(
+				).Without("instance", "node"),
+			),
+		),
+		"VolumeSpaceUsage2": promqlbuilder.Sum(
+			promqlbuilder.TopK(
+				promqlbuilder.Parenthesis(
 					vector.New(
-						vector.WithMetricName("kubelet_volume_stats_capacity_bytes"),
+						vector.WithMetricName("kubelet_volume_stats_available_bytes"),
 						vector.WithLabelMatchers(
 							label.New("cluster").Equal("$cluster"),
-							label.New("job").Equal("kubelet"),
+							jobLabelValues.KubeletMatcher(),
 							label.New("namespace").Equal("$namespace"),
 							label.New("persistentvolumeclaim").Equal("$volume"),
 						),
 					),
-					1,
-				),
-			),
-			&parser.NumberLiteral{Val: 100},
-		),
-	).Without("instance", "node"),
-	"VolumeInodesUsage1": promqlbuilder.Sum(
-		promqlbuilder.TopK(
-			promqlbuilder.Parenthesis(
-				vector.New(
-					vector.WithMetricName("kubelet_volume_stats_inodes_used"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("job").Equal("kubelet"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("persistentvolumeclaim").Equal("$volume"),
-					),
 				),
+				1,
 			),
-			1,
-		),
-	).Without("instance", "node"),
-	"VolumeInodesUsage2": promqlbuilder.Parenthesis(
-		promqlbuilder.Sub(
-			promqlbuilder.Sum(
-				promqlbuilder.TopK(
+		).Without("instance", "node"),
+		"VolumeSpaceUsageGauge": promqlbuilder.Max(
+			promqlbuilder.Mul(
+				promqlbuilder.Div(
 					promqlbuilder.Parenthesis(
-						vector.New(
-							vector.WithMetricName("kubelet_volume_stats_inodes"),
-							vector.WithLabelMatchers(
-								label.New("cluster").Equal("$cluster"),
-								label.New("job").Equal("kubelet"),
-								label.New("namespace").Equal("$namespace"),
-								label.New("persistentvolumeclaim").Equal("$volume"),
+						promqlbuilder.Sub(
+							promqlbuilder.TopK(
+								vector.New(
+									vector.WithMetricName("kubelet_volume_stats_capacity_bytes"),
+									vector.WithLabelMatchers(
+										label.New("cluster").Equal("$cluster"),
+										jobLabelValues.KubeletMatcher(),
+										label.New("namespace").Equal("$namespace"),
+										label.New("persistentvolumeclaim").Equal("$volume"),
+									),
+								),
+								1,
+							),
+							promqlbuilder.TopK(
+								vector.New(
+									vector.WithMetricName("kubelet_volume_stats_available_bytes"),
+									vector.WithLabelMatchers(
+										label.New("cluster").Equal("$cluster"),
+										jobLabelValues.KubeletMatcher(),
+										label.New("namespace").Equal("$namespace"),
+										label.New("persistentvolumeclaim").Equal("$volume"),
+									),
+								),
+								1,
 							),
 						),
 					),
-					1,
-				),
-			).Without("instance", "node"),
-			promqlbuilder.Sum(
-				promqlbuilder.TopK(
-					promqlbuilder.Parenthesis(
+					promqlbuilder.TopK(
 						vector.New(
-							vector.WithMetricName("kubelet_volume_stats_inodes_used"),
+							vector.WithMetricName("kubelet_volume_stats_capacity_bytes"),
 							vector.WithLabelMatchers(
 								label.New("cluster").Equal("$cluster"),
-								label.New("job").Equal("kubelet"),
+								jobLabelValues.KubeletMatcher(),
 								label.New("namespace").Equal("$namespace"),
 								label.New("persistentvolumeclaim").Equal("$volume"),
 							),
 						),
+						1,
 					),
-					1,
 				),
-			).Without("instance", "node"),
-		),
-	),
-	"VolumeInodesUsageGauge": promqlbuilder.Max(
-		promqlbuilder.Mul(
-			promqlbuilder.Div(
-				promqlbuilder.TopK(
+				&parser.NumberLiteral{Val: 100},
+			),
+		).Without("instance", "node"),
+		"VolumeInodesUsage1": promqlbuilder.Sum(
+			promqlbuilder.TopK(
+				promqlbuilder.Parenthesis(
 					vector.New(
 						vector.WithMetricName("kubelet_volume_stats_inodes_used"),
 						vector.WithLabelMatchers(
 							label.New("cluster").Equal("$cluster"),
-							label.New("job").Equal("kubelet"),
+							jobLabelValues.KubeletMatcher(),
 							label.New("namespace").Equal("$namespace"),
 							label.New("persistentvolumeclaim").Equal("$volume"),
 						),
 					),
-					1,
 				),
-				promqlbuilder.TopK(
-					vector.New(
-						vector.WithMetricName("kubelet_volume_stats_inodes"),
-						vector.WithLabelMatchers(
-							label.New("cluster").Equal("$cluster"),
-							label.New("job").Equal("kubelet"),
-							label.New("namespace").Equal("$namespace"),
-							label.New("persistentvolumeclaim").Equal("$volume"),
+				1,
+			),
+		).Without("instance", "node"),
+		"VolumeInodesUsage2": promqlbuilder.Parenthesis(
+			promqlbuilder.Sub(
+				promqlbuilder.Sum(
+					promqlbuilder.TopK(
+						promqlbuilder.Parenthesis(
+							vector.New(
+								vector.WithMetricName("kubelet_volume_stats_inodes"),
+								vector.WithLabelMatchers(
+									label.New("cluster").Equal("$cluster"),
+									jobLabelValues.KubeletMatcher(),
+									label.New("namespace").Equal("$namespace"),
+									label.New("persistentvolumeclaim").Equal("$volume"),
+								),
+							),
 						),
+						1,
 					),
-					1,
-				),
+				).Without("instance", "node"),
+				promqlbuilder.Sum(
+					promqlbuilder.TopK(
+						promqlbuilder.Parenthesis(
+							vector.New(
+								vector.WithMetricName("kubelet_volume_stats_inodes_used"),
+								vector.WithLabelMatchers(
+									label.New("cluster").Equal("$cluster"),
+									jobLabelValues.KubeletMatcher(),
+									label.New("namespace").Equal("$namespace"),
+									label.New("persistentvolumeclaim").Equal("$volume"),
+								),
+							),
+						),
+						1,
+					),
+				).Without("instance", "node"),
 			),
-			&parser.NumberLiteral{Val: 100},
 		),
-	).Without("instance", "node"),
-	// pod
-	"PodCPUThrottling": promqlbuilder.Div(
-		promqlbuilder.Sum(
-			promqlbuilder.Increase(
-				matrix.New(
-					vector.New(
-						vector.WithMetricName("container_cpu_cfs_throttled_periods_total"),
-						vector.WithLabelMatchers(
-							label.New("job").Equal("cadvisor"),
-							label.New("namespace").Equal("$namespace"),
-							label.New("pod").Equal("$pod"),
-							label.New("container").NotEqual(""),
-							label.New("cluster").Equal("$cluster"),
+		"VolumeInodesUsageGauge": promqlbuilder.Max(
+			promqlbuilder.Mul(
+				promqlbuilder.Div(
+					promqlbuilder.TopK(
+						vector.New(
+							vector.WithMetricName("kubelet_volume_stats_inodes_used"),
+							vector.WithLabelMatchers(
+								label.New("cluster").Equal("$cluster"),
+								jobLabelValues.KubeletMatcher(),
+								label.New("namespace").Equal("$namespace"),
+								label.New("persistentvolumeclaim").Equal("$volume"),
+							),
 						),
+						1,
+					),
+					promqlbuilder.TopK(
+						vector.New(
+							vector.WithMetricName("kubelet_volume_stats_inodes"),
+							vector.WithLabelMatchers(
+								label.New("cluster").Equal("$cluster"),
+								jobLabelValues.KubeletMatcher(),
+								label.New("namespace").Equal("$namespace"),
+								label.New("persistentvolumeclaim").Equal("$volume"),
+							),
+						),
+						1,
 					),
-					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
+				&parser.NumberLiteral{Val: 100},
 			),
-		).By("container"),
-		promqlbuilder.Sum(
-			promqlbuilder.Increase(
-				matrix.New(
-					vector.New(
-						vector.WithMetricName("container_cpu_cfs_periods_total"),
-						vector.WithLabelMatchers(
-							label.New("job").Equal("cadvisor"),
-							label.New("namespace").Equal("$namespace"),
-							label.New("pod").Equal("$pod"),
-							label.New("container").NotEqual(""),
-							label.New("cluster").Equal("$cluster"),
+		).Without("instance", "node"),
+		// pod
+		"PodCPUThrottling": promqlbuilder.Div(
+			promqlbuilder.Sum(
+				promqlbuilder.Increase(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("container_cpu_cfs_throttled_periods_total"),
+							vector.WithLabelMatchers(
+								jobLabelValues.CAdvisorMatcher(),
+								label.New("namespace").Equal("$namespace"),
+								label.New("pod").Equal("$pod"),
+								label.New("container").NotEqual(""),
+								label.New("cluster").Equal("$cluster"),
+							),
 						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
-					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
-			),
-		).By("container"),
-	),
-	"PodCPUUsageQuota1": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-				label.New("namespace").Equal("$namespace"),
-				label.New("pod").Equal("$pod"),
-				label.New("container").NotEqual(""),
-			),
-		),
-	).By("container"),
-	"PodCPUUsageQuota2": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("cluster:namespace:pod_cpu:active:kube_pod_container_resource_requests"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-				label.New("namespace").Equal("$namespace"),
-				label.New("pod").Equal("$pod"),
-				label.New("container").NotEqual(""),
-			),
+			).By("container"),
+			promqlbuilder.Sum(
+				promqlbuilder.Increase(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("container_cpu_cfs_periods_total"),
+							vector.WithLabelMatchers(
+								jobLabelValues.CAdvisorMatcher(),
+								label.New("namespace").Equal("$namespace"),
+								label.New("pod").Equal("$pod"),
+								label.New("container").NotEqual(""),
+								label.New("cluster").Equal("$cluster"),
+							),
+						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
+					),
+				),
+			).By("container"),
 		),
-	).By("container"),
-	"PodCPUUsageQuota3": promqlbuilder.Div(
-		promqlbuilder.Sum(
+		"PodCPUUsageQuota1": promqlbuilder.Sum(
 			vector.New(
 				vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
 				vector.WithLabelMatchers(
@@ -5085,7 +5064,7 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 				),
 			),
 		).By("container"),
-		promqlbuilder.Sum(
+		"PodCPUUsageQuota2": promqlbuilder.Sum(
 			vector.New(
 				vector.WithMetricName("cluster:namespace:pod_cpu:active:kube_pod_container_resource_requests"),
 				vector.WithLabelMatchers(
@@ -5096,31 +5075,31 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 				),
 			),
 		).By("container"),
-	),
-	"PodCPUUsageQuota4": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("cluster:namespace:pod_cpu:active:kube_pod_container_resource_limits"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-				label.New("namespace").Equal("$namespace"),
-				label.New("pod").Equal("$pod"),
-				label.New("container").NotEqual(""),
-			),
-		),
-	).By("container"),
-	"PodCPUUsageQuota5": promqlbuilder.Div(
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
-				vector.WithLabelMatchers(
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("pod").Equal("$pod"),
-					label.New("container").NotEqual(""),
+		"PodCPUUsageQuota3": promqlbuilder.Div(
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
+						label.New("pod").Equal("$pod"),
+						label.New("container").NotEqual(""),
+					),
 				),
-			),
-		).By("container"),
-		promqlbuilder.Sum(
+			).By("container"),
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("cluster:namespace:pod_cpu:active:kube_pod_container_resource_requests"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
+						label.New("pod").Equal("$pod"),
+						label.New("container").NotEqual(""),
+					),
+				),
+			).By("container"),
+		),
+		"PodCPUUsageQuota4": promqlbuilder.Sum(
 			vector.New(
 				vector.WithMetricName("cluster:namespace:pod_cpu:active:kube_pod_container_resource_limits"),
 				vector.WithLabelMatchers(
@@ -5131,44 +5110,44 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 				),
 			),
 		).By("container"),
-	),
-	"PodMemoryUsageQuota1": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("container_memory_working_set_bytes"),
-			vector.WithLabelMatchers(
-				label.New("job").Equal("cadvisor"),
-				label.New("cluster").Equal("$cluster"),
-				label.New("namespace").Equal("$namespace"),
-				label.New("pod").Equal("$pod"),
-				label.New("container").NotEqual(""),
-				label.New("image").NotEqual(""),
-			),
-		),
-	).By("container"),
-	"PodMemoryUsageQuota2": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("cluster:namespace:pod_memory:active:kube_pod_container_resource_requests"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-				label.New("namespace").Equal("$namespace"),
-				label.New("pod").Equal("$pod"),
-			),
+		"PodCPUUsageQuota5": promqlbuilder.Div(
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
+						label.New("pod").Equal("$pod"),
+						label.New("container").NotEqual(""),
+					),
+				),
+			).By("container"),
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("cluster:namespace:pod_cpu:active:kube_pod_container_resource_limits"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
+						label.New("pod").Equal("$pod"),
+						label.New("container").NotEqual(""),
+					),
+				),
+			).By("container"),
 		),
-	).By("container"),
-	"PodMemoryUsageQuota3": promqlbuilder.Div(
-		promqlbuilder.Sum(
+		"PodMemoryUsageQuota1": promqlbuilder.Sum(
 			vector.New(
 				vector.WithMetricName("container_memory_working_set_bytes"),
 				vector.WithLabelMatchers(
-					label.New("job").Equal("cadvisor"),
+					jobLabelValues.CAdvisorMatcher(),
 					label.New("cluster").Equal("$cluster"),
 					label.New("namespace").Equal("$namespace"),
 					label.New("pod").Equal("$pod"),
+					label.New("container").NotEqual(""),
 					label.New("image").NotEqual(""),
 				),
 			),
 		).By("container"),
-		promqlbuilder.Sum(
+		"PodMemoryUsageQuota2": promqlbuilder.Sum(
 			vector.New(
 				vector.WithMetricName("cluster:namespace:pod_memory:active:kube_pod_container_resource_requests"),
 				vector.WithLabelMatchers(
@@ -5178,32 +5157,31 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 				),
 			),
 		).By("container"),
-	),
-	"PodMemoryUsageQuota4": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("cluster:namespace:pod_memory:active:kube_pod_container_resource_limits"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-				label.New("namespace").Equal("$namespace"),
-				label.New("pod").Equal("$pod"),
-			),
-		),
-	).By("container"),
-	"PodMemoryUsageQuota5": promqlbuilder.Div(
-		promqlbuilder.Sum(
-			vector.New(
-				vector.WithMetricName("container_memory_working_set_bytes"),
-				vector.WithLabelMatchers(
-					label.New("job").Equal("cadvisor"),
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("pod").Equal("$pod"),
-					label.New("container").NotEqual(""),
-					label.New("image").NotEqual(""),
+		"PodMemoryUsageQuota3": promqlbuilder.Div(
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("container_memory_working_set_bytes"),
+					vector.WithLabelMatchers(
+						jobLabelValues.CAdvisorMatcher(),
+						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
+						label.New("pod").Equal("$pod"),
+						label.New("image").NotEqual(""),
+					),
 				),
-			),
-		).By("container"),
-		promqlbuilder.Sum(
+			).By("container"),
+			promqlbuilder.Sum(
+				vector.New(
+					vector.WithMetricName("cluster:namespace:pod_memory:active:kube_pod_container_resource_requests"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
+						label.New("pod").Equal("$pod"),
+					),
+				),
+			).By("container"),
+		),
+		"PodMemoryUsageQuota4": promqlbuilder.Sum(
 			vector.New(
 				vector.WithMetricName("cluster:namespace:pod_memory:active:kube_pod_container_resource_limits"),
 				vector.WithLabelMatchers(
@@ -5213,106 +5191,77 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 				),
 			),
 		).By("container"),
-	),
-	"PodMemoryUsageQuota6": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("container_memory_rss"),
-			vector.WithLabelMatchers(
-				label.New("job").Equal("cadvisor"),
-				label.New("cluster").Equal("$cluster"),
-				label.New("namespace").Equal("$namespace"),
-				label.New("pod").Equal("$pod"),
-				label.New("container").NotEqual(""),
-				label.New("container").NotEqual("POD"),
-			),
-		),
-	).By("container"),
-	"PodMemoryUsageQuota7": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("container_memory_cache"),
-			vector.WithLabelMatchers(
-				label.New("job").Equal("cadvisor"),
-				label.New("cluster").Equal("$cluster"),
-				label.New("namespace").Equal("$namespace"),
-				label.New("pod").Equal("$pod"),
-				label.New("container").NotEqual(""),
-				label.New("container").NotEqual("POD"),
-			),
-		),
-	).By("container"),
-	"PodMemoryUsageQuota8": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("container_memory_swap"),
-			vector.WithLabelMatchers(
-				label.New("job").Equal("cadvisor"),
-				label.New("cluster").Equal("$cluster"),
-				label.New("namespace").Equal("$namespace"),
-				label.New("pod").Equal("$pod"),
-				label.New("container").NotEqual(""),
-				label.New("container").NotEqual("POD"),
-			),
-		),
-	).By("container"),
-	"PodCurrentStorageIO1": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
+		"PodMemoryUsageQuota5": promqlbuilder.Div(
+			promqlbuilder.Sum(
 				vector.New(
-					vector.WithMetricName("container_fs_reads_total"),
+					vector.WithMetricName("container_memory_working_set_bytes"),
 					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
-						label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
-						label.New("container").NotEqual(""),
+						jobLabelValues.CAdvisorMatcher(),
 						label.New("cluster").Equal("$cluster"),
 						label.New("namespace").Equal("$namespace"),
 						label.New("pod").Equal("$pod"),
+						label.New("container").NotEqual(""),
+						label.New("image").NotEqual(""),
 					),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
-		),
-	).By("container"),
-	"PodCurrentStorageIO2": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
+			).By("container"),
+			promqlbuilder.Sum(
 				vector.New(
-					vector.WithMetricName("container_fs_writes_total"),
+					vector.WithMetricName("cluster:namespace:pod_memory:active:kube_pod_container_resource_limits"),
 					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
-						label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
-						label.New("container").NotEqual(""),
 						label.New("cluster").Equal("$cluster"),
 						label.New("namespace").Equal("$namespace"),
 						label.New("pod").Equal("$pod"),
 					),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
+			).By("container"),
 		),
-	).By("container"),
-	"PodCurrentStorageIO3": promqlbuilder.Sum(
-		promqlbuilder.Add(
-			promqlbuilder.Rate(
-				matrix.New(
-					vector.New(
-						vector.WithMetricName("container_fs_reads_total"),
-						vector.WithLabelMatchers(
-							label.New("job").Equal("cadvisor"),
-							label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
-							label.New("container").NotEqual(""),
-							label.New("cluster").Equal("$cluster"),
-							label.New("namespace").Equal("$namespace"),
-							label.New("pod").Equal("$pod"),
-						),
-					),
-					matrix.WithRangeAsVariable("$__rate_interval"),
-				),
-			),
-			promqlbuilder.Rate(
-				matrix.New(
-					vector.New(
-						vector.WithMetricName("container_fs_writes_total"),
+		"PodMemoryUsageQuota6": promqlbuilder.Sum(
+			vector.New(
+				vector.WithMetricName("container_memory_rss"),
+				vector.WithLabelMatchers(
+					jobLabelValues.CAdvisorMatcher(),
+					label.New("cluster").Equal("$cluster"),
+					label.New("namespace").Equal("$namespace"),
+					label.New("pod").Equal("$pod"),
+					label.New("container").NotEqual(""),
+					label.New("container").NotEqual("POD"),
+				),
+			),
+		).By("container"),
+		"PodMemoryUsageQuota7": promqlbuilder.Sum(
+			vector.New(
+				vector.WithMetricName("container_memory_cache"),
+				vector.WithLabelMatchers(
+					jobLabelValues.CAdvisorMatcher(),
+					label.New("cluster").Equal("$cluster"),
+					label.New("namespace").Equal("$namespace"),
+					label.New("pod").Equal("$pod"),
+					label.New("container").NotEqual(""),
+					label.New("container").NotEqual("POD"),
+				),
+			),
+		).By("container"),
+		"PodMemoryUsageQuota8": promqlbuilder.Sum(
+			vector.New(
+				vector.WithMetricName("container_memory_swap"),
+				vector.WithLabelMatchers(
+					jobLabelValues.CAdvisorMatcher(),
+					label.New("cluster").Equal("$cluster"),
+					label.New("namespace").Equal("$namespace"),
+					label.New("pod").Equal("$pod"),
+					label.New("container").NotEqual(""),
+					label.New("container").NotEqual("POD"),
+				),
+			),
+		).By("container"),
+		"PodCurrentStorageIO1": promqlbuilder.Sum(
+			promqlbuilder.Rate(
+				matrix.New(
+					vector.New(
+						vector.WithMetricName("container_fs_reads_total"),
 						vector.WithLabelMatchers(
-							label.New("job").Equal("cadvisor"),
+							jobLabelValues.CAdvisorMatcher(),
 							label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
 							label.New("container").NotEqual(""),
 							label.New("cluster").Equal("$cluster"),
@@ -5323,52 +5272,68 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-		),
-	).By("container"),
-	"PodCurrentStorageIO4": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_fs_reads_bytes_total"),
-					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
-						label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
-						label.New("container").NotEqual(""),
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("pod").Equal("$pod"),
+		).By("container"),
+		"PodCurrentStorageIO2": promqlbuilder.Sum(
+			promqlbuilder.Rate(
+				matrix.New(
+					vector.New(
+						vector.WithMetricName("container_fs_writes_total"),
+						vector.WithLabelMatchers(
+							jobLabelValues.CAdvisorMatcher(),
+							label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
+							label.New("container").NotEqual(""),
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("pod").Equal("$pod"),
+						),
 					),
+					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
 			),
-		),
-	).By("container"),
-	"PodCurrentStorageIO5": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_fs_writes_bytes_total"),
-					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
-						label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
-						label.New("container").NotEqual(""),
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("pod").Equal("$pod"),
+		).By("container"),
+		"PodCurrentStorageIO3": promqlbuilder.Sum(
+			promqlbuilder.Add(
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("container_fs_reads_total"),
+							vector.WithLabelMatchers(
+								jobLabelValues.CAdvisorMatcher(),
+								label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
+								label.New("container").NotEqual(""),
+								label.New("cluster").Equal("$cluster"),
+								label.New("namespace").Equal("$namespace"),
+								label.New("pod").Equal("$pod"),
+							),
+						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
+					),
+				),
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("container_fs_writes_total"),
+							vector.WithLabelMatchers(
+								jobLabelValues.CAdvisorMatcher(),
+								label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
+								label.New("container").NotEqual(""),
+								label.New("cluster").Equal("$cluster"),
+								label.New("namespace").Equal("$namespace"),
+								label.New("pod").Equal("$pod"),
+							),
+						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
 			),
-		),
-	).By("container"),
-	"PodCurrentStorageIO6": promqlbuilder.Sum(
-		promqlbuilder.Add(
+		).By("container"),
+		"PodCurrentStorageIO4": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
 						vector.WithMetricName("container_fs_reads_bytes_total"),
 						vector.WithLabelMatchers(
-							label.New("job").Equal("cadvisor"),
+							jobLabelValues.CAdvisorMatcher(),
 							label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
 							label.New("container").NotEqual(""),
 							label.New("cluster").Equal("$cluster"),
@@ -5379,12 +5344,14 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
+		).By("container"),
+		"PodCurrentStorageIO5": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
 						vector.WithMetricName("container_fs_writes_bytes_total"),
 						vector.WithLabelMatchers(
-							label.New("job").Equal("cadvisor"),
+							jobLabelValues.CAdvisorMatcher(),
 							label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
 							label.New("container").NotEqual(""),
 							label.New("cluster").Equal("$cluster"),
@@ -5395,853 +5362,447 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-		),
-	).By("container"),
-	// proxy
-	"ProxyUpStatus": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("up"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-				label.New("job").Equal("kube-proxy"),
-			),
-		),
-	),
-	"RulesSyncRate": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("kubeproxy_sync_proxy_rules_duration_seconds_count"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("job").Equal("kube-proxy"),
-						label.New("instance").EqualRegexp("$instance"),
+		).By("container"),
+		"PodCurrentStorageIO6": promqlbuilder.Sum(
+			promqlbuilder.Add(
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("container_fs_reads_bytes_total"),
+							vector.WithLabelMatchers(
+								jobLabelValues.CAdvisorMatcher(),
+								label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
+								label.New("container").NotEqual(""),
+								label.New("cluster").Equal("$cluster"),
+								label.New("namespace").Equal("$namespace"),
+								label.New("pod").Equal("$pod"),
+							),
+						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
-		),
-	),
-	"RulesSyncLatency": promqlbuilder.HistogramQuantile(
-		0.99,
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("kubeproxy_sync_proxy_rules_duration_seconds_bucket"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("job").Equal("kube-proxy"),
-						label.New("instance").EqualRegexp("$instance"),
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("container_fs_writes_bytes_total"),
+							vector.WithLabelMatchers(
+								jobLabelValues.CAdvisorMatcher(),
+								label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
+								label.New("container").NotEqual(""),
+								label.New("cluster").Equal("$cluster"),
+								label.New("namespace").Equal("$namespace"),
+								label.New("pod").Equal("$pod"),
+							),
+						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
 			),
-		),
-	),
-	"NetworkProgrammingRate": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("kubeproxy_network_programming_duration_seconds_count"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("job").Equal("kube-proxy"),
-						label.New("instance").EqualRegexp("$instance"),
-					),
+		).By("container"),
+		// proxy
+		"ProxyUpStatus": promqlbuilder.Sum(
+			vector.New(
+				vector.WithMetricName("up"),
+				vector.WithLabelMatchers(
+					label.New("cluster").Equal("$cluster"),
+					jobLabelValues.KubeProxyMatcher(),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
 			),
 		),
-	),
-	"NetworkProgrammingLatency": promqlbuilder.HistogramQuantile(
-		0.99,
-		promqlbuilder.Sum(
+		"RulesSyncRate": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("kubeproxy_network_programming_duration_seconds_bucket"),
+						vector.WithMetricName("kubeproxy_sync_proxy_rules_duration_seconds_count"),
 						vector.WithLabelMatchers(
 							label.New("cluster").Equal("$cluster"),
-							label.New("job").Equal("kube-proxy"),
+							jobLabelValues.KubeProxyMatcher(),
 							label.New("instance").EqualRegexp("$instance"),
 						),
 					),
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-		).By("instance", "le"),
-	),
-	// scheduler
-	"SchedulerUpStatus": promqlbuilder.Sum(
-		vector.New(
-			vector.WithMetricName("up"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-				label.New("job").Equal("kube-scheduler"),
-			),
 		),
-	),
-	"SchedulingRate1": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("scheduler_e2e_scheduling_duration_seconds_count"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("job").Equal("kube-scheduler"),
-						label.New("instance").EqualRegexp("$instance"),
+		"RulesSyncLatency": promqlbuilder.HistogramQuantile(
+			0.99,
+			promqlbuilder.Rate(
+				matrix.New(
+					vector.New(
+						vector.WithMetricName("kubeproxy_sync_proxy_rules_duration_seconds_bucket"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							jobLabelValues.KubeProxyMatcher(),
+							label.New("instance").EqualRegexp("$instance"),
+						),
 					),
+					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
 			),
 		),
-	).By("cluster", "instance"),
-	"SchedulingRate2": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("scheduler_binding_duration_seconds_count"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("job").Equal("kube-scheduler"),
-						label.New("instance").EqualRegexp("$instance"),
+		"NetworkProgrammingRate": promqlbuilder.Sum(
+			promqlbuilder.Rate(
+				matrix.New(
+					vector.New(
+						vector.WithMetricName("kubeproxy_network_programming_duration_seconds_count"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							jobLabelValues.KubeProxyMatcher(),
+							label.New("instance").EqualRegexp("$instance"),
+						),
 					),
+					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
 			),
 		),
-	).By("cluster", "instance"),
-	"SchedulingRate3": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("scheduler_scheduling_algorithm_duration_seconds_count"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("job").Equal("kube-scheduler"),
-						label.New("instance").EqualRegexp("$instance"),
+		"NetworkProgrammingLatency": promqlbuilder.HistogramQuantile(
+			0.99,
+			promqlbuilder.Sum(
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("kubeproxy_network_programming_duration_seconds_bucket"),
+							vector.WithLabelMatchers(
+								label.New("cluster").Equal("$cluster"),
+								jobLabelValues.KubeProxyMatcher(),
+								label.New("instance").EqualRegexp("$instance"),
+							),
+						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
+			).By("instance", "le"),
 		),
-	).By("cluster", "instance"),
-	"SchedulingRate4": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("scheduler_volume_scheduling_duration_seconds_count"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("job").Equal("kube-scheduler"),
-						label.New("instance").EqualRegexp("$instance"),
-					),
+		// scheduler
+		"SchedulerUpStatus": promqlbuilder.Sum(
+			vector.New(
+				vector.WithMetricName("up"),
+				vector.WithLabelMatchers(
+					label.New("cluster").Equal("$cluster"),
+					jobLabelValues.SchedulerMatcher(),
 				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
 			),
 		),
-	).By("cluster", "instance"),
-	"SchedulingLatency1": promqlbuilder.HistogramQuantile(
-		0.99,
-		promqlbuilder.Sum(
+		"SchedulingRate1": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("scheduler_e2e_scheduling_duration_seconds_bucket"),
+						vector.WithMetricName("scheduler_e2e_scheduling_duration_seconds_count"),
 						vector.WithLabelMatchers(
 							label.New("cluster").Equal("$cluster"),
-							label.New("job").Equal("kube-scheduler"),
+							jobLabelValues.SchedulerMatcher(),
 							label.New("instance").EqualRegexp("$instance"),
 						),
 					),
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-		).By("cluster", "instance", "le"),
-	),
-	"SchedulingLatency2": promqlbuilder.HistogramQuantile(
-		0.99,
-		promqlbuilder.Sum(
+		).By("cluster", "instance"),
+		"SchedulingRate2": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("scheduler_binding_duration_seconds_bucket"),
+						vector.WithMetricName("scheduler_binding_duration_seconds_count"),
 						vector.WithLabelMatchers(
 							label.New("cluster").Equal("$cluster"),
-							label.New("job").Equal("kube-scheduler"),
+							jobLabelValues.SchedulerMatcher(),
 							label.New("instance").EqualRegexp("$instance"),
 						),
 					),
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-		).By("cluster", "instance", "le"),
-	),
-	"SchedulingLatency3": promqlbuilder.HistogramQuantile(
-		0.99,
-		promqlbuilder.Sum(
+		).By("cluster", "instance"),
+		"SchedulingRate3": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("scheduler_scheduling_algorithm_duration_seconds_bucket"),
+						vector.WithMetricName("scheduler_scheduling_algorithm_duration_seconds_count"),
 						vector.WithLabelMatchers(
 							label.New("cluster").Equal("$cluster"),
-							label.New("job").Equal("kube-scheduler"),
+							jobLabelValues.SchedulerMatcher(),
 							label.New("instance").EqualRegexp("$instance"),
 						),
 					),
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-		).By("cluster", "instance", "le"),
-	),
-	"SchedulingLatency4": promqlbuilder.HistogramQuantile(
-		0.99,
-		promqlbuilder.Sum(
+		).By("cluster", "instance"),
+		"SchedulingRate4": promqlbuilder.Sum(
 			promqlbuilder.Rate(
 				matrix.New(
 					vector.New(
-						vector.WithMetricName("scheduler_volume_scheduling_duration_seconds_bucket"),
+						vector.WithMetricName("scheduler_volume_scheduling_duration_seconds_count"),
 						vector.WithLabelMatchers(
 							label.New("cluster").Equal("$cluster"),
-							label.New("job").Equal("kube-scheduler"),
+							jobLabelValues.SchedulerMatcher(),
 							label.New("instance").EqualRegexp("$instance"),
 						),
 					),
 					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
 			),
-		).By("cluster", "instance", "le"),
-	),
-	// storage
-	"KubernetesIOPS1": promqlbuilder.Ceil(
-		promqlbuilder.Sum(
-			promqlbuilder.Add(
+		).By("cluster", "instance"),
+		"SchedulingLatency1": promqlbuilder.HistogramQuantile(
+			0.99,
+			promqlbuilder.Sum(
 				promqlbuilder.Rate(
 					matrix.New(
 						vector.New(
-							vector.WithMetricName("container_fs_reads_total"),
+							vector.WithMetricName("scheduler_e2e_scheduling_duration_seconds_bucket"),
 							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
-								label.New("container").NotEqual(""),
-								label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
 								label.New("cluster").Equal("$cluster"),
-								label.New("namespace").NotEqual(""),
+								jobLabelValues.SchedulerMatcher(),
+								label.New("instance").EqualRegexp("$instance"),
 							),
 						),
 						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
+			).By("cluster", "instance", "le"),
+		),
+		"SchedulingLatency2": promqlbuilder.HistogramQuantile(
+			0.99,
+			promqlbuilder.Sum(
 				promqlbuilder.Rate(
 					matrix.New(
 						vector.New(
-							vector.WithMetricName("container_fs_writes_total"),
+							vector.WithMetricName("scheduler_binding_duration_seconds_bucket"),
 							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
-								label.New("container").NotEqual(""),
 								label.New("cluster").Equal("$cluster"),
-								label.New("namespace").NotEqual(""),
+								jobLabelValues.SchedulerMatcher(),
+								label.New("instance").EqualRegexp("$instance"),
 							),
 						),
 						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-			),
-		).By("namespace"),
-	),
-	"KubernetesIOPS2": promqlbuilder.Ceil(
-		promqlbuilder.Sum(
-			promqlbuilder.Add(
+			).By("cluster", "instance", "le"),
+		),
+		"SchedulingLatency3": promqlbuilder.HistogramQuantile(
+			0.99,
+			promqlbuilder.Sum(
 				promqlbuilder.Rate(
 					matrix.New(
 						vector.New(
-							vector.WithMetricName("container_fs_reads_total"),
+							vector.WithMetricName("scheduler_scheduling_algorithm_duration_seconds_bucket"),
 							vector.WithLabelMatchers(
-								label.New("container").NotEqual(""),
-								label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
 								label.New("cluster").Equal("$cluster"),
-								label.New("namespace").Equal("$namespace"),
+								jobLabelValues.SchedulerMatcher(),
+								label.New("instance").EqualRegexp("$instance"),
 							),
 						),
 						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
+			).By("cluster", "instance", "le"),
+		),
+		"SchedulingLatency4": promqlbuilder.HistogramQuantile(
+			0.99,
+			promqlbuilder.Sum(
 				promqlbuilder.Rate(
 					matrix.New(
 						vector.New(
-							vector.WithMetricName("container_fs_writes_total"),
+							vector.WithMetricName("scheduler_volume_scheduling_duration_seconds_bucket"),
 							vector.WithLabelMatchers(
-								label.New("container").NotEqual(""),
-								label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
 								label.New("cluster").Equal("$cluster"),
-								label.New("namespace").Equal("$namespace"),
+								jobLabelValues.SchedulerMatcher(),
+								label.New("instance").EqualRegexp("$instance"),
 							),
 						),
 						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-			),
-		).By("pod"),
-	),
-	"KubernetesIOPS3": promqlbuilder.Ceil(
-		promqlbuilder.Sum(
-			promqlbuilder.Rate(
-				matrix.New(
-					vector.New(
-						vector.WithMetricName("container_fs_writes_total"),
-						vector.WithLabelMatchers(
-							label.New("job").Equal("cadvisor"),
-							label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
-							label.New("container").NotEqual(""),
-							label.New("cluster").Equal("$cluster"),
-							label.New("namespace").Equal("$namespace"),
-							label.New("pod").EqualRegexp("$pod"),
+			).By("cluster", "instance", "le"),
+		),
+		// storage
+		"KubernetesIOPS1": promqlbuilder.Ceil(
+			promqlbuilder.Sum(
+				promqlbuilder.Add(
+					promqlbuilder.Rate(
+						matrix.New(
+							vector.New(
+								vector.WithMetricName("container_fs_reads_total"),
+								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
+									label.New("container").NotEqual(""),
+									label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
+									label.New("cluster").Equal("$cluster"),
+									label.New("namespace").NotEqual(""),
+								),
+							),
+							matrix.WithRangeAsVariable("$__rate_interval"),
 						),
 					),
-					matrix.WithRangeAsVariable("$__rate_interval"),
-				),
-			),
-		).By("pod"),
-	),
-	"KubernetesIOPS4": promqlbuilder.Ceil(
-		promqlbuilder.Sum(
-			promqlbuilder.Rate(
-				matrix.New(
-					vector.New(
-						vector.WithMetricName("container_fs_reads_total"),
-						vector.WithLabelMatchers(
-							label.New("job").Equal("cadvisor"),
-							label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
-							label.New("container").NotEqual(""),
-							label.New("cluster").Equal("$cluster"),
-							label.New("namespace").Equal("$namespace"),
-							label.New("pod").EqualRegexp("$pod"),
+					promqlbuilder.Rate(
+						matrix.New(
+							vector.New(
+								vector.WithMetricName("container_fs_writes_total"),
+								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
+									label.New("container").NotEqual(""),
+									label.New("cluster").Equal("$cluster"),
+									label.New("namespace").NotEqual(""),
+								),
+							),
+							matrix.WithRangeAsVariable("$__rate_interval"),
 						),
 					),
-					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
-			),
-		).By("pod"),
-	),
-	"KubernetesIOPS5": promqlbuilder.Ceil(
-		promqlbuilder.Sum(
-			promqlbuilder.Add(
-				promqlbuilder.Rate(
-					matrix.New(
-						vector.New(
-							vector.WithMetricName("container_fs_reads_total"),
-							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
-								label.New("container").NotEqual(""),
-								label.New("cluster").Equal("$cluster"),
-								label.New("namespace").Equal("$namespace"),
-								label.New("pod").Equal("$pod"),
+			).By("namespace"),
+		),
+		"KubernetesIOPS2": promqlbuilder.Ceil(
+			promqlbuilder.Sum(
+				promqlbuilder.Add(
+					promqlbuilder.Rate(
+						matrix.New(
+							vector.New(
+								vector.WithMetricName("container_fs_reads_total"),
+								vector.WithLabelMatchers(
+									label.New("container").NotEqual(""),
+									label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
+									label.New("cluster").Equal("$cluster"),
+									label.New("namespace").Equal("$namespace"),
+								),
 							),
+							matrix.WithRangeAsVariable("$__rate_interval"),
+						),
+					),
+					promqlbuilder.Rate(
+						matrix.New(
+							vector.New(
+								vector.WithMetricName("container_fs_writes_total"),
+								vector.WithLabelMatchers(
+									label.New("container").NotEqual(""),
+									label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
+									label.New("cluster").Equal("$cluster"),
+									label.New("namespace").Equal("$namespace"),
+								),
+							),
+							matrix.WithRangeAsVariable("$__rate_interval"),
 						),
-						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
+			).By("pod"),
+		),
+		"KubernetesIOPS3": promqlbuilder.Ceil(
+			promqlbuilder.Sum(
 				promqlbuilder.Rate(
 					matrix.New(
 						vector.New(
 							vector.WithMetricName("container_fs_writes_total"),
 							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
+								jobLabelValues.CAdvisorMatcher(),
+								label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
 								label.New("container").NotEqual(""),
 								label.New("cluster").Equal("$cluster"),
 								label.New("namespace").Equal("$namespace"),
-								label.New("pod").Equal("$pod"),
+								label.New("pod").EqualRegexp("$pod"),
 							),
 						),
 						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-			),
-		).By("container"),
-	),
-	"KubernetesThroughput1": promqlbuilder.Sum(
-		promqlbuilder.Add(
-			promqlbuilder.Rate(
-				matrix.New(
-					vector.New(
-						vector.WithMetricName("container_fs_reads_bytes_total"),
-						vector.WithLabelMatchers(
-							label.New("job").Equal("cadvisor"),
-							label.New("container").NotEqual(""),
-							label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
-							label.New("cluster").Equal("$cluster"),
-							label.New("namespace").NotEqual(""),
-						),
-					),
-					matrix.WithRangeAsVariable("$__rate_interval"),
-				),
-			),
-			promqlbuilder.Rate(
-				matrix.New(
-					vector.New(
-						vector.WithMetricName("container_fs_writes_bytes_total"),
-						vector.WithLabelMatchers(
-							label.New("job").Equal("cadvisor"),
-							label.New("container").NotEqual(""),
-							label.New("cluster").Equal("$cluster"),
-							label.New("namespace").NotEqual(""),
-						),
-					),
-					matrix.WithRangeAsVariable("$__rate_interval"),
-				),
-			),
-		),
-	).By("namespace"),
-	"KubernetesThroughput2": promqlbuilder.Sum(
-		promqlbuilder.Add(
-			promqlbuilder.Rate(
-				matrix.New(
-					vector.New(
-						vector.WithMetricName("container_fs_reads_bytes_total"),
-						vector.WithLabelMatchers(
-							label.New("container").NotEqual(""),
-							label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
-							label.New("cluster").Equal("$cluster"),
-							label.New("namespace").Equal("$namespace"),
-						),
-					),
-					matrix.WithRangeAsVariable("$__rate_interval"),
-				),
-			),
-			promqlbuilder.Rate(
-				matrix.New(
-					vector.New(
-						vector.WithMetricName("container_fs_writes_bytes_total"),
-						vector.WithLabelMatchers(
-							label.New("container").NotEqual(""),
-							label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
-							label.New("cluster").Equal("$cluster"),
-							label.New("namespace").Equal("$namespace"),
-						),
-					),
-					matrix.WithRangeAsVariable("$__rate_interval"),
-				),
-			),
-		),
-	).By("pod"),
-	"KubernetesThroughput3": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_fs_writes_bytes_total"),
-					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
-						label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
-						label.New("container").NotEqual(""),
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("pod").EqualRegexp("$pod"),
-					),
-				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
-		),
-	).By("pod"),
-	"KubernetesThroughput4": promqlbuilder.Sum(
-		promqlbuilder.Rate(
-			matrix.New(
-				vector.New(
-					vector.WithMetricName("container_fs_reads_bytes_total"),
-					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
-						label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
-						label.New("container").NotEqual(""),
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("pod").EqualRegexp("$pod"),
-					),
-				),
-				matrix.WithRangeAsVariable("$__rate_interval"),
-			),
-		),
-	).By("pod"),
-	"KubernetesThroughput5": promqlbuilder.Sum(
-		promqlbuilder.Add(
-			promqlbuilder.Rate(
-				matrix.New(
-					vector.New(
-						vector.WithMetricName("container_fs_reads_bytes_total"),
-						vector.WithLabelMatchers(
-							label.New("job").Equal("cadvisor"),
-							label.New("container").NotEqual(""),
-							label.New("cluster").Equal("$cluster"),
-							label.New("namespace").Equal("$namespace"),
-							label.New("pod").Equal("$pod"),
-						),
-					),
-					matrix.WithRangeAsVariable("$__rate_interval"),
-				),
-			),
-			promqlbuilder.Rate(
-				matrix.New(
-					vector.New(
-						vector.WithMetricName("container_fs_writes_bytes_total"),
-						vector.WithLabelMatchers(
-							label.New("job").Equal("cadvisor"),
-							label.New("container").NotEqual(""),
-							label.New("cluster").Equal("$cluster"),
-							label.New("namespace").Equal("$namespace"),
-							label.New("pod").Equal("$pod"),
-						),
-					),
-					matrix.WithRangeAsVariable("$__rate_interval"),
-				),
-			),
+			).By("pod"),
 		),
-	).By("container"),
-	// workload
-	"WorkloadCPUUsageQuota1": promqlbuilder.Sum(
-		promqlbuilder.Mul(
-			vector.New(
-				vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
-				vector.WithLabelMatchers(
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-				),
-			),
-			vector.New(
-				vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-				vector.WithLabelMatchers(
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("workload").Equal("$workload"),
-					label.New("workload_type").EqualRegexp("$type"),
-				),
-			),
-		).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-	).By("pod"),
-	"WorkloadCPUUsageQuota2": promqlbuilder.Sum(
-		promqlbuilder.Mul(
-			vector.New(
-				vector.WithMetricName("kube_pod_container_resource_requests"),
-				vector.WithLabelMatchers(
-					label.New("job").Equal("kube-state-metrics"),
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("resource").Equal("cpu"),
-				),
-			),
-			vector.New(
-				vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-				vector.WithLabelMatchers(
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("workload").Equal("$workload"),
-					label.New("workload_type").EqualRegexp("$type"),
-				),
-			),
-		).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-	).By("pod"),
-	"WorkloadCPUUsageQuota3": promqlbuilder.Div(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
-				vector.New(
-					vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-					),
-				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").Equal("$workload"),
-						label.New("workload_type").EqualRegexp("$type"),
-					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("pod"),
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
-				vector.New(
-					vector.WithMetricName("kube_pod_container_resource_requests"),
-					vector.WithLabelMatchers(
-						label.New("job").Equal("kube-state-metrics"),
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("resource").Equal("cpu"),
-					),
-				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").Equal("$workload"),
-						label.New("workload_type").EqualRegexp("$type"),
-					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("pod"),
-	),
-	"WorkloadCPUUsageQuota4": promqlbuilder.Sum(
-		promqlbuilder.Mul(
-			vector.New(
-				vector.WithMetricName("kube_pod_container_resource_limits"),
-				vector.WithLabelMatchers(
-					label.New("job").Equal("kube-state-metrics"),
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("resource").Equal("cpu"),
-				),
-			),
-			vector.New(
-				vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-				vector.WithLabelMatchers(
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("workload").Equal("$workload"),
-					label.New("workload_type").EqualRegexp("$type"),
-				),
-			),
-		).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-	).By("pod"),
-	"WorkloadCPUUsageQuota5": promqlbuilder.Div(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
-				vector.New(
-					vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-					),
-				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").Equal("$workload"),
-						label.New("workload_type").EqualRegexp("$type"),
-					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("pod"),
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
-				vector.New(
-					vector.WithMetricName("kube_pod_container_resource_limits"),
-					vector.WithLabelMatchers(
-						label.New("job").Equal("kube-state-metrics"),
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("resource").Equal("cpu"),
-					),
-				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").Equal("$workload"),
-						label.New("workload_type").EqualRegexp("$type"),
-					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("pod"),
-	),
-	"WorkloadMemoryUsageQuota1": promqlbuilder.Sum(
-		promqlbuilder.Mul(
-			vector.New(
-				vector.WithMetricName("container_memory_working_set_bytes"),
-				vector.WithLabelMatchers(
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("container").NotEqual(""),
-					label.New("image").NotEqual(""),
-				),
-			),
-			vector.New(
-				vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-				vector.WithLabelMatchers(
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("workload").Equal("$workload"),
-					label.New("workload_type").EqualRegexp("$type"),
-				),
-			),
-		).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-	).By("pod"),
-	"WorkloadMemoryUsageQuota2": promqlbuilder.Sum(
-		promqlbuilder.Mul(
-			vector.New(
-				vector.WithMetricName("kube_pod_container_resource_requests"),
-				vector.WithLabelMatchers(
-					label.New("job").Equal("kube-state-metrics"),
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("resource").Equal("memory"),
-				),
-			),
-			vector.New(
-				vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-				vector.WithLabelMatchers(
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("workload").Equal("$workload"),
-					label.New("workload_type").EqualRegexp("$type"),
-				),
-			),
-		).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-	).By("pod"),
-	"WorkloadMemoryUsageQuota3": promqlbuilder.Div(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
-				vector.New(
-					vector.WithMetricName("container_memory_working_set_bytes"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("container").NotEqual(""),
-						label.New("image").NotEqual(""),
-					),
-				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").Equal("$workload"),
-						label.New("workload_type").EqualRegexp("$type"),
-					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("pod"),
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
-				vector.New(
-					vector.WithMetricName("kube_pod_container_resource_requests"),
-					vector.WithLabelMatchers(
-						label.New("job").Equal("kube-state-metrics"),
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("resource").Equal("memory"),
-					),
-				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").Equal("$workload"),
-						label.New("workload_type").EqualRegexp("$type"),
-					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("pod"),
-	),
-	"WorkloadMemoryUsageQuota4": promqlbuilder.Sum(
-		promqlbuilder.Mul(
-			vector.New(
-				vector.WithMetricName("kube_pod_container_resource_limits"),
-				vector.WithLabelMatchers(
-					label.New("job").Equal("kube-state-metrics"),
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("resource").Equal("memory"),
-				),
-			),
-			vector.New(
-				vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-				vector.WithLabelMatchers(
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("workload").Equal("$workload"),
-					label.New("workload_type").EqualRegexp("$type"),
-				),
-			),
-		).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-	).By("pod"),
-	"WorkloadMemoryUsageQuota5": promqlbuilder.Div(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
-				vector.New(
-					vector.WithMetricName("container_memory_working_set_bytes"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("container").NotEqual(""),
-						label.New("image").NotEqual(""),
-					),
-				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").Equal("$workload"),
-						label.New("workload_type").EqualRegexp("$type"),
+		"KubernetesIOPS4": promqlbuilder.Ceil(
+			promqlbuilder.Sum(
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("container_fs_reads_total"),
+							vector.WithLabelMatchers(
+								jobLabelValues.CAdvisorMatcher(),
+								label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
+								label.New("container").NotEqual(""),
+								label.New("cluster").Equal("$cluster"),
+								label.New("namespace").Equal("$namespace"),
+								label.New("pod").EqualRegexp("$pod"),
+							),
+						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("pod"),
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
-				vector.New(
-					vector.WithMetricName("kube_pod_container_resource_limits"),
-					vector.WithLabelMatchers(
-						label.New("job").Equal("kube-state-metrics"),
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("resource").Equal("memory"),
+			).By("pod"),
+		),
+		"KubernetesIOPS5": promqlbuilder.Ceil(
+			promqlbuilder.Sum(
+				promqlbuilder.Add(
+					promqlbuilder.Rate(
+						matrix.New(
+							vector.New(
+								vector.WithMetricName("container_fs_reads_total"),
+								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
+									label.New("container").NotEqual(""),
+									label.New("cluster").Equal("$cluster"),
+									label.New("namespace").Equal("$namespace"),
+									label.New("pod").Equal("$pod"),
+								),
+							),
+							matrix.WithRangeAsVariable("$__rate_interval"),
+						),
 					),
-				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").Equal("$workload"),
-						label.New("workload_type").EqualRegexp("$type"),
+					promqlbuilder.Rate(
+						matrix.New(
+							vector.New(
+								vector.WithMetricName("container_fs_writes_total"),
+								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
+									label.New("container").NotEqual(""),
+									label.New("cluster").Equal("$cluster"),
+									label.New("namespace").Equal("$namespace"),
+									label.New("pod").Equal("$pod"),
+								),
+							),
+							matrix.WithRangeAsVariable("$__rate_interval"),
+						),
 					),
 				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("pod"),
-	),
-	"WorkloadCurrentNetworkUsage1": promqlbuilder.Parenthesis(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
+			).By("container"),
+		),
+		"KubernetesThroughput1": promqlbuilder.Sum(
+			promqlbuilder.Add(
 				promqlbuilder.Rate(
 					matrix.New(
 						vector.New(
-							vector.WithMetricName("container_network_receive_bytes_total"),
+							vector.WithMetricName("container_fs_reads_bytes_total"),
 							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
+								jobLabelValues.CAdvisorMatcher(),
+								label.New("container").NotEqual(""),
+								label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
 								label.New("cluster").Equal("$cluster"),
-								label.New("namespace").Equal("$namespace"),
+								label.New("namespace").NotEqual(""),
 							),
 						),
 						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp("$workload"),
-						label.New("workload_type").EqualRegexp("$type"),
+				promqlbuilder.Rate(
+					matrix.New(
+						vector.New(
+							vector.WithMetricName("container_fs_writes_bytes_total"),
+							vector.WithLabelMatchers(
+								jobLabelValues.CAdvisorMatcher(),
+								label.New("container").NotEqual(""),
+								label.New("cluster").Equal("$cluster"),
+								label.New("namespace").NotEqual(""),
+							),
+						),
+						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("pod"),
-	),
-	"WorkloadCurrentNetworkUsage2": promqlbuilder.Parenthesis(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
+			),
+		).By("namespace"),
+		"KubernetesThroughput2": promqlbuilder.Sum(
+			promqlbuilder.Add(
 				promqlbuilder.Rate(
 					matrix.New(
 						vector.New(
-							vector.WithMetricName("container_network_transmit_bytes_total"),
+							vector.WithMetricName("container_fs_reads_bytes_total"),
 							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
+								label.New("container").NotEqual(""),
+								label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
 								label.New("cluster").Equal("$cluster"),
 								label.New("namespace").Equal("$namespace"),
 							),
@@ -6249,27 +5810,13 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp("$workload"),
-						label.New("workload_type").EqualRegexp("$type"),
-					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("pod"),
-	),
-	"WorkloadCurrentNetworkUsage3": promqlbuilder.Parenthesis(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
 				promqlbuilder.Rate(
 					matrix.New(
 						vector.New(
-							vector.WithMetricName("container_network_receive_packets_total"),
+							vector.WithMetricName("container_fs_writes_bytes_total"),
 							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
+								label.New("container").NotEqual(""),
+								label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
 								label.New("cluster").Equal("$cluster"),
 								label.New("namespace").Equal("$namespace"),
 							),
@@ -6277,88 +5824,108 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp("$workload"),
-						label.New("workload_type").EqualRegexp("$type"),
+			),
+		).By("pod"),
+		"KubernetesThroughput3": promqlbuilder.Sum(
+			promqlbuilder.Rate(
+				matrix.New(
+					vector.New(
+						vector.WithMetricName("container_fs_writes_bytes_total"),
+						vector.WithLabelMatchers(
+							jobLabelValues.CAdvisorMatcher(),
+							label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
+							label.New("container").NotEqual(""),
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("pod").EqualRegexp("$pod"),
+						),
 					),
+					matrix.WithRangeAsVariable("$__rate_interval"),
 				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			),
 		).By("pod"),
-	),
-	"WorkloadCurrentNetworkUsage4": promqlbuilder.Parenthesis(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
+		"KubernetesThroughput4": promqlbuilder.Sum(
+			promqlbuilder.Rate(
+				matrix.New(
+					vector.New(
+						vector.WithMetricName("container_fs_reads_bytes_total"),
+						vector.WithLabelMatchers(
+							jobLabelValues.CAdvisorMatcher(),
+							label.New("device").EqualRegexp("(/dev.+)|mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|dasd.+"),
+							label.New("container").NotEqual(""),
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("pod").EqualRegexp("$pod"),
+						),
+					),
+					matrix.WithRangeAsVariable("$__rate_interval"),
+				),
+			),
+		).By("pod"),
+		"KubernetesThroughput5": promqlbuilder.Sum(
+			promqlbuilder.Add(
 				promqlbuilder.Rate(
 					matrix.New(
 						vector.New(
-							vector.WithMetricName("container_network_transmit_packets_total"),
+							vector.WithMetricName("container_fs_reads_bytes_total"),
 							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
+								jobLabelValues.CAdvisorMatcher(),
+								label.New("container").NotEqual(""),
 								label.New("cluster").Equal("$cluster"),
 								label.New("namespace").Equal("$namespace"),
+								label.New("pod").Equal("$pod"),
 							),
 						),
 						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp("$workload"),
-						label.New("workload_type").EqualRegexp("$type"),
-					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("pod"),
-	),
-	"WorkloadCurrentNetworkUsage5": promqlbuilder.Parenthesis(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
 				promqlbuilder.Rate(
 					matrix.New(
 						vector.New(
-							vector.WithMetricName("container_network_receive_packets_dropped_total"),
+							vector.WithMetricName("container_fs_writes_bytes_total"),
 							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
+								jobLabelValues.CAdvisorMatcher(),
+								label.New("container").NotEqual(""),
 								label.New("cluster").Equal("$cluster"),
 								label.New("namespace").Equal("$namespace"),
+								label.New("pod").Equal("$pod"),
 							),
 						),
 						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
 				),
+			),
+		).By("container"),
+		// workload
+		"WorkloadCPUUsageQuota1": promqlbuilder.Sum(
+			promqlbuilder.Mul(
+				vector.New(
+					vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
+					),
+				),
 				vector.New(
 					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
 					vector.WithLabelMatchers(
 						label.New("cluster").Equal("$cluster"),
 						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp("$workload"),
+						label.New("workload").Equal("$workload"),
 						label.New("workload_type").EqualRegexp("$type"),
 					),
 				),
 			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
 		).By("pod"),
-	),
-	"WorkloadCurrentNetworkUsage6": promqlbuilder.Parenthesis(
-		promqlbuilder.Sum(
+		"WorkloadCPUUsageQuota2": promqlbuilder.Sum(
 			promqlbuilder.Mul(
-				promqlbuilder.Rate(
-					matrix.New(
-						vector.New(
-							vector.WithMetricName("container_network_transmit_packets_dropped_total"),
-							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
-								label.New("cluster").Equal("$cluster"),
-								label.New("namespace").Equal("$namespace"),
-							),
-						),
-						matrix.WithRangeAsVariable("$__rate_interval"),
+				vector.New(
+					vector.WithMetricName("kube_pod_container_resource_requests"),
+					vector.WithLabelMatchers(
+						jobLabelValues.KubeStateMetricsMatcher(),
+						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
+						label.New("resource").Equal("cpu"),
 					),
 				),
 				vector.New(
@@ -6366,72 +5933,131 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 					vector.WithLabelMatchers(
 						label.New("cluster").Equal("$cluster"),
 						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp("$workload"),
+						label.New("workload").Equal("$workload"),
 						label.New("workload_type").EqualRegexp("$type"),
 					),
 				),
 			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
 		).By("pod"),
-	),
-	// workload_namespace
-	"WorkloadNamespaceCPUUsageQuota1": promqlbuilder.Count(
-		vector.New(
-			vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-				label.New("namespace").Equal("$namespace"),
-				label.New("workload_type").EqualRegexp("$type"),
-			),
+		"WorkloadCPUUsageQuota3": promqlbuilder.Div(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					vector.New(
+						vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+						),
+					),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").Equal("$workload"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("pod"),
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					vector.New(
+						vector.WithMetricName("kube_pod_container_resource_requests"),
+						vector.WithLabelMatchers(
+							jobLabelValues.KubeStateMetricsMatcher(),
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("resource").Equal("cpu"),
+						),
+					),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").Equal("$workload"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("pod"),
 		),
-	).By("workload", "workload_type"),
-	"WorkloadNamespaceCPUUsageQuota2": promqlbuilder.Sum(
-		promqlbuilder.Mul(
-			vector.New(
-				vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
-				vector.WithLabelMatchers(
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-				),
-			),
-			vector.New(
-				vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-				vector.WithLabelMatchers(
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("workload_type").EqualRegexp("$type"),
-				),
-			),
-		).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-	).By("workload", "workload_type"),
-	"WorkloadNamespaceCPUUsageQuota3": promqlbuilder.Sum(
-		promqlbuilder.Mul(
-			vector.New(
-				vector.WithMetricName("kube_pod_container_resource_requests"),
-				vector.WithLabelMatchers(
-					label.New("job").Equal("kube-state-metrics"),
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("resource").Equal("cpu"),
+		"WorkloadCPUUsageQuota4": promqlbuilder.Sum(
+			promqlbuilder.Mul(
+				vector.New(
+					vector.WithMetricName("kube_pod_container_resource_limits"),
+					vector.WithLabelMatchers(
+						jobLabelValues.KubeStateMetricsMatcher(),
+						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
+						label.New("resource").Equal("cpu"),
+					),
 				),
-			),
-			vector.New(
-				vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-				vector.WithLabelMatchers(
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("workload_type").EqualRegexp("$type"),
+				vector.New(
+					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+					vector.WithLabelMatchers(
+						label.New("cluster").Equal("$cluster"),
+						label.New("namespace").Equal("$namespace"),
+						label.New("workload").Equal("$workload"),
+						label.New("workload_type").EqualRegexp("$type"),
+					),
 				),
-			),
-		).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-	).By("workload", "workload_type"),
-	"WorkloadNamespaceCPUUsageQuota4": promqlbuilder.Div(
-		promqlbuilder.Sum(
+			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+		).By("pod"),
+		"WorkloadCPUUsageQuota5": promqlbuilder.Div(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					vector.New(
+						vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+						),
+					),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").Equal("$workload"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("pod"),
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					vector.New(
+						vector.WithMetricName("kube_pod_container_resource_limits"),
+						vector.WithLabelMatchers(
+							jobLabelValues.KubeStateMetricsMatcher(),
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("resource").Equal("cpu"),
+						),
+					),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").Equal("$workload"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("pod"),
+		),
+		"WorkloadMemoryUsageQuota1": promqlbuilder.Sum(
 			promqlbuilder.Mul(
 				vector.New(
-					vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
+					vector.WithMetricName("container_memory_working_set_bytes"),
 					vector.WithLabelMatchers(
 						label.New("cluster").Equal("$cluster"),
 						label.New("namespace").Equal("$namespace"),
+						label.New("container").NotEqual(""),
+						label.New("image").NotEqual(""),
 					),
 				),
 				vector.New(
@@ -6439,20 +6065,21 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 					vector.WithLabelMatchers(
 						label.New("cluster").Equal("$cluster"),
 						label.New("namespace").Equal("$namespace"),
+						label.New("workload").Equal("$workload"),
 						label.New("workload_type").EqualRegexp("$type"),
 					),
 				),
 			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("workload", "workload_type"),
-		promqlbuilder.Sum(
+		).By("pod"),
+		"WorkloadMemoryUsageQuota2": promqlbuilder.Sum(
 			promqlbuilder.Mul(
 				vector.New(
 					vector.WithMetricName("kube_pod_container_resource_requests"),
 					vector.WithLabelMatchers(
-						label.New("job").Equal("kube-state-metrics"),
+						jobLabelValues.KubeStateMetricsMatcher(),
 						label.New("cluster").Equal("$cluster"),
 						label.New("namespace").Equal("$namespace"),
-						label.New("resource").Equal("cpu"),
+						label.New("resource").Equal("memory"),
 					),
 				),
 				vector.New(
@@ -6460,41 +6087,67 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 					vector.WithLabelMatchers(
 						label.New("cluster").Equal("$cluster"),
 						label.New("namespace").Equal("$namespace"),
+						label.New("workload").Equal("$workload"),
 						label.New("workload_type").EqualRegexp("$type"),
 					),
 				),
 			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("workload", "workload_type"),
-	),
-	"WorkloadNamespaceCPUUsageQuota5": promqlbuilder.Sum(
-		promqlbuilder.Mul(
-			vector.New(
-				vector.WithMetricName("kube_pod_container_resource_limits"),
-				vector.WithLabelMatchers(
-					label.New("job").Equal("kube-state-metrics"),
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("resource").Equal("cpu"),
-				),
-			),
-			vector.New(
-				vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-				vector.WithLabelMatchers(
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("workload_type").EqualRegexp("$type"),
-				),
-			),
-		).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-	).By("workload", "workload_type"),
-	"WorkloadNamespaceCPUUsageQuota6": promqlbuilder.Div(
-		promqlbuilder.Sum(
+		).By("pod"),
+		"WorkloadMemoryUsageQuota3": promqlbuilder.Div(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					vector.New(
+						vector.WithMetricName("container_memory_working_set_bytes"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("container").NotEqual(""),
+							label.New("image").NotEqual(""),
+						),
+					),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").Equal("$workload"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("pod"),
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					vector.New(
+						vector.WithMetricName("kube_pod_container_resource_requests"),
+						vector.WithLabelMatchers(
+							jobLabelValues.KubeStateMetricsMatcher(),
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("resource").Equal("memory"),
+						),
+					),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").Equal("$workload"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("pod"),
+		),
+		"WorkloadMemoryUsageQuota4": promqlbuilder.Sum(
 			promqlbuilder.Mul(
 				vector.New(
-					vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
+					vector.WithMetricName("kube_pod_container_resource_limits"),
 					vector.WithLabelMatchers(
+						jobLabelValues.KubeStateMetricsMatcher(),
 						label.New("cluster").Equal("$cluster"),
 						label.New("namespace").Equal("$namespace"),
+						label.New("resource").Equal("memory"),
 					),
 				),
 				vector.New(
@@ -6502,20 +6155,244 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 					vector.WithLabelMatchers(
 						label.New("cluster").Equal("$cluster"),
 						label.New("namespace").Equal("$namespace"),
+						label.New("workload").Equal("$workload"),
 						label.New("workload_type").EqualRegexp("$type"),
 					),
 				),
 			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+		).By("pod"),
+		"WorkloadMemoryUsageQuota5": promqlbuilder.Div(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					vector.New(
+						vector.WithMetricName("container_memory_working_set_bytes"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("container").NotEqual(""),
+							label.New("image").NotEqual(""),
+						),
+					),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").Equal("$workload"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("pod"),
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					vector.New(
+						vector.WithMetricName("kube_pod_container_resource_limits"),
+						vector.WithLabelMatchers(
+							jobLabelValues.KubeStateMetricsMatcher(),
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("resource").Equal("memory"),
+						),
+					),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").Equal("$workload"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("pod"),
+		),
+		"WorkloadCurrentNetworkUsage1": promqlbuilder.Parenthesis(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					promqlbuilder.Rate(
+						matrix.New(
+							vector.New(
+								vector.WithMetricName("container_network_receive_bytes_total"),
+								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
+									label.New("cluster").Equal("$cluster"),
+									label.New("namespace").Equal("$namespace"),
+								),
+							),
+							matrix.WithRangeAsVariable("$__rate_interval"),
+						),
+					),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp("$workload"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("pod"),
+		),
+		"WorkloadCurrentNetworkUsage2": promqlbuilder.Parenthesis(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					promqlbuilder.Rate(
+						matrix.New(
+							vector.New(
+								vector.WithMetricName("container_network_transmit_bytes_total"),
+								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
+									label.New("cluster").Equal("$cluster"),
+									label.New("namespace").Equal("$namespace"),
+								),
+							),
+							matrix.WithRangeAsVariable("$__rate_interval"),
+						),
+					),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp("$workload"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("pod"),
+		),
+		"WorkloadCurrentNetworkUsage3": promqlbuilder.Parenthesis(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					promqlbuilder.Rate(
+						matrix.New(
+							vector.New(
+								vector.WithMetricName("container_network_receive_packets_total"),
+								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
+									label.New("cluster").Equal("$cluster"),
+									label.New("namespace").Equal("$namespace"),
+								),
+							),
+							matrix.WithRangeAsVariable("$__rate_interval"),
+						),
+					),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp("$workload"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("pod"),
+		),
+		"WorkloadCurrentNetworkUsage4": promqlbuilder.Parenthesis(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					promqlbuilder.Rate(
+						matrix.New(
+							vector.New(
+								vector.WithMetricName("container_network_transmit_packets_total"),
+								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
+									label.New("cluster").Equal("$cluster"),
+									label.New("namespace").Equal("$namespace"),
+								),
+							),
+							matrix.WithRangeAsVariable("$__rate_interval"),
+						),
+					),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp("$workload"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("pod"),
+		),
+		"WorkloadCurrentNetworkUsage5": promqlbuilder.Parenthesis(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					promqlbuilder.Rate(
+						matrix.New(
+							vector.New(
+								vector.WithMetricName("container_network_receive_packets_dropped_total"),
+								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
+									label.New("cluster").Equal("$cluster"),
+									label.New("namespace").Equal("$namespace"),
+								),
+							),
+							matrix.WithRangeAsVariable("$__rate_interval"),
+						),
+					),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp("$workload"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("pod"),
+		),
+		"WorkloadCurrentNetworkUsage6": promqlbuilder.Parenthesis(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					promqlbuilder.Rate(
+						matrix.New(
+							vector.New(
+								vector.WithMetricName("container_network_transmit_packets_dropped_total"),
+								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
+									label.New("cluster").Equal("$cluster"),
+									label.New("namespace").Equal("$namespace"),
+								),
+							),
+							matrix.WithRangeAsVariable("$__rate_interval"),
+						),
+					),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp("$workload"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("pod"),
+		),
+		// workload_namespace
+		"WorkloadNamespaceCPUUsageQuota1": promqlbuilder.Count(
+			vector.New(
+				vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+				vector.WithLabelMatchers(
+					label.New("cluster").Equal("$cluster"),
+					label.New("namespace").Equal("$namespace"),
+					label.New("workload_type").EqualRegexp("$type"),
+				),
+			),
 		).By("workload", "workload_type"),
-		promqlbuilder.Sum(
+		"WorkloadNamespaceCPUUsageQuota2": promqlbuilder.Sum(
 			promqlbuilder.Mul(
 				vector.New(
-					vector.WithMetricName("kube_pod_container_resource_limits"),
+					vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
 					vector.WithLabelMatchers(
-						label.New("job").Equal("kube-state-metrics"),
 						label.New("cluster").Equal("$cluster"),
 						label.New("namespace").Equal("$namespace"),
-						label.New("resource").Equal("cpu"),
 					),
 				),
 				vector.New(
@@ -6528,71 +6405,15 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 				),
 			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
 		).By("workload", "workload_type"),
-	),
-	"WorkloadNamespaceMemoryUsageQuota1": promqlbuilder.Count(
-		vector.New(
-			vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-			vector.WithLabelMatchers(
-				label.New("cluster").Equal("$cluster"),
-				label.New("namespace").Equal("$namespace"),
-				label.New("workload_type").EqualRegexp("$type"),
-			),
-		),
-	).By("workload", "workload_type"),
-	"WorkloadNamespaceMemoryUsageQuota2": promqlbuilder.Sum(
-		promqlbuilder.Mul(
-			vector.New(
-				vector.WithMetricName("container_memory_working_set_bytes"),
-				vector.WithLabelMatchers(
-					label.New("job").Equal("cadvisor"),
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("container").NotEqual(""),
-					label.New("image").NotEqual(""),
-				),
-			),
-			vector.New(
-				vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-				vector.WithLabelMatchers(
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("workload_type").EqualRegexp("$type"),
-				),
-			),
-		).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-	).By("workload", "workload_type"),
-	"WorkloadNamespaceMemoryUsageQuota3": promqlbuilder.Sum(
-		promqlbuilder.Mul(
-			vector.New(
-				vector.WithMetricName("kube_pod_container_resource_requests"),
-				vector.WithLabelMatchers(
-					label.New("job").Equal("kube-state-metrics"),
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("resource").Equal("memory"),
-				),
-			),
-			vector.New(
-				vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-				vector.WithLabelMatchers(
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("workload_type").EqualRegexp("$type"),
-				),
-			),
-		).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-	).By("workload", "workload_type"),
-	"WorkloadNamespaceMemoryUsageQuota4": promqlbuilder.Div(
-		promqlbuilder.Sum(
+		"WorkloadNamespaceCPUUsageQuota3": promqlbuilder.Sum(
 			promqlbuilder.Mul(
 				vector.New(
-					vector.WithMetricName("container_memory_working_set_bytes"),
+					vector.WithMetricName("kube_pod_container_resource_requests"),
 					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
+						jobLabelValues.KubeStateMetricsMatcher(),
 						label.New("cluster").Equal("$cluster"),
 						label.New("namespace").Equal("$namespace"),
-						label.New("container").NotEqual(""),
-						label.New("image").NotEqual(""),
+						label.New("resource").Equal("cpu"),
 					),
 				),
 				vector.New(
@@ -6605,15 +6426,57 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 				),
 			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
 		).By("workload", "workload_type"),
-		promqlbuilder.Sum(
+		"WorkloadNamespaceCPUUsageQuota4": promqlbuilder.Div(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					vector.New(
+						vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+						),
+					),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload", "workload_type"),
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					vector.New(
+						vector.WithMetricName("kube_pod_container_resource_requests"),
+						vector.WithLabelMatchers(
+							jobLabelValues.KubeStateMetricsMatcher(),
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("resource").Equal("cpu"),
+						),
+					),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload", "workload_type"),
+		),
+		"WorkloadNamespaceCPUUsageQuota5": promqlbuilder.Sum(
 			promqlbuilder.Mul(
 				vector.New(
-					vector.WithMetricName("kube_pod_container_resource_requests"),
+					vector.WithMetricName("kube_pod_container_resource_limits"),
 					vector.WithLabelMatchers(
-						label.New("job").Equal("kube-state-metrics"),
+						jobLabelValues.KubeStateMetricsMatcher(),
 						label.New("cluster").Equal("$cluster"),
 						label.New("namespace").Equal("$namespace"),
-						label.New("resource").Equal("memory"),
+						label.New("resource").Equal("cpu"),
 					),
 				),
 				vector.New(
@@ -6626,18 +6489,49 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 				),
 			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
 		).By("workload", "workload_type"),
-	),
-	"WorkloadNamespaceMemoryUsageQuota5": promqlbuilder.Sum(
-		promqlbuilder.Mul(
-			vector.New(
-				vector.WithMetricName("kube_pod_container_resource_limits"),
-				vector.WithLabelMatchers(
-					label.New("job").Equal("kube-state-metrics"),
-					label.New("cluster").Equal("$cluster"),
-					label.New("namespace").Equal("$namespace"),
-					label.New("resource").Equal("memory"),
-				),
-			),
+		"WorkloadNamespaceCPUUsageQuota6": promqlbuilder.Div(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					vector.New(
+						vector.WithMetricName("node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+						),
+					),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload", "workload_type"),
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					vector.New(
+						vector.WithMetricName("kube_pod_container_resource_limits"),
+						vector.WithLabelMatchers(
+							jobLabelValues.KubeStateMetricsMatcher(),
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("resource").Equal("cpu"),
+						),
+					),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload", "workload_type"),
+		),
+		"WorkloadNamespaceMemoryUsageQuota1": promqlbuilder.Count(
 			vector.New(
 				vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
 				vector.WithLabelMatchers(
@@ -6646,15 +6540,13 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 					label.New("workload_type").EqualRegexp("$type"),
 				),
 			),
-		).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-	).By("workload", "workload_type"),
-	"WorkloadNamespaceMemoryUsageQuota6": promqlbuilder.Div(
-		promqlbuilder.Sum(
+		).By("workload", "workload_type"),
+		"WorkloadNamespaceMemoryUsageQuota2": promqlbuilder.Sum(
 			promqlbuilder.Mul(
 				vector.New(
 					vector.WithMetricName("container_memory_working_set_bytes"),
 					vector.WithLabelMatchers(
-						label.New("job").Equal("cadvisor"),
+						jobLabelValues.CAdvisorMatcher(),
 						label.New("cluster").Equal("$cluster"),
 						label.New("namespace").Equal("$namespace"),
 						label.New("container").NotEqual(""),
@@ -6671,12 +6563,12 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 				),
 			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
 		).By("workload", "workload_type"),
-		promqlbuilder.Sum(
+		"WorkloadNamespaceMemoryUsageQuota3": promqlbuilder.Sum(
 			promqlbuilder.Mul(
 				vector.New(
-					vector.WithMetricName("kube_pod_container_resource_limits"),
+					vector.WithMetricName("kube_pod_container_resource_requests"),
 					vector.WithLabelMatchers(
-						label.New("job").Equal("kube-state-metrics"),
+						jobLabelValues.KubeStateMetricsMatcher(),
 						label.New("cluster").Equal("$cluster"),
 						label.New("namespace").Equal("$namespace"),
 						label.New("resource").Equal("memory"),
@@ -6692,102 +6584,60 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 				),
 			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
 		).By("workload", "workload_type"),
-	),
-	"WorkloadNamespaceCurrentNetworkUsage1": promqlbuilder.Parenthesis(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
-				promqlbuilder.Rate(
-					matrix.New(
-						vector.New(
-							vector.WithMetricName("container_network_receive_bytes_total"),
-							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
-								label.New("cluster").Equal("$cluster"),
-								label.New("namespace").Equal("$namespace"),
-							),
+		"WorkloadNamespaceMemoryUsageQuota4": promqlbuilder.Div(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					vector.New(
+						vector.WithMetricName("container_memory_working_set_bytes"),
+						vector.WithLabelMatchers(
+							jobLabelValues.CAdvisorMatcher(),
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("container").NotEqual(""),
+							label.New("image").NotEqual(""),
 						),
-						matrix.WithRangeAsVariable("$__rate_interval"),
-					),
-				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload_type").EqualRegexp("$type"),
 					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("workload"),
-	),
-	"WorkloadNamespaceCurrentNetworkUsage2": promqlbuilder.Parenthesis(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
-				promqlbuilder.Rate(
-					matrix.New(
-						vector.New(
-							vector.WithMetricName("container_network_transmit_bytes_total"),
-							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
-								label.New("cluster").Equal("$cluster"),
-								label.New("namespace").Equal("$namespace"),
-							),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload_type").EqualRegexp("$type"),
 						),
-						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
-				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload_type").EqualRegexp("$type"),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload", "workload_type"),
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					vector.New(
+						vector.WithMetricName("kube_pod_container_resource_requests"),
+						vector.WithLabelMatchers(
+							jobLabelValues.KubeStateMetricsMatcher(),
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("resource").Equal("memory"),
+						),
 					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("workload"),
-	),
-	"WorkloadNamespaceCurrentNetworkUsage3": promqlbuilder.Parenthesis(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
-				promqlbuilder.Rate(
-					matrix.New(
-						vector.New(
-							vector.WithMetricName("container_network_receive_packets_total"),
-							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
-								label.New("cluster").Equal("$cluster"),
-								label.New("namespace").Equal("$namespace"),
-							),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload_type").EqualRegexp("$type"),
 						),
-						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
-				),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload", "workload_type"),
+		),
+		"WorkloadNamespaceMemoryUsageQuota5": promqlbuilder.Sum(
+			promqlbuilder.Mul(
 				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+					vector.WithMetricName("kube_pod_container_resource_limits"),
 					vector.WithLabelMatchers(
+						jobLabelValues.KubeStateMetricsMatcher(),
 						label.New("cluster").Equal("$cluster"),
 						label.New("namespace").Equal("$namespace"),
-						label.New("workload_type").EqualRegexp("$type"),
-					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("workload"),
-	),
-	"WorkloadNamespaceCurrentNetworkUsage4": promqlbuilder.Parenthesis(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
-				promqlbuilder.Rate(
-					matrix.New(
-						vector.New(
-							vector.WithMetricName("container_network_transmit_packets_total"),
-							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
-								label.New("cluster").Equal("$cluster"),
-								label.New("namespace").Equal("$namespace"),
-							),
-						),
-						matrix.WithRangeAsVariable("$__rate_interval"),
+						label.New("resource").Equal("memory"),
 					),
 				),
 				vector.New(
@@ -6799,72 +6649,61 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 					),
 				),
 			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("workload"),
-	),
-	"WorkloadNamespaceCurrentNetworkUsage5": promqlbuilder.Parenthesis(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
-				promqlbuilder.Rate(
-					matrix.New(
-						vector.New(
-							vector.WithMetricName("container_network_receive_packets_dropped_total"),
-							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
-								label.New("cluster").Equal("$cluster"),
-								label.New("namespace").Equal("$namespace"),
-							),
+		).By("workload", "workload_type"),
+		"WorkloadNamespaceMemoryUsageQuota6": promqlbuilder.Div(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					vector.New(
+						vector.WithMetricName("container_memory_working_set_bytes"),
+						vector.WithLabelMatchers(
+							jobLabelValues.CAdvisorMatcher(),
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("container").NotEqual(""),
+							label.New("image").NotEqual(""),
 						),
-						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
-				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload_type").EqualRegexp("$type"),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
 					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("workload"),
-	),
-	"WorkloadNamespaceCurrentNetworkUsage6": promqlbuilder.Parenthesis(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
-				promqlbuilder.Rate(
-					matrix.New(
-						vector.New(
-							vector.WithMetricName("container_network_transmit_packets_dropped_total"),
-							vector.WithLabelMatchers(
-								label.New("job").Equal("cadvisor"),
-								label.New("cluster").Equal("$cluster"),
-								label.New("namespace").Equal("$namespace"),
-							),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload", "workload_type"),
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					vector.New(
+						vector.WithMetricName("kube_pod_container_resource_limits"),
+						vector.WithLabelMatchers(
+							jobLabelValues.KubeStateMetricsMatcher(),
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("resource").Equal("memory"),
 						),
-						matrix.WithRangeAsVariable("$__rate_interval"),
 					),
-				),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload_type").EqualRegexp("$type"),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
 					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("workload"),
-	),
-	// workload_ns_networking
-	"WorkloadNamespaceCurrentNetworkStatus1": promqlbuilder.SortDesc(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload", "workload_type"),
+		),
+		"WorkloadNamespaceCurrentNetworkUsage1": promqlbuilder.Parenthesis(
+			promqlbuilder.Sum(
 				promqlbuilder.Mul(
 					promqlbuilder.Rate(
 						matrix.New(
 							vector.New(
 								vector.WithMetricName("container_network_receive_bytes_total"),
 								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
 									label.New("cluster").Equal("$cluster"),
 									label.New("namespace").Equal("$namespace"),
 								),
@@ -6873,35 +6712,25 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 						),
 					),
 					vector.New(
-						vector.WithMetricName("kube_pod_info"),
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
 						vector.WithLabelMatchers(
 							label.New("cluster").Equal("$cluster"),
 							label.New("namespace").Equal("$namespace"),
-							label.New("host_network").Equal("false"),
+							label.New("workload_type").EqualRegexp("$type"),
 						),
 					),
-				).On("namespace", "pod"),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp(".+"),
-						label.New("workload_type").EqualRegexp("$type"),
-					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("workload", "workload_type"),
-	),
-	"WorkloadNamespaceCurrentNetworkStatus2": promqlbuilder.SortDesc(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload"),
+		),
+		"WorkloadNamespaceCurrentNetworkUsage2": promqlbuilder.Parenthesis(
+			promqlbuilder.Sum(
 				promqlbuilder.Mul(
 					promqlbuilder.Rate(
 						matrix.New(
 							vector.New(
 								vector.WithMetricName("container_network_transmit_bytes_total"),
 								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
 									label.New("cluster").Equal("$cluster"),
 									label.New("namespace").Equal("$namespace"),
 								),
@@ -6910,35 +6739,25 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 						),
 					),
 					vector.New(
-						vector.WithMetricName("kube_pod_info"),
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
 						vector.WithLabelMatchers(
 							label.New("cluster").Equal("$cluster"),
 							label.New("namespace").Equal("$namespace"),
-							label.New("host_network").Equal("false"),
+							label.New("workload_type").EqualRegexp("$type"),
 						),
 					),
-				).On("namespace", "pod"),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp(".+"),
-						label.New("workload_type").EqualRegexp("$type"),
-					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("workload", "workload_type"),
-	),
-	"WorkloadNamespaceCurrentNetworkStatus3": promqlbuilder.SortDesc(
-		promqlbuilder.Avg(
-			promqlbuilder.Mul(
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload"),
+		),
+		"WorkloadNamespaceCurrentNetworkUsage3": promqlbuilder.Parenthesis(
+			promqlbuilder.Sum(
 				promqlbuilder.Mul(
 					promqlbuilder.Rate(
 						matrix.New(
 							vector.New(
-								vector.WithMetricName("container_network_receive_bytes_total"),
+								vector.WithMetricName("container_network_receive_packets_total"),
 								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
 									label.New("cluster").Equal("$cluster"),
 									label.New("namespace").Equal("$namespace"),
 								),
@@ -6947,35 +6766,25 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 						),
 					),
 					vector.New(
-						vector.WithMetricName("kube_pod_info"),
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
 						vector.WithLabelMatchers(
 							label.New("cluster").Equal("$cluster"),
 							label.New("namespace").Equal("$namespace"),
-							label.New("host_network").Equal("false"),
+							label.New("workload_type").EqualRegexp("$type"),
 						),
 					),
-				).On("namespace", "pod"),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp(".+"),
-						label.New("workload_type").EqualRegexp("$type"),
-					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("workload", "workload_type"),
-	),
-	"WorkloadNamespaceCurrentNetworkStatus4": promqlbuilder.SortDesc(
-		promqlbuilder.Avg(
-			promqlbuilder.Mul(
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload"),
+		),
+		"WorkloadNamespaceCurrentNetworkUsage4": promqlbuilder.Parenthesis(
+			promqlbuilder.Sum(
 				promqlbuilder.Mul(
 					promqlbuilder.Rate(
 						matrix.New(
 							vector.New(
-								vector.WithMetricName("container_network_transmit_bytes_total"),
+								vector.WithMetricName("container_network_transmit_packets_total"),
 								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
 									label.New("cluster").Equal("$cluster"),
 									label.New("namespace").Equal("$namespace"),
 								),
@@ -6984,35 +6793,25 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 						),
 					),
 					vector.New(
-						vector.WithMetricName("kube_pod_info"),
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
 						vector.WithLabelMatchers(
 							label.New("cluster").Equal("$cluster"),
 							label.New("namespace").Equal("$namespace"),
-							label.New("host_network").Equal("false"),
+							label.New("workload_type").EqualRegexp("$type"),
 						),
 					),
-				).On("namespace", "pod"),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp(".+"),
-						label.New("workload_type").EqualRegexp("$type"),
-					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("workload", "workload_type"),
-	),
-	"WorkloadNamespaceCurrentNetworkStatus5": promqlbuilder.SortDesc(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload"),
+		),
+		"WorkloadNamespaceCurrentNetworkUsage5": promqlbuilder.Parenthesis(
+			promqlbuilder.Sum(
 				promqlbuilder.Mul(
 					promqlbuilder.Rate(
 						matrix.New(
 							vector.New(
-								vector.WithMetricName("container_network_receive_packets_total"),
+								vector.WithMetricName("container_network_receive_packets_dropped_total"),
 								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
 									label.New("cluster").Equal("$cluster"),
 									label.New("namespace").Equal("$namespace"),
 								),
@@ -7021,35 +6820,25 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 						),
 					),
 					vector.New(
-						vector.WithMetricName("kube_pod_info"),
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
 						vector.WithLabelMatchers(
 							label.New("cluster").Equal("$cluster"),
 							label.New("namespace").Equal("$namespace"),
-							label.New("host_network").Equal("false"),
+							label.New("workload_type").EqualRegexp("$type"),
 						),
 					),
-				).On("namespace", "pod"),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp(".+"),
-						label.New("workload_type").EqualRegexp("$type"),
-					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("workload", "workload_type"),
-	),
-	"WorkloadNamespaceCurrentNetworkStatus6": promqlbuilder.SortDesc(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload"),
+		),
+		"WorkloadNamespaceCurrentNetworkUsage6": promqlbuilder.Parenthesis(
+			promqlbuilder.Sum(
 				promqlbuilder.Mul(
 					promqlbuilder.Rate(
 						matrix.New(
 							vector.New(
-								vector.WithMetricName("container_network_transmit_packets_total"),
+								vector.WithMetricName("container_network_transmit_packets_dropped_total"),
 								vector.WithLabelMatchers(
+									jobLabelValues.CAdvisorMatcher(),
 									label.New("cluster").Equal("$cluster"),
 									label.New("namespace").Equal("$namespace"),
 								),
@@ -7058,105 +6847,323 @@ var KubernetesCommonPanelQueries = map[string]parser.Expr{
 						),
 					),
 					vector.New(
-						vector.WithMetricName("kube_pod_info"),
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
 						vector.WithLabelMatchers(
 							label.New("cluster").Equal("$cluster"),
 							label.New("namespace").Equal("$namespace"),
-							label.New("host_network").Equal("false"),
+							label.New("workload_type").EqualRegexp("$type"),
 						),
 					),
-				).On("namespace", "pod"),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp(".+"),
-						label.New("workload_type").EqualRegexp("$type"),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload"),
+		),
+		// workload_ns_networking
+		"WorkloadNamespaceCurrentNetworkStatus1": promqlbuilder.SortDesc(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					promqlbuilder.Mul(
+						promqlbuilder.Rate(
+							matrix.New(
+								vector.New(
+									vector.WithMetricName("container_network_receive_bytes_total"),
+									vector.WithLabelMatchers(
+										label.New("cluster").Equal("$cluster"),
+										label.New("namespace").Equal("$namespace"),
+									),
+								),
+								matrix.WithRangeAsVariable("$__rate_interval"),
+							),
+						),
+						vector.New(
+							vector.WithMetricName("kube_pod_info"),
+							vector.WithLabelMatchers(
+								label.New("cluster").Equal("$cluster"),
+								label.New("namespace").Equal("$namespace"),
+								label.New("host_network").Equal("false"),
+							),
+						),
+					).On("namespace", "pod"),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp(".+"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
 					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("workload", "workload_type"),
-	),
-	"WorkloadNamespaceCurrentNetworkStatus7": promqlbuilder.SortDesc(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload", "workload_type"),
+		),
+		"WorkloadNamespaceCurrentNetworkStatus2": promqlbuilder.SortDesc(
+			promqlbuilder.Sum(
 				promqlbuilder.Mul(
-					promqlbuilder.Rate(
-						matrix.New(
-							vector.New(
-								vector.WithMetricName("container_network_receive_packets_dropped_total"),
-								vector.WithLabelMatchers(
-									label.New("cluster").Equal("$cluster"),
-									label.New("namespace").Equal("$namespace"),
+					promqlbuilder.Mul(
+						promqlbuilder.Rate(
+							matrix.New(
+								vector.New(
+									vector.WithMetricName("container_network_transmit_bytes_total"),
+									vector.WithLabelMatchers(
+										label.New("cluster").Equal("$cluster"),
+										label.New("namespace").Equal("$namespace"),
+									),
 								),
+								matrix.WithRangeAsVariable("$__rate_interval"),
 							),
-							matrix.WithRangeAsVariable("$__rate_interval"),
+						),
+						vector.New(
+							vector.WithMetricName("kube_pod_info"),
+							vector.WithLabelMatchers(
+								label.New("cluster").Equal("$cluster"),
+								label.New("namespace").Equal("$namespace"),
+								label.New("host_network").Equal("false"),
+							),
+						),
+					).On("namespace", "pod"),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp(".+"),
+							label.New("workload_type").EqualRegexp("$type"),
 						),
 					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload", "workload_type"),
+		),
+		"WorkloadNamespaceCurrentNetworkStatus3": promqlbuilder.SortDesc(
+			promqlbuilder.Avg(
+				promqlbuilder.Mul(
+					promqlbuilder.Mul(
+						promqlbuilder.Rate(
+							matrix.New(
+								vector.New(
+									vector.WithMetricName("container_network_receive_bytes_total"),
+									vector.WithLabelMatchers(
+										label.New("cluster").Equal("$cluster"),
+										label.New("namespace").Equal("$namespace"),
+									),
+								),
+								matrix.WithRangeAsVariable("$__rate_interval"),
+							),
+						),
+						vector.New(
+							vector.WithMetricName("kube_pod_info"),
+							vector.WithLabelMatchers(
+								label.New("cluster").Equal("$cluster"),
+								label.New("namespace").Equal("$namespace"),
+								label.New("host_network").Equal("false"),
+							),
+						),
+					).On("namespace", "pod"),
 					vector.New(
-						vector.WithMetricName("kube_pod_info"),
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
 						vector.WithLabelMatchers(
 							label.New("cluster").Equal("$cluster"),
 							label.New("namespace").Equal("$namespace"),
-							label.New("host_network").Equal("false"),
+							label.New("workload").EqualRegexp(".+"),
+							label.New("workload_type").EqualRegexp("$type"),
 						),
 					),
-				).On("namespace", "pod"),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp(".+"),
-						label.New("workload_type").EqualRegexp("$type"),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload", "workload_type"),
+		),
+		"WorkloadNamespaceCurrentNetworkStatus4": promqlbuilder.SortDesc(
+			promqlbuilder.Avg(
+				promqlbuilder.Mul(
+					promqlbuilder.Mul(
+						promqlbuilder.Rate(
+							matrix.New(
+								vector.New(
+									vector.WithMetricName("container_network_transmit_bytes_total"),
+									vector.WithLabelMatchers(
+										label.New("cluster").Equal("$cluster"),
+										label.New("namespace").Equal("$namespace"),
+									),
+								),
+								matrix.WithRangeAsVariable("$__rate_interval"),
+							),
+						),
+						vector.New(
+							vector.WithMetricName("kube_pod_info"),
+							vector.WithLabelMatchers(
+								label.New("cluster").Equal("$cluster"),
+								label.New("namespace").Equal("$namespace"),
+								label.New("host_network").Equal("false"),
+							),
+						),
+					).On("namespace", "pod"),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp(".+"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
 					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("workload", "workload_type"),
-	),
-	"WorkloadNamespaceCurrentNetworkStatus8": promqlbuilder.SortDesc(
-		promqlbuilder.Sum(
-			promqlbuilder.Mul(
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload", "workload_type"),
+		),
+		"WorkloadNamespaceCurrentNetworkStatus5": promqlbuilder.SortDesc(
+			promqlbuilder.Sum(
 				promqlbuilder.Mul(
-					promqlbuilder.Rate(
-						matrix.New(
-							vector.New(
-								vector.WithMetricName("container_network_transmit_packets_dropped_total"),
-								vector.WithLabelMatchers(
-									label.New("cluster").Equal("$cluster"),
-									label.New("namespace").Equal("$namespace"),
+					promqlbuilder.Mul(
+						promqlbuilder.Rate(
+							matrix.New(
+								vector.New(
+									vector.WithMetricName("container_network_receive_packets_total"),
+									vector.WithLabelMatchers(
+										label.New("cluster").Equal("$cluster"),
+										label.New("namespace").Equal("$namespace"),
+									),
 								),
+								matrix.WithRangeAsVariable("$__rate_interval"),
 							),
-							matrix.WithRangeAsVariable("$__rate_interval"),
+						),
+						vector.New(
+							vector.WithMetricName("kube_pod_info"),
+							vector.WithLabelMatchers(
+								label.New("cluster").Equal("$cluster"),
+								label.New("namespace").Equal("$namespace"),
+								label.New("host_network").Equal("false"),
+							),
+						),
+					).On("namespace", "pod"),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp(".+"),
+							label.New("workload_type").EqualRegexp("$type"),
 						),
 					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload", "workload_type"),
+		),
+		"WorkloadNamespaceCurrentNetworkStatus6": promqlbuilder.SortDesc(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					promqlbuilder.Mul(
+						promqlbuilder.Rate(
+							matrix.New(
+								vector.New(
+									vector.WithMetricName("container_network_transmit_packets_total"),
+									vector.WithLabelMatchers(
+										label.New("cluster").Equal("$cluster"),
+										label.New("namespace").Equal("$namespace"),
+									),
+								),
+								matrix.WithRangeAsVariable("$__rate_interval"),
+							),
+						),
+						vector.New(
+							vector.WithMetricName("kube_pod_info"),
+							vector.WithLabelMatchers(
+								label.New("cluster").Equal("$cluster"),
+								label.New("namespace").Equal("$namespace"),
+								label.New("host_network").Equal("false"),
+							),
+						),
+					).On("namespace", "pod"),
 					vector.New(
-						vector.WithMetricName("kube_pod_info"),
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
 						vector.WithLabelMatchers(
 							label.New("cluster").Equal("$cluster"),
 							label.New("namespace").Equal("$namespace"),
-							label.New("host_network").Equal("false"),
+							label.New("workload").EqualRegexp(".+"),
+							label.New("workload_type").EqualRegexp("$type"),
 						),
 					),
-				).On("namespace", "pod"),
-				vector.New(
-					vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
-					vector.WithLabelMatchers(
-						label.New("cluster").Equal("$cluster"),
-						label.New("namespace").Equal("$namespace"),
-						label.New("workload").EqualRegexp(".+"),
-						label.New("workload_type").EqualRegexp("$type"),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload", "workload_type"),
+		),
+		"WorkloadNamespaceCurrentNetworkStatus7": promqlbuilder.SortDesc(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					promqlbuilder.Mul(
+						promqlbuilder.Rate(
+							matrix.New(
+								vector.New(
+									vector.WithMetricName("container_network_receive_packets_dropped_total"),
+									vector.WithLabelMatchers(
+										label.New("cluster").Equal("$cluster"),
+										label.New("namespace").Equal("$namespace"),
+									),
+								),
+								matrix.WithRangeAsVariable("$__rate_interval"),
+							),
+						),
+						vector.New(
+							vector.WithMetricName("kube_pod_info"),
+							vector.WithLabelMatchers(
+								label.New("cluster").Equal("$cluster"),
+								label.New("namespace").Equal("$namespace"),
+								label.New("host_network").Equal("false"),
+							),
+						),
+					).On("namespace", "pod"),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp(".+"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
 					),
-				),
-			).On("namespace", "pod").GroupLeft("workload", "workload_type"),
-		).By("workload", "workload_type"),
-	),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload", "workload_type"),
+		),
+		"WorkloadNamespaceCurrentNetworkStatus8": promqlbuilder.SortDesc(
+			promqlbuilder.Sum(
+				promqlbuilder.Mul(
+					promqlbuilder.Mul(
+						promqlbuilder.Rate(
+							matrix.New(
+								vector.New(
+									vector.WithMetricName("container_network_transmit_packets_dropped_total"),
+									vector.WithLabelMatchers(
+										label.New("cluster").Equal("$cluster"),
+										label.New("namespace").Equal("$namespace"),
+									),
+								),
+								matrix.WithRangeAsVariable("$__rate_interval"),
+							),
+						),
+						vector.New(
+							vector.WithMetricName("kube_pod_info"),
+							vector.WithLabelMatchers(
+								label.New("cluster").Equal("$cluster"),
+								label.New("namespace").Equal("$namespace"),
+								label.New("host_network").Equal("false"),
+							),
+						),
+					).On("namespace", "pod"),
+					vector.New(
+						vector.WithMetricName("namespace_workload_pod:kube_pod_owner:relabel"),
+						vector.WithLabelMatchers(
+							label.New("cluster").Equal("$cluster"),
+							label.New("namespace").Equal("$namespace"),
+							label.New("workload").EqualRegexp(".+"),
+							label.New("workload_type").EqualRegexp("$type"),
+						),
+					),
+				).On("namespace", "pod").GroupLeft("workload", "workload_type"),
+			).By("workload", "workload_type"),
+		),
+	}
 }
 
-// OverrideKubernetesPanelQueries overrides the KubernetesCommonPanelQueries global.
+// OverrideKubernetesPanelQueries overrides queries of the KubernetesCommonPanelQueries global.
 // Refer to panel queries in the map, that you'd like to override.
 // The convention of naming followed, is to use Panel function name (with _suffix, in case panel has multiple queries)
+//
+// Deprecated: the dashboards build their own queries with KubernetesPanelQueries and no longer read
+// KubernetesCommonPanelQueries, pass the overrides to the Build functions with
+// kubernetes.WithPanelQueryOverrides from pkg/dashboards/kubernetes instead.
 func OverrideKubernetesPanelQueries(queries map[string]parser.Expr) {
 	maps.Copy(KubernetesCommonPanelQueries, queries)
 }
@@ -0,0 +1,76 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/perses/community-mixins/pkg/querytest"
+)
+
+func TestKubernetesCommonPanelQueries(t *testing.T) {
+	querytest.Suite{
+		SeriesFile: "testdata/series.test",
+		Queries:    KubernetesCommonPanelQueries,
+		Variables: map[string]string{
+			"cluster":   "cluster-a",
+			"namespace": "default",
+			"node":      "node-1",
+			"pod":       "app-5d8f7c9b4-x2x7q",
+			"workload":  "app",
+			"type":      "deployment",
+			"volume":    "data-app-0",
+			// The instance variables of the dashboards are regular expressions, set to all the instances.
+			"instance": ".*",
+		},
+		Labels: map[string][]string{
+			"KubernetesCPUUsage1":     {`{cluster="cluster-a"}`, `{cluster="cluster-b"}`},
+			"NamespaceCPUUsageQuota1": {`{pod="app-5d8f7c9b4-x2x7q"}`},
+			"WorkloadCPUUsageQuota1":  {`{pod="app-5d8f7c9b4-x2x7q"}`},
+			"PodCPUUsageQuota1":       {`{container="app"}`, `{container="sidecar"}`},
+			"PodCPUThrottling":        {`{container="app"}`, `{container="sidecar"}`},
+			"RunningPodStat":          {`{}`},
+			"WorkQueueDepth":          {`{cluster="cluster-a", instance="10.0.0.2:10257", name="deployment"}`},
+			"VolumeSpaceUsageGauge":   {`{cluster="cluster-a", job="kubelet", namespace="default", persistentvolumeclaim="data-app-0"}`},
+			"APIServerAvailability":   {`{__name__="apiserver_request:availability30d", cluster="cluster-a", verb="all"}`},
+		},
+		Skip: map[string]string{
+			"ClusterTCPRetransmitRate":    "joins node-exporter with the pods off the host network, while node-exporter usually runs on it",
+			"ClusterTCPSYNRetransmitRate": "joins node-exporter with the pods off the host network, while node-exporter usually runs on it",
+		},
+	}.Run(t)
+}
+
+func TestKubernetesPanelQueriesJobLabelValues(t *testing.T) {
+	jobLabelValues := DefaultJobLabelValues()
+	jobLabelValues.Kubelet = "my-kubelet"
+	queries := KubernetesPanelQueries(jobLabelValues)
+
+	if got := queries["RunningKubeletStat"].String(); !strings.Contains(got, `job="my-kubelet"`) {
+		t.Errorf("RunningKubeletStat = %q, want it to select job=\"my-kubelet\"", got)
+	}
+	for name, query := range queries {
+		if strings.Contains(query.String(), `job="kubelet"`) {
+			t.Errorf("%s still selects the default kubelet job: %s", name, query)
+		}
+	}
+	if got, want := KubernetesPanelQueries(DefaultJobLabelValues())["RunningKubeletStat"].String(), KubernetesCommonPanelQueries["RunningKubeletStat"].String(); got != want {
+		t.Errorf("default RunningKubeletStat = %q, want %q", got, want)
+	}
+	queries["RunningKubeletStat"] = nil
+	if KubernetesPanelQueries(jobLabelValues)["RunningKubeletStat"] == nil {
+		t.Error("KubernetesPanelQueries() returned a map shared with an earlier call")
+	}
+}
@@ -23,81 +23,67 @@ import (
 	commonSdk "github.com/perses/perses/go-sdk/common"
 	timeSeriesPanel "github.com/perses/plugins/timeserieschart/sdk/go"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 )
 
-func KubernetesIOPS(granularity, datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func KubernetesIOPS(granularity, datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	var panelName, description string
-	var queries []panel.Option
+	var queryOptions []panel.Option
 
 	switch granularity {
 	case "cluster":
 		panelName = "IOPS(Reads+Writes)"
 		description = "Shows IOPS(Reads+Writes) by namespace."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesIOPS1"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{namespace}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesIOPS1",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{namespace}}"),
 			),
 		}
 	case "namespace-pod":
 		panelName = "IOPS(Reads+Writes)"
 		description = "Shows IOPS(Reads+Writes) by pods in a namespace."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesIOPS2"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesIOPS2",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "pod":
 		panelName = "IOPS(Pod)"
 		description = "Shows IOPS of a pod, split by read and write."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesIOPS3"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("Writes"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesIOPS3",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("Writes"),
 			),
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesIOPS4"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("Reads"),
-				),
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesIOPS4",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("Reads"),
 			),
 		}
 	case "pod-container":
 		panelName = "IOPS(Reads+Writes Containers) "
 		description = "Shows IOPS(Reads+Writes) by containers in a pod."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesIOPS5"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{container}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesIOPS5",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{container}}"),
 			),
 		}
 	}
@@ -124,84 +110,69 @@ func KubernetesIOPS(granularity, datasourceName string, labelMatchers ...*labels
 			}),
 		),
 	}
-	panelOpts = append(panelOpts, queries...)
+	panelOpts = append(panelOpts, queryOptions...)
 
 	return panelgroup.AddPanel(panelName, panelOpts...)
 }
 
-func KubernetesThroughput(granularity, datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+func KubernetesThroughput(granularity, datasourceName string, queries map[string]parser.Expr, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	var panelName, description string
-	var queries []panel.Option
+	var queryOptions []panel.Option
 
 	switch granularity {
 	case "cluster":
 		panelName = "ThroughPut(Read+Write)"
 		description = "Shows Throughput(Read+Write) by namespace."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesThroughput1"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{namespace}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesThroughput1",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{namespace}}"),
 			),
 		}
 	case "namespace-pod":
 		panelName = "ThroughPut(Read+Write)"
 		description = "Shows Throughput(Read+Write) by pods in a namespace."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesThroughput2"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{pod}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesThroughput2",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{pod}}"),
 			),
 		}
 	case "pod":
 		panelName = "ThroughPut(Pod)"
 		description = "Shows Throughput of a pod, split by read and write."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesThroughput3"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("Writes"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesThroughput3",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("Writes"),
 			),
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesThroughput4"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("Reads"),
-				),
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesThroughput4",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("Reads"),
 			),
 		}
 	case "pod-container":
 		panelName = "ThroughPut(Reads+Writes Containers) "
 		description = "Shows Throughput(Reads+Writes) by containers in a pod."
-		queries = []panel.Option{
-			panel.AddQuery(
-				query.PromQL(
-					promql.SetLabelMatchersV2(
-						KubernetesCommonPanelQueries["KubernetesThroughput5"],
-						labelMatchers,
-					).Pretty(0),
-					dashboards.AddQueryDataSource(datasourceName),
-					query.SeriesNameFormat("{{container}}"),
-				),
+		queryOptions = []panel.Option{
+			promql.AddQueryFrom(
+				"KubernetesCommonPanelQueries", queries,
+				"KubernetesThroughput5",
+				labelMatchers,
+				dashboards.AddQueryDataSource(datasourceName),
+				query.SeriesNameFormat("{{container}}"),
 			),
 		}
 	}
@@ -228,7 +199,7 @@ func KubernetesThroughput(granularity, datasourceName string, labelMatchers ...*
 			}),
 		),
 	}
-	panelOpts = append(panelOpts, queries...)
+	panelOpts = append(panelOpts, queryOptions...)
 
 	return panelgroup.AddPanel(panelName, panelOpts...)
 }
@@ -13,15 +13,6 @@
 
 package nodeexporter
 
-var NODE_EXPORTER_LABEL_VALUE = "node"
-
-// GetNodeExporterLabelValue returns the current job label value for node-exporter dashboards.
-func GetNodeExporterLabelValue() string {
-	return NODE_EXPORTER_LABEL_VALUE
-}
-
-// SetNodeExporterLabelValue sets the job label value for node-exporter dashboards globally.
-// WARNING: Ensure you only set this to a value that represents a Node Exporter specifically.
-func SetNodeExporterLabelValue(labelValue string) {
-	NODE_EXPORTER_LABEL_VALUE = labelValue
-}
+// DefaultJobLabelValue is the job label value selecting node-exporter targets unless a
+// dashboard or rules option says otherwise.
+const DefaultJobLabelValue = "node"
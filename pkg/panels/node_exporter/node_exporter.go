@@ -57,15 +57,12 @@ func NodeCPUUsagePercentage(datasourceName string, labelMatchers ...*labels.Matc
 				Values:   []commonSdk.Calculation{commonSdk.LastCalculation},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					NodeExporterCommonPanelQueries["NodeExporterCPUUsagePercentage"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{device}} - CPU - Usage"),
-			),
+		promql.AddQueryFrom(
+			"NodeExporterCommonPanelQueries", NodeExporterCommonPanelQueries,
+			"NodeExporterCPUUsagePercentage",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{device}} - CPU - Usage"),
 		),
 	)
 }
@@ -100,15 +97,12 @@ func ClusterNodeCPUUsagePercentage(datasourceName string, labelMatchers ...*labe
 				Values:   []commonSdk.Calculation{commonSdk.LastCalculation},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					NodeExporterCommonPanelQueries["NodeExporterClusterNodeCPUUsagePercentage"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}}"),
-			),
+		promql.AddQueryFrom(
+			"NodeExporterCommonPanelQueries", NodeExporterCommonPanelQueries,
+			"NodeExporterClusterNodeCPUUsagePercentage",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}}"),
 		),
 	)
 }
@@ -147,15 +141,12 @@ func ClusterNodeCPUSaturationPercentage(datasourceName string, labelMatchers ...
 				Values:   []commonSdk.Calculation{commonSdk.LastCalculation},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					NodeExporterCommonPanelQueries["NodeExporterClusterNodeCPUSaturationPercentage"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}}"),
-			),
+		promql.AddQueryFrom(
+			"NodeExporterCommonPanelQueries", NodeExporterCommonPanelQueries,
+			"NodeExporterClusterNodeCPUSaturationPercentage",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}}"),
 		),
 	)
 }
@@ -190,15 +181,12 @@ func ClusterNodeMemoryUsagePercentage(datasourceName string, labelMatchers ...*l
 				Values:   []commonSdk.Calculation{commonSdk.LastCalculation},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					NodeExporterCommonPanelQueries["NodeExporterClusterNodeMemoryUsagePercentage"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}}"),
-			),
+		promql.AddQueryFrom(
+			"NodeExporterCommonPanelQueries", NodeExporterCommonPanelQueries,
+			"NodeExporterClusterNodeMemoryUsagePercentage",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}}"),
 		),
 	)
 }
@@ -237,15 +225,12 @@ func ClusterNodeMemorySaturationPercentage(datasourceName string, labelMatchers
 				Values:   []commonSdk.Calculation{commonSdk.LastCalculation},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					NodeExporterCommonPanelQueries["NodeExporterClusterNodeMemorySaturationPercentage"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}}"),
-			),
+		promql.AddQueryFrom(
+			"NodeExporterCommonPanelQueries", NodeExporterCommonPanelQueries,
+			"NodeExporterClusterNodeMemorySaturationPercentage",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}}"),
 		),
 	)
 }
@@ -284,15 +269,12 @@ func ClusterNodeDiskUsagePercentage(datasourceName string, labelMatchers ...*lab
 				Values:   []commonSdk.Calculation{commonSdk.LastCalculation},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					NodeExporterCommonPanelQueries["NodeExporterClusterNodeDiskUsagePercentage"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}}"),
-			),
+		promql.AddQueryFrom(
+			"NodeExporterCommonPanelQueries", NodeExporterCommonPanelQueries,
+			"NodeExporterClusterNodeDiskUsagePercentage",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}}"),
 		),
 	)
 }
@@ -320,15 +302,12 @@ func ClusterNodeDiskSaturationPercentage(datasourceName string, labelMatchers ..
 				Values:   []commonSdk.Calculation{commonSdk.LastCalculation},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					NodeExporterCommonPanelQueries["NodeExporterClusterNodeDiskSaturationPercentage"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}}"),
-			),
+		promql.AddQueryFrom(
+			"NodeExporterCommonPanelQueries", NodeExporterCommonPanelQueries,
+			"NodeExporterClusterNodeDiskSaturationPercentage",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}}"),
 		),
 	)
 }
@@ -357,15 +336,12 @@ func ClusterNodeDiskSpacePercentage(datasourceName string, labelMatchers ...*lab
 				Values:   []commonSdk.Calculation{commonSdk.LastCalculation},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					NodeExporterCommonPanelQueries["NodeExporterClusterNodeDiskSpacePercentage"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}}"),
-			),
+		promql.AddQueryFrom(
+			"NodeExporterCommonPanelQueries", NodeExporterCommonPanelQueries,
+			"NodeExporterClusterNodeDiskSpacePercentage",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}}"),
 		),
 	)
 }
@@ -393,15 +369,12 @@ func ClusterNodeNetworkSaturationBytes(datasourceName string, labelMatchers ...*
 				Values:   []commonSdk.Calculation{commonSdk.LastCalculation},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					NodeExporterCommonPanelQueries["NodeExporterClusterNodeNetworkSaturationBytes"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} - Network - Received"),
-			),
+		promql.AddQueryFrom(
+			"NodeExporterCommonPanelQueries", NodeExporterCommonPanelQueries,
+			"NodeExporterClusterNodeNetworkSaturationBytes",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} - Network - Received"),
 		),
 	)
 }
@@ -430,25 +403,19 @@ func ClusterNodeNetworkUsageBytes(datasourceName string, labelMatchers ...*label
 				Values:   []commonSdk.Calculation{commonSdk.LastCalculation},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					NodeExporterCommonPanelQueries["NodeExporterClusterNodeNetworkUsageBytesRecv"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} - Network - Received"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					NodeExporterCommonPanelQueries["NodeExporterClusterNodeNetworkUsageBytesTrams"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} - Network - Transmitted"),
-			),
+		promql.AddQueryFrom(
+			"NodeExporterCommonPanelQueries", NodeExporterCommonPanelQueries,
+			"NodeExporterClusterNodeNetworkUsageBytesRecv",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} - Network - Received"),
+		),
+		promql.AddQueryFrom(
+			"NodeExporterCommonPanelQueries", NodeExporterCommonPanelQueries,
+			"NodeExporterClusterNodeNetworkUsageBytesTrams",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} - Network - Transmitted"),
 		),
 	)
 }
@@ -481,45 +448,33 @@ func NodeAverage(datasourceName string, labelMatchers ...*labels.Matcher) panelg
 				Values:   []commonSdk.Calculation{commonSdk.LastCalculation},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					NodeExporterCommonPanelQueries["NodeExporterNodeAverageLoad1"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("CPU - 1m Average"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					NodeExporterCommonPanelQueries["NodeExporterNodeAverageLoad5"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("CPU - 5m Average"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					NodeExporterCommonPanelQueries["NodeExporterNodeAverageLoad15"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("CPU - 15m Average"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					NodeExporterCommonPanelQueries["NodeExporterNodeAverageCountCPU"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("CPU - Logical Cores"),
-			),
+		promql.AddQueryFrom(
+			"NodeExporterCommonPanelQueries", NodeExporterCommonPanelQueries,
+			"NodeExporterNodeAverageLoad1",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("CPU - 1m Average"),
+		),
+		promql.AddQueryFrom(
+			"NodeExporterCommonPanelQueries", NodeExporterCommonPanelQueries,
+			"NodeExporterNodeAverageLoad5",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("CPU - 5m Average"),
+		),
+		promql.AddQueryFrom(
+			"NodeExporterCommonPanelQueries", NodeExporterCommonPanelQueries,
+			"NodeExporterNodeAverageLoad15",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("CPU - 15m Average"),
+		),
+		promql.AddQueryFrom(
+			"NodeExporterCommonPanelQueries", NodeExporterCommonPanelQueries,
+			"NodeExporterNodeAverageCountCPU",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("CPU - Logical Cores"),
 		),
 	)
 }
@@ -556,35 +511,26 @@ func NodeMemoryUsageBytes(datasourceName string, labelMatchers ...*labels.Matche
 				Values:   []commonSdk.Calculation{commonSdk.LastCalculation},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					NodeExporterCommonPanelQueries["NodeExporterNodeMemoryUsageBytesBuffers"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Memory - Buffers"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					NodeExporterCommonPanelQueries["NodeExporterNodeMemoryUsageBytesCached"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Memory - Cached"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					NodeExporterCommonPanelQueries["NodeExporterNodeMemoryUsageBytesMemFree"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Memory - Free"),
-			),
+		promql.AddQueryFrom(
+			"NodeExporterCommonPanelQueries", NodeExporterCommonPanelQueries,
+			"NodeExporterNodeMemoryUsageBytesBuffers",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Memory - Buffers"),
+		),
+		promql.AddQueryFrom(
+			"NodeExporterCommonPanelQueries", NodeExporterCommonPanelQueries,
+			"NodeExporterNodeMemoryUsageBytesCached",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Memory - Cached"),
+		),
+		promql.AddQueryFrom(
+			"NodeExporterCommonPanelQueries", NodeExporterCommonPanelQueries,
+			"NodeExporterNodeMemoryUsageBytesMemFree",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Memory - Free"),
 		),
 	)
 }
@@ -627,15 +573,12 @@ func NodeMemoryUsagePercentage(datasourceName string, labelMatchers ...*labels.M
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					NodeExporterCommonPanelQueries["NodeExporterNodeMemoryUsagePercentage"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Memory - Usage"),
-			),
+		promql.AddQueryFrom(
+			"NodeExporterCommonPanelQueries", NodeExporterCommonPanelQueries,
+			"NodeExporterNodeMemoryUsagePercentage",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Memory - Usage"),
 		),
 	)
 }
@@ -671,25 +614,19 @@ func NodeDiskIOBytes(datasourceName string, labelMatchers ...*labels.Matcher) pa
 				Values:   []commonSdk.Calculation{commonSdk.LastCalculation},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					NodeExporterCommonPanelQueries["NodeExporterNodeDiskIOBytesTotal"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{device}} - Disk - Usage"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					NodeExporterCommonPanelQueries["NodeExporterNodeDiskIOBytesTime"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{device}} - Disk - Written"),
-			),
+		promql.AddQueryFrom(
+			"NodeExporterCommonPanelQueries", NodeExporterCommonPanelQueries,
+			"NodeExporterNodeDiskIOBytesTotal",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{device}} - Disk - Usage"),
+		),
+		promql.AddQueryFrom(
+			"NodeExporterCommonPanelQueries", NodeExporterCommonPanelQueries,
+			"NodeExporterNodeDiskIOBytesTime",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{device}} - Disk - Written"),
 		),
 	)
 }
@@ -717,15 +654,12 @@ func NodeDiskIOSeconds(datasourceName string, labelMatchers ...*labels.Matcher)
 				Values:   []commonSdk.Calculation{commonSdk.LastCalculation},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					NodeExporterCommonPanelQueries["NodeExporterNodeDiskIOSeconds"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{device}} - Disk - IO Time"),
-			),
+		promql.AddQueryFrom(
+			"NodeExporterCommonPanelQueries", NodeExporterCommonPanelQueries,
+			"NodeExporterNodeDiskIOSeconds",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{device}} - Disk - IO Time"),
 		),
 	)
 }
@@ -761,15 +695,12 @@ func NodeNetworkReceivedBytes(datasourceName string, labelMatchers ...*labels.Ma
 				Values:   []commonSdk.Calculation{commonSdk.LastCalculation},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					NodeExporterCommonPanelQueries["NodeExporterNodeNetworkReceivedBytes"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{device}} - Network - Received"),
-			),
+		promql.AddQueryFrom(
+			"NodeExporterCommonPanelQueries", NodeExporterCommonPanelQueries,
+			"NodeExporterNodeNetworkReceivedBytes",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{device}} - Network - Received"),
 		),
 	)
 }
@@ -805,15 +736,12 @@ func NodeNetworkTransmitedBytes(datasourceName string, labelMatchers ...*labels.
 				Values:   []commonSdk.Calculation{commonSdk.LastCalculation},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					NodeExporterCommonPanelQueries["NodeExporterNodeNetworkReceivedBytes"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{device}} - Network - Transmitted"),
-			),
+		promql.AddQueryFrom(
+			"NodeExporterCommonPanelQueries", NodeExporterCommonPanelQueries,
+			"NodeExporterNodeNetworkReceivedBytes",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{device}} - Network - Transmitted"),
 		),
 	)
 }
@@ -0,0 +1,33 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodeexporter
+
+import (
+	"testing"
+
+	"github.com/perses/community-mixins/pkg/querytest"
+)
+
+func TestNodeExporterCommonPanelQueries(t *testing.T) {
+	querytest.Suite{
+		SeriesFile: "testdata/series.test",
+		Queries:    NodeExporterCommonPanelQueries,
+		Variables:  map[string]string{"instance": "10.0.0.1:9100"},
+		Labels: map[string][]string{
+			"NodeExporterCPUUsagePercentage":       {`{job="node", instance="10.0.0.1:9100", cpu="0"}`, `{job="node", instance="10.0.0.1:9100", cpu="1"}`},
+			"NodeExporterNodeAverageCountCPU":      {`{}`},
+			"NodeExporterNodeNetworkReceivedBytes": {`{job="node", instance="10.0.0.1:9100", device="eth0"}`},
+		},
+	}.Run(t)
+}
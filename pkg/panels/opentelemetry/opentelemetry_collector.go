@@ -48,25 +48,19 @@ func SpanRate(datasource string, labelMatchers ...*labels.Matcher) panelgroup.Op
 				Values:   []commonSdk.Calculation{commonSdk.LastCalculation},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					OpentelemetryCommonPanelQueries["SpanRate_accepted"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasource),
-				query.SeriesNameFormat("Accepted: {{receiver}}"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					OpentelemetryCommonPanelQueries["SpanRate_refused"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasource),
-				query.SeriesNameFormat("Refused: {{receiver}}"),
-			),
+		promql.AddQueryFrom(
+			"OpentelemetryCommonPanelQueries", OpentelemetryCommonPanelQueries,
+			"SpanRate_accepted",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasource),
+			query.SeriesNameFormat("Accepted: {{receiver}}"),
+		),
+		promql.AddQueryFrom(
+			"OpentelemetryCommonPanelQueries", OpentelemetryCommonPanelQueries,
+			"SpanRate_refused",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasource),
+			query.SeriesNameFormat("Refused: {{receiver}}"),
 		),
 	)
 }
@@ -95,25 +89,19 @@ func MeticPointsRate(datasource string, labelMatchers ...*labels.Matcher) panelg
 				Values:   []commonSdk.Calculation{commonSdk.LastCalculation},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					OpentelemetryCommonPanelQueries["MeticPointsRate_accepted"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasource),
-				query.SeriesNameFormat("Accepted: {{receiver}}"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					OpentelemetryCommonPanelQueries["MeticPointsRate_refused"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasource),
-				query.SeriesNameFormat("Refused: {{receiver}}"),
-			),
+		promql.AddQueryFrom(
+			"OpentelemetryCommonPanelQueries", OpentelemetryCommonPanelQueries,
+			"MeticPointsRate_accepted",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasource),
+			query.SeriesNameFormat("Accepted: {{receiver}}"),
+		),
+		promql.AddQueryFrom(
+			"OpentelemetryCommonPanelQueries", OpentelemetryCommonPanelQueries,
+			"MeticPointsRate_refused",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasource),
+			query.SeriesNameFormat("Refused: {{receiver}}"),
 		),
 	)
 }
@@ -142,25 +130,19 @@ func LogRecordsRate(datasource string, labelMatchers ...*labels.Matcher) panelgr
 				Values:   []commonSdk.Calculation{commonSdk.LastCalculation},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					OpentelemetryCommonPanelQueries["LogRecordsRate_accepted"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasource),
-				query.SeriesNameFormat("Accepted: {{receiver}}"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					OpentelemetryCommonPanelQueries["LogRecordsRate_refused"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasource),
-				query.SeriesNameFormat("Refused: {{receiver}}"),
-			),
+		promql.AddQueryFrom(
+			"OpentelemetryCommonPanelQueries", OpentelemetryCommonPanelQueries,
+			"LogRecordsRate_accepted",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasource),
+			query.SeriesNameFormat("Accepted: {{receiver}}"),
+		),
+		promql.AddQueryFrom(
+			"OpentelemetryCommonPanelQueries", OpentelemetryCommonPanelQueries,
+			"LogRecordsRate_refused",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasource),
+			query.SeriesNameFormat("Refused: {{receiver}}"),
 		),
 	)
 }
@@ -188,25 +170,19 @@ func SpanProcessorRate(datasource string, labelMatchers ...*labels.Matcher) pane
 				Values:   []commonSdk.Calculation{commonSdk.LastCalculation},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					OpentelemetryCommonPanelQueries["SpanProcessorRate_incoming_items"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasource),
-				query.SeriesNameFormat("Incoming: {{processor}}"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					OpentelemetryCommonPanelQueries["SpanProcessorRate_outgoing_items"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasource),
-				query.SeriesNameFormat("Outgoing: {{processor}}"),
-			),
+		promql.AddQueryFrom(
+			"OpentelemetryCommonPanelQueries", OpentelemetryCommonPanelQueries,
+			"SpanProcessorRate_incoming_items",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasource),
+			query.SeriesNameFormat("Incoming: {{processor}}"),
+		),
+		promql.AddQueryFrom(
+			"OpentelemetryCommonPanelQueries", OpentelemetryCommonPanelQueries,
+			"SpanProcessorRate_outgoing_items",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasource),
+			query.SeriesNameFormat("Outgoing: {{processor}}"),
 		),
 	)
 }
@@ -234,25 +210,19 @@ func MetricProcessorRate(datasource string, labelMatchers ...*labels.Matcher) pa
 				Values:   []commonSdk.Calculation{commonSdk.LastCalculation},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					OpentelemetryCommonPanelQueries["MetricProcessorRate_incoming_items"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasource),
-				query.SeriesNameFormat("Incoming: {{processor}}"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					OpentelemetryCommonPanelQueries["MetricProcessorRate_outgoing_items"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasource),
-				query.SeriesNameFormat("Outgoing: {{processor}}"),
-			),
+		promql.AddQueryFrom(
+			"OpentelemetryCommonPanelQueries", OpentelemetryCommonPanelQueries,
+			"MetricProcessorRate_incoming_items",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasource),
+			query.SeriesNameFormat("Incoming: {{processor}}"),
+		),
+		promql.AddQueryFrom(
+			"OpentelemetryCommonPanelQueries", OpentelemetryCommonPanelQueries,
+			"MetricProcessorRate_outgoing_items",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasource),
+			query.SeriesNameFormat("Outgoing: {{processor}}"),
 		),
 	)
 }
@@ -280,25 +250,19 @@ func LogProcessorRate(datasource string, labelMatchers ...*labels.Matcher) panel
 				Values:   []commonSdk.Calculation{commonSdk.LastCalculation},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					OpentelemetryCommonPanelQueries["LogProcessorRate_incoming_items"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasource),
-				query.SeriesNameFormat("Incoming: {{processor}}"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					OpentelemetryCommonPanelQueries["LogProcessorRate_outgoing_items"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasource),
-				query.SeriesNameFormat("Outgoing: {{processor}}"),
-			),
+		promql.AddQueryFrom(
+			"OpentelemetryCommonPanelQueries", OpentelemetryCommonPanelQueries,
+			"LogProcessorRate_incoming_items",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasource),
+			query.SeriesNameFormat("Incoming: {{processor}}"),
+		),
+		promql.AddQueryFrom(
+			"OpentelemetryCommonPanelQueries", OpentelemetryCommonPanelQueries,
+			"LogProcessorRate_outgoing_items",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasource),
+			query.SeriesNameFormat("Outgoing: {{processor}}"),
 		),
 	)
 }
@@ -326,15 +290,12 @@ func BatchProcessorBatchSendSize(datasource string, labelMatchers ...*labels.Mat
 				Values:   []commonSdk.Calculation{commonSdk.LastCalculation},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					OpentelemetryCommonPanelQueries["BatchProcessorRate_batch_send_size"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasource),
-				query.SeriesNameFormat("{{le}}"),
-			),
+		promql.AddQueryFrom(
+			"OpentelemetryCommonPanelQueries", OpentelemetryCommonPanelQueries,
+			"BatchProcessorRate_batch_send_size",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasource),
+			query.SeriesNameFormat("{{le}}"),
 		),
 	)
 }
@@ -359,25 +320,19 @@ func BatchProcessorBatchSendSizeCount(datasource string, labelMatchers ...*label
 				Values:   []commonSdk.Calculation{commonSdk.LastCalculation},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					OpentelemetryCommonPanelQueries["BatchProcessorRate_batch_send_size_count"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasource),
-				query.SeriesNameFormat("Batch send size count: {{processor}}"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					OpentelemetryCommonPanelQueries["BatchProcessorRate_batch_send_size_sum"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasource),
-				query.SeriesNameFormat("Batch send size sum: {{processor}}"),
-			),
+		promql.AddQueryFrom(
+			"OpentelemetryCommonPanelQueries", OpentelemetryCommonPanelQueries,
+			"BatchProcessorRate_batch_send_size_count",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasource),
+			query.SeriesNameFormat("Batch send size count: {{processor}}"),
+		),
+		promql.AddQueryFrom(
+			"OpentelemetryCommonPanelQueries", OpentelemetryCommonPanelQueries,
+			"BatchProcessorRate_batch_send_size_sum",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasource),
+			query.SeriesNameFormat("Batch send size sum: {{processor}}"),
 		),
 	)
 }
@@ -398,25 +353,19 @@ func BatchProcessorBatchSizeTriggerSend(datasource string, labelMatchers ...*lab
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					OpentelemetryCommonPanelQueries["BatchProcessorRate_batch_size_trigger_send"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasource),
-				query.SeriesNameFormat("Batch sent due to a timeout trigger: {{processor}}"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					OpentelemetryCommonPanelQueries["BatchProcessorRate_batch_timeout_trigger_send"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasource),
-				query.SeriesNameFormat("Batch sent due to a timeout trigger: {{processor}}"),
-			),
+		promql.AddQueryFrom(
+			"OpentelemetryCommonPanelQueries", OpentelemetryCommonPanelQueries,
+			"BatchProcessorRate_batch_size_trigger_send",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasource),
+			query.SeriesNameFormat("Batch sent due to a timeout trigger: {{processor}}"),
+		),
+		promql.AddQueryFrom(
+			"OpentelemetryCommonPanelQueries", OpentelemetryCommonPanelQueries,
+			"BatchProcessorRate_batch_timeout_trigger_send",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasource),
+			query.SeriesNameFormat("Batch sent due to a timeout trigger: {{processor}}"),
 		),
 	)
 }
@@ -437,35 +386,26 @@ func SpanExporterRate(datasource string, labelMatchers ...*labels.Matcher) panel
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					OpentelemetryCommonPanelQueries["ExporterRate_sent_spans"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasource),
-				query.SeriesNameFormat("Sent: {{exporter}}"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					OpentelemetryCommonPanelQueries["ExporterRate_enqueue_failed_spans"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasource),
-				query.SeriesNameFormat("Enqueue failed: {{exporter}}"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					OpentelemetryCommonPanelQueries["ExporterRate_send_failed_spans"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasource),
-				query.SeriesNameFormat("Send failed: {{exporter}}"),
-			),
+		promql.AddQueryFrom(
+			"OpentelemetryCommonPanelQueries", OpentelemetryCommonPanelQueries,
+			"ExporterRate_sent_spans",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasource),
+			query.SeriesNameFormat("Sent: {{exporter}}"),
+		),
+		promql.AddQueryFrom(
+			"OpentelemetryCommonPanelQueries", OpentelemetryCommonPanelQueries,
+			"ExporterRate_enqueue_failed_spans",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasource),
+			query.SeriesNameFormat("Enqueue failed: {{exporter}}"),
+		),
+		promql.AddQueryFrom(
+			"OpentelemetryCommonPanelQueries", OpentelemetryCommonPanelQueries,
+			"ExporterRate_send_failed_spans",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasource),
+			query.SeriesNameFormat("Send failed: {{exporter}}"),
 		),
 	)
 }
@@ -486,35 +426,26 @@ func MetricExporterRate(datasource string, labelMatchers ...*labels.Matcher) pan
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					OpentelemetryCommonPanelQueries["ExporterRate_sent_metrics"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasource),
-				query.SeriesNameFormat("Sent: {{exporter}}"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					OpentelemetryCommonPanelQueries["ExporterRate_enqueue_failed_metrics"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasource),
-				query.SeriesNameFormat("Enqueue failed: {{exporter}}"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					OpentelemetryCommonPanelQueries["ExporterRate_send_failed_metrics"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasource),
-				query.SeriesNameFormat("Send failed: {{exporter}}"),
-			),
+		promql.AddQueryFrom(
+			"OpentelemetryCommonPanelQueries", OpentelemetryCommonPanelQueries,
+			"ExporterRate_sent_metrics",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasource),
+			query.SeriesNameFormat("Sent: {{exporter}}"),
+		),
+		promql.AddQueryFrom(
+			"OpentelemetryCommonPanelQueries", OpentelemetryCommonPanelQueries,
+			"ExporterRate_enqueue_failed_metrics",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasource),
+			query.SeriesNameFormat("Enqueue failed: {{exporter}}"),
+		),
+		promql.AddQueryFrom(
+			"OpentelemetryCommonPanelQueries", OpentelemetryCommonPanelQueries,
+			"ExporterRate_send_failed_metrics",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasource),
+			query.SeriesNameFormat("Send failed: {{exporter}}"),
 		),
 	)
 }
@@ -535,35 +466,26 @@ func LogExporterRate(datasource string, labelMatchers ...*labels.Matcher) panelg
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					OpentelemetryCommonPanelQueries["ExporterRate_sent_logs"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasource),
-				query.SeriesNameFormat("Sent: {{exporter}}"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					OpentelemetryCommonPanelQueries["ExporterRate_enqueue_failed_logs"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasource),
-				query.SeriesNameFormat("Enqueue failed: {{exporter}}"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					OpentelemetryCommonPanelQueries["ExporterRate_send_failed_logs"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasource),
-				query.SeriesNameFormat("Send failed: {{exporter}}"),
-			),
+		promql.AddQueryFrom(
+			"OpentelemetryCommonPanelQueries", OpentelemetryCommonPanelQueries,
+			"ExporterRate_sent_logs",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasource),
+			query.SeriesNameFormat("Sent: {{exporter}}"),
+		),
+		promql.AddQueryFrom(
+			"OpentelemetryCommonPanelQueries", OpentelemetryCommonPanelQueries,
+			"ExporterRate_enqueue_failed_logs",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasource),
+			query.SeriesNameFormat("Enqueue failed: {{exporter}}"),
+		),
+		promql.AddQueryFrom(
+			"OpentelemetryCommonPanelQueries", OpentelemetryCommonPanelQueries,
+			"ExporterRate_send_failed_logs",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasource),
+			query.SeriesNameFormat("Send failed: {{exporter}}"),
 		),
 	)
 }
@@ -583,15 +505,12 @@ func QueueSizeExporterRate(datasource string, labelMatchers ...*labels.Matcher)
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					OpentelemetryCommonPanelQueries["ExporterRate_queue_size"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasource),
-				query.SeriesNameFormat("Queue size: {{exporter}}"),
-			),
+		promql.AddQueryFrom(
+			"OpentelemetryCommonPanelQueries", OpentelemetryCommonPanelQueries,
+			"ExporterRate_queue_size",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasource),
+			query.SeriesNameFormat("Queue size: {{exporter}}"),
 		),
 	)
 }
@@ -611,15 +530,12 @@ func QueueCapacityExporterRate(datasource string, labelMatchers ...*labels.Match
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					OpentelemetryCommonPanelQueries["ExporterRate_queue_capacity"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasource),
-				query.SeriesNameFormat("Queue capacity: {{exporter}}"),
-			),
+		promql.AddQueryFrom(
+			"OpentelemetryCommonPanelQueries", OpentelemetryCommonPanelQueries,
+			"ExporterRate_queue_capacity",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasource),
+			query.SeriesNameFormat("Queue capacity: {{exporter}}"),
 		),
 	)
 }
@@ -639,15 +555,12 @@ func QueueUtilizationExporterRate(datasource string, labelMatchers ...*labels.Ma
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					OpentelemetryCommonPanelQueries["ExporterRate_queue_utilization"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasource),
-				query.SeriesNameFormat("Queue utilization: {{exporter}}"),
-			),
+		promql.AddQueryFrom(
+			"OpentelemetryCommonPanelQueries", OpentelemetryCommonPanelQueries,
+			"ExporterRate_queue_utilization",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasource),
+			query.SeriesNameFormat("Queue utilization: {{exporter}}"),
 		),
 	)
 }
@@ -0,0 +1,43 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opentelemetry
+
+import (
+	"testing"
+
+	"github.com/perses/community-mixins/pkg/querytest"
+)
+
+func TestOpentelemetryCommonPanelQueries(t *testing.T) {
+	querytest.Suite{
+		SeriesFile: "testdata/series.test",
+		Queries:    OpentelemetryCommonPanelQueries,
+		Variables: map[string]string{
+			"job":       "otel-collector",
+			"receiver":  "otlp",
+			"processor": ".*",
+			"exporter":  "otlp",
+		},
+		Labels: map[string][]string{
+			"ExporterRate_queue_utilization":   {`{exporter="otlp"}`},
+			"SpanProcessorRate_incoming_items": {`{job="otel-collector", processor="memory_limiter", otel_signal="traces"}`},
+			"BatchProcessorRate_batch_send_size": {
+				`{job="otel-collector", processor="batch", le="10"}`,
+				`{job="otel-collector", processor="batch", le="100"}`,
+				`{job="otel-collector", processor="batch", le="1000"}`,
+				`{job="otel-collector", processor="batch", le="+Inf"}`,
+			},
+		},
+	}.Run(t)
+}
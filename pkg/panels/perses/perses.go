@@ -59,14 +59,11 @@ func StatsTable(datasourceName string, labelMatchers ...*labels.Matcher) panelgr
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PersesCommonPanelQueries["PersesStatsTable"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"PersesCommonPanelQueries", PersesCommonPanelQueries,
+			"PersesStatsTable",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
@@ -92,15 +89,12 @@ func HTTPRequestsLatencyPanel(datasourceName string, labelMatchers ...*labels.Ma
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PersesCommonPanelQueries["PersesHTTPLatency"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{handler}} {{method}}"),
-			),
+		promql.AddQueryFrom(
+			"PersesCommonPanelQueries", PersesCommonPanelQueries,
+			"PersesHTTPLatency",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{handler}} {{method}}"),
 		),
 	)
 }
@@ -127,15 +121,12 @@ func HTTPRequestsRatePanel(datasourceName string, labelMatchers ...*labels.Match
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PersesCommonPanelQueries["PersesHTTPRequestRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{handler}} {{method}} {{code}}"),
-			),
+		promql.AddQueryFrom(
+			"PersesCommonPanelQueries", PersesCommonPanelQueries,
+			"PersesHTTPRequestRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{handler}} {{method}} {{code}}"),
 		),
 	)
 }
@@ -169,15 +160,12 @@ func HTTPErrorPercentagePanel(datasourceName string, labelMatchers ...*labels.Ma
 				},
 			),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PersesCommonPanelQueries["PersesHTTPErrorsPercentage"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{handler}} {{code}}"),
-			),
+		promql.AddQueryFrom(
+			"PersesCommonPanelQueries", PersesCommonPanelQueries,
+			"PersesHTTPErrorsPercentage",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{handler}} {{code}}"),
 		),
 	)
 }
@@ -204,25 +192,19 @@ func FileDescriptors(datasourceName string, labelMatchers ...*labels.Matcher) pa
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PersesCommonPanelQueries["PersesFileDescriptorsOpenFDS"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} - {{pod}} Open FDs"),
-			),
+		promql.AddQueryFrom(
+			"PersesCommonPanelQueries", PersesCommonPanelQueries,
+			"PersesFileDescriptorsOpenFDS",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} - {{pod}} Open FDs"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PersesCommonPanelQueries["PersesFileDescriptorsMaxFDS"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} - {{pod}} - Max FDs"),
-			),
+		promql.AddQueryFrom(
+			"PersesCommonPanelQueries", PersesCommonPanelQueries,
+			"PersesFileDescriptorsMaxFDS",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} - {{pod}} - Max FDs"),
 		),
 	)
 }
@@ -249,15 +231,12 @@ func PluginSchemaLoadAttempts(datasourceName string, labelMatchers ...*labels.Ma
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PersesCommonPanelQueries["PersesPluginSchemaLoadAttempts"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{pod}} - {{schema}} - {{status}}"),
-			),
+		promql.AddQueryFrom(
+			"PersesCommonPanelQueries", PersesCommonPanelQueries,
+			"PersesPluginSchemaLoadAttempts",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{pod}} - {{schema}} - {{status}}"),
 		),
 	)
 }
@@ -0,0 +1,38 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package perses
+
+import (
+	"testing"
+
+	"github.com/perses/community-mixins/pkg/querytest"
+)
+
+func TestPersesCommonPanelQueries(t *testing.T) {
+	querytest.Suite{
+		SeriesFile: "testdata/series.test",
+		Queries:    PersesCommonPanelQueries,
+		Variables: map[string]string{
+			"job":      "perses",
+			"instance": "perses-0:8080",
+		},
+		Labels: map[string][]string{
+			"PersesHTTPErrorsPercentage": {
+				`{handler="/api/v1/dashboards", code="404"}`,
+				`{handler="/api/v1/projects", code="404"}`,
+			},
+			"PersesStatsTable": {`{job="perses", instance="perses-0:8080", version="0.52.0", namespace="perses", pod="perses-0"}`},
+		},
+	}.Run(t)
+}
@@ -60,14 +60,11 @@ func PrometheusStatsTable(datasourceName string, labelMatchers ...*labels.Matche
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PrometheusCommonPanelQueries["PrometheusStatsTable"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"PrometheusCommonPanelQueries", PrometheusCommonPanelQueries,
+			"PrometheusStatsTable",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
@@ -101,15 +98,12 @@ func PrometheusTargetSync(datasourceName string, labelMatchers ...*labels.Matche
 				Mode:     timeSeriesPanel.TableMode,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PrometheusCommonPanelQueries["PrometheusTargetSync"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}} - {{instance}} - Metrics"),
-			),
+		promql.AddQueryFrom(
+			"PrometheusCommonPanelQueries", PrometheusCommonPanelQueries,
+			"PrometheusTargetSync",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}} - {{instance}} - Metrics"),
 		),
 	)
 }
@@ -138,15 +132,12 @@ func PrometheusTargets(datasourceName string, labelMatchers ...*labels.Matcher)
 				Mode:     timeSeriesPanel.TableMode,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PrometheusCommonPanelQueries["PrometheusTargets"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}} - {{instance}} - Metrics"),
-			),
+		promql.AddQueryFrom(
+			"PrometheusCommonPanelQueries", PrometheusCommonPanelQueries,
+			"PrometheusTargets",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}} - {{instance}} - Metrics"),
 		),
 	)
 }
@@ -180,15 +171,12 @@ func PrometheusAverageScrapeIntervalDuration(datasourceName string, labelMatcher
 				Mode:     timeSeriesPanel.TableMode,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PrometheusCommonPanelQueries["PrometheusAverageScrapeIntervalDuration"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}} - {{instance}} - {{interval}} Configured"),
-			),
+		promql.AddQueryFrom(
+			"PrometheusCommonPanelQueries", PrometheusCommonPanelQueries,
+			"PrometheusAverageScrapeIntervalDuration",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}} - {{instance}} - {{interval}} Configured"),
 		),
 	)
 }
@@ -214,55 +202,40 @@ func PrometheusScrapeFailures(datasourceName string, labelMatchers ...*labels.Ma
 				Mode:     timeSeriesPanel.TableMode,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PrometheusCommonPanelQueries["PrometheusScrapeFailures_exceededBodySizeLimit"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("exceeded body size limit: {{job}} - {{instance}} - Metrics"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PrometheusCommonPanelQueries["PrometheusScrapeFailures_exceededSampleLimit"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("exceeded sample limit: {{job}} - {{instance}} - Metrics"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PrometheusCommonPanelQueries["PrometheusScrapeFailures_duplicateTimestamp"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("duplicate timestamp: {{job}} - {{instance}} - Metrics"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PrometheusCommonPanelQueries["PrometheusScrapeFailures_outOfBounds"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("out of bounds: {{job}} - {{instance}} - Metrics"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PrometheusCommonPanelQueries["PrometheusScrapeFailures_outOfOrder"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("out of order: {{job}} - {{instance}} - Metrics"),
-			),
+		promql.AddQueryFrom(
+			"PrometheusCommonPanelQueries", PrometheusCommonPanelQueries,
+			"PrometheusScrapeFailures_exceededBodySizeLimit",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("exceeded body size limit: {{job}} - {{instance}} - Metrics"),
+		),
+		promql.AddQueryFrom(
+			"PrometheusCommonPanelQueries", PrometheusCommonPanelQueries,
+			"PrometheusScrapeFailures_exceededSampleLimit",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("exceeded sample limit: {{job}} - {{instance}} - Metrics"),
+		),
+		promql.AddQueryFrom(
+			"PrometheusCommonPanelQueries", PrometheusCommonPanelQueries,
+			"PrometheusScrapeFailures_duplicateTimestamp",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("duplicate timestamp: {{job}} - {{instance}} - Metrics"),
+		),
+		promql.AddQueryFrom(
+			"PrometheusCommonPanelQueries", PrometheusCommonPanelQueries,
+			"PrometheusScrapeFailures_outOfBounds",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("out of bounds: {{job}} - {{instance}} - Metrics"),
+		),
+		promql.AddQueryFrom(
+			"PrometheusCommonPanelQueries", PrometheusCommonPanelQueries,
+			"PrometheusScrapeFailures_outOfOrder",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("out of order: {{job}} - {{instance}} - Metrics"),
 		),
 	)
 }
@@ -284,15 +257,12 @@ func PrometheusAppendedSamples(datasourceName string, labelMatchers ...*labels.M
 				Mode:     timeSeriesPanel.TableMode,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PrometheusCommonPanelQueries["PrometheusAppendedSamples"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}} - {{instance}} - {{remote_name}} - {{url}}"),
-			),
+		promql.AddQueryFrom(
+			"PrometheusCommonPanelQueries", PrometheusCommonPanelQueries,
+			"PrometheusAppendedSamples",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}} - {{instance}} - {{remote_name}} - {{url}}"),
 		),
 	)
 }
@@ -313,15 +283,12 @@ func PrometheusHeadSeries(datasourceName string, labelMatchers ...*labels.Matche
 				Mode:     timeSeriesPanel.TableMode,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PrometheusCommonPanelQueries["PrometheusHeadSeries"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}} - {{instance}} - Head Series"),
-			),
+		promql.AddQueryFrom(
+			"PrometheusCommonPanelQueries", PrometheusCommonPanelQueries,
+			"PrometheusHeadSeries",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}} - {{instance}} - Head Series"),
 		),
 	)
 }
@@ -343,15 +310,12 @@ func PrometheusHeadChunks(datasourceName string, labelMatchers ...*labels.Matche
 				Mode:     timeSeriesPanel.TableMode,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PrometheusCommonPanelQueries["PrometheusHeadChunks"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}} - {{instance}} - Head Chunks"),
-			),
+		promql.AddQueryFrom(
+			"PrometheusCommonPanelQueries", PrometheusCommonPanelQueries,
+			"PrometheusHeadChunks",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}} - {{instance}} - Head Chunks"),
 		),
 	)
 }
@@ -379,15 +343,12 @@ func PrometheusQueryRate(datasourceName string, labelMatchers ...*labels.Matcher
 				Mode:     timeSeriesPanel.TableMode,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PrometheusCommonPanelQueries["PrometheusQueryRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}} - {{instance}} - Query Rate"),
-			),
+		promql.AddQueryFrom(
+			"PrometheusCommonPanelQueries", PrometheusCommonPanelQueries,
+			"PrometheusQueryRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}} - {{instance}} - Query Rate"),
 		),
 	)
 }
@@ -422,15 +383,12 @@ func PrometheusQueryStateDuration(datasourceName string, labelMatchers ...*label
 				Mode:     timeSeriesPanel.TableMode,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PrometheusCommonPanelQueries["PrometheusQueryStateDuration"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{slice}} - Duration"),
-			),
+		promql.AddQueryFrom(
+			"PrometheusCommonPanelQueries", PrometheusCommonPanelQueries,
+			"PrometheusQueryStateDuration",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{slice}} - Duration"),
 		),
 	)
 }
@@ -455,15 +413,12 @@ func PrometheusRemoteStorageTimestampLag(datasourceName string, labelMatchers ..
 				Mode:     timeSeriesPanel.TableMode,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PrometheusCommonPanelQueries["PrometheusRemoteStorageTimestampLag"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} - {{remote_name}} - {{url}} - Segment"),
-			),
+		promql.AddQueryFrom(
+			"PrometheusCommonPanelQueries", PrometheusCommonPanelQueries,
+			"PrometheusRemoteStorageTimestampLag",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} - {{remote_name}} - {{url}} - Segment"),
 		),
 	)
 }
@@ -486,15 +441,12 @@ func PrometheusRemoteStorageRateLag(datasourceName string, labelMatchers ...*lab
 				Mode:     timeSeriesPanel.TableMode,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PrometheusCommonPanelQueries["PrometheusRemoteStorageRateLag"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} - {{remote_name}} - {{url}} - Metrics"),
-			),
+		promql.AddQueryFrom(
+			"PrometheusCommonPanelQueries", PrometheusCommonPanelQueries,
+			"PrometheusRemoteStorageRateLag",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} - {{remote_name}} - {{url}} - Metrics"),
 		),
 	)
 }
@@ -526,15 +478,12 @@ func PrometheusRemoteStorageSampleRate(datasourceName string, labelMatchers ...*
 				Mode:     timeSeriesPanel.TableMode,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PrometheusCommonPanelQueries["PrometheusRemoteStorageSampleRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} - {{remote_name}} - {{url}} - Metrics"),
-			),
+		promql.AddQueryFrom(
+			"PrometheusCommonPanelQueries", PrometheusCommonPanelQueries,
+			"PrometheusRemoteStorageSampleRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} - {{remote_name}} - {{url}} - Metrics"),
 		),
 	)
 }
@@ -557,15 +506,12 @@ func PrometheusRemoteStorageCurrentShards(datasourceName string, labelMatchers .
 				Mode:     timeSeriesPanel.TableMode,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PrometheusCommonPanelQueries["PrometheusRemoteStorageCurrentShards"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} - {{remote_name}} - {{url}} - Metrics"),
-			),
+		promql.AddQueryFrom(
+			"PrometheusCommonPanelQueries", PrometheusCommonPanelQueries,
+			"PrometheusRemoteStorageCurrentShards",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} - {{remote_name}} - {{url}} - Metrics"),
 		),
 	)
 }
@@ -588,15 +534,12 @@ func PrometheusRemoteStorageDesiredShards(datasourceName string, labelMatchers .
 				Mode:     timeSeriesPanel.TableMode,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PrometheusCommonPanelQueries["PrometheusRemoteStorageDesiredShards"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} - {{remote_name}} - {{url}} - Metrics"),
-			),
+		promql.AddQueryFrom(
+			"PrometheusCommonPanelQueries", PrometheusCommonPanelQueries,
+			"PrometheusRemoteStorageDesiredShards",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} - {{remote_name}} - {{url}} - Metrics"),
 		),
 	)
 }
@@ -619,15 +562,12 @@ func PrometheusRemoteStorageMaxShards(datasourceName string, labelMatchers ...*l
 				Mode:     timeSeriesPanel.TableMode,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PrometheusCommonPanelQueries["PrometheusRemoteStorageMaxShards"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} - {{remote_name}} - {{url}} - Metrics"),
-			),
+		promql.AddQueryFrom(
+			"PrometheusCommonPanelQueries", PrometheusCommonPanelQueries,
+			"PrometheusRemoteStorageMaxShards",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} - {{remote_name}} - {{url}} - Metrics"),
 		),
 	)
 }
@@ -650,15 +590,12 @@ func PrometheusRemoteStorageMinShards(datasourceName string, labelMatchers ...*l
 				Mode:     timeSeriesPanel.TableMode,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PrometheusCommonPanelQueries["PrometheusRemoteStorageMinShards"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} - {{remote_name}} - {{url}} - Metrics"),
-			),
+		promql.AddQueryFrom(
+			"PrometheusCommonPanelQueries", PrometheusCommonPanelQueries,
+			"PrometheusRemoteStorageMinShards",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} - {{remote_name}} - {{url}} - Metrics"),
 		),
 	)
 }
@@ -681,15 +618,12 @@ func PrometheusRemoteStorageShardCapacity(datasourceName string, labelMatchers .
 				Mode:     timeSeriesPanel.TableMode,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PrometheusCommonPanelQueries["PrometheusRemoteStorageShardCapacity"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} - {{remote_name}} - {{url}} - Metrics"),
-			),
+		promql.AddQueryFrom(
+			"PrometheusCommonPanelQueries", PrometheusCommonPanelQueries,
+			"PrometheusRemoteStorageShardCapacity",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} - {{remote_name}} - {{url}} - Metrics"),
 		),
 	)
 }
@@ -713,15 +647,12 @@ func PrometheusRemoteStoragePendingSamples(datasourceName string, labelMatchers
 				Mode:     timeSeriesPanel.TableMode,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PrometheusCommonPanelQueries["PrometheusRemoteStoragePendingSamples"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} - {{remote_name}} - {{url}} - Metrics"),
-			),
+		promql.AddQueryFrom(
+			"PrometheusCommonPanelQueries", PrometheusCommonPanelQueries,
+			"PrometheusRemoteStoragePendingSamples",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} - {{remote_name}} - {{url}} - Metrics"),
 		),
 	)
 }
@@ -744,15 +675,12 @@ func PrometheusTSDBCurrentSegment(datasourceName string, labelMatchers ...*label
 				Mode:     timeSeriesPanel.TableMode,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PrometheusCommonPanelQueries["PrometheusTSDBCurrentSegment"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} - Segment - Metrics"),
-			),
+		promql.AddQueryFrom(
+			"PrometheusCommonPanelQueries", PrometheusCommonPanelQueries,
+			"PrometheusTSDBCurrentSegment",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} - Segment - Metrics"),
 		),
 	)
 }
@@ -775,15 +703,12 @@ func PrometheusRemoteWriteCurrentSegment(datasourceName string, labelMatchers ..
 				Mode:     timeSeriesPanel.TableMode,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PrometheusCommonPanelQueries["PrometheusRemoteWriteCurrentSegment"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} - Segment - Metrics"),
-			),
+		promql.AddQueryFrom(
+			"PrometheusCommonPanelQueries", PrometheusCommonPanelQueries,
+			"PrometheusRemoteWriteCurrentSegment",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} - Segment - Metrics"),
 		),
 	)
 }
@@ -807,15 +732,12 @@ func PrometheusRemoteStorageDroppedSamplesRate(datasourceName string, labelMatch
 				Mode:     timeSeriesPanel.TableMode,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PrometheusCommonPanelQueries["PrometheusRemoteStorageDroppedSamplesRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} - {{remote_name}} - {{url}} - Metrics"),
-			),
+		promql.AddQueryFrom(
+			"PrometheusCommonPanelQueries", PrometheusCommonPanelQueries,
+			"PrometheusRemoteStorageDroppedSamplesRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} - {{remote_name}} - {{url}} - Metrics"),
 		),
 	)
 }
@@ -839,15 +761,12 @@ func PrometheusRemoteStorageFailedSamplesRate(datasourceName string, labelMatche
 				Mode:     timeSeriesPanel.TableMode,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PrometheusCommonPanelQueries["PrometheusRemoteStorageFailedSamplesRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} - {{remote_name}} - {{url}} - Metrics"),
-			),
+		promql.AddQueryFrom(
+			"PrometheusCommonPanelQueries", PrometheusCommonPanelQueries,
+			"PrometheusRemoteStorageFailedSamplesRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} - {{remote_name}} - {{url}} - Metrics"),
 		),
 	)
 }
@@ -871,15 +790,12 @@ func PrometheusRemoteStorageRetriedSamplesRate(datasourceName string, labelMatch
 				Mode:     timeSeriesPanel.TableMode,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PrometheusCommonPanelQueries["PrometheusRemoteStorageRetriedSamplesRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} - {{remote_name}} - {{url}} - Metrics"),
-			),
+		promql.AddQueryFrom(
+			"PrometheusCommonPanelQueries", PrometheusCommonPanelQueries,
+			"PrometheusRemoteStorageRetriedSamplesRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} - {{remote_name}} - {{url}} - Metrics"),
 		),
 	)
 }
@@ -902,15 +818,12 @@ func PrometheusRemoteStorageEnqueueRetriesRate(datasourceName string, labelMatch
 				Mode:     timeSeriesPanel.TableMode,
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					PrometheusCommonPanelQueries["PrometheusRemoteStorageEnqueueRetriesRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{instance}} - {{remote_name}} - {{url}} - Metrics"),
-			),
+		promql.AddQueryFrom(
+			"PrometheusCommonPanelQueries", PrometheusCommonPanelQueries,
+			"PrometheusRemoteStorageEnqueueRetriesRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{instance}} - {{remote_name}} - {{url}} - Metrics"),
 		),
 	)
 }
@@ -0,0 +1,38 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"testing"
+
+	"github.com/perses/community-mixins/pkg/querytest"
+)
+
+func TestPrometheusCommonPanelQueries(t *testing.T) {
+	querytest.Suite{
+		SeriesFile: "testdata/series.test",
+		Queries:    PrometheusCommonPanelQueries,
+		Variables: map[string]string{
+			"job":      "prometheus",
+			"instance": "prometheus-0:9090",
+			"url":      "https://remote.example.com/api/v1/write",
+		},
+		Labels: map[string][]string{
+			"PrometheusStatsTable": {`{job="prometheus", instance="prometheus-0:9090", version="3.5.0"}`},
+			"PrometheusRemoteStorageTimestampLag": {
+				`{job="prometheus", instance="prometheus-0:9090", remote_name="remote", url="https://remote.example.com/api/v1/write"}`,
+			},
+		},
+	}.Run(t)
+}
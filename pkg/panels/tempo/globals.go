@@ -0,0 +1,28 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tempo
+
+import (
+	"github.com/perses/promql-builder/label"
+	"github.com/prometheus/prometheus/model/labels"
+)
+
+// DefaultTenantLabelName is the label carrying the tenant on Tempo metrics unless a
+// dashboard or rules option says otherwise.
+const DefaultTenantLabelName = "tenant"
+
+// tenantMatcher returns the label matcher selecting the tenant picked in the tenant variable.
+func tenantMatcher(tenantLabelName string) *labels.Matcher {
+	return label.New(tenantLabelName).Equal("$tenant")
+}
@@ -0,0 +1,36 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tempo
+
+import "testing"
+
+func TestTenantMatcher(t *testing.T) {
+	tests := []struct {
+		name      string
+		labelName string
+		wantMatch string
+	}{
+		{"default", DefaultTenantLabelName, `tenant="$tenant"`},
+		{"relabeled tenant", "tempo_tenant", `tempo_tenant="$tenant"`},
+		{"org id", "org_id", `org_id="$tenant"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tenantMatcher(tt.labelName).String(); got != tt.wantMatch {
+				t.Errorf("tenantMatcher(%q) = %q, want %q", tt.labelName, got, tt.wantMatch)
+			}
+		})
+	}
+}
@@ -0,0 +1,257 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tempo
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/perses/community-mixins/pkg/promql"
+	"github.com/perses/perses/go-sdk/panel"
+	"github.com/perses/plugins/prometheus/sdk/go/query"
+	promqlbuilder "github.com/perses/promql-builder"
+	"github.com/perses/promql-builder/label"
+	"github.com/perses/promql-builder/matrix"
+	"github.com/perses/promql-builder/vector"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+// addTenantQuery is like promql.AddQueryFrom but also selects the tenant picked in the tenant variable,
+// whose values are read from the tenantLabelName label.
+func addTenantQuery(tenantLabelName string, key string, labelMatchers []*labels.Matcher, options ...query.Option) panel.Option {
+	return promql.AddQueryWithPolicies(
+		fmt.Sprintf("TempoCommonPanelQueries[%q]", key),
+		TempoCommonPanelQueries[key],
+		append([]*labels.Matcher{tenantMatcher(tenantLabelName)}, labelMatchers...),
+		promql.Policies{tenantLabelName: skipBuildInfo},
+		options...,
+	)
+}
+
+// skipBuildInfo injects a label into every vector selector but those of tempo_build_info, which isn't per tenant.
+func skipBuildInfo(selector *parser.VectorSelector, _ []parser.Node, _ string) bool {
+	return selector.Name != "tempo_build_info"
+}
+
+// envoyGRPCStatusQuery reads the gRPC status from the name of the Envoy metrics, so it relabels them before computing
+// the rate, which takes a subquery over $__rate_interval that promql-builder can't express. It is added to panels with
+// promql.AddParsedQuery rather than being part of TempoCommonPanelQueries.
+const envoyGRPCStatusQuery = `sum by (grpc_status) (
+    rate(
+        label_replace(
+            {cluster=~"$cluster", job=~"($namespace)/cortex-gw(-internal)?", __name__=~"envoy_cluster_grpc_proto_collector_trace_v1_TraceService_[0-9]+"},
+            "grpc_status", "$1", "__name__", "envoy_cluster_grpc_proto_collector_trace_v1_TraceService_(.+)"
+        )
+        [$__rate_interval:30s]
+    )
+)`
+
+// componentMatchers selects a Tempo component of the namespaces picked in the namespace variable, e.g. distributor.
+func componentMatchers(component string, labelMatchers ...*labels.Matcher) []*labels.Matcher {
+	return slices.Concat([]*labels.Matcher{
+		label.New("cluster").EqualRegexp("$cluster"),
+		label.New("job").EqualRegexp("($namespace)/" + component),
+	}, labelMatchers)
+}
+
+// rate is the rate of metricName over $__rate_interval.
+func rate(metricName string, labelMatchers []*labels.Matcher) *parser.Call {
+	return promqlbuilder.Rate(
+		matrix.New(
+			vector.New(
+				vector.WithMetricName(metricName),
+				vector.WithLabelMatchers(labelMatchers...),
+			),
+			matrix.WithRangeAsVariable("$__rate_interval"),
+		),
+	)
+}
+
+// requestsByStatus is the rate of the requests of a request duration histogram, by status class, e.g. 2xx, or status
+// name, e.g. success.
+func requestsByStatus(metricName string, labelMatchers []*labels.Matcher) parser.Expr {
+	return promqlbuilder.Sum(
+		promqlbuilder.LabelReplace(
+			promqlbuilder.LabelReplace(
+				rate(metricName+"_count", labelMatchers),
+				"status", "${1}xx", "status_code", "([0-9]).."),
+			"status", "${1}", "status_code", "([a-zA-Z]+)"),
+	).By("status")
+}
+
+// latencyQuantile is the quantile of a request duration histogram, in milliseconds.
+func latencyQuantile(quantile float64, metricName string, labelMatchers []*labels.Matcher) parser.Expr {
+	return promqlbuilder.Mul(
+		promqlbuilder.HistogramQuantile(quantile, promqlbuilder.Sum(rate(metricName+"_bucket", labelMatchers)).By("le")),
+		promqlbuilder.NewNumber(1e3),
+	)
+}
+
+// latencyAverage is the average of a request duration histogram, in milliseconds.
+func latencyAverage(metricName string, labelMatchers []*labels.Matcher) parser.Expr {
+	return promqlbuilder.Div(
+		promqlbuilder.Mul(promqlbuilder.Sum(rate(metricName+"_sum", labelMatchers)), promqlbuilder.NewNumber(1e3)),
+		promqlbuilder.Sum(rate(metricName+"_count", labelMatchers)),
+	)
+}
+
+// tenantLimits is the value of the Tempo limits of the tenant, from its overrides or else the defaults.
+func tenantLimits(by string, labelMatchers ...*labels.Matcher) parser.Expr {
+	limit := func(metricName string, labelMatchers []*labels.Matcher) parser.Expr {
+		return promqlbuilder.Max(
+			vector.New(
+				vector.WithMetricName(metricName),
+				vector.WithLabelMatchers(componentMatchers("compactor", labelMatchers...)...),
+			),
+		).By("cluster", "namespace", "limit_name")
+	}
+	return promqlbuilder.Max(
+		promqlbuilder.Or(
+			limit("tempo_limits_overrides", append([]*labels.Matcher{label.New("user").Equal("$tenant")}, labelMatchers...)),
+			limit("tempo_limits_defaults", labelMatchers),
+		),
+	).By(by)
+}
+
+var (
+	gatewayMatchers = componentMatchers("cortex-gw(-internal)?",
+		label.New("route").EqualRegexp("(opentelemetry_proto_collector_trace_v1_traceservice_export|otlp_v1_traces)"),
+	)
+	distributorMatchers        = componentMatchers("distributor")
+	ingesterPushMatchers       = componentMatchers("ingester", label.New("route").EqualRegexp("/tempopb.Pusher/Push.*"))
+	memcachedIngesterMatchers  = componentMatchers("ingester", label.New("method").Equal("Memcache.Put"))
+	backendIngesterMatchers    = componentMatchers("ingester", label.New("operation").EqualRegexp("(PUT|POST)"))
+	memcachedCompactorMatchers = componentMatchers("compactor", label.New("method").Equal("Memcache.Put"))
+	backendCompactorMatchers   = componentMatchers("compactor", label.New("operation").EqualRegexp("(PUT|POST)"))
+)
+
+var TempoCommonPanelQueries = map[string]parser.Expr{
+	// Tempo / Tenant
+	"TenantInfo":                      tenantLimits("limit_name"),
+	"TenantDistributorBytes_received": promql.SumRate("tempo_distributor_bytes_received_total", distributorMatchers...),
+	"TenantDistributorBytes_limit": tenantLimits("ingestion_rate_limit_bytes",
+		label.New("limit_name").Equal("ingestion_rate_limit_bytes"),
+	),
+	"TenantDistributorBytes_burstLimit": tenantLimits("ingestion_burst_size_bytes",
+		label.New("limit_name").Equal("ingestion_burst_size_bytes"),
+	),
+	"TenantDistributorSpan_accepted": promql.SumRate("tempo_distributor_spans_received_total", distributorMatchers...),
+	"TenantDistributorSpan_refused":  promql.SumByRate("tempo_discarded_spans_total", []string{"reason"}, distributorMatchers...),
+	"TenantLiveTraces": promqlbuilder.Max(
+		vector.New(
+			vector.WithMetricName("tempo_ingester_live_traces"),
+			vector.WithLabelMatchers(componentMatchers("ingester")...),
+		),
+	),
+	"TenantLiveTraces_globalLimit": tenantLimits("max_global_traces_per_user",
+		label.New("limit_name").Equal("max_global_traces_per_user"),
+	),
+	"TenantLiveTraces_localLimit": tenantLimits("max_local_traces_per_user",
+		label.New("limit_name").Equal("max_local_traces_per_user"),
+	),
+	"TenantQueriesID": promql.SumByRate("tempo_query_frontend_queries_total", []string{"status"},
+		componentMatchers("query-frontend", label.New("op").Equal("traces"))...,
+	),
+	"TenantQueriesSearch": promql.SumByRate("tempo_query_frontend_queries_total", []string{"status"},
+		componentMatchers("query-frontend", label.New("op").Equal("search"))...,
+	),
+	"TenantBlockslistLength": promqlbuilder.Avg(
+		vector.New(
+			vector.WithMetricName("tempodb_blocklist_length"),
+			vector.WithLabelMatchers(componentMatchers("compactor")...),
+		),
+	),
+	"TenantOutstandingCompactions": promqlbuilder.Div(
+		promqlbuilder.Sum(
+			vector.New(
+				vector.WithMetricName("tempodb_compaction_outstanding_blocks"),
+				vector.WithLabelMatchers(componentMatchers("compactor")...),
+			),
+		),
+		promqlbuilder.Count(
+			vector.New(
+				vector.WithMetricName("tempo_build_info"),
+				vector.WithLabelMatchers(componentMatchers("compactor")...),
+			),
+		),
+	),
+	"TenantMetricGeneratorBytes": promql.SumRate("tempo_metrics_generator_bytes_received_total", componentMatchers("metrics-generator")...),
+	"TenantMetricGeneratorActiveSeries": promqlbuilder.Sum(
+		vector.New(
+			vector.WithMetricName("tempo_metrics_generator_registry_active_series"),
+			vector.WithLabelMatchers(componentMatchers("metrics-generator")...),
+		),
+	),
+	"TenantMetricGeneratorActiveSeries_limit": tenantLimits("metrics_generator_max_active_series",
+		label.New("limit_name").Equal("metrics_generator_max_active_series"),
+	),
+
+	// Tempo / Writes
+	"WritesGatewayQPS":                      requestsByStatus("tempo_request_duration_seconds", gatewayMatchers),
+	"WritesGatewayLatency_p99":              latencyQuantile(0.99, "tempo_request_duration_seconds", gatewayMatchers),
+	"WritesGatewayLatency_p50":              latencyQuantile(0.50, "tempo_request_duration_seconds", gatewayMatchers),
+	"WritesGatewayLatency_avg":              latencyAverage("tempo_request_duration_seconds", gatewayMatchers),
+	"WritesDistributorSpansSecond_accepted": promql.SumRate("tempo_receiver_accepted_spans", distributorMatchers...),
+	"WritesDistributorSpansSecond_refused":  promql.SumRate("tempo_receiver_refused_spans", distributorMatchers...),
+	"WritesDistributorBytesPerSecond":       promql.SumByRate("tempo_distributor_bytes_received_total", []string{"status"}, distributorMatchers...),
+	"WritesDistributorLatency_p99":          latencyQuantile(0.99, "tempo_distributor_push_duration_seconds", distributorMatchers),
+	"WritesDistributorLatency_p50":          latencyQuantile(0.50, "tempo_distributor_push_duration_seconds", distributorMatchers),
+	"WritesDistributorLatency_avg":          latencyAverage("tempo_distributor_push_duration_seconds", distributorMatchers),
+	"WritesDistributorKafkaAppendRecords": promql.SumRate("tempo_distributor_kafka_appends_total",
+		componentMatchers("distributor", label.New("status").Equal("success"))...,
+	),
+	"WritesDistributorKafkaAppendFail": promql.SumRate("tempo_distributor_kafka_appends_total",
+		componentMatchers("distributor", label.New("status").Equal("fail"))...,
+	),
+	"WritesDistributorKafkaWrite": promql.SumRate("tempo_distributor_kafka_write_bytes_total", distributorMatchers...),
+	"WritesDistributorKafkaWriteLatency_p50": promqlbuilder.HistogramQuantile(0.50,
+		promql.SumByRate("tempo_distributor_kafka_write_latency_seconds_bucket", []string{"le"}, distributorMatchers...),
+	),
+	"WritesDistributorKafkaWriteLatency_p99": promqlbuilder.HistogramQuantile(0.99,
+		promql.SumByRate("tempo_distributor_kafka_write_latency_seconds_bucket", []string{"le"}, distributorMatchers...),
+	),
+	"WritesDistributorKafkaWriteLatency_avg": promqlbuilder.Div(
+		promql.SumRate("tempo_distributor_kafka_write_latency_seconds_sum", distributorMatchers...),
+		promql.SumRate("tempo_distributor_kafka_write_latency_seconds_count", distributorMatchers...),
+	),
+	"WritesIngesterQPS":                   requestsByStatus("tempo_request_duration_seconds", ingesterPushMatchers),
+	"WritesIngesterLatency_p99":           latencyQuantile(0.99, "tempo_request_duration_seconds", ingesterPushMatchers),
+	"WritesIngesterLatency_p50":           latencyQuantile(0.50, "tempo_request_duration_seconds", ingesterPushMatchers),
+	"WritesIngesterLatency_avg":           latencyAverage("tempo_request_duration_seconds", ingesterPushMatchers),
+	"WritesMemcachedIngesterQPS":          requestsByStatus("tempo_memcache_request_duration_seconds", memcachedIngesterMatchers),
+	"WritesMemcachedIngesterLatency_p99":  latencyQuantile(0.99, "tempo_memcache_request_duration_seconds", memcachedIngesterMatchers),
+	"WritesMemcachedIngesterLatency_p50":  latencyQuantile(0.50, "tempo_memcache_request_duration_seconds", memcachedIngesterMatchers),
+	"WritesMemcachedIngesterLatency_avg":  latencyAverage("tempo_memcache_request_duration_seconds", memcachedIngesterMatchers),
+	"WritesBackendIngesterQPS":            requestsByStatus("tempodb_backend_request_duration_seconds", backendIngesterMatchers),
+	"WritesBackendIngesterLatency_p99":    latencyQuantile(0.99, "tempodb_backend_request_duration_seconds", backendIngesterMatchers),
+	"WritesBackendIngesterLatency_p50":    latencyQuantile(0.50, "tempodb_backend_request_duration_seconds", backendIngesterMatchers),
+	"WritesBackendIngesterLatency_avg":    latencyAverage("tempodb_backend_request_duration_seconds", backendIngesterMatchers),
+	"WritesMemcachedCompactorQPS":         requestsByStatus("tempo_memcache_request_duration_seconds", memcachedCompactorMatchers),
+	"WritesMemcachedCompactorLatency_p99": latencyQuantile(0.99, "tempo_memcache_request_duration_seconds", memcachedCompactorMatchers),
+	"WritesMemcachedCompactorLatency_p50": latencyQuantile(0.50, "tempo_memcache_request_duration_seconds", memcachedCompactorMatchers),
+	"WritesMemcachedCompactorLatency_avg": latencyAverage("tempo_memcache_request_duration_seconds", memcachedCompactorMatchers),
+	"WritesBackendCompactorQPS":           requestsByStatus("tempodb_backend_request_duration_seconds", backendCompactorMatchers),
+	"WritesBackendCompactorLatency_p99":   latencyQuantile(0.99, "tempodb_backend_request_duration_seconds", backendCompactorMatchers),
+	"WritesBackendCompactorLatency_p50":   latencyQuantile(0.50, "tempodb_backend_request_duration_seconds", backendCompactorMatchers),
+	"WritesBackendCompactorLatency_avg":   latencyAverage("tempodb_backend_request_duration_seconds", backendCompactorMatchers),
+}
+
+// OverrideTempoPanelQueries overrides the TempoCommonPanelQueries global.
+// Refer to panel queries in the map, that you'd like to override.
+// The convention of naming followed, is to use Panel function name (with _suffix, in case panel has multiple queries)
+func OverrideTempoPanelQueries(queries map[string]parser.Expr) {
+	maps.Copy(TempoCommonPanelQueries, queries)
+}
@@ -0,0 +1,45 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tempo
+
+import (
+	"testing"
+
+	"github.com/perses/community-mixins/pkg/querytest"
+)
+
+func TestTempoCommonPanelQueries(t *testing.T) {
+	querytest.Suite{
+		SeriesFile: "testdata/series.test",
+		Queries:    TempoCommonPanelQueries,
+		Variables: map[string]string{
+			"cluster":   "cluster-a",
+			"namespace": "tempo",
+			"tenant":    "tenant-a",
+		},
+		Labels: map[string][]string{
+			"WritesGatewayQPS":              {`{status="2xx"}`},
+			"WritesIngesterQPS":             {`{status="success"}`},
+			"TenantDistributorSpan_refused": {`{reason="rate_limited"}`},
+			"TenantDistributorBytes_limit":  {`{}`},
+			"TenantInfo": {
+				`{limit_name="ingestion_burst_size_bytes"}`,
+				`{limit_name="ingestion_rate_limit_bytes"}`,
+				`{limit_name="max_global_traces_per_user"}`,
+				`{limit_name="max_local_traces_per_user"}`,
+				`{limit_name="metrics_generator_max_active_series"}`,
+			},
+		},
+	}.Run(t)
+}
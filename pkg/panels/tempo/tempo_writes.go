@@ -22,9 +22,10 @@ import (
 	markdown "github.com/perses/plugins/markdown/sdk/go"
 	"github.com/perses/plugins/prometheus/sdk/go/query"
 	timeSeriesPanel "github.com/perses/plugins/timeserieschart/sdk/go"
+	"github.com/prometheus/prometheus/model/labels"
 )
 
-func WritesGatewayQPS(datasourceName string, labelMatchers ...promql.LabelMatcher) panelgroup.Option {
+func WritesGatewayQPS(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("QPS",
 		panel.Description("Rate of HTTP request durations for Tempo Gateway"),
 		timeSeriesPanel.Chart(
@@ -47,20 +48,14 @@ func WritesGatewayQPS(datasourceName string, labelMatchers ...promql.LabelMatche
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"sum by (status) (\n  label_replace(label_replace(rate(tempo_request_duration_seconds_count{cluster=~\"$cluster\", job=~\"($namespace)/cortex-gw(-internal)?\", route=~\"(opentelemetry_proto_collector_trace_v1_traceservice_export|otlp_v1_traces)\"}[$__rate_interval]),\n  \"status\", \"${1}xx\", \"status_code\", \"([0-9])..\"),\n  \"status\", \"${1}\", \"status_code\", \"([a-zA-Z]+)\"))\n",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{status}}"),
-			),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesGatewayQPS", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{status}}"),
 		),
 	)
 }
 
-func WritesGatewayLatency(datasourceName string, labelMatchers ...promql.LabelMatcher) panelgroup.Option {
+func WritesGatewayLatency(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Latency",
 		panel.Description("Shows the 99th and 50th quantile latency of Gateway."),
 		timeSeriesPanel.Chart(
@@ -82,40 +77,22 @@ func WritesGatewayLatency(datasourceName string, labelMatchers ...promql.LabelMa
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"histogram_quantile(0.99, sum(rate(tempo_request_duration_seconds_bucket{cluster=~\"$cluster\", job=~\"($namespace)/cortex-gw(-internal)?\", route=~\"(opentelemetry_proto_collector_trace_v1_traceservice_export|otlp_v1_traces)\"}[$__rate_interval])) by (le,)) * 1e3",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{route}} 99th"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"histogram_quantile(0.50, sum(rate(tempo_request_duration_seconds_bucket{cluster=~\"$cluster\", job=~\"($namespace)/cortex-gw(-internal)?\", route=~\"(opentelemetry_proto_collector_trace_v1_traceservice_export|otlp_v1_traces)\"}[$__rate_interval])) by (le,)) * 1e3",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{route}} 50th"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"sum(rate(tempo_request_duration_seconds_sum{cluster=~\"$cluster\", job=~\"($namespace)/cortex-gw(-internal)?\", route=~\"(opentelemetry_proto_collector_trace_v1_traceservice_export|otlp_v1_traces)\"}[$__rate_interval])) by () * 1e3 / sum(rate(tempo_request_duration_seconds_count{cluster=~\"$cluster\", job=~\"($namespace)/cortex-gw(-internal)?\", route=~\"(opentelemetry_proto_collector_trace_v1_traceservice_export|otlp_v1_traces)\"}[$__rate_interval])) by ()",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{route}} Average"),
-			),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesGatewayLatency_p99", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{route}} 99th"),
+		),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesGatewayLatency_p50", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{route}} 50th"),
+		),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesGatewayLatency_avg", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{route}} Average"),
 		),
 	)
 }
 
-func WritesEnvoyProxyQPS(datasourceName string, labelMatchers ...promql.LabelMatcher) panelgroup.Option {
+func WritesEnvoyProxyQPS(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("QPS",
 		panel.Description("Rate of gRPC response statuses from Envoy"),
 		timeSeriesPanel.Chart(
@@ -138,20 +115,14 @@ func WritesEnvoyProxyQPS(datasourceName string, labelMatchers ...promql.LabelMat
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"sum by (grpc_status) (\n    rate(\n        label_replace(\n            {cluster=~\"$cluster\", job=~\"($namespace)/cortex-gw(-internal)?\", __name__=~\"envoy_cluster_grpc_proto_collector_trace_v1_TraceService_[0-9]+\"},\n            \"grpc_status\", \"$1\", \"__name__\", \"envoy_cluster_grpc_proto_collector_trace_v1_TraceService_(.+)\"\n        )\n        [$__rate_interval:30s]\n    )\n)\n",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{grpc_status}}"),
-			),
+		promql.AddParsedQuery("envoyGRPCStatusQuery", envoyGRPCStatusQuery, labelMatchers, nil,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{grpc_status}}"),
 		),
 	)
 }
 
-func WritesEnvoygRPCStatusCodes(datasourceName string, labelMatchers ...promql.LabelMatcher) panelgroup.Option {
+func WritesEnvoygRPCStatusCodes(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("gRPC status codes",
 		markdown.Markdown("gRPC status codes",
 			markdown.Text(`Visit [Status codes and their use in gRPC](https://github.com/grpc/grpc/blob/master/doc/statuscodes.md)
@@ -178,7 +149,7 @@ UNAUTHENTICATED | 16 | The request does not have valid authentication credential
 	)
 }
 
-func WritesDistributorSpansSecond(datasourceName string, labelMatchers ...promql.LabelMatcher) panelgroup.Option {
+func WritesDistributorSpansSecond(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Spans / sec",
 		panel.Description("Rate of Spans per Second for Tempo Distributor"),
 		timeSeriesPanel.Chart(
@@ -200,30 +171,18 @@ func WritesDistributorSpansSecond(datasourceName string, labelMatchers ...promql
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"sum(rate(tempo_receiver_accepted_spans{cluster=~\"$cluster\", job=~\"($namespace)/distributor\"}[$__rate_interval]))",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("accepted"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"sum(rate(tempo_receiver_refused_spans{cluster=~\"$cluster\", job=~\"($namespace)/distributor\"}[$__rate_interval]))",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("refused"),
-			),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesDistributorSpansSecond_accepted", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("accepted"),
+		),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesDistributorSpansSecond_refused", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("refused"),
 		),
 	)
 }
 
-func WritesDistributorBytesPerSecond(datasourceName string, labelMatchers ...promql.LabelMatcher) panelgroup.Option {
+func WritesDistributorBytesPerSecond(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Bytes / sec",
 		panel.Description("Rate of bytes received by Tempo distributors"),
 		timeSeriesPanel.Chart(
@@ -244,20 +203,14 @@ func WritesDistributorBytesPerSecond(datasourceName string, labelMatchers ...pro
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"sum(rate(tempo_distributor_bytes_received_total{cluster=~\"$cluster\", job=~\"($namespace)/distributor\"}[$__rate_interval])) by (status)",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("received"),
-			),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesDistributorBytesPerSecond", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("received"),
 		),
 	)
 }
 
-func WritesDistributorLatency(datasourceName string, labelMatchers ...promql.LabelMatcher) panelgroup.Option {
+func WritesDistributorLatency(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Latency",
 		panel.Description("Shows the 99th and 50th quantile latency of Distributor."),
 		timeSeriesPanel.Chart(
@@ -279,40 +232,22 @@ func WritesDistributorLatency(datasourceName string, labelMatchers ...promql.Lab
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"histogram_quantile(0.99, sum(rate(tempo_distributor_push_duration_seconds_bucket{cluster=~\"$cluster\", job=~\"($namespace)/distributor\"}[$__rate_interval])) by (le,)) * 1e3",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{route}} 99th"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"histogram_quantile(0.5, sum(rate(tempo_distributor_push_duration_seconds_bucket{cluster=~\"$cluster\", job=~\"($namespace)/distributor\"}[$__rate_interval])) by (le,)) * 1e3",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{route}} 50th"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"sum(rate(tempo_distributor_push_duration_seconds_sum{cluster=~\"$cluster\", job=~\"($namespace)/distributor\"}[$__rate_interval])) by () * 1e3 / sum(rate(tempo_distributor_push_duration_seconds_count{cluster=~\"$cluster\", job=~\"($namespace)/distributor\"}[$__rate_interval])) by ()",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{route}} Average"),
-			),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesDistributorLatency_p99", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{route}} 99th"),
+		),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesDistributorLatency_p50", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{route}} 50th"),
+		),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesDistributorLatency_avg", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{route}} Average"),
 		),
 	)
 }
 
-func WritesDistributorKafkaAppendRecords(datasourceName string, labelMatchers ...promql.LabelMatcher) panelgroup.Option {
+func WritesDistributorKafkaAppendRecords(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Kafka append records / sec",
 		panel.Description("Rate of bytes received by Tempo Distributors"),
 		timeSeriesPanel.Chart(
@@ -334,20 +269,14 @@ func WritesDistributorKafkaAppendRecords(datasourceName string, labelMatchers ..
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"sum(rate(tempo_distributor_kafka_appends_total{cluster=~\"$cluster\", job=~\"($namespace)/distributor\", status=\"success\"}[$__rate_interval]))",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("appends"),
-			),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesDistributorKafkaAppendRecords", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("appends"),
 		),
 	)
 }
 
-func WritesDistributorKafkaAppendFail(datasourceName string, labelMatchers ...promql.LabelMatcher) panelgroup.Option {
+func WritesDistributorKafkaAppendFail(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Kafka failed append records / sec",
 		panel.Description("Rate of failed bytes received by Tempo distributors"),
 		timeSeriesPanel.Chart(
@@ -369,20 +298,14 @@ func WritesDistributorKafkaAppendFail(datasourceName string, labelMatchers ...pr
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"sum(rate(tempo_distributor_kafka_appends_total{cluster=~\"$cluster\", job=~\"($namespace)/distributor\", status=\"fail\"}[$__rate_interval]))",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("failed"),
-			),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesDistributorKafkaAppendFail", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("failed"),
 		),
 	)
 }
 
-func WritesDistributorKafkaWrite(datasourceName string, labelMatchers ...promql.LabelMatcher) panelgroup.Option {
+func WritesDistributorKafkaWrite(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Kafka write bytes / sec",
 		panel.Description("Rate of append (write) operations the Tempo Distributor to Kafkas"),
 		timeSeriesPanel.Chart(
@@ -404,20 +327,14 @@ func WritesDistributorKafkaWrite(datasourceName string, labelMatchers ...promql.
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"sum(rate(tempo_distributor_kafka_write_bytes_total{cluster=~\"$cluster\", job=~\"($namespace)/distributor\"}[$__rate_interval]))",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("writes"),
-			),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesDistributorKafkaWrite", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("writes"),
 		),
 	)
 }
 
-func WritesDistributorKafkaWriteLatency(datasourceName string, labelMatchers ...promql.LabelMatcher) panelgroup.Option {
+func WritesDistributorKafkaWriteLatency(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Kafka write latency (sec)",
 		panel.Description("Shows the 99th and 50th quantile latency of Distributor Kafka Write."),
 		timeSeriesPanel.Chart(
@@ -439,40 +356,22 @@ func WritesDistributorKafkaWriteLatency(datasourceName string, labelMatchers ...
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"histogram_quantile(0.50, sum by (le) (rate(tempo_distributor_kafka_write_latency_seconds_bucket{cluster=~\"$cluster\", job=~\"($namespace)/distributor\"}[$__rate_interval])))",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("50th percentile"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"histogram_quantile(0.99, sum by (le) (rate(tempo_distributor_kafka_write_latency_seconds_bucket{cluster=~\"$cluster\", job=~\"($namespace)/distributor\"}[$__rate_interval])))",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("99th percentile"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"sum(rate(tempo_distributor_kafka_write_latency_seconds_sum{cluster=~\"$cluster\", job=~\"($namespace)/distributor\"}[$__rate_interval])) / sum(rate(tempo_distributor_kafka_write_latency_seconds_count{cluster=~\"$cluster\", job=~\"($namespace)/distributor\"}[$__rate_interval]))",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Average"),
-			),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesDistributorKafkaWriteLatency_p50", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("50th percentile"),
+		),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesDistributorKafkaWriteLatency_p99", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("99th percentile"),
+		),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesDistributorKafkaWriteLatency_avg", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Average"),
 		),
 	)
 }
 
-func WritesIngesterQPS(datasourceName string, labelMatchers ...promql.LabelMatcher) panelgroup.Option {
+func WritesIngesterQPS(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("QPS",
 		panel.Description("Rate of HTTP request durations for Tempo Ingester"),
 		timeSeriesPanel.Chart(
@@ -495,20 +394,14 @@ func WritesIngesterQPS(datasourceName string, labelMatchers ...promql.LabelMatch
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"sum by (status) (\n  label_replace(label_replace(rate(tempo_request_duration_seconds_count{cluster=~\"$cluster\", job=~\"($namespace)/ingester\", route=~\"/tempopb.Pusher/Push.*\"}[$__rate_interval]),\n  \"status\", \"${1}xx\", \"status_code\", \"([0-9])..\"),\n  \"status\", \"${1}\", \"status_code\", \"([a-zA-Z]+)\"))\n",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{status}}"),
-			),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesIngesterQPS", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{status}}"),
 		),
 	)
 }
 
-func WritesIngesterLatency(datasourceName string, labelMatchers ...promql.LabelMatcher) panelgroup.Option {
+func WritesIngesterLatency(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Latency",
 		panel.Description("Shows the 99th and 50th quantile latency of Ingester."),
 		timeSeriesPanel.Chart(
@@ -530,40 +423,22 @@ func WritesIngesterLatency(datasourceName string, labelMatchers ...promql.LabelM
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"histogram_quantile(0.99, sum(rate(tempo_request_duration_seconds_bucket{cluster=~\"$cluster\", job=~\"($namespace)/ingester\",route=~\"/tempopb.Pusher/Push.*\"}[$__rate_interval])) by (le,)) * 1e3",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{route}} 99th"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"histogram_quantile(0.50, sum(rate(tempo_request_duration_seconds_bucket{cluster=~\"$cluster\", job=~\"($namespace)/ingester\",route=~\"/tempopb.Pusher/Push.*\"}[$__rate_interval])) by (le,)) * 1e3",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{route}} 50th"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"sum(rate(tempo_request_duration_seconds_sum{cluster=~\"$cluster\", job=~\"($namespace)/ingester\",route=~\"/tempopb.Pusher/Push.*\"}[$__rate_interval])) by () * 1e3 / sum(rate(tempo_request_duration_seconds_count{cluster=~\"$cluster\", job=~\"($namespace)/ingester\",route=~\"/tempopb.Pusher/Push.*\"}[$__rate_interval])) by ()",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{route}} Average"),
-			),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesIngesterLatency_p99", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{route}} 99th"),
+		),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesIngesterLatency_p50", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{route}} 50th"),
+		),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesIngesterLatency_avg", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{route}} Average"),
 		),
 	)
 }
 
-func WritesMemcachedIngesterQPS(datasourceName string, labelMatchers ...promql.LabelMatcher) panelgroup.Option {
+func WritesMemcachedIngesterQPS(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("QPS",
 		panel.Description("Rate of HTTP request durations for Tempo Memcached Ingester"),
 		timeSeriesPanel.Chart(
@@ -586,20 +461,14 @@ func WritesMemcachedIngesterQPS(datasourceName string, labelMatchers ...promql.L
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"sum by (status) (\n  label_replace(label_replace(rate(tempo_memcache_request_duration_seconds_count{cluster=~\"$cluster\", job=~\"($namespace)/ingester\",method=\"Memcache.Put\"}[$__rate_interval]),\n  \"status\", \"${1}xx\", \"status_code\", \"([0-9])..\"),\n  \"status\", \"${1}\", \"status_code\", \"([a-zA-Z]+)\"))\n",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{status}}"),
-			),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesMemcachedIngesterQPS", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{status}}"),
 		),
 	)
 }
 
-func WritesMemcachedIngesterLatency(datasourceName string, labelMatchers ...promql.LabelMatcher) panelgroup.Option {
+func WritesMemcachedIngesterLatency(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Latency",
 		panel.Description("Shows the 99th and 50th quantile latency of Memcached Ingester."),
 		timeSeriesPanel.Chart(
@@ -621,40 +490,22 @@ func WritesMemcachedIngesterLatency(datasourceName string, labelMatchers ...prom
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"histogram_quantile(0.99, sum(rate(tempo_memcache_request_duration_seconds_bucket{cluster=~\"$cluster\", job=~\"($namespace)/ingester\",method=\"Memcache.Put\"}[$__rate_interval])) by (le,)) * 1e3",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{route}} 99th"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"histogram_quantile(0.50, sum(rate(tempo_memcache_request_duration_seconds_bucket{cluster=~\"$cluster\", job=~\"($namespace)/ingester\",method=\"Memcache.Put\"}[$__rate_interval])) by (le,)) * 1e3",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{route}} 50th"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"sum(rate(tempo_memcache_request_duration_seconds_sum{cluster=~\"$cluster\", job=~\"($namespace)/ingester\",method=\"Memcache.Put\"}[$__rate_interval])) by () * 1e3 / sum(rate(tempo_memcache_request_duration_seconds_count{cluster=~\"$cluster\", job=~\"($namespace)/ingester\",method=\"Memcache.Put\"}[$__rate_interval])) by ()",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{route}} Average"),
-			),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesMemcachedIngesterLatency_p99", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{route}} 99th"),
+		),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesMemcachedIngesterLatency_p50", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{route}} 50th"),
+		),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesMemcachedIngesterLatency_avg", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{route}} Average"),
 		),
 	)
 }
 
-func WritesBackendIngesterQPS(datasourceName string, labelMatchers ...promql.LabelMatcher) panelgroup.Option {
+func WritesBackendIngesterQPS(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("QPS",
 		panel.Description("Rate of HTTP request durations for Tempo Backend Ingester"),
 		timeSeriesPanel.Chart(
@@ -677,20 +528,14 @@ func WritesBackendIngesterQPS(datasourceName string, labelMatchers ...promql.Lab
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"sum by (status) (\n  label_replace(label_replace(rate(tempodb_backend_request_duration_seconds_count{cluster=~\"$cluster\", job=~\"($namespace)/ingester\",operation=~\"(PUT|POST)\"}[$__rate_interval]),\n  \"status\", \"${1}xx\", \"status_code\", \"([0-9])..\"),\n  \"status\", \"${1}\", \"status_code\", \"([a-zA-Z]+)\"))\n",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{status}}"),
-			),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesBackendIngesterQPS", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{status}}"),
 		),
 	)
 }
 
-func WritesBackendIngesterLatency(datasourceName string, labelMatchers ...promql.LabelMatcher) panelgroup.Option {
+func WritesBackendIngesterLatency(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Latency",
 		panel.Description("Shows the 99th and 50th quantile latency of Backend Ingester."),
 		timeSeriesPanel.Chart(
@@ -712,40 +557,22 @@ func WritesBackendIngesterLatency(datasourceName string, labelMatchers ...promql
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"histogram_quantile(0.99, sum(rate(tempodb_backend_request_duration_seconds_bucket{cluster=~\"$cluster\", job=~\"($namespace)/ingester\",operation=~\"(PUT|POST)\"}[$__rate_interval])) by (le,)) * 1e3",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{route}} 99th"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"histogram_quantile(0.99, sum(rate(tempodb_backend_request_duration_seconds_bucket{cluster=~\"$cluster\", job=~\"($namespace)/ingester\",operation=~\"(PUT|POST)\"}[$__rate_interval])) by (le,)) * 1e3",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{route}} 50th"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"sum(rate(tempodb_backend_request_duration_seconds_sum{cluster=~\"$cluster\", job=~\"($namespace)/ingester\",operation=~\"(PUT|POST)\"}[$__rate_interval])) by () * 1e3 / sum(rate(tempodb_backend_request_duration_seconds_count{cluster=~\"$cluster\", job=~\"($namespace)/ingester\",operation=~\"(PUT|POST)\"}[$__rate_interval])) by ()",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{route}} Average"),
-			),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesBackendIngesterLatency_p99", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{route}} 99th"),
+		),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesBackendIngesterLatency_p50", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{route}} 50th"),
+		),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesBackendIngesterLatency_avg", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{route}} Average"),
 		),
 	)
 }
 
-func WritesMemcachedCompactorQPS(datasourceName string, labelMatchers ...promql.LabelMatcher) panelgroup.Option {
+func WritesMemcachedCompactorQPS(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("QPS",
 		panel.Description("Rate of HTTP request durations for Memcached Compactor"),
 		timeSeriesPanel.Chart(
@@ -768,20 +595,14 @@ func WritesMemcachedCompactorQPS(datasourceName string, labelMatchers ...promql.
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"sum by (status) (\n  label_replace(label_replace(rate(tempo_memcache_request_duration_seconds_count{cluster=~\"$cluster\", job=~\"($namespace)/compactor\",method=\"Memcache.Put\"}[$__rate_interval]),\n  \"status\", \"${1}xx\", \"status_code\", \"([0-9])..\"),\n  \"status\", \"${1}\", \"status_code\", \"([a-zA-Z]+)\"))\n",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{status}}"),
-			),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesMemcachedCompactorQPS", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{status}}"),
 		),
 	)
 }
 
-func WritesMemcachedCompactorLatency(datasourceName string, labelMatchers ...promql.LabelMatcher) panelgroup.Option {
+func WritesMemcachedCompactorLatency(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Latency",
 		panel.Description("Shows the 99th and 50th quantile latency of Backend Ingester."),
 		timeSeriesPanel.Chart(
@@ -803,40 +624,22 @@ func WritesMemcachedCompactorLatency(datasourceName string, labelMatchers ...pro
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"histogram_quantile(0.99, sum(rate(tempo_memcache_request_duration_seconds_bucket{cluster=~\"$cluster\", job=~\"($namespace)/compactor\",method=\"Memcache.Put\"}[$__rate_interval])) by (le,)) * 1e3",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{route}} 99th"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"histogram_quantile(0.50, sum(rate(tempo_memcache_request_duration_seconds_bucket{cluster=~\"$cluster\", job=~\"($namespace)/compactor\",method=\"Memcache.Put\"}[$__rate_interval])) by (le,)) * 1e3",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{route}} 50th"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"sum(rate(tempo_memcache_request_duration_seconds_sum{cluster=~\"$cluster\", job=~\"($namespace)/compactor\",method=\"Memcache.Put\"}[$__rate_interval])) by () * 1e3 / sum(rate(tempo_memcache_request_duration_seconds_count{cluster=~\"$cluster\", job=~\"($namespace)/compactor\",method=\"Memcache.Put\"}[$__rate_interval])) by ()",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{route}} Average"),
-			),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesMemcachedCompactorLatency_p99", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{route}} 99th"),
+		),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesMemcachedCompactorLatency_p50", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{route}} 50th"),
+		),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesMemcachedCompactorLatency_avg", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{route}} Average"),
 		),
 	)
 }
 
-func WritesBackendCompactorQPS(datasourceName string, labelMatchers ...promql.LabelMatcher) panelgroup.Option {
+func WritesBackendCompactorQPS(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("QPS",
 		panel.Description("Rate of HTTP request durations for Backend Compactor"),
 		timeSeriesPanel.Chart(
@@ -859,20 +662,14 @@ func WritesBackendCompactorQPS(datasourceName string, labelMatchers ...promql.La
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"sum by (status) (\n  label_replace(label_replace(rate(tempodb_backend_request_duration_seconds_count{cluster=~\"$cluster\", job=~\"($namespace)/compactor\",operation=~\"(PUT|POST)\"}[$__rate_interval]),\n  \"status\", \"${1}xx\", \"status_code\", \"([0-9])..\"),\n  \"status\", \"${1}\", \"status_code\", \"([a-zA-Z]+)\"))\n",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{status}}"),
-			),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesBackendCompactorQPS", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{status}}"),
 		),
 	)
 }
 
-func WritesBackendCompactorLatency(datasourceName string, labelMatchers ...promql.LabelMatcher) panelgroup.Option {
+func WritesBackendCompactorLatency(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
 	return panelgroup.AddPanel("Latency",
 		panel.Description("Shows the 99th and 50th quantile latency of Backend Ingester."),
 		timeSeriesPanel.Chart(
@@ -894,35 +691,17 @@ func WritesBackendCompactorLatency(datasourceName string, labelMatchers ...promq
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"histogram_quantile(0.99, sum(rate(tempodb_backend_request_duration_seconds_bucket{cluster=~\"$cluster\", job=~\"($namespace)/compactor\",operation=~\"(PUT|POST)\"}[$__rate_interval])) by (le,)) * 1e3",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{route}} 99th"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"histogram_quantile(0.99, sum(rate(tempodb_backend_request_duration_seconds_bucket{cluster=~\"$cluster\", job=~\"($namespace)/compactor\",operation=~\"(PUT|POST)\"}[$__rate_interval])) by (le,)) * 1e3",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{route}} 50th"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchers(
-					"sum(rate(tempodb_backend_request_duration_seconds_sum{cluster=~\"$cluster\", job=~\"($namespace)/compactor\",operation=~\"(PUT|POST)\"}[$__rate_interval])) by () * 1e3 / sum(rate(tempodb_backend_request_duration_seconds_count{cluster=~\"$cluster\", job=~\"($namespace)/compactor\",operation=~\"(PUT|POST)\"}[$__rate_interval])) by ()",
-					labelMatchers,
-				),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{route}} Average"),
-			),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesBackendCompactorLatency_p99", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{route}} 99th"),
+		),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesBackendCompactorLatency_p50", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{route}} 50th"),
+		),
+		promql.AddQueryFrom("TempoCommonPanelQueries", TempoCommonPanelQueries, "WritesBackendCompactorLatency_avg", labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{route}} Average"),
 		),
 	)
 }
@@ -48,15 +48,12 @@ func BucketOperationRate(datasourceName string, labelMatchers ...*labels.Matcher
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["BucketOperationRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}} {{operation}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"BucketOperationRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}} {{operation}} {{namespace}}"),
 		),
 	)
 }
@@ -83,15 +80,12 @@ func BucketOperationErrors(datasourceName string, labelMatchers ...*labels.Match
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["BucketOperationErrors"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}} {{operation}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"BucketOperationErrors",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}} {{operation}} {{namespace}}"),
 		),
 	)
 }
@@ -117,35 +111,26 @@ func BucketOperationDurations(datasourceName string, labelMatchers ...*labels.Ma
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["BucketOperationDurations_99"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("p99 {{job}} {{operation}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"BucketOperationDurations_99",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p99 {{job}} {{operation}} {{namespace}}"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["BucketOperationDurations_90"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("p90 {{job}} {{operation}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"BucketOperationDurations_90",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p90 {{job}} {{operation}} {{namespace}}"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["BucketOperationDurations_50"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("p50 {{job}} {{operation}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"BucketOperationDurations_50",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p50 {{job}} {{operation}} {{namespace}}"),
 		),
 	)
 }
@@ -172,15 +157,12 @@ func ReadGRPCUnaryRate(datasourceName string, labelMatchers ...*labels.Matcher)
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["ReadGRPCUnaryRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{namespace}} {{job}} {{grpc_method}} {{grpc_code}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"ReadGRPCUnaryRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{namespace}} {{job}} {{grpc_method}} {{grpc_code}}"),
 		),
 	)
 }
@@ -207,15 +189,12 @@ func ReadGRPCUnaryErrors(datasourceName string, labelMatchers ...*labels.Matcher
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["ReadGRPCUnaryErrors"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{namespace}} {{job}} {{grpc_method}} {{grpc_code}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"ReadGRPCUnaryErrors",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{namespace}} {{job}} {{grpc_method}} {{grpc_code}}"),
 		),
 	)
 }
@@ -241,35 +220,26 @@ func ReadGPRCUnaryDurations(datasourceName string, labelMatchers ...*labels.Matc
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["ReadGPRCUnaryDurations_50"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("p50 {{namespace}} {{job}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"ReadGPRCUnaryDurations_50",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p50 {{namespace}} {{job}}"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["ReadGPRCUnaryDurations_90"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("p90 {{namespace}} {{job}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"ReadGPRCUnaryDurations_90",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p90 {{namespace}} {{job}}"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["ReadGPRCUnaryDurations_99"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("p99 {{namespace}} {{job}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"ReadGPRCUnaryDurations_99",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p99 {{namespace}} {{job}}"),
 		),
 	)
 }
@@ -296,15 +266,12 @@ func ReadGRPCStreamRate(datasourceName string, labelMatchers ...*labels.Matcher)
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["ReadGRPCStreamRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{namespace}} {{job}} {{grpc_method}} {{grpc_code}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"ReadGRPCStreamRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{namespace}} {{job}} {{grpc_method}} {{grpc_code}}"),
 		),
 	)
 }
@@ -331,15 +298,12 @@ func ReadGRPCStreamErrors(datasourceName string, labelMatchers ...*labels.Matche
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["ReadGRPCStreamErrors"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{namespace}} {{job}} {{grpc_method}} {{grpc_code}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"ReadGRPCStreamErrors",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{namespace}} {{job}} {{grpc_method}} {{grpc_code}}"),
 		),
 	)
 }
@@ -365,35 +329,26 @@ func ReadGPRCStreamDurations(datasourceName string, labelMatchers ...*labels.Mat
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["ReadGPRCStreamDurations_50"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("p50 {{namespace}} {{job}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"ReadGPRCStreamDurations_50",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p50 {{namespace}} {{job}}"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["ReadGPRCStreamDurations_90"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("p90 {{namespace}} {{job}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"ReadGPRCStreamDurations_90",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p90 {{namespace}} {{job}}"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["ReadGPRCStreamDurations_99"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("p99 {{namespace}} {{job}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"ReadGPRCStreamDurations_99",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p99 {{namespace}} {{job}}"),
 		),
 	)
 }
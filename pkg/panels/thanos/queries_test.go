@@ -0,0 +1,39 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thanos
+
+import (
+	"testing"
+
+	"github.com/perses/community-mixins/pkg/querytest"
+)
+
+func TestThanosCommonPanelQueries(t *testing.T) {
+	querytest.Suite{
+		SeriesFile: "testdata/series.test",
+		Queries:    ThanosCommonPanelQueries,
+		Variables: map[string]string{
+			"namespace": "thanos",
+			// The dashboards of every component share the queries, so they are evaluated against all of them.
+			"job":    "thanos-.*",
+			"tenant": "team-a",
+		},
+		Labels: map[string][]string{
+			"RuleGroupEvaluationsTooSlow": {`{namespace="thanos", job="thanos-ruler", rule_group="/etc/thanos/rules.yaml;alerts"}`},
+			"TenantedRemoteWriteRequestErrors": {
+				`{tenant="team-a", namespace="thanos", job="thanos-receive-router", code="500"}`,
+			},
+		},
+	}.Run(t)
+}
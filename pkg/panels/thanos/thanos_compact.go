@@ -48,15 +48,12 @@ func GroupCompactionRate(datasourceName string, labelMatchers ...*labels.Matcher
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["GroupCompactionRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Resolution: {{resolution}} - {{job}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"GroupCompactionRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Resolution: {{resolution}} - {{job}} {{namespace}}"),
 		),
 	)
 }
@@ -83,15 +80,12 @@ func GroupCompactionErrors(datasourceName string, labelMatchers ...*labels.Match
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["GroupCompactionErrors"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"GroupCompactionErrors",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}} {{namespace}}"),
 		),
 	)
 }
@@ -118,15 +112,12 @@ func DownsampleRate(datasourceName string, labelMatchers ...*labels.Matcher) pan
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["DownsampleRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("Resolution: {{resolution}} - {{job}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"DownsampleRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Resolution: {{resolution}} - {{job}} {{namespace}}"),
 		),
 	)
 }
@@ -153,15 +144,12 @@ func DownsampleErrors(datasourceName string, labelMatchers ...*labels.Matcher) p
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["DownsampleErrors"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"DownsampleErrors",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}} {{namespace}}"),
 		),
 	)
 }
@@ -187,35 +175,26 @@ func DownsampleDurations(datasourceName string, labelMatchers ...*labels.Matcher
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["DownsampleDurations_50"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("p50 Resolution: {{resolution}} - {{job}} {{namespace}}"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["DownsampleDurations_90"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("p90 Resolution: {{resolution}} - {{job}} {{namespace}}"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["DownsampleDurations_99"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("p99 Resolution: {{resolution}} - {{job}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"DownsampleDurations_50",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p50 Resolution: {{resolution}} - {{job}} {{namespace}}"),
+		),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"DownsampleDurations_90",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p90 Resolution: {{resolution}} - {{job}} {{namespace}}"),
+		),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"DownsampleDurations_99",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p99 Resolution: {{resolution}} - {{job}} {{namespace}}"),
 		),
 	)
 }
@@ -242,15 +221,12 @@ func SyncMetaRate(datasourceName string, labelMatchers ...*labels.Matcher) panel
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["SyncMetaRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"SyncMetaRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}} {{namespace}}"),
 		),
 	)
 }
@@ -277,15 +253,12 @@ func SyncMetaErrors(datasourceName string, labelMatchers ...*labels.Matcher) pan
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["SyncMetaErrors"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"SyncMetaErrors",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}} {{namespace}}"),
 		),
 	)
 }
@@ -311,35 +284,26 @@ func SyncMetaDurations(datasourceName string, labelMatchers ...*labels.Matcher)
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["SyncMetaDurations_50"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("p50 {{job}} {{namespace}}"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["SyncMetaDurations_90"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("p90 {{job}} {{namespace}}"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["SyncMetaDurations_99"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("p99 {{job}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"SyncMetaDurations_50",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p50 {{job}} {{namespace}}"),
+		),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"SyncMetaDurations_90",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p90 {{job}} {{namespace}}"),
+		),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"SyncMetaDurations_99",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p99 {{job}} {{namespace}}"),
 		),
 	)
 }
@@ -366,15 +330,12 @@ func DeletionRate(datasourceName string, labelMatchers ...*labels.Matcher) panel
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["DeletionRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"DeletionRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}} {{namespace}}"),
 		),
 	)
 }
@@ -401,15 +362,12 @@ func DeletionErrors(datasourceName string, labelMatchers ...*labels.Matcher) pan
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["DeletionErrors"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"DeletionErrors",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}} {{namespace}}"),
 		),
 	)
 }
@@ -436,15 +394,12 @@ func MarkingRate(datasourceName string, labelMatchers ...*labels.Matcher) panelg
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["MarkingRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"MarkingRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}} {{namespace}}"),
 		),
 	)
 }
@@ -471,15 +426,12 @@ func GarbageCollectionRate(datasourceName string, labelMatchers ...*labels.Match
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["GarbageCollectionRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"GarbageCollectionRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}} {{namespace}}"),
 		),
 	)
 }
@@ -506,15 +458,12 @@ func GarbageCollectionErrors(datasourceName string, labelMatchers ...*labels.Mat
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["GarbageCollectionErrors"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"GarbageCollectionErrors",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}} {{namespace}}"),
 		),
 	)
 }
@@ -540,35 +489,26 @@ func GarbageCollectionDurations(datasourceName string, labelMatchers ...*labels.
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["GarbageCollectionDurations_50"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("p50 {{job}} {{namespace}}"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["GarbageCollectionDurations_90"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("p90 {{job}} {{namespace}}"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["GarbageCollectionDurations_99"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("p99 {{job}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"GarbageCollectionDurations_50",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p50 {{job}} {{namespace}}"),
+		),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"GarbageCollectionDurations_90",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p90 {{job}} {{namespace}}"),
+		),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"GarbageCollectionDurations_99",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p99 {{job}} {{namespace}}"),
 		),
 	)
 }
@@ -595,15 +535,12 @@ func TodoCompactionBlocks(datasourceName string, labelMatchers ...*labels.Matche
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["TodoCompactionBlocks"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"TodoCompactionBlocks",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}} {{namespace}}"),
 		),
 	)
 }
@@ -630,15 +567,12 @@ func TodoCompactions(datasourceName string, labelMatchers ...*labels.Matcher) pa
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["TodoCompactions"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"TodoCompactions",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}} {{namespace}}"),
 		),
 	)
 }
@@ -665,15 +599,12 @@ func TodoDeletions(datasourceName string, labelMatchers ...*labels.Matcher) pane
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["TodoDeletions"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"TodoDeletions",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}} {{namespace}}"),
 		),
 	)
 }
@@ -700,15 +631,12 @@ func TodoDownsamples(datasourceName string, labelMatchers ...*labels.Matcher) pa
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["TodoDownsamples"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"TodoDownsamples",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}} {{namespace}}"),
 		),
 	)
 }
@@ -735,15 +663,12 @@ func HaltedCompactors(datasourceName string, labelMatchers ...*labels.Matcher) p
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["HaltedCompactors"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"HaltedCompactors",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}} {{namespace}}"),
 		),
 	)
 }
@@ -48,15 +48,12 @@ func InstantQueryRequestRate(datasourceName string, labelMatchers ...*labels.Mat
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["InstantQueryRequestRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}} {{namespace}} {{handler}} {{code}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"InstantQueryRequestRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}} {{namespace}} {{handler}} {{code}}"),
 		),
 	)
 }
@@ -83,15 +80,12 @@ func InstantQueryRequestErrors(datasourceName string, labelMatchers ...*labels.M
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["InstantQueryRequestErrors"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}} {{namespace}} {{code}} {{handler}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"InstantQueryRequestErrors",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}} {{namespace}} {{code}} {{handler}}"),
 		),
 	)
 }
@@ -117,35 +111,26 @@ func InstantQueryRequestDurations(datasourceName string, labelMatchers ...*label
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["InstantQueryRequestDurations_50"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("p50 {{job}} - {{namespace}} duration"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"InstantQueryRequestDurations_50",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p50 {{job}} - {{namespace}} duration"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["InstantQueryRequestDurations_90"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("p90 {{job}} - {{namespace}} duration"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"InstantQueryRequestDurations_90",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p90 {{job}} - {{namespace}} duration"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["InstantQueryRequestDurations_99"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("p99 {{job}} {{namespace}} duration"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"InstantQueryRequestDurations_99",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p99 {{job}} {{namespace}} duration"),
 		),
 	)
 }
@@ -172,15 +157,12 @@ func RangeQueryRequestRate(datasourceName string, labelMatchers ...*labels.Match
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["RangeQueryRequestRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}} {{namespace}} {{handler}} {{code}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"RangeQueryRequestRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}} {{namespace}} {{handler}} {{code}}"),
 		),
 	)
 }
@@ -207,15 +189,12 @@ func RangeQueryRequestErrors(datasourceName string, labelMatchers ...*labels.Mat
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["RangeQueryRequestErrors"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}} {{namespace}} {{code}} {{handler}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"RangeQueryRequestErrors",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}} {{namespace}} {{code}} {{handler}}"),
 		),
 	)
 }
@@ -241,35 +220,26 @@ func RangeQueryRequestDurations(datasourceName string, labelMatchers ...*labels.
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["RangeQueryRequestDurations_50"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("p50 {{job}} - {{namespace}} duration"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"RangeQueryRequestDurations_50",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p50 {{job}} - {{namespace}} duration"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["RangeQueryRequestDurations_90"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("p90 {{job}} - {{namespace}} duration"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"RangeQueryRequestDurations_90",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p90 {{job}} - {{namespace}} duration"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["RangeQueryRequestDurations_99"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("p99 {{job}} {{namespace}} duration"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"RangeQueryRequestDurations_99",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p99 {{job}} {{namespace}} duration"),
 		),
 	)
 }
@@ -291,15 +261,12 @@ func QueryConcurrency(datasourceName string, labelMatchers ...*labels.Matcher) p
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["QueryConcurrency"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}} {{pod}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"QueryConcurrency",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}} {{pod}}"),
 		),
 	)
 }
@@ -325,15 +292,12 @@ func DNSLookups(datasourceName string, labelMatchers ...*labels.Matcher) panelgr
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["DNSLookups"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"DNSLookups",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}}"),
 		),
 	)
 }
@@ -360,15 +324,12 @@ func DNSLookupsErrors(datasourceName string, labelMatchers ...*labels.Matcher) p
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["DNSLookupsErrors"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"DNSLookupsErrors",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}}"),
 		),
 	)
 }
@@ -48,15 +48,12 @@ func QueryFrontendRequestRate(datasourceName string, labelMatchers ...*labels.Ma
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["QueryFrontendRequestRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{code}} - {{job}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"QueryFrontendRequestRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{code}} - {{job}} {{namespace}}"),
 		),
 	)
 }
@@ -83,15 +80,12 @@ func QueryFrontendQueryRate(datasourceName string, labelMatchers ...*labels.Matc
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["QueryFrontendQueryRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{code}} - {{job}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"QueryFrontendQueryRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{code}} - {{job}} {{namespace}}"),
 		),
 	)
 }
@@ -118,15 +112,12 @@ func QueryFrontendErrors(datasourceName string, labelMatchers ...*labels.Matcher
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["QueryFrontendErrors"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{code}} - {{job}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"QueryFrontendErrors",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{code}} - {{job}} {{namespace}}"),
 		),
 	)
 }
@@ -152,35 +143,26 @@ func QueryFrontendDurations(datasourceName string, labelMatchers ...*labels.Matc
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["QueryFrontendDurations_50"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("p50 {{job}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"QueryFrontendDurations_50",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p50 {{job}} {{namespace}}"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["QueryFrontendDurations_90"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("p90 {{job}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"QueryFrontendDurations_90",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p90 {{job}} {{namespace}}"),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["QueryFrontendDurations_99"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("p99 {{job}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"QueryFrontendDurations_99",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p99 {{job}} {{namespace}}"),
 		),
 	)
 }
@@ -207,15 +189,12 @@ func QueryFrontendCacheRequestRate(datasourceName string, labelMatchers ...*labe
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["QueryFrontendCacheRequestRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{tripperware}} - {{job}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"QueryFrontendCacheRequestRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{tripperware}} - {{job}} {{namespace}}"),
 		),
 	)
 }
@@ -242,15 +221,12 @@ func QueryFrontendCacheHitRate(datasourceName string, labelMatchers ...*labels.M
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["QueryFrontendCacheHitRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{tripperware}} - {{job}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"QueryFrontendCacheHitRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{tripperware}} - {{job}} {{namespace}}"),
 		),
 	)
 }
@@ -277,15 +253,12 @@ func QueryFrontendCacheMissRate(datasourceName string, labelMatchers ...*labels.
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["QueryFrontendCacheMissRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{tripperware}} - {{job}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"QueryFrontendCacheMissRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{tripperware}} - {{job}} {{namespace}}"),
 		),
 	)
 }
@@ -312,15 +285,12 @@ func QueryFrontendFetchedKeyRate(datasourceName string, labelMatchers ...*labels
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["QueryFrontendFetchedKeyRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{tripperware}} - {{job}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"QueryFrontendFetchedKeyRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{tripperware}} - {{job}} {{namespace}}"),
 		),
 	)
 }
@@ -49,15 +49,12 @@ func RemoteWriteRequestRate(datasourceName string, labelMatchers ...*labels.Matc
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["RemoteWriteRequestRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}} {{namespace}} {{handler}} {{code}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"RemoteWriteRequestRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}} {{namespace}} {{handler}} {{code}}"),
 		),
 	)
 }
@@ -84,15 +81,12 @@ func RemoteWriteRequestErrors(datasourceName string, labelMatchers ...*labels.Ma
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["RemoteWriteRequestErrors"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}} {{namespace}} {{code}} {{handler}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"RemoteWriteRequestErrors",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}} {{namespace}} {{code}} {{handler}}"),
 		),
 	)
 }
@@ -118,35 +112,26 @@ func RemoteWriteRequestDurations(datasourceName string, labelMatchers ...*labels
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["RemoteWriteRequestDurations_50"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("p50 {{job}} - {{namespace}} duration"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["RemoteWriteRequestDurations_90"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("p90 {{job}} - {{namespace}} duration"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["RemoteWriteRequestDurations_99"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("p99 {{job}} {{namespace}} duration"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"RemoteWriteRequestDurations_50",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p50 {{job}} - {{namespace}} duration"),
+		),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"RemoteWriteRequestDurations_90",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p90 {{job}} - {{namespace}} duration"),
+		),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"RemoteWriteRequestDurations_99",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p99 {{job}} {{namespace}} duration"),
 		),
 	)
 }
@@ -172,15 +157,12 @@ func TenantedRemoteWriteRequestRate(datasourceName string, labelMatchers ...*lab
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["TenantedRemoteWriteRequestRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{tenant}} {{code}} {{job}} {{namespace}} {{handler}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"TenantedRemoteWriteRequestRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{tenant}} {{code}} {{job}} {{namespace}} {{handler}}"),
 		),
 	)
 }
@@ -206,15 +188,12 @@ func TenantedRemoteWriteRequestErrors(datasourceName string, labelMatchers ...*l
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["TenantedRemoteWriteRequestErrors"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{tenant}} {{code}} {{job}} {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"TenantedRemoteWriteRequestErrors",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{tenant}} {{code}} {{job}} {{namespace}}"),
 		),
 	)
 }
@@ -240,15 +219,12 @@ func TenantedRemoteWriteRequestDurations(datasourceName string, labelMatchers ..
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["TenantedRemoteWriteRequestDurations"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{tenant}} {{namespace}} {{job}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"TenantedRemoteWriteRequestDurations",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{tenant}} {{namespace}} {{job}}"),
 		),
 	)
 }
@@ -273,15 +249,12 @@ func AvgRemoteWriteRequestSize(datasourceName string, labelMatchers ...*labels.M
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["AvgRemoteWriteRequestSize"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{tenant}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"AvgRemoteWriteRequestSize",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{tenant}}"),
 		),
 	)
 }
@@ -307,15 +280,12 @@ func AvgFailedRemoteWriteRequestSize(datasourceName string, labelMatchers ...*la
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["AvgFailedRemoteWriteRequestSize"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{tenant}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"AvgFailedRemoteWriteRequestSize",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{tenant}}"),
 		),
 	)
 }
@@ -341,15 +311,12 @@ func InflightRemoteWriteRequests(datasourceName string, labelMatchers ...*labels
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["InflightRemoteWriteRequests"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{method}} {{tenant}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"InflightRemoteWriteRequests",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{method}} {{tenant}}"),
 		),
 	)
 }
@@ -370,15 +337,12 @@ func RemoteWriteSeriesRate(datasourceName string, labelMatchers ...*labels.Match
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["RemoteWriteSeriesRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{tenant}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"RemoteWriteSeriesRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{tenant}}"),
 		),
 	)
 }
@@ -399,15 +363,12 @@ func RemoteWriteSeriesNotWrittenRate(datasourceName string, labelMatchers ...*la
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["RemoteWriteSeriesNotWrittenRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{tenant}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"RemoteWriteSeriesNotWrittenRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{tenant}}"),
 		),
 	)
 }
@@ -428,15 +389,12 @@ func RemoteWriteSamplesRate(datasourceName string, labelMatchers ...*labels.Matc
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["RemoteWriteSamplesRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{tenant}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"RemoteWriteSamplesRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{tenant}}"),
 		),
 	)
 }
@@ -457,15 +415,12 @@ func RemoteWriteSamplesNotWrittenRate(datasourceName string, labelMatchers ...*l
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["RemoteWriteSamplesNotWrittenRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{tenant}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"RemoteWriteSamplesNotWrittenRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{tenant}}"),
 		),
 	)
 }
@@ -491,15 +446,12 @@ func RemoteWriteReplicationRate(datasourceName string, labelMatchers ...*labels.
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["RemoteWriteReplicationRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"RemoteWriteReplicationRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}}"),
 		),
 	)
 }
@@ -525,15 +477,12 @@ func RemoteWriteReplicationErrorRate(datasourceName string, labelMatchers ...*la
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["RemoteWriteReplicationErrorRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"RemoteWriteReplicationErrorRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}}"),
 		),
 	)
 }
@@ -559,15 +508,12 @@ func RemoteWriteForwardRate(datasourceName string, labelMatchers ...*labels.Matc
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["RemoteWriteForwardRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"RemoteWriteForwardRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}}"),
 		),
 	)
 }
@@ -593,15 +539,12 @@ func RemoteWriteForwardErrorRate(datasourceName string, labelMatchers ...*labels
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["RemoteWriteForwardErrorRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"RemoteWriteForwardErrorRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}}"),
 		),
 	)
 }
@@ -628,15 +571,12 @@ func WriteGRPCUnaryRate(datasourceName string, labelMatchers ...*labels.Matcher)
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["WriteGRPCUnaryRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{namespace}} {{job}} {{grpc_method}} {{grpc_code}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"WriteGRPCUnaryRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{namespace}} {{job}} {{grpc_method}} {{grpc_code}}"),
 		),
 	)
 }
@@ -663,15 +603,12 @@ func WriteGRPCUnaryErrors(datasourceName string, labelMatchers ...*labels.Matche
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["WriteGRPCUnaryErrors"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{namespace}} {{job}} {{grpc_method}} {{grpc_code}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"WriteGRPCUnaryErrors",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{namespace}} {{job}} {{grpc_method}} {{grpc_code}}"),
 		),
 	)
 }
@@ -697,35 +634,26 @@ func WriteGPRCUnaryDurations(datasourceName string, labelMatchers ...*labels.Mat
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["WriteGPRCUnaryDurations_50"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("p50 {{namespace}} {{job}}"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["WriteGPRCUnaryDurations_90"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("p90 {{namespace}} {{job}}"),
-			),
-		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["WriteGPRCUnaryDurations_99"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("p99 {{namespace}} {{job}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"WriteGPRCUnaryDurations_50",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p50 {{namespace}} {{job}}"),
+		),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"WriteGPRCUnaryDurations_90",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p90 {{namespace}} {{job}}"),
+		),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"WriteGPRCUnaryDurations_99",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p99 {{namespace}} {{job}}"),
 		),
 	)
 }
@@ -746,15 +674,12 @@ func ReceiveAppendedSampleRate(datasourceName string, labelMatchers ...*labels.M
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["ReceiveAppendedSampleRate"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}} - {{namespace}}"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"ReceiveAppendedSampleRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}} - {{namespace}}"),
 		),
 	)
 }
@@ -775,15 +700,12 @@ func ReceiveHeadSeries(datasourceName string, labelMatchers ...*labels.Matcher)
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["ReceiveHeadSeries"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}} - {{namespace}} - Head Series"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"ReceiveHeadSeries",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}} - {{namespace}} - Head Series"),
 		),
 	)
 }
@@ -804,15 +726,12 @@ func ReceiveHeadChunks(datasourceName string, labelMatchers ...*labels.Matcher)
 				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["ReceiveHeadChunks"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-				query.SeriesNameFormat("{{job}} - {{namespace}} - Head Chunks"),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"ReceiveHeadChunks",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{job}} - {{namespace}} - Head Chunks"),
 		),
 	)
 }
@@ -847,14 +766,11 @@ func BucketUploadTable(datasourceName string, labelMatchers ...*labels.Matcher)
 				},
 			}),
 		),
-		panel.AddQuery(
-			query.PromQL(
-				promql.SetLabelMatchersV2(
-					ThanosCommonPanelQueries["BucketUploadTable_uploadedAgo"],
-					labelMatchers,
-				).Pretty(0),
-				dashboards.AddQueryDataSource(datasourceName),
-			),
+		promql.AddQueryFrom(
+			"ThanosCommonPanelQueries", ThanosCommonPanelQueries,
+			"BucketUploadTable_uploadedAgo",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
 		),
 	)
 }
@@ -0,0 +1,201 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thanosoperator
+
+import (
+	"maps"
+
+	"github.com/perses/community-mixins/pkg/promql"
+	promqlbuilder "github.com/perses/promql-builder"
+	"github.com/perses/promql-builder/label"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+var ThanosOperatorCommonPanelQueries = map[string]parser.Expr{
+	// Controller reconciliation
+	"ReconcileRate": promql.SumByRate(
+		"controller_runtime_reconcile_total",
+		[]string{"controller", "result"},
+		label.New("job").EqualRegexp("$job"),
+	),
+	"ReconcileErrors": promql.ErrorCasePercentage(
+		"controller_runtime_reconcile_errors_total",
+		[]string{"controller"},
+		[]*labels.Matcher{
+			label.New("job").EqualRegexp("$job"),
+		},
+		"controller_runtime_reconcile_total",
+		[]string{"controller"},
+		[]*labels.Matcher{
+			label.New("job").EqualRegexp("$job"),
+		},
+	),
+	"ReconcileDuration_99": promqlbuilder.HistogramQuantile(
+		0.99,
+		promql.SumByRate(
+			"controller_runtime_reconcile_time_seconds_bucket",
+			[]string{"controller", "le"},
+			label.New("job").EqualRegexp("$job"),
+		),
+	),
+	"ReconcileDuration_50": promqlbuilder.HistogramQuantile(
+		0.50,
+		promql.SumByRate(
+			"controller_runtime_reconcile_time_seconds_bucket",
+			[]string{"controller", "le"},
+			label.New("job").EqualRegexp("$job"),
+		),
+	),
+
+	// Workqueue
+	"WorkqueueDepth": promql.SumBy(
+		"workqueue_depth",
+		[]string{"name"},
+		label.New("job").EqualRegexp("$job"),
+	),
+	"WorkqueueRetries": promql.SumByRate(
+		"workqueue_retries_total",
+		[]string{"name"},
+		label.New("job").EqualRegexp("$job"),
+	),
+	"WorkqueueLatency_99": promqlbuilder.HistogramQuantile(
+		0.99,
+		promql.SumByRate(
+			"workqueue_queue_duration_seconds_bucket",
+			[]string{"name", "le"},
+			label.New("job").EqualRegexp("$job"),
+		),
+	),
+	"WorkqueueLatency_50": promqlbuilder.HistogramQuantile(
+		0.50,
+		promql.SumByRate(
+			"workqueue_queue_duration_seconds_bucket",
+			[]string{"name", "le"},
+			label.New("job").EqualRegexp("$job"),
+		),
+	),
+
+	// Paused resources
+	"PausedResources": promqlbuilder.Eqlc(
+		promql.MaxBy(
+			"thanos_operator_paused",
+			[]string{"component", "controller", "namespace", "resource"},
+			label.New("job").EqualRegexp("$job"),
+		),
+		promqlbuilder.NewNumber(1),
+	),
+
+	// ThanosQuery
+	"QueryEndpointsConfigured": promql.MaxBy(
+		"thanos_operator_query_endpoints_configured",
+		[]string{"namespace", "resource"},
+		label.New("job").EqualRegexp("$job"),
+	),
+	"QueryServiceEventReconciliations": promql.SumByRate(
+		"thanos_operator_query_service_event_reconciliations_total",
+		[]string{"namespace", "resource"},
+		label.New("job").EqualRegexp("$job"),
+	),
+
+	// ThanosReceive
+	"ReceiveHashringsConfigured": promql.MaxBy(
+		"thanos_operator_receive_hashrings_configured",
+		[]string{"namespace", "resource"},
+		label.New("job").EqualRegexp("$job"),
+	),
+	"ReceiveHashringEndpointsConfigured": promql.MaxBy(
+		"thanos_operator_receive_hashring_endpoints_configured",
+		[]string{"namespace", "resource", "hashring"},
+		label.New("job").EqualRegexp("$job"),
+	),
+	"ReceiveEndpointEventReconciliations": promql.SumByRate(
+		"thanos_operator_receive_endpoint_event_reconciliations_total",
+		[]string{"namespace", "resource"},
+		label.New("job").EqualRegexp("$job"),
+	),
+
+	// ThanosRuler
+	"RulerQueryEndpointsConfigured": promql.MaxBy(
+		"thanos_operator_ruler_query_endpoints_configured",
+		[]string{"namespace", "resource"},
+		label.New("job").EqualRegexp("$job"),
+	),
+	"RulerRulesConfigured_promRules": promql.MaxBy(
+		"thanos_operator_ruler_promrules_found",
+		[]string{"namespace", "resource"},
+		label.New("job").EqualRegexp("$job"),
+	),
+	"RulerRulesConfigured_ruleFiles": promql.MaxBy(
+		"thanos_operator_ruler_rulefiles_configured",
+		[]string{"namespace", "resource"},
+		label.New("job").EqualRegexp("$job"),
+	),
+	"RulerConfigMapOperations_created": promql.SumByRate(
+		"thanos_operator_ruler_cfgmaps_created_total",
+		[]string{"namespace", "resource"},
+		label.New("job").EqualRegexp("$job"),
+	),
+	"RulerConfigMapOperations_failures": promql.SumByRate(
+		"thanos_operator_ruler_cfgmaps_creation_failures_total",
+		[]string{"namespace", "resource"},
+		label.New("job").EqualRegexp("$job"),
+	),
+	"RulerEventReconciliations_service": promql.SumByRate(
+		"thanos_operator_ruler_service_event_reconciliations_total",
+		[]string{"namespace", "resource"},
+		label.New("job").EqualRegexp("$job"),
+	),
+	"RulerEventReconciliations_cfgmap": promql.SumByRate(
+		"thanos_operator_ruler_cfgmap_event_reconciliations_total",
+		[]string{"namespace", "resource"},
+		label.New("job").EqualRegexp("$job"),
+	),
+	"RulerEventReconciliations_promrule": promql.SumByRate(
+		"thanos_operator_ruler_promrule_event_reconciliations_total",
+		[]string{"namespace", "resource"},
+		label.New("job").EqualRegexp("$job"),
+	),
+
+	// ThanosStore
+	"StoreShardsConfigured": promql.MaxBy(
+		"thanos_operator_store_shards_configured",
+		[]string{"namespace", "resource"},
+		label.New("job").EqualRegexp("$job"),
+	),
+	"StoreShardFailures": promql.SumByRate(
+		"thanos_operator_store_shards_creation_update_failures_total",
+		[]string{"namespace", "resource"},
+		label.New("job").EqualRegexp("$job"),
+	),
+
+	// ThanosCompact
+	"CompactShardsConfigured": promql.MaxBy(
+		"thanos_operator_compact_shards_configured",
+		[]string{"namespace", "resource"},
+		label.New("job").EqualRegexp("$job"),
+	),
+	"CompactShardFailures": promql.SumByRate(
+		"thanos_operator_compact_shards_creation_update_failures_total",
+		[]string{"namespace", "resource"},
+		label.New("job").EqualRegexp("$job"),
+	),
+}
+
+// OverrideThanosOperatorPanelQueries overrides the ThanosOperatorCommonPanelQueries global.
+// Refer to panel queries in the map, that you'd like to override.
+// The convention of naming followed, is to use Panel function name (with _suffix, in case panel has multiple queries)
+func OverrideThanosOperatorPanelQueries(queries map[string]parser.Expr) {
+	maps.Copy(ThanosOperatorCommonPanelQueries, queries)
+}
@@ -0,0 +1,706 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thanosoperator
+
+import (
+	"github.com/perses/perses/go-sdk/panel"
+	panelgroup "github.com/perses/perses/go-sdk/panel-group"
+	"github.com/perses/plugins/prometheus/sdk/go/query"
+	"github.com/prometheus/prometheus/model/labels"
+
+	"github.com/perses/community-mixins/pkg/dashboards"
+	"github.com/perses/community-mixins/pkg/promql"
+
+	commonSdk "github.com/perses/perses/go-sdk/common"
+	tablePanel "github.com/perses/plugins/table/sdk/go"
+	timeSeriesPanel "github.com/perses/plugins/timeserieschart/sdk/go"
+)
+
+func ReconcileRate(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+	return panelgroup.AddPanel("Reconcile Rate",
+		panel.Description("Shows the rate of reconciliations per controller, split by result."),
+		timeSeriesPanel.Chart(
+			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{
+				Format: &commonSdk.Format{
+					Unit: &dashboards.CountsPerSecondsUnit,
+				},
+			}),
+			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
+				Position: timeSeriesPanel.BottomPosition,
+				Mode:     timeSeriesPanel.TableMode,
+			}),
+			timeSeriesPanel.WithVisual(timeSeriesPanel.Visual{
+				Display:      timeSeriesPanel.LineDisplay,
+				ConnectNulls: false,
+				LineWidth:    0.25,
+				AreaOpacity:  1,
+				Stack:        timeSeriesPanel.AllStack,
+				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
+			}),
+		),
+		promql.AddQueryFrom(
+			"ThanosOperatorCommonPanelQueries", ThanosOperatorCommonPanelQueries,
+			"ReconcileRate",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{controller}} - {{result}}"),
+		),
+	)
+}
+
+func ReconcileErrors(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+	return panelgroup.AddPanel("Reconcile Errors",
+		panel.Description("Shows the percentage of reconciliations that returned an error, per controller."),
+		timeSeriesPanel.Chart(
+			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{
+				Format: &commonSdk.Format{
+					Unit: &dashboards.PercentUnit,
+				},
+			}),
+			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
+				Position: timeSeriesPanel.BottomPosition,
+				Mode:     timeSeriesPanel.TableMode,
+			}),
+			timeSeriesPanel.WithVisual(timeSeriesPanel.Visual{
+				Display:      timeSeriesPanel.LineDisplay,
+				ConnectNulls: false,
+				LineWidth:    0.25,
+				AreaOpacity:  0.5,
+				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
+			}),
+		),
+		promql.AddQueryFrom(
+			"ThanosOperatorCommonPanelQueries", ThanosOperatorCommonPanelQueries,
+			"ReconcileErrors",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{controller}}"),
+		),
+	)
+}
+
+func ReconcileDuration(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+	return panelgroup.AddPanel("Reconcile Duration",
+		panel.Description("Shows the 99th and 50th percentile of time spent reconciling, per controller."),
+		timeSeriesPanel.Chart(
+			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{
+				Format: &commonSdk.Format{
+					Unit: &dashboards.SecondsUnit,
+				},
+			}),
+			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
+				Position: timeSeriesPanel.BottomPosition,
+				Mode:     timeSeriesPanel.TableMode,
+			}),
+			timeSeriesPanel.WithVisual(timeSeriesPanel.Visual{
+				Display:      timeSeriesPanel.LineDisplay,
+				ConnectNulls: false,
+				LineWidth:    0.25,
+				AreaOpacity:  0.5,
+				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
+			}),
+		),
+		promql.AddQueryFrom(
+			"ThanosOperatorCommonPanelQueries", ThanosOperatorCommonPanelQueries,
+			"ReconcileDuration_99",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p99 {{controller}}"),
+		),
+		promql.AddQueryFrom(
+			"ThanosOperatorCommonPanelQueries", ThanosOperatorCommonPanelQueries,
+			"ReconcileDuration_50",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p50 {{controller}}"),
+		),
+	)
+}
+
+func WorkqueueDepth(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+	return panelgroup.AddPanel("Workqueue Depth",
+		panel.Description("Shows the number of items waiting in each controller workqueue."),
+		timeSeriesPanel.Chart(
+			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{
+				Format: &commonSdk.Format{
+					Unit: &dashboards.DecimalUnit,
+				},
+			}),
+			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
+				Position: timeSeriesPanel.BottomPosition,
+				Mode:     timeSeriesPanel.TableMode,
+			}),
+			timeSeriesPanel.WithVisual(timeSeriesPanel.Visual{
+				Display:      timeSeriesPanel.LineDisplay,
+				ConnectNulls: false,
+				LineWidth:    0.25,
+				AreaOpacity:  0.5,
+				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
+			}),
+		),
+		promql.AddQueryFrom(
+			"ThanosOperatorCommonPanelQueries", ThanosOperatorCommonPanelQueries,
+			"WorkqueueDepth",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{name}}"),
+		),
+	)
+}
+
+func WorkqueueRetries(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+	return panelgroup.AddPanel("Workqueue Retries",
+		panel.Description("Shows the rate of items being requeued in each controller workqueue."),
+		timeSeriesPanel.Chart(
+			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{
+				Format: &commonSdk.Format{
+					Unit: &dashboards.CountsPerSecondsUnit,
+				},
+			}),
+			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
+				Position: timeSeriesPanel.BottomPosition,
+				Mode:     timeSeriesPanel.TableMode,
+			}),
+			timeSeriesPanel.WithVisual(timeSeriesPanel.Visual{
+				Display:      timeSeriesPanel.LineDisplay,
+				ConnectNulls: false,
+				LineWidth:    0.25,
+				AreaOpacity:  1,
+				Stack:        timeSeriesPanel.AllStack,
+				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
+			}),
+		),
+		promql.AddQueryFrom(
+			"ThanosOperatorCommonPanelQueries", ThanosOperatorCommonPanelQueries,
+			"WorkqueueRetries",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{name}}"),
+		),
+	)
+}
+
+func WorkqueueLatency(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+	return panelgroup.AddPanel("Workqueue Latency",
+		panel.Description("Shows the 99th and 50th percentile of time items wait in each workqueue before being processed."),
+		timeSeriesPanel.Chart(
+			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{
+				Format: &commonSdk.Format{
+					Unit: &dashboards.SecondsUnit,
+				},
+			}),
+			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
+				Position: timeSeriesPanel.BottomPosition,
+				Mode:     timeSeriesPanel.TableMode,
+			}),
+			timeSeriesPanel.WithVisual(timeSeriesPanel.Visual{
+				Display:      timeSeriesPanel.LineDisplay,
+				ConnectNulls: false,
+				LineWidth:    0.25,
+				AreaOpacity:  0.5,
+				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
+			}),
+		),
+		promql.AddQueryFrom(
+			"ThanosOperatorCommonPanelQueries", ThanosOperatorCommonPanelQueries,
+			"WorkqueueLatency_99",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p99 {{name}}"),
+		),
+		promql.AddQueryFrom(
+			"ThanosOperatorCommonPanelQueries", ThanosOperatorCommonPanelQueries,
+			"WorkqueueLatency_50",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("p50 {{name}}"),
+		),
+	)
+}
+
+func PausedResources(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+	return panelgroup.AddPanel("Paused Resources",
+		panel.Description("Lists the resources that are currently paused and are therefore not being reconciled by the operator."),
+		tablePanel.Table(
+			tablePanel.WithColumnSettings([]tablePanel.ColumnSettings{
+				{
+					Name:   "component",
+					Header: "Component",
+				},
+				{
+					Name:   "namespace",
+					Header: "Namespace",
+				},
+				{
+					Name:   "resource",
+					Header: "Resource",
+				},
+				{
+					Name:   "controller",
+					Header: "Controller",
+				},
+				{
+					Name: "value",
+					Hide: true,
+				},
+				{
+					Name: "timestamp",
+					Hide: true,
+				},
+			}),
+		),
+		promql.AddQueryFrom(
+			"ThanosOperatorCommonPanelQueries", ThanosOperatorCommonPanelQueries,
+			"PausedResources",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+		),
+	)
+}
+
+func QueryEndpointsConfigured(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+	return panelgroup.AddPanel("Query Endpoints Configured",
+		panel.Description("Shows the number of store endpoints configured for each ThanosQuery resource."),
+		timeSeriesPanel.Chart(
+			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{
+				Format: &commonSdk.Format{
+					Unit: &dashboards.DecimalUnit,
+				},
+			}),
+			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
+				Position: timeSeriesPanel.BottomPosition,
+				Mode:     timeSeriesPanel.TableMode,
+			}),
+			timeSeriesPanel.WithVisual(timeSeriesPanel.Visual{
+				Display:      timeSeriesPanel.LineDisplay,
+				ConnectNulls: false,
+				LineWidth:    0.25,
+				AreaOpacity:  0.5,
+				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
+			}),
+		),
+		promql.AddQueryFrom(
+			"ThanosOperatorCommonPanelQueries", ThanosOperatorCommonPanelQueries,
+			"QueryEndpointsConfigured",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{namespace}}/{{resource}}"),
+		),
+	)
+}
+
+func QueryServiceEventReconciliations(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+	return panelgroup.AddPanel("Query Service Event Reconciliations",
+		panel.Description("Shows the rate of ThanosQuery reconciliations triggered by service events."),
+		timeSeriesPanel.Chart(
+			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{
+				Format: &commonSdk.Format{
+					Unit: &dashboards.CountsPerSecondsUnit,
+				},
+			}),
+			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
+				Position: timeSeriesPanel.BottomPosition,
+				Mode:     timeSeriesPanel.TableMode,
+			}),
+			timeSeriesPanel.WithVisual(timeSeriesPanel.Visual{
+				Display:      timeSeriesPanel.LineDisplay,
+				ConnectNulls: false,
+				LineWidth:    0.25,
+				AreaOpacity:  1,
+				Stack:        timeSeriesPanel.AllStack,
+				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
+			}),
+		),
+		promql.AddQueryFrom(
+			"ThanosOperatorCommonPanelQueries", ThanosOperatorCommonPanelQueries,
+			"QueryServiceEventReconciliations",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{namespace}}/{{resource}}"),
+		),
+	)
+}
+
+func ReceiveHashringsConfigured(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+	return panelgroup.AddPanel("Receive Hashrings Configured",
+		panel.Description("Shows the number of hashrings configured for each ThanosReceive resource."),
+		timeSeriesPanel.Chart(
+			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{
+				Format: &commonSdk.Format{
+					Unit: &dashboards.DecimalUnit,
+				},
+			}),
+			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
+				Position: timeSeriesPanel.BottomPosition,
+				Mode:     timeSeriesPanel.TableMode,
+			}),
+			timeSeriesPanel.WithVisual(timeSeriesPanel.Visual{
+				Display:      timeSeriesPanel.LineDisplay,
+				ConnectNulls: false,
+				LineWidth:    0.25,
+				AreaOpacity:  0.5,
+				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
+			}),
+		),
+		promql.AddQueryFrom(
+			"ThanosOperatorCommonPanelQueries", ThanosOperatorCommonPanelQueries,
+			"ReceiveHashringsConfigured",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{namespace}}/{{resource}}"),
+		),
+	)
+}
+
+func ReceiveHashringEndpointsConfigured(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+	return panelgroup.AddPanel("Receive Hashring Endpoints",
+		panel.Description("Shows the number of endpoints configured in each hashring of each ThanosReceive resource."),
+		timeSeriesPanel.Chart(
+			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{
+				Format: &commonSdk.Format{
+					Unit: &dashboards.DecimalUnit,
+				},
+			}),
+			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
+				Position: timeSeriesPanel.BottomPosition,
+				Mode:     timeSeriesPanel.TableMode,
+			}),
+			timeSeriesPanel.WithVisual(timeSeriesPanel.Visual{
+				Display:      timeSeriesPanel.LineDisplay,
+				ConnectNulls: false,
+				LineWidth:    0.25,
+				AreaOpacity:  0.5,
+				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
+			}),
+		),
+		promql.AddQueryFrom(
+			"ThanosOperatorCommonPanelQueries", ThanosOperatorCommonPanelQueries,
+			"ReceiveHashringEndpointsConfigured",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{namespace}}/{{resource}} - {{hashring}}"),
+		),
+	)
+}
+
+func ReceiveEndpointEventReconciliations(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+	return panelgroup.AddPanel("Receive Endpoint Event Reconciliations",
+		panel.Description("Shows the rate of ThanosReceive reconciliations triggered by endpoint events."),
+		timeSeriesPanel.Chart(
+			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{
+				Format: &commonSdk.Format{
+					Unit: &dashboards.CountsPerSecondsUnit,
+				},
+			}),
+			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
+				Position: timeSeriesPanel.BottomPosition,
+				Mode:     timeSeriesPanel.TableMode,
+			}),
+			timeSeriesPanel.WithVisual(timeSeriesPanel.Visual{
+				Display:      timeSeriesPanel.LineDisplay,
+				ConnectNulls: false,
+				LineWidth:    0.25,
+				AreaOpacity:  1,
+				Stack:        timeSeriesPanel.AllStack,
+				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
+			}),
+		),
+		promql.AddQueryFrom(
+			"ThanosOperatorCommonPanelQueries", ThanosOperatorCommonPanelQueries,
+			"ReceiveEndpointEventReconciliations",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{namespace}}/{{resource}}"),
+		),
+	)
+}
+
+func RulerQueryEndpointsConfigured(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+	return panelgroup.AddPanel("Ruler Query Endpoints Configured",
+		panel.Description("Shows the number of query endpoints configured for each ThanosRuler resource."),
+		timeSeriesPanel.Chart(
+			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{
+				Format: &commonSdk.Format{
+					Unit: &dashboards.DecimalUnit,
+				},
+			}),
+			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
+				Position: timeSeriesPanel.BottomPosition,
+				Mode:     timeSeriesPanel.TableMode,
+			}),
+			timeSeriesPanel.WithVisual(timeSeriesPanel.Visual{
+				Display:      timeSeriesPanel.LineDisplay,
+				ConnectNulls: false,
+				LineWidth:    0.25,
+				AreaOpacity:  0.5,
+				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
+			}),
+		),
+		promql.AddQueryFrom(
+			"ThanosOperatorCommonPanelQueries", ThanosOperatorCommonPanelQueries,
+			"RulerQueryEndpointsConfigured",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{namespace}}/{{resource}}"),
+		),
+	)
+}
+
+func RulerRulesConfigured(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+	return panelgroup.AddPanel("Ruler Rules Configured",
+		panel.Description("Shows the number of PrometheusRules found and rule files configured for each ThanosRuler resource."),
+		timeSeriesPanel.Chart(
+			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{
+				Format: &commonSdk.Format{
+					Unit: &dashboards.DecimalUnit,
+				},
+			}),
+			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
+				Position: timeSeriesPanel.BottomPosition,
+				Mode:     timeSeriesPanel.TableMode,
+			}),
+			timeSeriesPanel.WithVisual(timeSeriesPanel.Visual{
+				Display:      timeSeriesPanel.LineDisplay,
+				ConnectNulls: false,
+				LineWidth:    0.25,
+				AreaOpacity:  0.5,
+				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
+			}),
+		),
+		promql.AddQueryFrom(
+			"ThanosOperatorCommonPanelQueries", ThanosOperatorCommonPanelQueries,
+			"RulerRulesConfigured_promRules",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("PrometheusRules {{namespace}}/{{resource}}"),
+		),
+		promql.AddQueryFrom(
+			"ThanosOperatorCommonPanelQueries", ThanosOperatorCommonPanelQueries,
+			"RulerRulesConfigured_ruleFiles",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Rule files {{namespace}}/{{resource}}"),
+		),
+	)
+}
+
+func RulerConfigMapOperations(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+	return panelgroup.AddPanel("Ruler ConfigMap Operations",
+		panel.Description("Shows the rate of rule ConfigMaps created and of ConfigMap creation failures for each ThanosRuler resource."),
+		timeSeriesPanel.Chart(
+			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{
+				Format: &commonSdk.Format{
+					Unit: &dashboards.CountsPerSecondsUnit,
+				},
+			}),
+			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
+				Position: timeSeriesPanel.BottomPosition,
+				Mode:     timeSeriesPanel.TableMode,
+			}),
+			timeSeriesPanel.WithVisual(timeSeriesPanel.Visual{
+				Display:      timeSeriesPanel.LineDisplay,
+				ConnectNulls: false,
+				LineWidth:    0.25,
+				AreaOpacity:  0.5,
+				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
+			}),
+		),
+		promql.AddQueryFrom(
+			"ThanosOperatorCommonPanelQueries", ThanosOperatorCommonPanelQueries,
+			"RulerConfigMapOperations_created",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Created {{namespace}}/{{resource}}"),
+		),
+		promql.AddQueryFrom(
+			"ThanosOperatorCommonPanelQueries", ThanosOperatorCommonPanelQueries,
+			"RulerConfigMapOperations_failures",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Failed {{namespace}}/{{resource}}"),
+		),
+	)
+}
+
+func RulerEventReconciliations(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+	return panelgroup.AddPanel("Ruler Event Reconciliations",
+		panel.Description("Shows the rate of ThanosRuler reconciliations triggered by service, ConfigMap and PrometheusRule events."),
+		timeSeriesPanel.Chart(
+			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{
+				Format: &commonSdk.Format{
+					Unit: &dashboards.CountsPerSecondsUnit,
+				},
+			}),
+			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
+				Position: timeSeriesPanel.BottomPosition,
+				Mode:     timeSeriesPanel.TableMode,
+			}),
+			timeSeriesPanel.WithVisual(timeSeriesPanel.Visual{
+				Display:      timeSeriesPanel.LineDisplay,
+				ConnectNulls: false,
+				LineWidth:    0.25,
+				AreaOpacity:  1,
+				Stack:        timeSeriesPanel.AllStack,
+				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
+			}),
+		),
+		promql.AddQueryFrom(
+			"ThanosOperatorCommonPanelQueries", ThanosOperatorCommonPanelQueries,
+			"RulerEventReconciliations_service",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("Service {{namespace}}/{{resource}}"),
+		),
+		promql.AddQueryFrom(
+			"ThanosOperatorCommonPanelQueries", ThanosOperatorCommonPanelQueries,
+			"RulerEventReconciliations_cfgmap",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("ConfigMap {{namespace}}/{{resource}}"),
+		),
+		promql.AddQueryFrom(
+			"ThanosOperatorCommonPanelQueries", ThanosOperatorCommonPanelQueries,
+			"RulerEventReconciliations_promrule",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("PrometheusRule {{namespace}}/{{resource}}"),
+		),
+	)
+}
+
+func StoreShardsConfigured(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+	return panelgroup.AddPanel("Store Shards Configured",
+		panel.Description("Shows the number of shards configured for each ThanosStore resource."),
+		timeSeriesPanel.Chart(
+			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{
+				Format: &commonSdk.Format{
+					Unit: &dashboards.DecimalUnit,
+				},
+			}),
+			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
+				Position: timeSeriesPanel.BottomPosition,
+				Mode:     timeSeriesPanel.TableMode,
+			}),
+			timeSeriesPanel.WithVisual(timeSeriesPanel.Visual{
+				Display:      timeSeriesPanel.LineDisplay,
+				ConnectNulls: false,
+				LineWidth:    0.25,
+				AreaOpacity:  0.5,
+				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
+			}),
+		),
+		promql.AddQueryFrom(
+			"ThanosOperatorCommonPanelQueries", ThanosOperatorCommonPanelQueries,
+			"StoreShardsConfigured",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{namespace}}/{{resource}}"),
+		),
+	)
+}
+
+func StoreShardFailures(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+	return panelgroup.AddPanel("Store Shard Failures",
+		panel.Description("Shows the rate of failures to create or update shards for each ThanosStore resource."),
+		timeSeriesPanel.Chart(
+			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{
+				Format: &commonSdk.Format{
+					Unit: &dashboards.CountsPerSecondsUnit,
+				},
+			}),
+			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
+				Position: timeSeriesPanel.BottomPosition,
+				Mode:     timeSeriesPanel.TableMode,
+			}),
+			timeSeriesPanel.WithVisual(timeSeriesPanel.Visual{
+				Display:      timeSeriesPanel.LineDisplay,
+				ConnectNulls: false,
+				LineWidth:    0.25,
+				AreaOpacity:  1,
+				Stack:        timeSeriesPanel.AllStack,
+				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
+			}),
+		),
+		promql.AddQueryFrom(
+			"ThanosOperatorCommonPanelQueries", ThanosOperatorCommonPanelQueries,
+			"StoreShardFailures",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{namespace}}/{{resource}}"),
+		),
+	)
+}
+
+func CompactShardsConfigured(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+	return panelgroup.AddPanel("Compact Shards Configured",
+		panel.Description("Shows the number of shards configured for each ThanosCompact resource."),
+		timeSeriesPanel.Chart(
+			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{
+				Format: &commonSdk.Format{
+					Unit: &dashboards.DecimalUnit,
+				},
+			}),
+			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
+				Position: timeSeriesPanel.BottomPosition,
+				Mode:     timeSeriesPanel.TableMode,
+			}),
+			timeSeriesPanel.WithVisual(timeSeriesPanel.Visual{
+				Display:      timeSeriesPanel.LineDisplay,
+				ConnectNulls: false,
+				LineWidth:    0.25,
+				AreaOpacity:  0.5,
+				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
+			}),
+		),
+		promql.AddQueryFrom(
+			"ThanosOperatorCommonPanelQueries", ThanosOperatorCommonPanelQueries,
+			"CompactShardsConfigured",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{namespace}}/{{resource}}"),
+		),
+	)
+}
+
+func CompactShardFailures(datasourceName string, labelMatchers ...*labels.Matcher) panelgroup.Option {
+	return panelgroup.AddPanel("Compact Shard Failures",
+		panel.Description("Shows the rate of failures to create or update shards for each ThanosCompact resource."),
+		timeSeriesPanel.Chart(
+			timeSeriesPanel.WithYAxis(timeSeriesPanel.YAxis{
+				Format: &commonSdk.Format{
+					Unit: &dashboards.CountsPerSecondsUnit,
+				},
+			}),
+			timeSeriesPanel.WithLegend(timeSeriesPanel.Legend{
+				Position: timeSeriesPanel.BottomPosition,
+				Mode:     timeSeriesPanel.TableMode,
+			}),
+			timeSeriesPanel.WithVisual(timeSeriesPanel.Visual{
+				Display:      timeSeriesPanel.LineDisplay,
+				ConnectNulls: false,
+				LineWidth:    0.25,
+				AreaOpacity:  1,
+				Stack:        timeSeriesPanel.AllStack,
+				Palette:      &timeSeriesPanel.Palette{Mode: timeSeriesPanel.AutoMode},
+			}),
+		),
+		promql.AddQueryFrom(
+			"ThanosOperatorCommonPanelQueries", ThanosOperatorCommonPanelQueries,
+			"CompactShardFailures",
+			labelMatchers,
+			dashboards.AddQueryDataSource(datasourceName),
+			query.SeriesNameFormat("{{namespace}}/{{resource}}"),
+		),
+	)
+}
@@ -15,30 +15,6 @@ package promql
 
 import "github.com/prometheus/prometheus/model/labels"
 
-var NamespaceVar LabelMatcher = LabelMatcher{
-	Name:  "namespace",
-	Value: "$namespace",
-	Type:  "=",
-}
-
-var JobVar LabelMatcher = LabelMatcher{
-	Name:  "job",
-	Value: "$job",
-	Type:  "=~",
-}
-
-var InstanceVar LabelMatcher = LabelMatcher{
-	Name:  "instance",
-	Value: "$instance",
-	Type:  "=~",
-}
-
-var ClusterVar LabelMatcher = LabelMatcher{
-	Name:  "cluster",
-	Value: "$cluster",
-	Type:  "=~",
-}
-
 var NamespaceVarV2 *labels.Matcher = &labels.Matcher{
 	Name:  "namespace",
 	Value: "$namespace",
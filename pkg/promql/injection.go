@@ -0,0 +1,104 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package promql
+
+import (
+	"slices"
+
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+// InjectionPolicy decides whether the matcher of the given label is set on a vector selector of a query,
+// path holding the ancestors of the selector from the root of the query.
+type InjectionPolicy func(selector *parser.VectorSelector, path []parser.Node, label string) bool
+
+// Policies holds the injection policy of each label name.
+type Policies map[string]InjectionPolicy
+
+func (p Policies) policy(label string) InjectionPolicy {
+	if policy, ok := p[label]; ok {
+		return policy
+	}
+	return InjectAll()
+}
+
+// InjectAll sets the matcher on every vector selector. It is the policy of the labels missing from Policies.
+func InjectAll() InjectionPolicy {
+	return func(*parser.VectorSelector, []parser.Node, string) bool {
+		return true
+	}
+}
+
+// InjectNone never sets the matcher, opting a query out of a label.
+func InjectNone() InjectionPolicy {
+	return func(*parser.VectorSelector, []parser.Node, string) bool {
+		return false
+	}
+}
+
+// InjectMetrics only sets the matcher on the selectors of the given metric names, leaving alone the
+// info metrics or recording rules the query joins against.
+func InjectMetrics(names ...string) InjectionPolicy {
+	return func(selector *parser.VectorSelector, _ []parser.Node, _ string) bool {
+		return slices.Contains(names, MetricName(selector))
+	}
+}
+
+// SkipAbsent does not set the matcher on the selectors inside absent() and absent_over_time().
+func SkipAbsent() InjectionPolicy {
+	return func(_ *parser.VectorSelector, path []parser.Node, _ string) bool {
+		for _, node := range path {
+			if call, ok := node.(*parser.Call); ok && call.Func != nil &&
+				(call.Func.Name == "absent" || call.Func.Name == "absent_over_time") {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// InjectExisting only sets the matcher on the selectors already matching on its label, overwriting it.
+func InjectExisting() InjectionPolicy {
+	return func(selector *parser.VectorSelector, _ []parser.Node, label string) bool {
+		return slices.ContainsFunc(selector.LabelMatchers, func(m *labels.Matcher) bool {
+			return m.Name == label
+		})
+	}
+}
+
+// AllOf only sets the matcher on the selectors picked by all the given policies.
+func AllOf(policies ...InjectionPolicy) InjectionPolicy {
+	return func(selector *parser.VectorSelector, path []parser.Node, label string) bool {
+		for _, policy := range policies {
+			if !policy(selector, path, label) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// MetricName returns the metric name of a selector, set either as its name or with a __name__ equality matcher.
+func MetricName(selector *parser.VectorSelector) string {
+	if selector.Name != "" {
+		return selector.Name
+	}
+	for _, m := range selector.LabelMatchers {
+		if m.Name == labels.MetricName && m.Type == labels.MatchEqual {
+			return m.Value
+		}
+	}
+	return ""
+}
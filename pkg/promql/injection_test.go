@@ -0,0 +1,109 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package promql
+
+import (
+	"testing"
+
+	promqlbuilder "github.com/perses/promql-builder"
+	"github.com/perses/promql-builder/label"
+	"github.com/perses/promql-builder/vector"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInjectLabelMatchers(t *testing.T) {
+	// up * build_info, build_info standing for an info metric lacking the cluster label.
+	join := func() parser.Expr {
+		return promqlbuilder.Mul(
+			vector.New(vector.WithMetricName("up")),
+			vector.New(vector.WithMetricName("build_info")),
+		)
+	}
+	cluster := label.New("cluster").EqualRegexp("$cluster")
+
+	tests := []struct {
+		name     string
+		query    parser.Expr
+		policies Policies
+		want     string
+	}{
+		{
+			name:  "all selectors by default",
+			query: join(),
+			want:  `up{cluster=~"$cluster"} * build_info{cluster=~"$cluster"}`,
+		},
+		{
+			name:     "only the given metrics",
+			query:    join(),
+			policies: Policies{"cluster": InjectMetrics("up")},
+			want:     `up{cluster=~"$cluster"} * build_info`,
+		},
+		{
+			name:     "opted out",
+			query:    join(),
+			policies: Policies{"cluster": InjectNone()},
+			want:     `up * build_info`,
+		},
+		{
+			name:     "policies of other labels are ignored",
+			query:    join(),
+			policies: Policies{"namespace": InjectNone()},
+			want:     `up{cluster=~"$cluster"} * build_info{cluster=~"$cluster"}`,
+		},
+		{
+			name: "skip absent",
+			query: promqlbuilder.Or(
+				vector.New(vector.WithMetricName("up")),
+				promqlbuilder.Absent(vector.New(vector.WithMetricName("up"))),
+			),
+			policies: Policies{"cluster": SkipAbsent()},
+			want:     `up{cluster=~"$cluster"} or absent(up)`,
+		},
+		{
+			name: "only selectors carrying the label",
+			query: promqlbuilder.Mul(
+				vector.New(vector.WithMetricName("up"), vector.WithLabelMatchers(label.New("cluster").Equal("old"))),
+				vector.New(vector.WithMetricName("build_info")),
+			),
+			policies: Policies{"cluster": InjectExisting()},
+			want:     `up{cluster=~"$cluster"} * build_info`,
+		},
+		{
+			name: "all of",
+			query: promqlbuilder.Or(
+				join(),
+				promqlbuilder.Absent(vector.New(vector.WithMetricName("up"))),
+			),
+			policies: Policies{"cluster": AllOf(InjectMetrics("up"), SkipAbsent())},
+			want:     `up{cluster=~"$cluster"} * build_info or absent(up)`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := InjectLabelMatchers(tt.query, []*labels.Matcher{cluster}, tt.policies)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
+
+func TestInjectLabelMatchers_KeepsQueryUnchanged(t *testing.T) {
+	query := vector.New(vector.WithMetricName("up"))
+	_, err := InjectLabelMatchers(query, []*labels.Matcher{label.New("cluster").Equal("a")}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, `up`, query.String())
+}
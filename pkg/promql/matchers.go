@@ -19,40 +19,69 @@ import (
 	"github.com/prometheus/prometheus/promql/parser"
 )
 
+// SetLabelMatchersV2 returns a copy of query with the given label matchers set on every vector selector.
+// It panics when the resulting query is invalid, see TrySetLabelMatchersV2 for a variant returning the error.
+//
+// Deprecated: use TrySetLabelMatchersV2, or dashboards.AddVariableMatcher for the matchers of a variable.
 func SetLabelMatchersV2(query parser.Expr, matchers []*labels.Matcher) parser.Expr {
+	copy, err := TrySetLabelMatchersV2(query, matchers)
+	if err != nil {
+		panic(err)
+	}
+	return copy
+}
+
+// TrySetLabelMatchersV2 is like SetLabelMatchersV2 but returns an error when the resulting query is invalid.
+func TrySetLabelMatchersV2(query parser.Expr, matchers []*labels.Matcher) (parser.Expr, error) {
+	return InjectLabelMatchers(query, matchers, nil)
+}
+
+// InjectLabelMatchers returns a copy of query with the given label matchers set on the vector selectors
+// picked by the policy of their label name, every selector for the labels missing from policies.
+// It returns an error when the resulting query is invalid.
+func InjectLabelMatchers(query parser.Expr, matchers []*labels.Matcher, policies Policies) (parser.Expr, error) {
 	copy := promqlbuilder.DeepCopyExpr(query)
 	for _, l := range matchers {
-		copy = LabelsSetPromQLV2(copy, l.Type, l.Name, l.Value)
+		copy = injectLabelMatcher(copy, l.Type, l.Name, l.Value, policies.policy(l.Name))
 	}
 	if err := promqlbuilder.Validate(copy); err != nil {
-		panic(err)
+		return nil, err
 	}
-	return copy
+	return copy, nil
 }
 
+// LabelsSetPromQLV2 sets the given label matcher on every vector selector of query, in place.
 func LabelsSetPromQLV2(query parser.Expr, matchType labels.MatchType, name, value string) parser.Expr {
+	return injectLabelMatcher(query, matchType, name, value, InjectAll())
+}
+
+func injectLabelMatcher(query parser.Expr, matchType labels.MatchType, name, value string, policy InjectionPolicy) parser.Expr {
 	if name == "" || value == "" {
 		return query
 	}
 
+	matcher := &labels.Matcher{
+		Type:  matchType,
+		Name:  name,
+		Value: value,
+	}
 	promqlbuilder.Inspect(query, func(node parser.Node, path []parser.Node) error {
-		if n, ok := node.(*parser.VectorSelector); ok {
-			var found bool
-			for i, l := range n.LabelMatchers {
-				if l.Name == name {
-					n.LabelMatchers[i].Type = matchType
-					n.LabelMatchers[i].Value = value
-					found = true
-				}
-			}
-			if !found {
-				n.LabelMatchers = append(n.LabelMatchers, &labels.Matcher{
-					Type:  matchType,
-					Name:  name,
-					Value: value,
-				})
+		n, ok := node.(*parser.VectorSelector)
+		if !ok || !policy(n, path, name) {
+			return nil
+		}
+		var found bool
+		for i, l := range n.LabelMatchers {
+			if l.Name == name {
+				// Replace rather than mutate the matcher: it may be shared with the
+				// query the expression was copied from, which other builds read concurrently.
+				n.LabelMatchers[i] = matcher
+				found = true
 			}
 		}
+		if !found {
+			n.LabelMatchers = append(n.LabelMatchers, matcher)
+		}
 		return nil
 	})
 
@@ -70,6 +70,22 @@ func TestSetLabelMatchersV2(t *testing.T) {
 	})
 }
 
+func TestTrySetLabelMatchersV2(t *testing.T) {
+	expr := vector.New(vector.WithMetricName("up"))
+	got, err := TrySetLabelMatchersV2(expr, []*labels.Matcher{
+		label.New("job").Equal("prometheus"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `up{job="prometheus"}`, got.String())
+
+	invalid := &parser.BinaryExpr{
+		Op:  parser.ADD,
+		LHS: vector.New(vector.WithMetricName("foo")),
+	}
+	_, err = TrySetLabelMatchersV2(invalid, nil)
+	assert.Error(t, err)
+}
+
 func TestLabelsSetPromQLV2(t *testing.T) {
 	expr := vector.New(vector.WithMetricName("up"))
 	got := LabelsSetPromQLV2(expr, labels.MatchEqual, "job", "prometheus")
@@ -15,6 +15,7 @@ package promql
 
 import (
 	"fmt"
+	"regexp"
 	"sort"
 	"strings"
 
@@ -22,151 +23,81 @@ import (
 	"github.com/prometheus/prometheus/promql/parser"
 )
 
-// Use SetLabelMatchersV2 instead, which uses perses/promql-builder with inbuilt support for variables.
-type PersesVarProcessor struct {
-	Replacements map[string]string
-	SortedKeys   []string
+// persesVariables maps the Perses builtin variables to placeholders the PromQL parser accepts where the variables are used,
+// e.g. durations for $__rate_interval. Keep the placeholders super unique, so that they can be restored safely.
+var persesVariables = map[string]string{
+	"$__rate_interval": "2d20h8m7s",
+	"$__interval":      "2d20h8m8s",
+	"$__interval_ms":   "7d19h59m27s",
+	"$__dashboard":     "CHEESECAKE",
+	"$__project":       "CHEESECAKE-DEV",
+	"$__from":          "1715222400000.000",
+	"$__to":            "1715222400001.000",
+	"$__range":         "2d20h8m9s",
+	"$__range_s":       "1h2m17s",
+	"$__range_ms":      "3737373",
 }
 
-// Use SetLabelMatchersV2 instead, which uses perses/promql-builder with inbuilt support for variables.
-// NewPersesVarProcessor creates a new PersesVarProcessor which will help replace/restore Perses variables in a query
-// to make it compatible with PromQL parser.
-// Sort of a hack, by modifying the query in place with random values
-func NewPersesVarProcessor() *PersesVarProcessor {
-	// Keep the variables in this map super unique, so that we can replace them safely.
-	replacements := map[string]string{
-		"$__rate_interval": "2d20h8m7s",
-		"$__interval":      "2d20h8m8s",
-		"$__interval_ms":   "7d19h59m27s",
-		"$__dashboard":     "CHEESECAKE",
-		"$__project":       "CHEESECAKE-DEV",
-		"$__from":          "1715222400000.000",
-		"$__to":            "1715222400000.000",
-		"$__range":         "2d20h8m9s",
-		"$__range_s":       "1h2m17s",
-		"$__range_ms":      "3737373",
-	}
-
-	var sortedKeys []string
-	for key := range replacements {
-		sortedKeys = append(sortedKeys, key)
-	}
-	sort.Slice(sortedKeys, func(i, j int) bool {
-		return len(sortedKeys[i]) > len(sortedKeys[j])
-	})
+// VariableReference matches the references to a variable, $name and ${name}, optionally with a format as in
+// ${name:csv}. The name is the first submatch for ${name}, the second one for $name. Names can't start with a digit,
+// so that $1 in the replacement of label_replace isn't taken for a variable.
+var VariableReference = regexp.MustCompile(`\$(?:\{([A-Za-z_]\w*)(?::[^}]*)?\}|([A-Za-z_]\w*))`)
 
-	return &PersesVarProcessor{
-		Replacements: replacements,
-		SortedKeys:   sortedKeys,
+// ReferencedVariables returns the names of the variables s references, in order of appearance.
+func ReferencedVariables(s string) []string {
+	var names []string
+	for _, m := range VariableReference.FindAllStringSubmatch(s, -1) {
+		names = append(names, m[1]+m[2])
 	}
+	return names
 }
 
-func (p *PersesVarProcessor) Replace(query string) (string, map[string]string) {
-	original := make(map[string]string)
-	for _, name := range p.SortedKeys {
-		if strings.Contains(query, name) {
-			placeholder := p.Replacements[name]
-			original[name] = placeholder
-			query = strings.ReplaceAll(query, name, placeholder)
-		}
+// ParseQuery parses query, a PromQL query that may reference Perses variables, and returns it pretty-printed with
+// labelMatchers set on its vector selectors following policies, see InjectLabelMatchers.
+// It is meant for the queries promql-builder can't express, e.g. subqueries over $__rate_interval; prefer building
+// queries with promql-builder otherwise. It returns an error when query doesn't parse or the result is invalid.
+func ParseQuery(query string, labelMatchers []*labels.Matcher, policies Policies) (string, error) {
+	replaced, used := replacePersesVariables(query)
+	expr, err := parser.NewParser(parser.Options{}).ParseExpr(replaced)
+	if err != nil {
+		return "", fmt.Errorf("parse %q: %w", query, err)
+	}
+	expr, err = InjectLabelMatchers(expr, labelMatchers, policies)
+	if err != nil {
+		return "", err
 	}
-	return query, original
+	return restorePersesVariables(expr.Pretty(0), used), nil
 }
 
-func (p *PersesVarProcessor) Restore(query string, original map[string]string) string {
-	// Build a list of varName/placeholder pairs
-	type pair struct {
-		varName     string
-		placeholder string
-	}
-	var pairs []pair
-	for varName, placeholder := range original {
-		pairs = append(pairs, pair{varName, placeholder})
+// replacePersesVariables replaces the Perses variables of query with their placeholder, longest names first so that
+// $__interval doesn't match the start of $__interval_ms. It returns the variables it replaced.
+func replacePersesVariables(query string) (string, []string) {
+	names := make([]string, 0, len(persesVariables))
+	for name := range persesVariables {
+		names = append(names, name)
 	}
-
-	// Sort by placeholder length descending
-	sort.Slice(pairs, func(i, j int) bool {
-		return len(pairs[i].placeholder) > len(pairs[j].placeholder)
+	sort.Slice(names, func(i, j int) bool {
+		return len(names[i]) > len(names[j])
 	})
 
-	// Replace each placeholder with the original var
-	for _, p := range pairs {
-		query = strings.ReplaceAll(query, p.placeholder, p.varName)
+	var used []string
+	for _, name := range names {
+		if strings.Contains(query, name) {
+			used = append(used, name)
+			query = strings.ReplaceAll(query, name, persesVariables[name])
+		}
 	}
-
-	return query
+	return query, used
 }
 
-// Use SetLabelMatchersV2 with prometheus []*labels.Matcher instead.
-type LabelMatcher struct {
-	Name  string
-	Value string
-	Type  string
-}
-
-// Use SetLabelMatchersV2 instead.
-func SetLabelMatchers(query string, labelMatchers []LabelMatcher) string {
-	processor := NewPersesVarProcessor()
-
-	for _, l := range labelMatchers {
-		query = LabelsSetPromQL(query, l.Type, l.Name, l.Value, processor)
+// restorePersesVariables puts the variables replaced by replacePersesVariables back into query, longest placeholders
+// first so that a placeholder contained in another one isn't restored in its place.
+func restorePersesVariables(query string, used []string) string {
+	sort.Slice(used, func(i, j int) bool {
+		return len(persesVariables[used[i]]) > len(persesVariables[used[j]])
+	})
+	for _, name := range used {
+		query = strings.ReplaceAll(query, persesVariables[name], name)
 	}
 	return query
 }
-
-// Use LabelsSetPromQLV2 instead.
-func LabelsSetPromQL(query, labelMatchType, name, value string, processor *PersesVarProcessor) string {
-	modifiedQuery, originalVars := processor.Replace(query)
-	p := parser.NewParser(parser.Options{})
-	expr, err := p.ParseExpr(modifiedQuery)
-	if err != nil {
-		fmt.Println("Error parsing query:", err, modifiedQuery)
-		return ""
-	}
-
-	if name == "" || value == "" {
-		// Get the modified query and restore Perses variables
-		result := expr.Pretty(0)
-		return processor.Restore(result, originalVars)
-	}
-
-	var matchType labels.MatchType
-	switch labelMatchType {
-	case parser.ItemType(parser.EQL).String():
-		matchType = labels.MatchEqual
-	case parser.ItemType(parser.NEQ).String():
-		matchType = labels.MatchNotEqual
-	case parser.ItemType(parser.EQL_REGEX).String():
-		matchType = labels.MatchRegexp
-	case parser.ItemType(parser.NEQ_REGEX).String():
-		matchType = labels.MatchNotRegexp
-	default:
-		fmt.Println("Invalid match type:", labelMatchType)
-		return ""
-	}
-
-	parser.Inspect(expr, func(node parser.Node, path []parser.Node) error {
-		if n, ok := node.(*parser.VectorSelector); ok {
-			var found bool
-			for i, l := range n.LabelMatchers {
-				if l.Name == name {
-					n.LabelMatchers[i].Type = matchType
-					n.LabelMatchers[i].Value = value
-					found = true
-				}
-			}
-			if !found {
-				n.LabelMatchers = append(n.LabelMatchers, &labels.Matcher{
-					Type:  matchType,
-					Name:  name,
-					Value: value,
-				})
-			}
-		}
-		return nil
-	})
-
-	// Get the modified query and restore Perses variables
-	result := expr.Pretty(0)
-	return processor.Restore(result, originalVars)
-}
@@ -15,109 +15,118 @@ package promql
 
 import (
 	"testing"
+
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
-func TestSetLabelMatchers(t *testing.T) {
+func TestReferencedVariables(t *testing.T) {
+	got := ReferencedVariables(`sum by (pod) (rate(x{ns="$namespace",pod=~"${pod:regex}"}[$__rate_interval])) / $1`)
+	assert.Equal(t, []string{"namespace", "pod", "__rate_interval"}, got)
+}
+
+func TestParseQuery(t *testing.T) {
 	tests := []struct {
 		name          string
 		query         string
-		labelMatchers []LabelMatcher
+		labelMatchers []*labels.Matcher
 		want          string
 	}{
 		{
 			name:  "simple metric query",
 			query: "metric{job=\"test\"}",
-			labelMatchers: []LabelMatcher{
-				{Name: "instance", Value: "localhost:9090", Type: "="},
+			labelMatchers: []*labels.Matcher{
+				{Name: "instance", Value: "localhost:9090", Type: labels.MatchEqual},
 			},
 			want: "metric{instance=\"localhost:9090\",job=\"test\"}",
 		},
 		{
 			name:  "query with rate and interval variable",
 			query: "rate(metric{job=\"test\"}[$__rate_interval])",
-			labelMatchers: []LabelMatcher{
-				{Name: "instance", Value: "localhost:9090", Type: "="},
+			labelMatchers: []*labels.Matcher{
+				{Name: "instance", Value: "localhost:9090", Type: labels.MatchEqual},
 			},
 			want: "rate(metric{instance=\"localhost:9090\",job=\"test\"}[$__rate_interval])",
 		},
 		{
 			name:  "query with multiple variables",
 			query: "metric{job=\"test\"}[$__rate_interval] offset $__range",
-			labelMatchers: []LabelMatcher{
-				{Name: "instance", Value: "localhost:9090", Type: "="},
+			labelMatchers: []*labels.Matcher{
+				{Name: "instance", Value: "localhost:9090", Type: labels.MatchEqual},
 			},
 			want: "metric{instance=\"localhost:9090\",job=\"test\"}[$__rate_interval] offset $__range",
 		},
 		{
 			name:  "query with regex match",
 			query: "metric{job=~\"test.*\"}",
-			labelMatchers: []LabelMatcher{
-				{Name: "instance", Value: "localhost.*", Type: "=~"},
+			labelMatchers: []*labels.Matcher{
+				{Name: "instance", Value: "localhost.*", Type: labels.MatchRegexp},
 			},
 			want: "metric{instance=~\"localhost.*\",job=~\"test.*\"}",
 		},
 		{
 			name:  "query with negative match",
 			query: "metric{job!=\"test\"}",
-			labelMatchers: []LabelMatcher{
-				{Name: "instance", Value: "localhost:9090", Type: "!="},
+			labelMatchers: []*labels.Matcher{
+				{Name: "instance", Value: "localhost:9090", Type: labels.MatchNotEqual},
 			},
 			want: "metric{instance!=\"localhost:9090\",job!=\"test\"}",
 		},
 		{
 			name:  "query with multiple label matchers",
 			query: "metric{job=\"test\"}",
-			labelMatchers: []LabelMatcher{
-				{Name: "instance", Value: "localhost:9090", Type: "="},
-				{Name: "env", Value: "prod", Type: "="},
+			labelMatchers: []*labels.Matcher{
+				{Name: "instance", Value: "localhost:9090", Type: labels.MatchEqual},
+				{Name: "env", Value: "prod", Type: labels.MatchEqual},
 			},
 			want: "metric{env=\"prod\",instance=\"localhost:9090\",job=\"test\"}",
 		},
 		{
 			name:  "query with complex expression and variables",
 			query: "sum(rate(metric{job=\"test\"}[$__rate_interval])) by (instance) / 3",
-			labelMatchers: []LabelMatcher{
-				{Name: "env", Value: "prod", Type: "="},
+			labelMatchers: []*labels.Matcher{
+				{Name: "env", Value: "prod", Type: labels.MatchEqual},
 			},
 			want: "sum by (instance) (rate(metric{env=\"prod\",job=\"test\"}[$__rate_interval])) / 3",
 		},
 		{
 			name:  "query with all Perses variables",
 			query: "metric{job=\"test\"}[$__interval] offset $__range",
-			labelMatchers: []LabelMatcher{
-				{Name: "instance", Value: "localhost:9090", Type: "="},
+			labelMatchers: []*labels.Matcher{
+				{Name: "instance", Value: "localhost:9090", Type: labels.MatchEqual},
 			},
 			want: "metric{instance=\"localhost:9090\",job=\"test\"}[$__interval] offset $__range",
 		},
 		{
 			name:  "query with dashboard and project variables",
 			query: "metric{job=\"test\",dashboard=\"$__dashboard\",project=\"$__project\"}",
-			labelMatchers: []LabelMatcher{
-				{Name: "instance", Value: "localhost:9090", Type: "="},
+			labelMatchers: []*labels.Matcher{
+				{Name: "instance", Value: "localhost:9090", Type: labels.MatchEqual},
 			},
 			want: "metric{dashboard=\"$__dashboard\",instance=\"localhost:9090\",job=\"test\",project=\"$__project\"}",
 		},
 		{
 			name:  "query with time range variables",
 			query: "metric{job=\"test\"}[$__interval] @ $__from",
-			labelMatchers: []LabelMatcher{
-				{Name: "instance", Value: "localhost:9090", Type: "="},
+			labelMatchers: []*labels.Matcher{
+				{Name: "instance", Value: "localhost:9090", Type: labels.MatchEqual},
 			},
 			want: "metric{instance=\"localhost:9090\",job=\"test\"}[$__interval] @ $__from",
 		},
 		{
 			name:  "query with ms variables",
 			query: "metric{job=\"test\"}[$__interval_ms]",
-			labelMatchers: []LabelMatcher{
-				{Name: "instance", Value: "localhost:9090", Type: "="},
+			labelMatchers: []*labels.Matcher{
+				{Name: "instance", Value: "localhost:9090", Type: labels.MatchEqual},
 			},
 			want: "metric{instance=\"localhost:9090\",job=\"test\"}[$__interval_ms]",
 		},
 		{
 			name:  "query with range variables",
 			query: "metric{job=\"test\"}[$__range_s]",
-			labelMatchers: []LabelMatcher{
-				{Name: "instance", Value: "localhost:9090", Type: "="},
+			labelMatchers: []*labels.Matcher{
+				{Name: "instance", Value: "localhost:9090", Type: labels.MatchEqual},
 			},
 			want: "metric{instance=\"localhost:9090\",job=\"test\"}[$__range_s]",
 		},
@@ -125,10 +134,40 @@ func TestSetLabelMatchers(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := SetLabelMatchers(tt.query, tt.labelMatchers)
-			if got != tt.want {
-				t.Errorf("SetLabelMatchers() = %v, want %v", got, tt.want)
-			}
+			got, err := ParseQuery(tt.query, tt.labelMatchers, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseQuery_Subquery(t *testing.T) {
+	got, err := ParseQuery(
+		`sum(rate(label_replace({__name__=~"metric_[0-9]+"}, "code", "$1", "__name__", "metric_(.+)")[$__rate_interval:30s]))`,
+		[]*labels.Matcher{{Name: "cluster", Value: "$cluster", Type: labels.MatchEqual}},
+		nil,
+	)
+	require.NoError(t, err)
+	assert.Contains(t, got, `[$__rate_interval:30s]`)
+	assert.Contains(t, got, `cluster="$cluster"`)
+}
+
+func TestParseQuery_TimeRangeVariables(t *testing.T) {
+	got, err := ParseQuery(`metric @ $__from - metric @ $__to`, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, `metric @ $__from - metric @ $__to`, got)
+}
+
+func TestParseQuery_Errors(t *testing.T) {
+	for name, query := range map[string]string{
+		"unbalanced parenthesis": `sum(rate(metric[$__rate_interval])`,
+		"unknown function":       `foo(metric)`,
+		"empty query":            ``,
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseQuery(query, nil, nil)
+			assert.Error(t, err)
+			assert.Empty(t, got)
 		})
 	}
 }
@@ -0,0 +1,95 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package promql
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/perses/perses/go-sdk/panel"
+	"github.com/perses/plugins/prometheus/sdk/go/query"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+// QueryError is the error of an invalid query of a panel, identified by the panel title and the name of the query.
+type QueryError struct {
+	Panel string
+	Query string
+	Err   error
+}
+
+func (e *QueryError) Error() string {
+	return fmt.Sprintf("panel %q: query %s: %v", e.Panel, e.Query, e.Err)
+}
+
+func (e *QueryError) Unwrap() error {
+	return e.Err
+}
+
+// AddQuery returns a panel.Option adding the PromQL query expr to a panel, with labelMatchers set.
+// When the resulting query is invalid, the option fails with a *QueryError naming the panel and the query,
+// name being how the query is referred to in the code, e.g. EtcdCommonPanelQueries["EtcdUpStatus"].
+func AddQuery(name string, expr parser.Expr, labelMatchers []*labels.Matcher, options ...query.Option) panel.Option {
+	return AddQueryWithPolicies(name, expr, labelMatchers, nil, options...)
+}
+
+// AddQueryFrom returns a panel.Option adding the query of queries named key to a panel, with labelMatchers set,
+// queries being the common panel queries of a component and mapName how they are referred to in the code,
+// e.g. EtcdCommonPanelQueries. The option fails with a *QueryError naming the query as mapName[key], e.g.
+// EtcdCommonPanelQueries["EtcdUpStatus"], when queries has no query named key or when the resulting query is invalid.
+func AddQueryFrom(mapName string, queries map[string]parser.Expr, key string, labelMatchers []*labels.Matcher, options ...query.Option) panel.Option {
+	name := fmt.Sprintf("%s[%s]", mapName, strconv.Quote(key))
+	expr, ok := queries[key]
+	if !ok {
+		return addQuery(name, func() (string, error) {
+			return "", fmt.Errorf("no query named %q", key)
+		}, options)
+	}
+	return AddQuery(name, expr, labelMatchers, options...)
+}
+
+// AddQueryWithPolicies is like AddQuery but injects labelMatchers following policies, see InjectLabelMatchers.
+// Use InjectNone to opt the query out of a label.
+func AddQueryWithPolicies(name string, expr parser.Expr, labelMatchers []*labels.Matcher, policies Policies, options ...query.Option) panel.Option {
+	return addQuery(name, func() (string, error) {
+		q, err := InjectLabelMatchers(expr, labelMatchers, policies)
+		if err != nil {
+			return "", err
+		}
+		return q.Pretty(0), nil
+	}, options)
+}
+
+// AddParsedQuery is like AddQueryWithPolicies for a query promql-builder can't express, parsed by ParseQuery.
+// A query that doesn't parse fails the option with a *QueryError.
+func AddParsedQuery(name string, q string, labelMatchers []*labels.Matcher, policies Policies, options ...query.Option) panel.Option {
+	return addQuery(name, func() (string, error) {
+		return ParseQuery(q, labelMatchers, policies)
+	}, options)
+}
+
+func addQuery(name string, build func() (string, error), options []query.Option) panel.Option {
+	return func(builder *panel.Builder) error {
+		q, err := build()
+		if err != nil {
+			queryErr := &QueryError{Query: name, Err: err}
+			if builder.Spec.Display != nil {
+				queryErr.Panel = builder.Spec.Display.Name
+			}
+			return queryErr
+		}
+		return panel.AddQuery(query.PromQL(q, options...))(builder)
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package promql
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/perses/perses/go-sdk/panel"
+	"github.com/perses/promql-builder/vector"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddQuery_InvalidQuery(t *testing.T) {
+	invalid := &parser.BinaryExpr{
+		Op:  parser.ADD,
+		LHS: vector.New(vector.WithMetricName("foo")),
+	}
+	_, err := panel.New("Up", AddQuery(`EtcdCommonPanelQueries["EtcdUpStatus"]`, invalid, nil))
+
+	var queryErr *QueryError
+	require.True(t, errors.As(err, &queryErr), "panel.New() error = %v, want a *QueryError", err)
+	assert.Equal(t, "Up", queryErr.Panel)
+	assert.Equal(t, `EtcdCommonPanelQueries["EtcdUpStatus"]`, queryErr.Query)
+	assert.Contains(t, err.Error(), `panel "Up": query EtcdCommonPanelQueries["EtcdUpStatus"]`)
+}
+
+func TestAddParsedQuery_ParseError(t *testing.T) {
+	_, err := panel.New("QPS", AddParsedQuery("envoyGRPCStatusQuery", "sum(rate(metric[$__rate_interval])", nil, nil))
+
+	var queryErr *QueryError
+	require.True(t, errors.As(err, &queryErr), "panel.New() error = %v, want a *QueryError", err)
+	assert.Equal(t, "QPS", queryErr.Panel)
+	assert.Equal(t, "envoyGRPCStatusQuery", queryErr.Query)
+	assert.Contains(t, err.Error(), `sum(rate(metric[$__rate_interval])`)
+}
+
+func TestAddQueryFrom_UnknownQuery(t *testing.T) {
+	queries := map[string]parser.Expr{"EtcdUpStatus": vector.New(vector.WithMetricName("etcd_server_has_leader"))}
+	_, err := panel.New("Up", AddQueryFrom("EtcdCommonPanelQueries", queries, "EtcdDown", nil))
+
+	var queryErr *QueryError
+	require.True(t, errors.As(err, &queryErr), "panel.New() error = %v, want a *QueryError", err)
+	assert.Equal(t, "Up", queryErr.Panel)
+	assert.Equal(t, `EtcdCommonPanelQueries["EtcdDown"]`, queryErr.Query)
+	assert.Contains(t, err.Error(), `no query named "EtcdDown"`)
+}
@@ -0,0 +1,179 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package querytest evaluates the panel queries and the rules of a component with the Prometheus engine against
+// synthetic series, so that a query or an alert that no longer returns data, e.g. after an exporter renamed a metric,
+// fails a test.
+//
+// The series are written in the load format of the PromQL test scripting language, see
+// https://github.com/prometheus/prometheus/tree/main/promql/promqltest. They are loaded into an in-memory storage,
+// so the tests run offline.
+package querytest
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/promql"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/promql/promqltest"
+	"github.com/prometheus/prometheus/util/teststorage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	persespromql "github.com/perses/community-mixins/pkg/promql"
+)
+
+// DefaultEvalTime is the time the queries are evaluated at when Suite.EvalTime is zero. The series are loaded
+// from the Unix epoch on, so the series files must cover at least the first 10 minutes.
+const DefaultEvalTime = 10 * time.Minute
+
+// BuiltinVariables are the values of the variables Perses defines on every dashboard. Suite.Variables take
+// precedence over them.
+var BuiltinVariables = map[string]string{
+	"__interval":      "1m",
+	"__interval_ms":   "60000",
+	"__rate_interval": "2m",
+	"__range":         "10m",
+	"__range_s":       "600",
+	"__range_ms":      "600000",
+}
+
+// Suite evaluates the queries of a component against the series of a file.
+type Suite struct {
+	// SeriesFile is the path of a file of load commands, e.g. testdata/series.test.
+	SeriesFile string
+	// Queries are the queries to evaluate, by name, e.g. EtcdCommonPanelQueries.
+	Queries map[string]parser.Expr
+	// Variables are the values of the dashboard variables the queries reference, e.g. cluster.
+	Variables map[string]string
+	// Labels are the label sets some of the queries must return, e.g. `{cluster="cluster-a"}`. The other queries
+	// only have to return a sample.
+	Labels map[string][]string
+	// Skip are the queries that can't be evaluated against synthetic series, with the reason why.
+	Skip map[string]string
+	// EvalTime is the time the queries are evaluated at, DefaultEvalTime when zero.
+	EvalTime time.Duration
+}
+
+// Run evaluates each query of s in a subtest, and fails the subtest when the query doesn't return any sample or
+// doesn't return the label sets of s.Labels.
+func (s Suite) Run(t *testing.T) {
+	t.Helper()
+	storage := loadStorage(t, s.SeriesFile)
+	engine := promqltest.NewTestEngine(t, false, 0, promqltest.DefaultMaxSamplesPerQuery)
+
+	evalTime := s.EvalTime
+	if evalTime == 0 {
+		evalTime = DefaultEvalTime
+	}
+	for name := range s.Labels {
+		assert.Contains(t, s.Queries, name, "Labels refers to a query that doesn't exist")
+	}
+	for name := range s.Skip {
+		assert.Contains(t, s.Queries, name, "Skip refers to a query that doesn't exist")
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(s.Queries)) {
+		t.Run(name, func(t *testing.T) {
+			if reason, ok := s.Skip[name]; ok {
+				t.Skip(reason)
+			}
+			qs, err := s.interpolate(s.Queries[name].String())
+			require.NoError(t, err)
+
+			q, err := engine.NewInstantQuery(context.Background(), storage, nil, qs, evalAt(evalTime))
+			require.NoError(t, err, qs)
+			defer q.Close()
+			res := q.Exec(context.Background())
+			require.NoError(t, res.Err, qs)
+
+			got, err := labelSets(res)
+			require.NoError(t, err, qs)
+			require.NotEmpty(t, got, "query returned no data: %s", qs)
+			if want, ok := s.Labels[name]; ok {
+				assert.ElementsMatch(t, normalize(t, want), got, qs)
+			}
+		})
+	}
+}
+
+// loadStorage returns an in-memory storage with the series of seriesFile loaded, closed when t ends.
+func loadStorage(t *testing.T, seriesFile string) *teststorage.TestStorage {
+	t.Helper()
+	series, err := os.ReadFile(seriesFile)
+	require.NoError(t, err)
+	storage := promqltest.LoadedStorage(t, string(series))
+	t.Cleanup(func() { _ = storage.Close() })
+	return storage
+}
+
+// interpolate replaces the variables query references with their value, as Perses does before sending a query to
+// Prometheus. It returns an error when a variable has no value.
+func (s Suite) interpolate(query string) (string, error) {
+	var unbound []string
+	interpolated := persespromql.VariableReference.ReplaceAllStringFunc(query, func(ref string) string {
+		m := persespromql.VariableReference.FindStringSubmatch(ref)
+		name := m[1] + m[2]
+		if value, ok := s.Variables[name]; ok {
+			return value
+		}
+		if value, ok := BuiltinVariables[name]; ok {
+			return value
+		}
+		unbound = append(unbound, name)
+		return ref
+	})
+	if len(unbound) > 0 {
+		return "", fmt.Errorf("query %q references variables without a value: %v", query, unbound)
+	}
+	return interpolated, nil
+}
+
+// labelSets returns the label sets of the series of res, formatted as series selectors.
+func labelSets(res *promql.Result) ([]string, error) {
+	var sets []string
+	switch v := res.Value.(type) {
+	case promql.Vector:
+		for _, sample := range v {
+			sets = append(sets, sample.Metric.String())
+		}
+	case promql.Matrix:
+		for _, series := range v {
+			sets = append(sets, series.Metric.String())
+		}
+	case promql.Scalar:
+		sets = append(sets, "{}")
+	default:
+		return nil, fmt.Errorf("unexpected result type %s", res.Value.Type())
+	}
+	return sets, nil
+}
+
+// normalize formats the label sets of sets the way labels.Labels does, so that they can be compared regardless of
+// the order of their labels or of spaces.
+func normalize(t *testing.T, sets []string) []string {
+	t.Helper()
+	normalized := make([]string, 0, len(sets))
+	for _, set := range sets {
+		lset, err := parser.NewParser(parser.Options{}).ParseMetric(set)
+		require.NoError(t, err)
+		normalized = append(normalized, lset.String())
+	}
+	return normalized
+}
@@ -0,0 +1,32 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querytest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInterpolate(t *testing.T) {
+	s := Suite{Variables: map[string]string{"cluster": "cluster-a", "__rate_interval": "5m"}}
+
+	got, err := s.interpolate(`rate(up{cluster="$cluster",job=~"${cluster:regex}"}[$__rate_interval]) > $__interval_ms`)
+	require.NoError(t, err)
+	assert.Equal(t, `rate(up{cluster="cluster-a",job=~"cluster-a"}[5m]) > 60000`, got)
+
+	_, err = s.interpolate(`up{namespace="$namespace",pod="${pod}"}`)
+	assert.EqualError(t, err, `query "up{namespace=\"$namespace\",pod=\"${pod}\"}" references variables without a value: [namespace pod]`)
+}
@@ -0,0 +1,256 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querytest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql"
+	"github.com/prometheus/prometheus/promql/promqltest"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// DefaultEvalInterval is the interval the rules of a group are evaluated at when the group doesn't set one, the
+// default evaluation interval of Prometheus.
+const DefaultEvalInterval = time.Minute
+
+// RuleSuite evaluates the rules of a component against the series of a file, the way Prometheus does: the
+// recording rules are evaluated at each interval from the Unix epoch on, and their samples added to the series, so
+// that the rules using them have data. A series file can still load the series of a recording rule, e.g. for the
+// panel queries using it: the loaded samples take precedence over those the rule records.
+type RuleSuite struct {
+	// SeriesFile is the path of a file of load commands, e.g. ../../panels/etcd/testdata/series.test, usually the
+	// one of the panel queries of the component.
+	SeriesFile string
+	// Groups are the rule groups to evaluate, e.g. the groups of the PrometheusRule of the component.
+	Groups []monitoringv1.RuleGroup
+	// Labels are the label sets the expression of some of the rules must return, by record or alert name. The other
+	// rules only have to return a sample.
+	Labels map[string][]string
+	// Skip are the rules that can't be evaluated against synthetic series, by record or alert name, with the reason
+	// why.
+	Skip map[string]string
+	// EvalTime is the time the rules are checked at, DefaultEvalTime after the longest for duration of the alerts
+	// when zero. The series file must cover it.
+	EvalTime time.Duration
+}
+
+// Run evaluates the rules of s and checks each of them in a subtest of the subtest of its group. A recording rule
+// must return a sample at EvalTime. An alert must fire at EvalTime: its expression must return a sample with the
+// same labels at each evaluation of its for duration up to EvalTime.
+func (s RuleSuite) Run(t *testing.T) {
+	t.Helper()
+	db := loadStorage(t, s.SeriesFile)
+	engine := promqltest.NewTestEngine(t, false, 0, promqltest.DefaultMaxSamplesPerQuery)
+
+	names := map[string]bool{}
+	var longestFor time.Duration
+	for _, group := range s.Groups {
+		for _, rule := range group.Rules {
+			names[ruleName(rule)] = true
+			longestFor = max(longestFor, parseDuration(t, rule.For))
+		}
+	}
+	for name := range s.Labels {
+		assert.Contains(t, names, name, "Labels refers to a rule that doesn't exist")
+	}
+	for name := range s.Skip {
+		assert.Contains(t, names, name, "Skip refers to a rule that doesn't exist")
+	}
+
+	evalTime := s.EvalTime
+	if evalTime == 0 {
+		evalTime = DefaultEvalTime + longestFor
+	}
+	recordErrs := s.record(t, engine, db, evalTime)
+
+	for i, group := range s.Groups {
+		interval := parseDuration(t, group.Interval)
+		if interval == 0 {
+			interval = DefaultEvalInterval
+		}
+		t.Run(group.Name, func(t *testing.T) {
+			for j, rule := range group.Rules {
+				t.Run(ruleName(rule), func(t *testing.T) {
+					if reason, ok := s.Skip[ruleName(rule)]; ok {
+						t.Skip(reason)
+					}
+					require.NoError(t, recordErrs[[2]int{i, j}])
+
+					var got []string
+					if rule.Alert == "" {
+						got = evalRule(t, engine, db, rule, evalTime)
+					} else {
+						got = firing(t, engine, db, rule, evalTime, interval)
+					}
+					if want, ok := s.Labels[ruleName(rule)]; ok {
+						assert.ElementsMatch(t, normalize(t, want), got, rule.Expr.String())
+					}
+				})
+			}
+		})
+	}
+}
+
+// record evaluates the recording rules of s at each interval of their group from the Unix epoch to evalTime, and
+// appends their samples to db. Like Prometheus, it evaluates the rules of a group in order and commits the samples of
+// each rule before evaluating the next one, so a rule has the samples of the rules before it at the same time. It
+// returns the first error of each rule that failed, by index of its group and of the rule in the group.
+func (s RuleSuite) record(t *testing.T, engine *promql.Engine, db storage.Storage, evalTime time.Duration) map[[2]int]error {
+	t.Helper()
+	intervals := make([]time.Duration, len(s.Groups))
+	step := time.Duration(0)
+	for i, group := range s.Groups {
+		intervals[i] = parseDuration(t, group.Interval)
+		if intervals[i] == 0 {
+			intervals[i] = DefaultEvalInterval
+		}
+		step = gcd(step, intervals[i])
+	}
+
+	errs := map[[2]int]error{}
+	for ts := time.Duration(0); ts <= evalTime && step > 0; ts += step {
+		for i, group := range s.Groups {
+			if ts%intervals[i] != 0 {
+				continue
+			}
+			for j, rule := range group.Rules {
+				if rule.Record == "" || errs[[2]int{i, j}] != nil {
+					continue
+				}
+				if err := recordAt(engine, db, group, rule, ts); err != nil {
+					errs[[2]int{i, j}] = fmt.Errorf("recording at %s: %w", ts, err)
+				}
+			}
+		}
+	}
+	return errs
+}
+
+// recordAt evaluates the recording rule of group at ts and appends its samples to db, with the labels of the group
+// and of the rule. The samples of a series the series file loads at ts or later are dropped.
+func recordAt(engine *promql.Engine, db storage.Storage, group monitoringv1.RuleGroup, rule monitoringv1.Rule, ts time.Duration) error {
+	q, err := engine.NewInstantQuery(context.Background(), db, nil, rule.Expr.String(), evalAt(ts))
+	if err != nil {
+		return err
+	}
+	defer q.Close()
+	res := q.Exec(context.Background())
+	if res.Err != nil {
+		return res.Err
+	}
+	vector, err := res.Vector()
+	if err != nil {
+		return err
+	}
+
+	app := db.Appender(context.Background())
+	for _, sample := range vector {
+		builder := labels.NewBuilder(sample.Metric)
+		for name, value := range group.Labels {
+			builder.Set(name, value)
+		}
+		for name, value := range rule.Labels {
+			builder.Set(name, value)
+		}
+		builder.Set(labels.MetricName, rule.Record)
+		_, err := app.Append(0, builder.Labels(), evalAt(ts).UnixMilli(), sample.F)
+		if errors.Is(err, storage.ErrOutOfOrderSample) || errors.Is(err, storage.ErrDuplicateSampleForTimestamp) {
+			// The series file loads the series, for the panel queries using it.
+			continue
+		}
+		if err != nil {
+			_ = app.Rollback()
+			return err
+		}
+	}
+	return app.Commit()
+}
+
+// evalRule evaluates the expression of rule at ts and returns the label sets of its result, failing t when it
+// doesn't return any sample.
+func evalRule(t *testing.T, engine *promql.Engine, db storage.Queryable, rule monitoringv1.Rule, ts time.Duration) []string {
+	t.Helper()
+	expr := rule.Expr.String()
+	q, err := engine.NewInstantQuery(context.Background(), db, nil, expr, evalAt(ts))
+	require.NoError(t, err, expr)
+	defer q.Close()
+	res := q.Exec(context.Background())
+	require.NoError(t, res.Err, expr)
+
+	got, err := labelSets(res)
+	require.NoError(t, err, expr)
+	require.NotEmpty(t, got, "rule returned no data at %s: %s", ts, expr)
+	return got
+}
+
+// firing evaluates the alert rule at each interval of its for duration up to evalTime, and returns the label sets
+// its expression returned at each of them, those of the alerts firing at evalTime. It fails t when there are none.
+func firing(t *testing.T, engine *promql.Engine, db storage.Queryable, rule monitoringv1.Rule, evalTime, interval time.Duration) []string {
+	t.Helper()
+	forDuration := parseDuration(t, rule.For)
+	require.LessOrEqual(t, forDuration, evalTime, "the alert is pending for longer than the series are evaluated")
+
+	got := evalRule(t, engine, db, rule, evalTime)
+	for ts := evalTime - interval; ts >= evalTime-forDuration; ts -= interval {
+		pending := evalRule(t, engine, db, rule, ts)
+		got = slices.DeleteFunc(got, func(set string) bool {
+			return !slices.Contains(pending, set)
+		})
+		require.NotEmpty(t, got, "alert stopped being pending at %s, before its for duration of %s: %s", ts, forDuration, rule.Expr.String())
+	}
+	return got
+}
+
+// ruleName returns the record or the alert name of rule.
+func ruleName(rule monitoringv1.Rule) string {
+	if rule.Alert != "" {
+		return rule.Alert
+	}
+	return rule.Record
+}
+
+// parseDuration returns the value of d, zero when d is nil.
+func parseDuration(t *testing.T, d *monitoringv1.Duration) time.Duration {
+	t.Helper()
+	if d == nil {
+		return 0
+	}
+	parsed, err := model.ParseDuration(string(*d))
+	require.NoError(t, err)
+	return time.Duration(parsed)
+}
+
+// gcd returns the greatest common divisor of a and b.
+func gcd(a, b time.Duration) time.Duration {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// evalAt returns the time of the evaluation at ts after the Unix epoch, where the series are loaded from.
+func evalAt(ts time.Duration) time.Time {
+	return time.Unix(0, 0).UTC().Add(ts)
+}
@@ -0,0 +1,43 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/perses/community-mixins/pkg/querytest"
+)
+
+func TestAlertmanagerRules(t *testing.T) {
+	// The critical integrations select pagerduty and the others webhook, rather than all of them by default.
+	result := BuildAlertmanagerRules("default", nil, nil,
+		WithCriticalIntegrationSelectorRegexp("pagerduty"),
+		WithNonCriticalIntegrationSelectorRegexp("pagerduty"),
+	)
+	require.NoError(t, result.Err())
+
+	querytest.RuleSuite{
+		SeriesFile: "../../panels/alertmanager/testdata/series.test",
+		Groups:     result.Rule().Spec.Groups,
+		Labels: map[string][]string{
+			"AlertmanagerFailedReload":        {`{job="alertmanager", instance="alertmanager-1:9093"}`},
+			"AlertmanagerMembersInconsistent": {`{__name__="alertmanager_cluster_members", job="alertmanager", instance="alertmanager-1:9093"}`},
+			"AlertmanagerConfigInconsistent":  {`{job="alertmanager"}`},
+			"AlertmanagerClusterDown":         {`{job="alertmanager"}`},
+			"AlertmanagerClusterCrashlooping": {`{job="alertmanager"}`},
+		},
+	}.Run(t)
+}
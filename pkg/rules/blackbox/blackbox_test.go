@@ -0,0 +1,37 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blackbox
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/perses/community-mixins/pkg/querytest"
+)
+
+func TestBlackboxRules(t *testing.T) {
+	result := BuildBlackboxRules("default", nil, nil)
+	require.NoError(t, result.Err())
+
+	querytest.RuleSuite{
+		SeriesFile: "../../panels/blackbox/testdata/series.test",
+		Groups:     result.Rule().Spec.Groups,
+		Labels: map[string][]string{
+			"BlackboxProbeFailed":                  {`{__name__="probe_success", job="blackbox-exporter", instance="https://down.example.com"}`},
+			"BlackboxLowUptime30d":                 {`{job="blackbox-exporter", instance="https://down.example.com"}`},
+			"BlackboxSslCertificateWillExpireSoon": {`{job="blackbox-exporter", instance="https://example.com"}`},
+		},
+	}.Run(t)
+}
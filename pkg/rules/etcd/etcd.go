@@ -0,0 +1,366 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcd
+
+import (
+	"time"
+
+	promqlbuilder "github.com/perses/promql-builder"
+	"github.com/perses/promql-builder/label"
+	"github.com/perses/promql-builder/matrix"
+	"github.com/perses/promql-builder/vector"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+
+	panels "github.com/perses/community-mixins/pkg/panels/etcd"
+	rulehelpers "github.com/perses/community-mixins/pkg/rules"
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/alerting"
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/common"
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/promtheusrule"
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/rulegroup"
+)
+
+// Runbook fragments
+const (
+	runbookEtcdMembersDown               = "/etcdmembersdown"
+	runbookEtcdInsufficientMembers       = "/etcdinsufficientmembers"
+	runbookEtcdNoLeader                  = "/etcdnoleader"
+	runbookEtcdHighNumberOfLeaderChanges = "/etcdhighnumberofleaderchanges"
+	runbookEtcdGRPCRequestsSlow          = "/etcdgrpcrequestsslow"
+	runbookEtcdHighFsyncDurations        = "/etcdhighfsyncdurations"
+	runbookEtcdDatabaseQuotaLowSpace     = "/etcddatabasequotalowspace"
+)
+
+type EtcdRulesConfig struct {
+	RunbookURL   string
+	DashboardURL string
+
+	JobSelector string
+
+	AdditionalAlertLabels      map[string]string
+	AdditionalAlertAnnotations map[string]string
+}
+
+type EtcdRulesConfigOption func(*EtcdRulesConfig)
+
+func WithRunbookURL(runbookURL string) EtcdRulesConfigOption {
+	return func(etcdRulesConfig *EtcdRulesConfig) {
+		etcdRulesConfig.RunbookURL = runbookURL
+	}
+}
+
+func WithDashboardURL(dashboardURL string) EtcdRulesConfigOption {
+	return func(etcdRulesConfig *EtcdRulesConfig) {
+		etcdRulesConfig.DashboardURL = dashboardURL
+	}
+}
+
+// WithJobSelector sets the job regexp used to select the etcd targets.
+// Pass the same value to the etcd dashboard so that the dashboard and the alerts look at the same targets.
+func WithJobSelector(jobSelector string) EtcdRulesConfigOption {
+	return func(etcdRulesConfig *EtcdRulesConfig) {
+		if jobSelector == "" {
+			jobSelector = panels.DefaultJobSelector
+		}
+		etcdRulesConfig.JobSelector = jobSelector
+	}
+}
+
+func WithAdditionalAlertLabels(additionalAlertLabels map[string]string) EtcdRulesConfigOption {
+	return func(etcdRulesConfig *EtcdRulesConfig) {
+		etcdRulesConfig.AdditionalAlertLabels = additionalAlertLabels
+	}
+}
+
+func WithAdditionalAlertAnnotations(additionalAlertAnnotations map[string]string) EtcdRulesConfigOption {
+	return func(etcdRulesConfig *EtcdRulesConfig) {
+		etcdRulesConfig.AdditionalAlertAnnotations = additionalAlertAnnotations
+	}
+}
+
+// NewEtcdRulesBuilder creates a new etcd rules builder.
+func NewEtcdRulesBuilder(
+	namespace string,
+	labels map[string]string,
+	annotations map[string]string,
+	options ...EtcdRulesConfigOption,
+) (promtheusrule.Builder, error) {
+	etcdRulesConfig := EtcdRulesConfig{
+		JobSelector: panels.DefaultJobSelector,
+	}
+	for _, option := range options {
+		option(&etcdRulesConfig)
+	}
+
+	promRule, err := promtheusrule.New(
+		"etcd-rules",
+		namespace,
+		promtheusrule.Labels(labels),
+		promtheusrule.Annotations(annotations),
+		promtheusrule.AddRuleGroup(
+			"etcd",
+			etcdRulesConfig.EtcdGroup()...,
+		),
+	)
+
+	return promRule, err
+}
+
+// BuildEtcdRules builds the etcd rules for the given namespace, dashboard URL, runbook URL, labels, and annotations.
+func BuildEtcdRules(
+	namespace string,
+	labels map[string]string,
+	annotations map[string]string,
+	options ...EtcdRulesConfigOption,
+) rulehelpers.RuleResult {
+	promRule, err := NewEtcdRulesBuilder(namespace, labels, annotations, options...)
+	if err != nil {
+		return rulehelpers.NewRuleResult(nil, err).Component("etcd")
+	}
+
+	return rulehelpers.NewRuleResult(
+		&promRule.PrometheusRule,
+		nil,
+	).Component("etcd")
+}
+
+// etcd returns a selector for the given etcd series, scoped to the configured job.
+func (e EtcdRulesConfig) etcd(metricName string, labelMatchers ...*labels.Matcher) *parser.VectorSelector {
+	return vector.New(
+		vector.WithMetricName(metricName),
+		vector.WithLabelMatchers(
+			append([]*labels.Matcher{panels.JobMatcher(e.JobSelector)}, labelMatchers...)...,
+		),
+	)
+}
+
+func (e EtcdRulesConfig) alert(
+	alertName, runbookFragment string,
+	expr parser.Expr,
+	forDuration, severity, description, summary string,
+) rulegroup.Option {
+	return rulegroup.AddRule(
+		alertName,
+		alerting.Expr(expr),
+		alerting.For(forDuration),
+		alerting.Labels(
+			common.MergeMaps(
+				map[string]string{
+					"severity": severity,
+				},
+				e.AdditionalAlertLabels,
+			),
+		),
+		alerting.Annotations(
+			common.MergeMaps(
+				common.BuildAnnotations(
+					e.DashboardURL,
+					e.RunbookURL,
+					runbookFragment,
+					description,
+					summary,
+				),
+				e.AdditionalAlertAnnotations,
+			),
+		),
+	)
+}
+
+// fsyncDurationsP99 returns the 99th percentile of the WAL fsync durations per etcd instance.
+func (e EtcdRulesConfig) fsyncDurationsP99() parser.Expr {
+	return promqlbuilder.HistogramQuantile(
+		0.99,
+		promqlbuilder.Rate(
+			matrix.New(
+				e.etcd("etcd_disk_wal_fsync_duration_seconds_bucket"),
+				matrix.WithRange(5*time.Minute),
+			),
+		),
+	)
+}
+
+func (e EtcdRulesConfig) EtcdGroup() []rulegroup.Option {
+	return []rulegroup.Option{
+		e.alert(
+			"etcdMembersDown",
+			runbookEtcdMembersDown,
+			promqlbuilder.Gtr(
+				promqlbuilder.Max(
+					promqlbuilder.Or(
+						promqlbuilder.Sum(
+							promqlbuilder.Eqlc(
+								e.etcd("up"),
+								promqlbuilder.NewNumber(0),
+							).Bool(),
+						).Without("instance", "pod"),
+						promqlbuilder.Gtr(
+							promqlbuilder.Count(
+								promqlbuilder.Sum(
+									promqlbuilder.Rate(
+										matrix.New(
+											e.etcd("etcd_network_peer_sent_failures_total"),
+											matrix.WithRange(2*time.Minute),
+										),
+									),
+								).Without("instance", "pod"),
+							).Without("To"),
+							promqlbuilder.NewNumber(0.01),
+						),
+					),
+				).Without("endpoint"),
+				promqlbuilder.NewNumber(0),
+			),
+			"20m",
+			"critical",
+			"etcd cluster \"{{ $labels.job }}\": members are down ({{ $value }}).",
+			"etcd cluster members are down.",
+		),
+		e.alert(
+			"etcdInsufficientMembers",
+			runbookEtcdInsufficientMembers,
+			promqlbuilder.Lss(
+				promqlbuilder.Sum(
+					promqlbuilder.Eqlc(
+						e.etcd("up"),
+						promqlbuilder.NewNumber(1),
+					).Bool(),
+				).Without("instance", "pod"),
+				promqlbuilder.Parenthesis(
+					promqlbuilder.Div(
+						promqlbuilder.Parenthesis(
+							promqlbuilder.Add(
+								promqlbuilder.Count(e.etcd("up")).Without("instance", "pod"),
+								promqlbuilder.NewNumber(1),
+							),
+						),
+						promqlbuilder.NewNumber(2),
+					),
+				),
+			),
+			"3m",
+			"critical",
+			"etcd cluster \"{{ $labels.job }}\": insufficient members ({{ $value }}).",
+			"etcd cluster has insufficient number of members.",
+		),
+		e.alert(
+			"etcdNoLeader",
+			runbookEtcdNoLeader,
+			promqlbuilder.Eqlc(
+				e.etcd("etcd_server_has_leader"),
+				promqlbuilder.NewNumber(0),
+			),
+			"1m",
+			"critical",
+			"etcd cluster \"{{ $labels.job }}\": member {{ $labels.instance }} has no leader.",
+			"etcd cluster has no leader.",
+		),
+		e.alert(
+			"etcdHighNumberOfLeaderChanges",
+			runbookEtcdHighNumberOfLeaderChanges,
+			promqlbuilder.Gte(
+				promqlbuilder.Increase(
+					matrix.New(
+						e.etcd("etcd_server_leader_changes_seen_total"),
+						matrix.WithRange(15*time.Minute),
+					),
+				),
+				promqlbuilder.NewNumber(4),
+			),
+			"5m",
+			"warning",
+			"etcd cluster \"{{ $labels.job }}\": {{ $value }} leader changes within the last 15 minutes. Frequent elections may be a sign of insufficient resources, high network latency, or disruptions by other components and should be investigated.",
+			"etcd cluster has high number of leader changes.",
+		),
+		e.alert(
+			"etcdGRPCRequestsSlow",
+			runbookEtcdGRPCRequestsSlow,
+			promqlbuilder.Gtr(
+				promqlbuilder.HistogramQuantile(
+					0.99,
+					promqlbuilder.Sum(
+						promqlbuilder.Rate(
+							matrix.New(
+								e.etcd(
+									"grpc_server_handling_seconds_bucket",
+									label.New("grpc_method").NotEqual("Defragment"),
+									label.New("grpc_type").Equal("unary"),
+								),
+								matrix.WithRange(5*time.Minute),
+							),
+						),
+					).Without("grpc_type"),
+				),
+				promqlbuilder.NewNumber(0.15),
+			),
+			"10m",
+			"critical",
+			"etcd cluster \"{{ $labels.job }}\": 99th percentile of gRPC requests is {{ $value }}s on etcd instance {{ $labels.instance }} for {{ $labels.grpc_method }} method.",
+			"etcd grpc requests are slow.",
+		),
+		e.alert(
+			"etcdHighFsyncDurations",
+			runbookEtcdHighFsyncDurations,
+			promqlbuilder.Gtr(
+				e.fsyncDurationsP99(),
+				promqlbuilder.NewNumber(0.5),
+			),
+			"10m",
+			"warning",
+			"etcd cluster \"{{ $labels.job }}\": 99th percentile fsync durations are {{ $value }}s on etcd instance {{ $labels.instance }}.",
+			"etcd cluster 99th percentile fsync durations are too high.",
+		),
+		e.alert(
+			"etcdHighFsyncDurations",
+			runbookEtcdHighFsyncDurations,
+			promqlbuilder.Gtr(
+				e.fsyncDurationsP99(),
+				promqlbuilder.NewNumber(1),
+			),
+			"10m",
+			"critical",
+			"etcd cluster \"{{ $labels.job }}\": 99th percentile fsync durations are {{ $value }}s on etcd instance {{ $labels.instance }}.",
+			"etcd cluster 99th percentile fsync durations are too high.",
+		),
+		e.alert(
+			"etcdDatabaseQuotaLowSpace",
+			runbookEtcdDatabaseQuotaLowSpace,
+			promqlbuilder.Gtr(
+				promqlbuilder.Mul(
+					promqlbuilder.Parenthesis(
+						promqlbuilder.Div(
+							promqlbuilder.LastOverTime(
+								matrix.New(
+									e.etcd("etcd_mvcc_db_total_size_in_bytes"),
+									matrix.WithRange(5*time.Minute),
+								),
+							),
+							promqlbuilder.LastOverTime(
+								matrix.New(
+									e.etcd("etcd_server_quota_backend_bytes"),
+									matrix.WithRange(5*time.Minute),
+								),
+							),
+						),
+					),
+					promqlbuilder.NewNumber(100),
+				),
+				promqlbuilder.NewNumber(95),
+			),
+			"10m",
+			"critical",
+			"etcd cluster \"{{ $labels.job }}\": database size exceeds the defined quota on etcd instance {{ $labels.instance }}, please defrag or increase the quota as the writes to etcd will be disabled when it is full.",
+			"etcd cluster database is running full.",
+		),
+	}
+}
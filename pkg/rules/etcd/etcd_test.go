@@ -0,0 +1,38 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/perses/community-mixins/pkg/querytest"
+)
+
+func TestEtcdRules(t *testing.T) {
+	result := BuildEtcdRules("default", nil, nil)
+	require.NoError(t, result.Err())
+
+	querytest.RuleSuite{
+		SeriesFile: "../../panels/etcd/testdata/series.test",
+		Groups:     result.Rule().Spec.Groups,
+		Labels: map[string][]string{
+			"etcdMembersDown":           {`{cluster="cluster-c", job="etcd"}`},
+			"etcdInsufficientMembers":   {`{cluster="cluster-c", job="etcd"}`},
+			"etcdNoLeader":              {`{__name__="etcd_server_has_leader", cluster="cluster-c", job="etcd", instance="etcd-0:2379"}`},
+			"etcdDatabaseQuotaLowSpace": {`{cluster="cluster-c", job="etcd", instance="etcd-0:2379"}`},
+		},
+	}.Run(t)
+}
@@ -17,11 +17,10 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
-	"io"
-	"os"
-	"path"
 
+	"github.com/perses/community-mixins/pkg/sink"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	k8syaml "sigs.k8s.io/yaml"
 )
 
@@ -36,7 +35,8 @@ type Groups struct {
 	Groups []monitoringv1.RuleGroup `json:"groups,omitempty"`
 }
 
-func executeRuleBuilder(rule *monitoringv1.PrometheusRule, outputFormat string, outputDir string, errWriter io.Writer) {
+// renderRule marshals the rule in the given output format and returns it with its file extension.
+func renderRule(rule *monitoringv1.PrometheusRule, outputFormat string) ([]byte, string, error) {
 	var err error
 	var output []byte
 	var ext string
@@ -55,32 +55,18 @@ func executeRuleBuilder(rule *monitoringv1.PrometheusRule, outputFormat string,
 		output, err = json.MarshalIndent(rule, "", "  ")
 		ext = JSONOutput
 	default:
-		err = fmt.Errorf("--output must be %q, %q, %q or %q", YAMLOutput, JSONOutput, OperatorOutput, OperatorJSONOutput)
+		err = fmt.Errorf("unsupported output format %q, supported: %q, %q, %q, %q", outputFormat, YAMLOutput, JSONOutput, OperatorOutput, OperatorJSONOutput)
 	}
-
 	if err != nil {
-		if _, ferr := fmt.Fprint(errWriter, err); ferr != nil {
-			panic(fmt.Errorf("failed to write err: %w", err))
-		}
-		os.Exit(-1)
-	}
-
-	// create output directory if not exists
-	_, err = os.Stat(outputDir)
-	if err != nil && !os.IsNotExist(err) {
-		if _, ferr := fmt.Fprint(errWriter, err); ferr != nil {
-			panic(fmt.Errorf("failed to write err: %w", err))
-		}
-		os.Exit(-1)
-	}
-
-	if err != nil && os.IsNotExist(err) {
-		_ = os.MkdirAll(outputDir, os.ModePerm)
+		return nil, "", err
 	}
-
-	_ = os.WriteFile(fmt.Sprintf("%s/%s.%s", outputDir, rule.Name, ext), output, os.ModePerm)
+	return output, ext, nil
 }
 
+// NewExec returns an Exec writing rules in the format of the --output-rules flag under the directory of the
+// --output-rules-dir flag.
+//
+// Deprecated: use NewExecWithDir, which doesn't depend on the command line flags.
 func NewExec() Exec {
 	output := flag.Lookup("output-rules").Value.String()
 	outputDir := flag.Lookup("output-rules-dir").Value.String()
@@ -89,22 +75,80 @@ func NewExec() Exec {
 		panic("output-rules and output-rules-dir flags are required for generating rules")
 	}
 
+	return NewExecWithDir(output, outputDir)
+}
+
+// NewExecWithDir returns an Exec writing rules in outputFormat under outputDir.
+func NewExecWithDir(outputFormat string, outputDir string) Exec {
+	return NewExecWithSink(outputFormat, sink.NewDir(outputDir))
+}
+
+// NewExecWithSink returns an Exec writing rules in outputFormat to out.
+func NewExecWithSink(outputFormat string, out sink.Sink) Exec {
 	return Exec{
-		outputFormat: output,
-		outputDir:    outputDir,
+		outputFormat: outputFormat,
+		out:          out,
 	}
 }
 
 type Exec struct {
 	outputFormat string
-	outputDir    string
+	out          sink.Sink
+}
+
+// BuildRule writes the result of a rule builder to the sink of the Exec.
+// The returned error, if any, is a *RuleError.
+func (b *Exec) BuildRule(dr RuleResult) error {
+	if dr.err != nil {
+		return newRuleError(dr, dr.err)
+	}
+	if dr.rule == nil {
+		return newRuleError(dr, fmt.Errorf("no rule was built"))
+	}
+	output, ext, err := renderRule(dr.rule, b.outputFormat)
+	if err != nil {
+		return newRuleError(dr, err)
+	}
+	if err := b.out.Write(dr.component, dr.rule.Name, ext, output); err != nil {
+		return newRuleError(dr, err)
+	}
+	return nil
 }
 
-// BuildDashboard is a helper to print the result of a dashboard builder in stdout and errors to stderr
-func (b *Exec) BuildRule(dr RuleResult) {
+// BuildRuleOperatorResource returns the PrometheusRule of a rule builder as a runtime.Object.
+// The returned error, if any, is a *RuleError.
+func (b *Exec) BuildRuleOperatorResource(dr RuleResult) (runtime.Object, error) {
 	if dr.err != nil {
-		fmt.Fprint(os.Stderr, dr.err)
-		os.Exit(-1)
+		return nil, newRuleError(dr, dr.err)
 	}
-	executeRuleBuilder(dr.rule, b.outputFormat, path.Join(b.outputDir, dr.component), os.Stdout)
+	if dr.rule == nil {
+		return nil, newRuleError(dr, fmt.Errorf("no rule was built"))
+	}
+	return dr.rule, nil
+}
+
+// RuleError is the error of a single PrometheusRule, identified by its component and name.
+type RuleError struct {
+	Component string
+	Name      string
+	Err       error
+}
+
+func newRuleError(dr RuleResult, err error) *RuleError {
+	ruleErr := &RuleError{
+		Component: dr.component,
+		Err:       err,
+	}
+	if dr.rule != nil {
+		ruleErr.Name = dr.rule.Name
+	}
+	return ruleErr
+}
+
+func (e *RuleError) Error() string {
+	return fmt.Sprintf("rule %q of component %q: %v", e.Name, e.Component, e.Err)
+}
+
+func (e *RuleError) Unwrap() error {
+	return e.Err
 }
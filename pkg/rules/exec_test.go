@@ -0,0 +1,65 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/perses/community-mixins/pkg/sink"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRuleWriterOperatorResources(t *testing.T) {
+	writer := NewRuleWriterWithSink(OperatorOutput, sink.NewMemory())
+	rule := &monitoringv1.PrometheusRule{
+		ObjectMeta: metav1.ObjectMeta{Name: "etcd-rules", Namespace: "monitoring"},
+	}
+	writer.Add(NewRuleResult(rule, nil).Component("etcd"))
+
+	resources, err := writer.OperatorResources()
+	if err != nil {
+		t.Fatalf("OperatorResources() returned error: %v", err)
+	}
+	if len(resources) != 1 || resources[0] != rule {
+		t.Fatalf("OperatorResources() = %v, want the etcd-rules PrometheusRule", resources)
+	}
+}
+
+func TestRuleWriterOperatorResources_AggregatesErrors(t *testing.T) {
+	writer := NewRuleWriterWithSink(OperatorOutput, sink.NewMemory())
+	writer.Add(NewRuleResult(&monitoringv1.PrometheusRule{ObjectMeta: metav1.ObjectMeta{Name: "etcd-rules"}}, nil).Component("etcd"))
+	writer.Add(NewRuleResult(nil, errors.New("invalid expression")).Component("istio"))
+	writer.Add(NewRuleResult(nil, nil).Component("tempo"))
+
+	resources, err := writer.OperatorResources()
+	if err == nil {
+		t.Fatal("OperatorResources() returned no error, want the errors of the istio and tempo rules")
+	}
+	if resources != nil {
+		t.Errorf("OperatorResources() = %v, want no resources on error", resources)
+	}
+	var components []string
+	for _, err := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var ruleErr *RuleError
+		if !errors.As(err, &ruleErr) {
+			t.Fatalf("error %v is not a *RuleError", err)
+		}
+		components = append(components, ruleErr.Component)
+	}
+	if len(components) != 2 || components[0] != "istio" || components[1] != "tempo" {
+		t.Errorf("got errors for components %v, want istio and tempo", components)
+	}
+}
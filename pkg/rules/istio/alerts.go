@@ -0,0 +1,169 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package istio
+
+import (
+	"time"
+
+	promqlbuilder "github.com/perses/promql-builder"
+	"github.com/perses/promql-builder/label"
+	"github.com/perses/promql-builder/matrix"
+	"github.com/perses/promql-builder/vector"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+
+	istioPanels "github.com/perses/community-mixins/pkg/panels/istio"
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/alerting"
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/common"
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/rulegroup"
+)
+
+// ztunnelConnectionsRate returns the per ztunnel pod rate of the given connection counter.
+func ztunnelConnectionsRate(metricName string) parser.Expr {
+	return promqlbuilder.Sum(
+		promqlbuilder.Rate(
+			matrix.New(
+				vector.New(
+					vector.WithMetricName(metricName),
+					vector.WithLabelMatchers(
+						label.New("pod").EqualRegexp("ztunnel-.*"),
+					),
+				),
+				matrix.WithRange(5*time.Minute),
+			),
+		),
+	).By("pod")
+}
+
+// pilotIncrease returns the increase of the given istiod counter over the last 5 minutes, aggregated by the given labels.
+func pilotIncrease(metricName string, by ...string) parser.Expr {
+	return promqlbuilder.Sum(
+		promqlbuilder.Increase(
+			matrix.New(
+				vector.New(
+					vector.WithMetricName(metricName),
+				),
+				matrix.WithRange(5*time.Minute),
+			),
+		),
+	).By(by...)
+}
+
+func (i IstioRulesConfig) alert(
+	alertName, runbookFragment, dashboardURL string,
+	expr parser.Expr,
+	forDuration, severity, description, summary string,
+) rulegroup.Option {
+	return rulegroup.AddRule(
+		alertName,
+		alerting.Expr(expr),
+		alerting.For(forDuration),
+		alerting.Labels(
+			common.MergeMaps(
+				map[string]string{
+					"severity": severity,
+				},
+				i.AdditionalAlertLabels,
+			),
+		),
+		alerting.Annotations(
+			common.MergeMaps(
+				common.BuildAnnotations(
+					dashboardURL,
+					i.RunbookURL,
+					runbookFragment,
+					description,
+					summary,
+				),
+				i.AdditionalAlertAnnotations,
+			),
+		),
+	)
+}
+
+func (i IstioRulesConfig) IstioGroup() []rulegroup.Option {
+	return []rulegroup.Option{
+		i.alert(
+			"IstiodPushErrors",
+			runbookIstiodPushErrors,
+			i.ControlPlaneDashboardURL,
+			promqlbuilder.Gtr(
+				pilotIncrease("pilot_total_xds_internal_errors", "pod"),
+				promqlbuilder.NewNumber(0),
+			),
+			"10m",
+			"warning",
+			"istiod {{ $labels.pod }} failed {{ $value | humanize }} xDS pushes because of internal errors over the last 5 minutes.",
+			"istiod is failing to push configuration to the proxies.",
+		),
+		i.alert(
+			"IstioXDSRejections",
+			runbookIstioXDSRejections,
+			i.ControlPlaneDashboardURL,
+			promqlbuilder.Gtr(
+				pilotIncrease("pilot_total_xds_rejects", "type"),
+				promqlbuilder.NewNumber(0),
+			),
+			"10m",
+			"warning",
+			"Proxies rejected {{ $value | humanize }} {{ $labels.type }} xDS updates over the last 5 minutes, the pushed configuration is likely invalid.",
+			"Proxies are rejecting the configuration pushed by istiod.",
+		),
+		i.alert(
+			"IstioServiceHigh5xxRate",
+			runbookIstioServiceHigh5xxRate,
+			i.ServiceDashboardURL,
+			promqlbuilder.Gtr(
+				promqlbuilder.Div(
+					serviceRequestsRate(label.New("response_code").EqualRegexp("5..")),
+					serviceRequestsRate(),
+				),
+				promqlbuilder.NewNumber(i.Service5xxRateThreshold),
+			),
+			"10m",
+			"warning",
+			"{{ $value | humanizePercentage }} of the requests to service {{ $labels.destination_service }} in namespace {{ $labels.destination_service_namespace }} are failing with 5xx responses.",
+			"Istio service is returning a high rate of 5xx responses.",
+		),
+		i.alert(
+			"IstioZtunnelConnectionFailures",
+			runbookIstioZtunnelConnectionFailures,
+			i.ZtunnelDashboardURL,
+			promqlbuilder.Gtr(
+				promqlbuilder.Div(
+					ztunnelConnectionsRate("istio_tcp_connections_failed_total"),
+					ztunnelConnectionsRate("istio_tcp_connections_opened_total"),
+				),
+				promqlbuilder.NewNumber(i.ZtunnelConnectionFailureRatioThreshold),
+			),
+			"15m",
+			"warning",
+			"ztunnel {{ $labels.pod }} fails to establish {{ $value | humanizePercentage }} of its connections.",
+			"ztunnel is failing to establish connections.",
+		),
+	}
+}
+
+// serviceRequestsRate returns the per service rate of the requests reported by the destination proxies, matching
+// labelMatchers, read from the workload rates recorded by IstioWorkloadRecordingGroup.
+func serviceRequestsRate(labelMatchers ...*labels.Matcher) parser.Expr {
+	return promqlbuilder.Sum(
+		vector.New(
+			vector.WithMetricName(istioPanels.RecordedMetricName("istio_requests_total")),
+			vector.WithLabelMatchers(
+				append([]*labels.Matcher{label.New("reporter").Equal("destination")}, labelMatchers...)...,
+			),
+		),
+	).By("destination_service", "destination_service_namespace")
+}
@@ -0,0 +1,156 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package istio
+
+import (
+	rulehelpers "github.com/perses/community-mixins/pkg/rules"
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/promtheusrule"
+)
+
+// Runbook fragments
+const (
+	runbookIstiodPushErrors               = "#istiodpusherrors"
+	runbookIstioXDSRejections             = "#istioxdsrejections"
+	runbookIstioServiceHigh5xxRate        = "#istioservicehigh5xxrate"
+	runbookIstioZtunnelConnectionFailures = "#istioztunnelconnectionfailures"
+)
+
+type IstioRulesConfig struct {
+	RunbookURL               string
+	ControlPlaneDashboardURL string
+	ServiceDashboardURL      string
+	ZtunnelDashboardURL      string
+
+	// Service5xxRateThreshold is the ratio of 5xx responses above which a service alerts.
+	Service5xxRateThreshold float64
+	// ZtunnelConnectionFailureRatioThreshold is the ratio of failed to opened connections above which a ztunnel alerts.
+	ZtunnelConnectionFailureRatioThreshold float64
+
+	AdditionalAlertLabels      map[string]string
+	AdditionalAlertAnnotations map[string]string
+}
+
+type IstioRulesConfigOption func(*IstioRulesConfig)
+
+func WithRunbookURL(runbookURL string) IstioRulesConfigOption {
+	return func(istioRulesConfig *IstioRulesConfig) {
+		istioRulesConfig.RunbookURL = runbookURL
+	}
+}
+
+func WithControlPlaneDashboardURL(controlPlaneDashboardURL string) IstioRulesConfigOption {
+	return func(istioRulesConfig *IstioRulesConfig) {
+		istioRulesConfig.ControlPlaneDashboardURL = controlPlaneDashboardURL
+	}
+}
+
+func WithServiceDashboardURL(serviceDashboardURL string) IstioRulesConfigOption {
+	return func(istioRulesConfig *IstioRulesConfig) {
+		istioRulesConfig.ServiceDashboardURL = serviceDashboardURL
+	}
+}
+
+func WithZtunnelDashboardURL(ztunnelDashboardURL string) IstioRulesConfigOption {
+	return func(istioRulesConfig *IstioRulesConfig) {
+		istioRulesConfig.ZtunnelDashboardURL = ztunnelDashboardURL
+	}
+}
+
+func WithService5xxRateThreshold(service5xxRateThreshold float64) IstioRulesConfigOption {
+	return func(istioRulesConfig *IstioRulesConfig) {
+		if service5xxRateThreshold <= 0 {
+			service5xxRateThreshold = defaultService5xxRateThreshold
+		}
+		istioRulesConfig.Service5xxRateThreshold = service5xxRateThreshold
+	}
+}
+
+func WithZtunnelConnectionFailureRatioThreshold(ztunnelConnectionFailureRatioThreshold float64) IstioRulesConfigOption {
+	return func(istioRulesConfig *IstioRulesConfig) {
+		if ztunnelConnectionFailureRatioThreshold <= 0 {
+			ztunnelConnectionFailureRatioThreshold = defaultZtunnelConnectionFailureRatioThreshold
+		}
+		istioRulesConfig.ZtunnelConnectionFailureRatioThreshold = ztunnelConnectionFailureRatioThreshold
+	}
+}
+
+func WithAdditionalAlertLabels(additionalAlertLabels map[string]string) IstioRulesConfigOption {
+	return func(istioRulesConfig *IstioRulesConfig) {
+		istioRulesConfig.AdditionalAlertLabels = additionalAlertLabels
+	}
+}
+
+func WithAdditionalAlertAnnotations(additionalAlertAnnotations map[string]string) IstioRulesConfigOption {
+	return func(istioRulesConfig *IstioRulesConfig) {
+		istioRulesConfig.AdditionalAlertAnnotations = additionalAlertAnnotations
+	}
+}
+
+const (
+	defaultService5xxRateThreshold                = 0.05
+	defaultZtunnelConnectionFailureRatioThreshold = 0.05
+)
+
+// NewIstioRulesBuilder creates a new Istio rules builder.
+// The workload recording rules record the rates the Istio dashboards query when built with
+// istio.WithRecordingRules(true) from pkg/dashboards/istio.
+func NewIstioRulesBuilder(
+	namespace string,
+	labels map[string]string,
+	annotations map[string]string,
+	options ...IstioRulesConfigOption,
+) (promtheusrule.Builder, error) {
+	istioRulesConfig := IstioRulesConfig{
+		Service5xxRateThreshold:                defaultService5xxRateThreshold,
+		ZtunnelConnectionFailureRatioThreshold: defaultZtunnelConnectionFailureRatioThreshold,
+	}
+	for _, option := range options {
+		option(&istioRulesConfig)
+	}
+
+	promRule, err := promtheusrule.New(
+		"istio-rules",
+		namespace,
+		promtheusrule.Labels(labels),
+		promtheusrule.Annotations(annotations),
+		promtheusrule.AddRuleGroup(
+			"istio-workload.rules",
+			istioRulesConfig.IstioWorkloadRecordingGroup()...,
+		),
+		promtheusrule.AddRuleGroup(
+			"istio",
+			istioRulesConfig.IstioGroup()...,
+		),
+	)
+
+	return promRule, err
+}
+
+// BuildIstioRules builds the Istio rules for the given namespace, dashboard URLs, runbook URL, labels, and annotations.
+func BuildIstioRules(
+	namespace string,
+	labels map[string]string,
+	annotations map[string]string,
+	options ...IstioRulesConfigOption,
+) rulehelpers.RuleResult {
+	promRule, err := NewIstioRulesBuilder(namespace, labels, annotations, options...)
+	if err != nil {
+		return rulehelpers.NewRuleResult(nil, err).Component("istio")
+	}
+
+	return rulehelpers.NewRuleResult(
+		&promRule.PrometheusRule,
+		nil,
+	).Component("istio")
+}
@@ -0,0 +1,40 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package istio
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/perses/community-mixins/pkg/querytest"
+)
+
+func TestIstioRules(t *testing.T) {
+	result := BuildIstioRules("default", nil, nil)
+	require.NoError(t, result.Err())
+
+	querytest.RuleSuite{
+		SeriesFile: "../../panels/istio/testdata/series.test",
+		Groups:     result.Rule().Spec.Groups,
+		Labels: map[string][]string{
+			"IstiodPushErrors":   {`{pod="istiod-6b8f9c7d4-m2n4p"}`},
+			"IstioXDSRejections": {`{type="type.googleapis.com/envoy.config.cluster.v3.Cluster"}`},
+			"IstioServiceHigh5xxRate": {
+				`{destination_service="httpbin.legacy.svc.cluster.local", destination_service_namespace="legacy"}`,
+			},
+			"IstioZtunnelConnectionFailures": {`{pod="ztunnel-x7k2p"}`},
+		},
+	}.Run(t)
+}
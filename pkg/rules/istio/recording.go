@@ -0,0 +1,50 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package istio
+
+import (
+	"time"
+
+	promqlbuilder "github.com/perses/promql-builder"
+	"github.com/perses/promql-builder/matrix"
+	"github.com/perses/promql-builder/vector"
+
+	istioPanels "github.com/perses/community-mixins/pkg/panels/istio"
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/recording"
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/rulegroup"
+)
+
+// IstioWorkloadRecordingGroup records the 5m rates of the Istio standard metrics queried by the Istio panels,
+// dropping the per instance and per pod labels.
+func (i IstioRulesConfig) IstioWorkloadRecordingGroup() []rulegroup.Option {
+	options := make([]rulegroup.Option, 0, len(istioPanels.RecordedMetrics))
+	for _, metricName := range istioPanels.RecordedMetrics {
+		options = append(options, rulegroup.AddRule(
+			istioPanels.RecordedMetricName(metricName),
+			recording.Expr(
+				promqlbuilder.Sum(
+					promqlbuilder.Rate(
+						matrix.New(
+							vector.New(
+								vector.WithMetricName(metricName),
+							),
+							matrix.WithRange(5*time.Minute),
+						),
+					),
+				).Without(istioPanels.AggregatedLabels...),
+			),
+		))
+	}
+	return options
+}
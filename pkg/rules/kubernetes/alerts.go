@@ -0,0 +1,1336 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"time"
+
+	promqlbuilder "github.com/perses/promql-builder"
+	"github.com/perses/promql-builder/label"
+	"github.com/perses/promql-builder/matrix"
+	"github.com/perses/promql-builder/vector"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/alerting"
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/common"
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/rulegroup"
+)
+
+// kubeStateMetrics returns a selector for the given kube-state-metrics series.
+func (k KubernetesRulesConfig) kubeStateMetrics(metricName string, matchers ...*labels.Matcher) *parser.VectorSelector {
+	return vector.New(
+		vector.WithMetricName(metricName),
+		vector.WithLabelMatchers(
+			append([]*labels.Matcher{label.New("job").Equal(k.KubeStateMetricsSelector)}, matchers...)...,
+		),
+	)
+}
+
+// kubelet returns a selector for the given kubelet series.
+func (k KubernetesRulesConfig) kubelet(metricName string, matchers ...*labels.Matcher) *parser.VectorSelector {
+	return vector.New(
+		vector.WithMetricName(metricName),
+		vector.WithLabelMatchers(
+			append([]*labels.Matcher{label.New("job").Equal(k.KubeletSelector)}, matchers...)...,
+		),
+	)
+}
+
+// unlessExcludedVolume drops the PersistentVolumeClaims which are read-only or which opted out
+// of alerting with the excluded_from_alerts label.
+func (k KubernetesRulesConfig) unlessExcludedVolume(expr parser.Expr) parser.Expr {
+	return promqlbuilder.Unless(
+		promqlbuilder.Unless(
+			expr,
+			promqlbuilder.Eqlc(
+				k.kubeStateMetrics(
+					"kube_persistentvolumeclaim_access_mode",
+					label.New("access_mode").Equal("ReadOnlyMany"),
+				),
+				promqlbuilder.NewNumber(1),
+			),
+		).On("cluster", "namespace", "persistentvolumeclaim"),
+		promqlbuilder.Eqlc(
+			k.kubeStateMetrics(
+				"kube_persistentvolumeclaim_labels",
+				label.New("label_excluded_from_alerts").Equal("true"),
+			),
+			promqlbuilder.NewNumber(1),
+		),
+	).On("cluster", "namespace", "persistentvolumeclaim")
+}
+
+func (k KubernetesRulesConfig) KubernetesAppsGroup() []rulegroup.Option {
+	return []rulegroup.Option{
+		rulegroup.AddRule(
+			"KubePodCrashLooping",
+			alerting.Expr(
+				promqlbuilder.Gte(
+					promqlbuilder.MaxOverTime(
+						matrix.New(
+							k.kubeStateMetrics(
+								"kube_pod_container_status_waiting_reason",
+								label.New("reason").Equal("CrashLoopBackOff"),
+							),
+							matrix.WithRange(5*time.Minute),
+						),
+					),
+					promqlbuilder.NewNumber(1),
+				),
+			),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.WorkloadDashboardURL,
+						k.RunbookURL,
+						runbookKubePodCrashLooping,
+						"Pod {{ $labels.namespace }}/{{ $labels.pod }} ({{ $labels.container }}) is in waiting state (reason: \"CrashLoopBackOff\") on cluster {{ $labels.cluster }}.",
+						"Pod is crash looping.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubePodNotReady",
+			alerting.Expr(
+				promqlbuilder.Gtr(
+					promqlbuilder.Sum(
+						promqlbuilder.Mul(
+							promqlbuilder.Max(
+								k.kubeStateMetrics(
+									"kube_pod_status_phase",
+									label.New("phase").EqualRegexp("Pending|Unknown"),
+								),
+							).By("namespace", "pod", "cluster"),
+							promqlbuilder.TopK(
+								promqlbuilder.Max(
+									k.kubeStateMetrics(
+										"kube_pod_owner",
+										label.New("owner_kind").NotEqual("Job"),
+									),
+								).By("namespace", "pod", "owner_kind", "cluster"),
+								1,
+							).By("namespace", "pod", "cluster"),
+						).On("namespace", "pod", "cluster").GroupLeft("owner_kind"),
+					).By("namespace", "pod", "cluster"),
+					promqlbuilder.NewNumber(0),
+				),
+			),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.WorkloadDashboardURL,
+						k.RunbookURL,
+						runbookKubePodNotReady,
+						"Pod {{ $labels.namespace }}/{{ $labels.pod }} has been in a non-ready state for longer than 15 minutes on cluster {{ $labels.cluster }}.",
+						"Pod has been in a non-ready state for more than 15 minutes.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubeDeploymentGenerationMismatch",
+			alerting.Expr(
+				promqlbuilder.Neq(
+					k.kubeStateMetrics("kube_deployment_status_observed_generation"),
+					k.kubeStateMetrics("kube_deployment_metadata_generation"),
+				),
+			),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.WorkloadDashboardURL,
+						k.RunbookURL,
+						runbookKubeDeploymentGenerationMismatch,
+						"Deployment generation for {{ $labels.namespace }}/{{ $labels.deployment }} does not match, this indicates that the Deployment has failed but has not been rolled back on cluster {{ $labels.cluster }}.",
+						"Deployment generation mismatch due to possible roll-back.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubeDeploymentReplicasMismatch",
+			alerting.Expr(
+				promqlbuilder.And(
+					promqlbuilder.Parenthesis(
+						promqlbuilder.Gtr(
+							k.kubeStateMetrics("kube_deployment_spec_replicas"),
+							k.kubeStateMetrics("kube_deployment_status_replicas_available"),
+						),
+					),
+					promqlbuilder.Parenthesis(
+						promqlbuilder.Eqlc(
+							promqlbuilder.Changes(
+								matrix.New(
+									k.kubeStateMetrics("kube_deployment_status_replicas_updated"),
+									matrix.WithRange(10*time.Minute),
+								),
+							),
+							promqlbuilder.NewNumber(0),
+						),
+					),
+				),
+			),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.WorkloadDashboardURL,
+						k.RunbookURL,
+						runbookKubeDeploymentReplicasMismatch,
+						"Deployment {{ $labels.namespace }}/{{ $labels.deployment }} has not matched the expected number of replicas for longer than 15 minutes on cluster {{ $labels.cluster }}.",
+						"Deployment has not matched the expected number of replicas.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubeDeploymentRolloutStuck",
+			alerting.Expr(
+				promqlbuilder.Neq(
+					k.kubeStateMetrics(
+						"kube_deployment_status_condition",
+						label.New("condition").Equal("Progressing"),
+						label.New("status").Equal("false"),
+					),
+					promqlbuilder.NewNumber(0),
+				),
+			),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.WorkloadDashboardURL,
+						k.RunbookURL,
+						runbookKubeDeploymentRolloutStuck,
+						"Rollout of deployment {{ $labels.namespace }}/{{ $labels.deployment }} is not progressing for longer than 15 minutes on cluster {{ $labels.cluster }}.",
+						"Deployment rollout is not progressing.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubeStatefulSetReplicasMismatch",
+			alerting.Expr(
+				promqlbuilder.And(
+					promqlbuilder.Parenthesis(
+						promqlbuilder.Neq(
+							k.kubeStateMetrics("kube_statefulset_status_replicas_ready"),
+							k.kubeStateMetrics("kube_statefulset_status_replicas"),
+						),
+					),
+					promqlbuilder.Parenthesis(
+						promqlbuilder.Eqlc(
+							promqlbuilder.Changes(
+								matrix.New(
+									k.kubeStateMetrics("kube_statefulset_status_replicas_updated"),
+									matrix.WithRange(10*time.Minute),
+								),
+							),
+							promqlbuilder.NewNumber(0),
+						),
+					),
+				),
+			),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.WorkloadDashboardURL,
+						k.RunbookURL,
+						runbookKubeStatefulSetReplicasMismatch,
+						"StatefulSet {{ $labels.namespace }}/{{ $labels.statefulset }} has not matched the expected number of replicas for longer than 15 minutes on cluster {{ $labels.cluster }}.",
+						"StatefulSet has not matched the expected number of replicas.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubeStatefulSetGenerationMismatch",
+			alerting.Expr(
+				promqlbuilder.Neq(
+					k.kubeStateMetrics("kube_statefulset_status_observed_generation"),
+					k.kubeStateMetrics("kube_statefulset_metadata_generation"),
+				),
+			),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.WorkloadDashboardURL,
+						k.RunbookURL,
+						runbookKubeStatefulSetGenerationMismatch,
+						"StatefulSet generation for {{ $labels.namespace }}/{{ $labels.statefulset }} does not match, this indicates that the StatefulSet has failed but has not been rolled back on cluster {{ $labels.cluster }}.",
+						"StatefulSet generation mismatch due to possible roll-back.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubeStatefulSetUpdateNotRolledOut",
+			alerting.Expr(
+				promqlbuilder.And(
+					promqlbuilder.Mul(
+						promqlbuilder.Parenthesis(
+							promqlbuilder.Unless(
+								promqlbuilder.Max(
+									k.kubeStateMetrics("kube_statefulset_status_current_revision"),
+								).By("namespace", "statefulset", "job", "cluster"),
+								k.kubeStateMetrics("kube_statefulset_status_update_revision"),
+							),
+						),
+						promqlbuilder.Parenthesis(
+							promqlbuilder.Neq(
+								k.kubeStateMetrics("kube_statefulset_replicas"),
+								k.kubeStateMetrics("kube_statefulset_status_replicas_updated"),
+							),
+						),
+					).On("namespace", "statefulset", "job", "cluster"),
+					promqlbuilder.Parenthesis(
+						promqlbuilder.Eqlc(
+							promqlbuilder.Changes(
+								matrix.New(
+									k.kubeStateMetrics("kube_statefulset_status_replicas_updated"),
+									matrix.WithRange(5*time.Minute),
+								),
+							),
+							promqlbuilder.NewNumber(0),
+						),
+					),
+				).On("namespace", "statefulset", "job", "cluster"),
+			),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.WorkloadDashboardURL,
+						k.RunbookURL,
+						runbookKubeStatefulSetUpdateNotRolledOut,
+						"StatefulSet {{ $labels.namespace }}/{{ $labels.statefulset }} update has not been rolled out on cluster {{ $labels.cluster }}.",
+						"StatefulSet update has not been rolled out.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubeDaemonSetRolloutStuck",
+			alerting.Expr(
+				promqlbuilder.And(
+					promqlbuilder.Parenthesis(
+						promqlbuilder.Or(
+							promqlbuilder.Or(
+								promqlbuilder.Or(
+									promqlbuilder.Parenthesis(
+										promqlbuilder.Neq(
+											k.kubeStateMetrics("kube_daemonset_status_current_number_scheduled"),
+											k.kubeStateMetrics("kube_daemonset_status_desired_number_scheduled"),
+										),
+									),
+									promqlbuilder.Parenthesis(
+										promqlbuilder.Neq(
+											k.kubeStateMetrics("kube_daemonset_status_number_misscheduled"),
+											promqlbuilder.NewNumber(0),
+										),
+									),
+								),
+								promqlbuilder.Parenthesis(
+									promqlbuilder.Neq(
+										k.kubeStateMetrics("kube_daemonset_status_updated_number_scheduled"),
+										k.kubeStateMetrics("kube_daemonset_status_desired_number_scheduled"),
+									),
+								),
+							),
+							promqlbuilder.Parenthesis(
+								promqlbuilder.Neq(
+									k.kubeStateMetrics("kube_daemonset_status_number_available"),
+									k.kubeStateMetrics("kube_daemonset_status_desired_number_scheduled"),
+								),
+							),
+						),
+					),
+					promqlbuilder.Parenthesis(
+						promqlbuilder.Eqlc(
+							promqlbuilder.Changes(
+								matrix.New(
+									k.kubeStateMetrics("kube_daemonset_status_updated_number_scheduled"),
+									matrix.WithRange(5*time.Minute),
+								),
+							),
+							promqlbuilder.NewNumber(0),
+						),
+					),
+				),
+			),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.WorkloadDashboardURL,
+						k.RunbookURL,
+						runbookKubeDaemonSetRolloutStuck,
+						"DaemonSet {{ $labels.namespace }}/{{ $labels.daemonset }} has not finished or progressed for at least 15m on cluster {{ $labels.cluster }}.",
+						"DaemonSet rollout is stuck.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubeContainerWaiting",
+			alerting.Expr(
+				promqlbuilder.Gtr(
+					k.kubeStateMetrics(
+						"kube_pod_container_status_waiting_reason",
+						label.New("reason").NotEqual("CrashLoopBackOff"),
+					),
+					promqlbuilder.NewNumber(0),
+				),
+			),
+			alerting.For("1h"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.WorkloadDashboardURL,
+						k.RunbookURL,
+						runbookKubeContainerWaiting,
+						"Pod/{{ $labels.pod }} in namespace {{ $labels.namespace }} on container {{ $labels.container }} has been in waiting state for longer than 1 hour (reason: \"{{ $labels.reason }}\") on cluster {{ $labels.cluster }}.",
+						"Pod container waiting longer than 1 hour.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubeDaemonSetNotScheduled",
+			alerting.Expr(
+				promqlbuilder.Gtr(
+					promqlbuilder.Sub(
+						k.kubeStateMetrics("kube_daemonset_status_desired_number_scheduled"),
+						k.kubeStateMetrics("kube_daemonset_status_current_number_scheduled"),
+					),
+					promqlbuilder.NewNumber(0),
+				),
+			),
+			alerting.For("10m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.WorkloadDashboardURL,
+						k.RunbookURL,
+						runbookKubeDaemonSetNotScheduled,
+						"{{ $value }} Pods of DaemonSet {{ $labels.namespace }}/{{ $labels.daemonset }} are not scheduled on cluster {{ $labels.cluster }}.",
+						"DaemonSet pods are not scheduled.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubeDaemonSetMisScheduled",
+			alerting.Expr(
+				promqlbuilder.Gtr(
+					k.kubeStateMetrics("kube_daemonset_status_number_misscheduled"),
+					promqlbuilder.NewNumber(0),
+				),
+			),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.WorkloadDashboardURL,
+						k.RunbookURL,
+						runbookKubeDaemonSetMisScheduled,
+						"{{ $value }} Pods of DaemonSet {{ $labels.namespace }}/{{ $labels.daemonset }} are running where they are not supposed to run on cluster {{ $labels.cluster }}.",
+						"DaemonSet pods are misscheduled.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubeJobNotCompleted",
+			alerting.Expr(
+				promqlbuilder.Gtr(
+					promqlbuilder.Sub(
+						promqlbuilder.Time(),
+						promqlbuilder.Max(
+							promqlbuilder.And(
+								k.kubeStateMetrics("kube_job_status_start_time"),
+								promqlbuilder.Gtr(
+									k.kubeStateMetrics("kube_job_status_active"),
+									promqlbuilder.NewNumber(0),
+								),
+							),
+						).By("namespace", "job_name", "cluster"),
+					),
+					promqlbuilder.NewNumber(43200),
+				),
+			),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.WorkloadDashboardURL,
+						k.RunbookURL,
+						runbookKubeJobNotCompleted,
+						"Job {{ $labels.namespace }}/{{ $labels.job_name }} is taking more than {{ \"43200\" | humanizeDuration }} to complete on cluster {{ $labels.cluster }}.",
+						"Job did not complete in time.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubeJobFailed",
+			alerting.Expr(
+				promqlbuilder.Gtr(
+					k.kubeStateMetrics("kube_job_failed"),
+					promqlbuilder.NewNumber(0),
+				),
+			),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.WorkloadDashboardURL,
+						k.RunbookURL,
+						runbookKubeJobFailed,
+						"Job {{ $labels.namespace }}/{{ $labels.job_name }} failed to complete. Removing failed job after investigation should clear this alert on cluster {{ $labels.cluster }}.",
+						"Job failed to complete.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubeHpaReplicasMismatch",
+			alerting.Expr(
+				promqlbuilder.And(
+					promqlbuilder.And(
+						promqlbuilder.And(
+							promqlbuilder.Parenthesis(
+								promqlbuilder.Neq(
+									k.kubeStateMetrics("kube_horizontalpodautoscaler_status_desired_replicas"),
+									k.kubeStateMetrics("kube_horizontalpodautoscaler_status_current_replicas"),
+								),
+							),
+							promqlbuilder.Parenthesis(
+								promqlbuilder.Gtr(
+									k.kubeStateMetrics("kube_horizontalpodautoscaler_status_current_replicas"),
+									k.kubeStateMetrics("kube_horizontalpodautoscaler_spec_min_replicas"),
+								),
+							),
+						),
+						promqlbuilder.Parenthesis(
+							promqlbuilder.Lss(
+								k.kubeStateMetrics("kube_horizontalpodautoscaler_status_current_replicas"),
+								k.kubeStateMetrics("kube_horizontalpodautoscaler_spec_max_replicas"),
+							),
+						),
+					),
+					promqlbuilder.Eqlc(
+						promqlbuilder.Changes(
+							matrix.New(
+								k.kubeStateMetrics("kube_horizontalpodautoscaler_status_current_replicas"),
+								matrix.WithRange(15*time.Minute),
+							),
+						),
+						promqlbuilder.NewNumber(0),
+					),
+				),
+			),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.WorkloadDashboardURL,
+						k.RunbookURL,
+						runbookKubeHpaReplicasMismatch,
+						"HPA {{ $labels.namespace }}/{{ $labels.horizontalpodautoscaler }} has not matched the desired number of replicas for longer than 15 minutes on cluster {{ $labels.cluster }}.",
+						"HPA has not matched desired number of replicas.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubeHpaMaxedOut",
+			alerting.Expr(
+				promqlbuilder.Eqlc(
+					k.kubeStateMetrics("kube_horizontalpodautoscaler_status_current_replicas"),
+					k.kubeStateMetrics("kube_horizontalpodautoscaler_spec_max_replicas"),
+				),
+			),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.WorkloadDashboardURL,
+						k.RunbookURL,
+						runbookKubeHpaMaxedOut,
+						"HPA {{ $labels.namespace }}/{{ $labels.horizontalpodautoscaler }} has been running at max replicas for longer than 15 minutes on cluster {{ $labels.cluster }}.",
+						"HPA is running at max replicas.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+	}
+}
+
+// spareAllocatable returns the per-cluster allocatable resource left once the largest node is lost.
+func (k KubernetesRulesConfig) spareAllocatable(resource string) parser.Expr {
+	return promqlbuilder.Parenthesis(
+		promqlbuilder.Sub(
+			promqlbuilder.Sum(
+				k.kubeStateMetrics(
+					"kube_node_status_allocatable",
+					label.New("resource").Equal(resource),
+				),
+			).By("cluster"),
+			promqlbuilder.Max(
+				k.kubeStateMetrics(
+					"kube_node_status_allocatable",
+					label.New("resource").Equal(resource),
+				),
+			).By("cluster"),
+		),
+	)
+}
+
+// resourceQuotaRatio returns the used to hard ratio of every resource quota.
+func (k KubernetesRulesConfig) resourceQuotaRatio() parser.Expr {
+	return promqlbuilder.Div(
+		k.kubeStateMetrics(
+			"kube_resourcequota",
+			label.New("type").Equal("used"),
+		),
+		promqlbuilder.Parenthesis(
+			promqlbuilder.Gtr(
+				k.kubeStateMetrics(
+					"kube_resourcequota",
+					label.New("type").Equal("hard"),
+				),
+				promqlbuilder.NewNumber(0),
+			),
+		),
+	).Ignoring("instance", "job", "type")
+}
+
+func (k KubernetesRulesConfig) KubernetesResourcesGroup() []rulegroup.Option {
+	return []rulegroup.Option{
+		rulegroup.AddRule(
+			"KubeCPUOvercommit",
+			alerting.Expr(
+				promqlbuilder.And(
+					promqlbuilder.Gtr(
+						promqlbuilder.Sub(
+							promqlbuilder.Sum(
+								vector.New(
+									vector.WithMetricName("namespace_cpu:kube_pod_container_resource_requests:sum"),
+								),
+							).By("cluster"),
+							k.spareAllocatable("cpu"),
+						),
+						promqlbuilder.NewNumber(0),
+					),
+					promqlbuilder.Gtr(
+						k.spareAllocatable("cpu"),
+						promqlbuilder.NewNumber(0),
+					),
+				),
+			),
+			alerting.For("10m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.ClusterDashboardURL,
+						k.RunbookURL,
+						runbookKubeCPUOvercommit,
+						"Cluster {{ $labels.cluster }} has overcommitted CPU resource requests for Pods by {{ $value }} CPU shares and cannot tolerate node failure.",
+						"Cluster has overcommitted CPU resource requests.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubeMemoryOvercommit",
+			alerting.Expr(
+				promqlbuilder.And(
+					promqlbuilder.Gtr(
+						promqlbuilder.Sub(
+							promqlbuilder.Sum(
+								vector.New(
+									vector.WithMetricName("namespace_memory:kube_pod_container_resource_requests:sum"),
+								),
+							).By("cluster"),
+							k.spareAllocatable("memory"),
+						),
+						promqlbuilder.NewNumber(0),
+					),
+					promqlbuilder.Gtr(
+						k.spareAllocatable("memory"),
+						promqlbuilder.NewNumber(0),
+					),
+				),
+			),
+			alerting.For("10m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.ClusterDashboardURL,
+						k.RunbookURL,
+						runbookKubeMemoryOvercommit,
+						"Cluster {{ $labels.cluster }} has overcommitted memory resource requests for Pods by {{ $value | humanize }} bytes and cannot tolerate node failure.",
+						"Cluster has overcommitted memory resource requests.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubeCPUQuotaOvercommit",
+			alerting.Expr(
+				promqlbuilder.Gtr(
+					promqlbuilder.Div(
+						promqlbuilder.Sum(
+							promqlbuilder.Min(
+								k.kubeStateMetrics(
+									"kube_resourcequota",
+									label.New("type").Equal("hard"),
+									label.New("resource").EqualRegexp("(cpu|requests.cpu)"),
+								),
+							).Without("resource"),
+						).By("cluster"),
+						promqlbuilder.Sum(
+							k.kubeStateMetrics(
+								"kube_node_status_allocatable",
+								label.New("resource").Equal("cpu"),
+							),
+						).By("cluster"),
+					),
+					promqlbuilder.NewNumber(1.5),
+				),
+			),
+			alerting.For("5m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.ClusterDashboardURL,
+						k.RunbookURL,
+						runbookKubeCPUQuotaOvercommit,
+						"Cluster {{ $labels.cluster }} has overcommitted CPU resource requests for Namespaces.",
+						"Cluster has overcommitted CPU resource requests.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubeMemoryQuotaOvercommit",
+			alerting.Expr(
+				promqlbuilder.Gtr(
+					promqlbuilder.Div(
+						promqlbuilder.Sum(
+							promqlbuilder.Min(
+								k.kubeStateMetrics(
+									"kube_resourcequota",
+									label.New("type").Equal("hard"),
+									label.New("resource").EqualRegexp("(memory|requests.memory)"),
+								),
+							).Without("resource"),
+						).By("cluster"),
+						promqlbuilder.Sum(
+							k.kubeStateMetrics(
+								"kube_node_status_allocatable",
+								label.New("resource").Equal("memory"),
+							),
+						).By("cluster"),
+					),
+					promqlbuilder.NewNumber(1.5),
+				),
+			),
+			alerting.For("5m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.ClusterDashboardURL,
+						k.RunbookURL,
+						runbookKubeMemoryQuotaOvercommit,
+						"Cluster {{ $labels.cluster }} has overcommitted memory resource requests for Namespaces.",
+						"Cluster has overcommitted memory resource requests.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubeQuotaAlmostFull",
+			alerting.Expr(
+				promqlbuilder.Lss(
+					promqlbuilder.Gtr(
+						k.resourceQuotaRatio(),
+						promqlbuilder.NewNumber(0.9),
+					),
+					promqlbuilder.NewNumber(1),
+				),
+			),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "info",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.ClusterDashboardURL,
+						k.RunbookURL,
+						runbookKubeQuotaAlmostFull,
+						"Namespace {{ $labels.namespace }} is using {{ $value | humanizePercentage }} of its {{ $labels.resource }} quota on cluster {{ $labels.cluster }}.",
+						"Namespace quota is going to be full.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubeQuotaFullyUsed",
+			alerting.Expr(
+				promqlbuilder.Eqlc(
+					k.resourceQuotaRatio(),
+					promqlbuilder.NewNumber(1),
+				),
+			),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "info",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.ClusterDashboardURL,
+						k.RunbookURL,
+						runbookKubeQuotaFullyUsed,
+						"Namespace {{ $labels.namespace }} is using {{ $value | humanizePercentage }} of its {{ $labels.resource }} quota on cluster {{ $labels.cluster }}.",
+						"Namespace quota is fully used.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubeQuotaExceeded",
+			alerting.Expr(
+				promqlbuilder.Gtr(
+					k.resourceQuotaRatio(),
+					promqlbuilder.NewNumber(1),
+				),
+			),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.ClusterDashboardURL,
+						k.RunbookURL,
+						runbookKubeQuotaExceeded,
+						"Namespace {{ $labels.namespace }} is using {{ $value | humanizePercentage }} of its {{ $labels.resource }} quota on cluster {{ $labels.cluster }}.",
+						"Namespace quota has exceeded the limits.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"CPUThrottlingHigh",
+			alerting.Expr(
+				promqlbuilder.Gtr(
+					promqlbuilder.Div(
+						promqlbuilder.Sum(
+							promqlbuilder.Increase(
+								matrix.New(
+									vector.New(
+										vector.WithMetricName("container_cpu_cfs_throttled_periods_total"),
+										vector.WithLabelMatchers(
+											label.New("job").Equal(k.CAdvisorSelector),
+											label.New("container").NotEqual(""),
+										),
+									),
+									matrix.WithRange(5*time.Minute),
+								),
+							),
+						).Without("id", "metrics_path", "name", "image", "endpoint", "job", "node"),
+						promqlbuilder.Sum(
+							promqlbuilder.Increase(
+								matrix.New(
+									vector.New(
+										vector.WithMetricName("container_cpu_cfs_periods_total"),
+										vector.WithLabelMatchers(
+											label.New("job").Equal(k.CAdvisorSelector),
+										),
+									),
+									matrix.WithRange(5*time.Minute),
+								),
+							),
+						).Without("id", "metrics_path", "name", "image", "endpoint", "job", "node"),
+					).On("cluster", "namespace", "pod", "container", "instance").GroupLeft(),
+					promqlbuilder.NewNumber(0.25),
+				),
+			),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "info",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.WorkloadDashboardURL,
+						k.RunbookURL,
+						runbookCPUThrottlingHigh,
+						"{{ $value | humanizePercentage }} throttling of CPU in namespace {{ $labels.namespace }} for container {{ $labels.container }} in pod {{ $labels.pod }} on cluster {{ $labels.cluster }}.",
+						"Processes experience elevated CPU throttling.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+	}
+}
+
+func (k KubernetesRulesConfig) KubernetesStorageGroup() []rulegroup.Option {
+	return []rulegroup.Option{
+		rulegroup.AddRule(
+			"KubePersistentVolumeFillingUp",
+			alerting.Expr(
+				k.unlessExcludedVolume(
+					promqlbuilder.And(
+						promqlbuilder.Lss(
+							promqlbuilder.Parenthesis(
+								promqlbuilder.Div(
+									k.kubelet("kubelet_volume_stats_available_bytes"),
+									k.kubelet("kubelet_volume_stats_capacity_bytes"),
+								),
+							),
+							promqlbuilder.NewNumber(0.03),
+						),
+						promqlbuilder.Gtr(
+							k.kubelet("kubelet_volume_stats_used_bytes"),
+							promqlbuilder.NewNumber(0),
+						),
+					),
+				),
+			),
+			alerting.For("1m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "critical",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.PersistentVolumeDashboardURL,
+						k.RunbookURL,
+						runbookKubePersistentVolumeFillingUp,
+						"The PersistentVolume claimed by {{ $labels.persistentvolumeclaim }} in Namespace {{ $labels.namespace }} on cluster {{ $labels.cluster }} is only {{ $value | humanizePercentage }} free.",
+						"PersistentVolume is filling up.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubePersistentVolumeFillingUp",
+			alerting.Expr(
+				k.unlessExcludedVolume(
+					promqlbuilder.And(
+						promqlbuilder.And(
+							promqlbuilder.Lss(
+								promqlbuilder.Parenthesis(
+									promqlbuilder.Div(
+										k.kubelet("kubelet_volume_stats_available_bytes"),
+										k.kubelet("kubelet_volume_stats_capacity_bytes"),
+									),
+								),
+								promqlbuilder.NewNumber(0.15),
+							),
+							promqlbuilder.Gtr(
+								k.kubelet("kubelet_volume_stats_used_bytes"),
+								promqlbuilder.NewNumber(0),
+							),
+						),
+						promqlbuilder.Lss(
+							promqlbuilder.PredictLinear(
+								matrix.New(
+									k.kubelet("kubelet_volume_stats_available_bytes"),
+									matrix.WithRange(6*time.Hour),
+								),
+								4*24*3600,
+							),
+							promqlbuilder.NewNumber(0),
+						),
+					),
+				),
+			),
+			alerting.For("1h"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.PersistentVolumeDashboardURL,
+						k.RunbookURL,
+						runbookKubePersistentVolumeFillingUp,
+						"Based on recent sampling, the PersistentVolume claimed by {{ $labels.persistentvolumeclaim }} in Namespace {{ $labels.namespace }} on cluster {{ $labels.cluster }} is expected to fill up within four days. Currently {{ $value | humanizePercentage }} is available.",
+						"PersistentVolume is filling up.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubePersistentVolumeInodesFillingUp",
+			alerting.Expr(
+				k.unlessExcludedVolume(
+					promqlbuilder.And(
+						promqlbuilder.Lss(
+							promqlbuilder.Parenthesis(
+								promqlbuilder.Div(
+									k.kubelet("kubelet_volume_stats_inodes_free"),
+									k.kubelet("kubelet_volume_stats_inodes"),
+								),
+							),
+							promqlbuilder.NewNumber(0.03),
+						),
+						promqlbuilder.Gtr(
+							k.kubelet("kubelet_volume_stats_inodes_used"),
+							promqlbuilder.NewNumber(0),
+						),
+					),
+				),
+			),
+			alerting.For("1m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "critical",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.PersistentVolumeDashboardURL,
+						k.RunbookURL,
+						runbookKubePersistentVolumeInodesFillingUp,
+						"The PersistentVolume claimed by {{ $labels.persistentvolumeclaim }} in Namespace {{ $labels.namespace }} on cluster {{ $labels.cluster }} only has {{ $value | humanizePercentage }} free inodes.",
+						"PersistentVolumeInodes are filling up.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubePersistentVolumeInodesFillingUp",
+			alerting.Expr(
+				k.unlessExcludedVolume(
+					promqlbuilder.And(
+						promqlbuilder.And(
+							promqlbuilder.Lss(
+								promqlbuilder.Parenthesis(
+									promqlbuilder.Div(
+										k.kubelet("kubelet_volume_stats_inodes_free"),
+										k.kubelet("kubelet_volume_stats_inodes"),
+									),
+								),
+								promqlbuilder.NewNumber(0.15),
+							),
+							promqlbuilder.Gtr(
+								k.kubelet("kubelet_volume_stats_inodes_used"),
+								promqlbuilder.NewNumber(0),
+							),
+						),
+						promqlbuilder.Lss(
+							promqlbuilder.PredictLinear(
+								matrix.New(
+									k.kubelet("kubelet_volume_stats_inodes_free"),
+									matrix.WithRange(6*time.Hour),
+								),
+								4*24*3600,
+							),
+							promqlbuilder.NewNumber(0),
+						),
+					),
+				),
+			),
+			alerting.For("1h"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.PersistentVolumeDashboardURL,
+						k.RunbookURL,
+						runbookKubePersistentVolumeInodesFillingUp,
+						"Based on recent sampling, the PersistentVolume claimed by {{ $labels.persistentvolumeclaim }} in Namespace {{ $labels.namespace }} on cluster {{ $labels.cluster }} is expected to run out of inodes within four days. Currently {{ $value | humanizePercentage }} of its inodes are free.",
+						"PersistentVolumeInodes are filling up.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubePersistentVolumeErrors",
+			alerting.Expr(
+				promqlbuilder.Gtr(
+					k.kubeStateMetrics(
+						"kube_persistentvolume_status_phase",
+						label.New("phase").EqualRegexp("Failed|Pending"),
+					),
+					promqlbuilder.NewNumber(0),
+				),
+			),
+			alerting.For("5m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "critical",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.PersistentVolumeDashboardURL,
+						k.RunbookURL,
+						runbookKubePersistentVolumeErrors,
+						"The persistent volume {{ $labels.persistentvolume }} on cluster {{ $labels.cluster }} has status {{ $labels.phase }}.",
+						"PersistentVolume is having issues with provisioning.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+	}
+}
@@ -0,0 +1,381 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	k8sPanels "github.com/perses/community-mixins/pkg/panels/kubernetes"
+	rulehelpers "github.com/perses/community-mixins/pkg/rules"
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/promtheusrule"
+)
+
+// Runbook fragments
+const (
+	runbookKubePodCrashLooping                   = "/kubepodcrashlooping"
+	runbookKubePodNotReady                       = "/kubepodnotready"
+	runbookKubeDeploymentGenerationMismatch      = "/kubedeploymentgenerationmismatch"
+	runbookKubeDeploymentReplicasMismatch        = "/kubedeploymentreplicasmismatch"
+	runbookKubeDeploymentRolloutStuck            = "/kubedeploymentrolloutstuck"
+	runbookKubeStatefulSetReplicasMismatch       = "/kubestatefulsetreplicasmismatch"
+	runbookKubeStatefulSetGenerationMismatch     = "/kubestatefulsetgenerationmismatch"
+	runbookKubeStatefulSetUpdateNotRolledOut     = "/kubestatefulsetupdatenotrolledout"
+	runbookKubeDaemonSetRolloutStuck             = "/kubedaemonsetrolloutstuck"
+	runbookKubeContainerWaiting                  = "/kubecontainerwaiting"
+	runbookKubeDaemonSetNotScheduled             = "/kubedaemonsetnotscheduled"
+	runbookKubeDaemonSetMisScheduled             = "/kubedaemonsetmisscheduled"
+	runbookKubeJobNotCompleted                   = "/kubejobnotcompleted"
+	runbookKubeJobFailed                         = "/kubejobfailed"
+	runbookKubeHpaReplicasMismatch               = "/kubehpareplicasmismatch"
+	runbookKubeHpaMaxedOut                       = "/kubehpamaxedout"
+	runbookKubeCPUOvercommit                     = "/kubecpuovercommit"
+	runbookKubeMemoryOvercommit                  = "/kubememoryovercommit"
+	runbookKubeCPUQuotaOvercommit                = "/kubecpuquotaovercommit"
+	runbookKubeMemoryQuotaOvercommit             = "/kubememoryquotaovercommit"
+	runbookKubeQuotaAlmostFull                   = "/kubequotaalmostfull"
+	runbookKubeQuotaFullyUsed                    = "/kubequotafullyused"
+	runbookKubeQuotaExceeded                     = "/kubequotaexceeded"
+	runbookCPUThrottlingHigh                     = "/cputhrottlinghigh"
+	runbookKubePersistentVolumeFillingUp         = "/kubepersistentvolumefillingup"
+	runbookKubePersistentVolumeInodesFillingUp   = "/kubepersistentvolumeinodesfillingup"
+	runbookKubePersistentVolumeErrors            = "/kubepersistentvolumeerrors"
+	runbookKubeVersionMismatch                   = "/kubeversionmismatch"
+	runbookKubeClientErrors                      = "/kubeclienterrors"
+	runbookKubeAPIErrorBudgetBurn                = "/kubeapierrorbudgetburn"
+	runbookKubeClientCertificateExpiration       = "/kubeclientcertificateexpiration"
+	runbookKubeAggregatedAPIErrors               = "/kubeaggregatedapierrors"
+	runbookKubeAggregatedAPIDown                 = "/kubeaggregatedapidown"
+	runbookKubeAPIDown                           = "/kubeapidown"
+	runbookKubeAPITerminatedRequests             = "/kubeapiterminatedrequests"
+	runbookKubeNodeNotReady                      = "/kubenodenotready"
+	runbookKubeNodeUnreachable                   = "/kubenodeunreachable"
+	runbookKubeletTooManyPods                    = "/kubelettoomanypods"
+	runbookKubeNodeReadinessFlapping             = "/kubenodereadinessflapping"
+	runbookKubeletPlegDurationHigh               = "/kubeletplegdurationhigh"
+	runbookKubeletPodStartUpLatencyHigh          = "/kubeletpodstartuplatencyhigh"
+	runbookKubeletClientCertificateExpiration    = "/kubeletclientcertificateexpiration"
+	runbookKubeletServerCertificateExpiration    = "/kubeletservercertificateexpiration"
+	runbookKubeletClientCertificateRenewalErrors = "/kubeletclientcertificaterenewalerrors"
+	runbookKubeletServerCertificateRenewalErrors = "/kubeletservercertificaterenewalerrors"
+	runbookKubeletDown                           = "/kubeletdown"
+	runbookKubeSchedulerDown                     = "/kubeschedulerdown"
+	runbookKubeControllerManagerDown             = "/kubecontrollermanagerdown"
+	runbookKubeProxyDown                         = "/kubeproxydown"
+)
+
+type KubernetesRulesConfig struct {
+	RunbookURL                    string
+	APIServerDashboardURL         string
+	KubeletDashboardURL           string
+	ControllerManagerDashboardURL string
+	SchedulerDashboardURL         string
+	ProxyDashboardURL             string
+	ClusterDashboardURL           string
+	WorkloadDashboardURL          string
+	PersistentVolumeDashboardURL  string
+
+	KubeStateMetricsSelector  string
+	CAdvisorSelector          string
+	NodeExporterSelector      string
+	APIServerSelector         string
+	KubeletSelector           string
+	ControllerManagerSelector string
+	SchedulerSelector         string
+	KubeProxySelector         string
+
+	AdditionalAlertLabels      map[string]string
+	AdditionalAlertAnnotations map[string]string
+}
+
+type KubernetesRulesConfigOption func(*KubernetesRulesConfig)
+
+func WithKubeStateMetricsSelector(kubeStateMetricsSelector string) KubernetesRulesConfigOption {
+	return func(config *KubernetesRulesConfig) {
+		if kubeStateMetricsSelector == "" {
+			kubeStateMetricsSelector = k8sPanels.DefaultJobLabelValues().KubeStateMetrics
+		}
+		config.KubeStateMetricsSelector = kubeStateMetricsSelector
+	}
+}
+
+func WithCAdvisorSelector(cAdvisorSelector string) KubernetesRulesConfigOption {
+	return func(config *KubernetesRulesConfig) {
+		if cAdvisorSelector == "" {
+			cAdvisorSelector = k8sPanels.DefaultJobLabelValues().CAdvisor
+		}
+		config.CAdvisorSelector = cAdvisorSelector
+	}
+}
+
+func WithNodeExporterSelector(nodeExporterSelector string) KubernetesRulesConfigOption {
+	return func(config *KubernetesRulesConfig) {
+		if nodeExporterSelector == "" {
+			nodeExporterSelector = k8sPanels.DefaultJobLabelValues().NodeExporter
+		}
+		config.NodeExporterSelector = nodeExporterSelector
+	}
+}
+
+func WithAPIServerSelector(apiServerSelector string) KubernetesRulesConfigOption {
+	return func(config *KubernetesRulesConfig) {
+		if apiServerSelector == "" {
+			apiServerSelector = k8sPanels.DefaultJobLabelValues().APIServer
+		}
+		config.APIServerSelector = apiServerSelector
+	}
+}
+
+func WithKubeletSelector(kubeletSelector string) KubernetesRulesConfigOption {
+	return func(config *KubernetesRulesConfig) {
+		if kubeletSelector == "" {
+			kubeletSelector = k8sPanels.DefaultJobLabelValues().Kubelet
+		}
+		config.KubeletSelector = kubeletSelector
+	}
+}
+
+func WithControllerManagerSelector(controllerManagerSelector string) KubernetesRulesConfigOption {
+	return func(config *KubernetesRulesConfig) {
+		if controllerManagerSelector == "" {
+			controllerManagerSelector = k8sPanels.DefaultJobLabelValues().ControllerManager
+		}
+		config.ControllerManagerSelector = controllerManagerSelector
+	}
+}
+
+func WithSchedulerSelector(schedulerSelector string) KubernetesRulesConfigOption {
+	return func(config *KubernetesRulesConfig) {
+		if schedulerSelector == "" {
+			schedulerSelector = k8sPanels.DefaultJobLabelValues().Scheduler
+		}
+		config.SchedulerSelector = schedulerSelector
+	}
+}
+
+func WithKubeProxySelector(kubeProxySelector string) KubernetesRulesConfigOption {
+	return func(config *KubernetesRulesConfig) {
+		if kubeProxySelector == "" {
+			kubeProxySelector = k8sPanels.DefaultJobLabelValues().KubeProxy
+		}
+		config.KubeProxySelector = kubeProxySelector
+	}
+}
+
+func WithRunbookURL(runbookURL string) KubernetesRulesConfigOption {
+	return func(config *KubernetesRulesConfig) {
+		config.RunbookURL = runbookURL
+	}
+}
+
+func WithAPIServerDashboardURL(apiServerDashboardURL string) KubernetesRulesConfigOption {
+	return func(config *KubernetesRulesConfig) {
+		config.APIServerDashboardURL = apiServerDashboardURL
+	}
+}
+
+func WithKubeletDashboardURL(kubeletDashboardURL string) KubernetesRulesConfigOption {
+	return func(config *KubernetesRulesConfig) {
+		config.KubeletDashboardURL = kubeletDashboardURL
+	}
+}
+
+func WithControllerManagerDashboardURL(controllerManagerDashboardURL string) KubernetesRulesConfigOption {
+	return func(config *KubernetesRulesConfig) {
+		config.ControllerManagerDashboardURL = controllerManagerDashboardURL
+	}
+}
+
+func WithSchedulerDashboardURL(schedulerDashboardURL string) KubernetesRulesConfigOption {
+	return func(config *KubernetesRulesConfig) {
+		config.SchedulerDashboardURL = schedulerDashboardURL
+	}
+}
+
+func WithProxyDashboardURL(proxyDashboardURL string) KubernetesRulesConfigOption {
+	return func(config *KubernetesRulesConfig) {
+		config.ProxyDashboardURL = proxyDashboardURL
+	}
+}
+
+func WithClusterDashboardURL(clusterDashboardURL string) KubernetesRulesConfigOption {
+	return func(config *KubernetesRulesConfig) {
+		config.ClusterDashboardURL = clusterDashboardURL
+	}
+}
+
+func WithWorkloadDashboardURL(workloadDashboardURL string) KubernetesRulesConfigOption {
+	return func(config *KubernetesRulesConfig) {
+		config.WorkloadDashboardURL = workloadDashboardURL
+	}
+}
+
+func WithPersistentVolumeDashboardURL(persistentVolumeDashboardURL string) KubernetesRulesConfigOption {
+	return func(config *KubernetesRulesConfig) {
+		config.PersistentVolumeDashboardURL = persistentVolumeDashboardURL
+	}
+}
+
+func WithAdditionalAlertLabels(additionalAlertLabels map[string]string) KubernetesRulesConfigOption {
+	return func(config *KubernetesRulesConfig) {
+		config.AdditionalAlertLabels = additionalAlertLabels
+	}
+}
+
+func WithAdditionalAlertAnnotations(additionalAlertAnnotations map[string]string) KubernetesRulesConfigOption {
+	return func(config *KubernetesRulesConfig) {
+		config.AdditionalAlertAnnotations = additionalAlertAnnotations
+	}
+}
+
+// WithJobLabelValues sets every job selector from the values used by the Kubernetes dashboards,
+// so that the generated rules and the dashboards look at the same targets.
+// Empty values fall back to their defaults.
+func WithJobLabelValues(jobLabelValues k8sPanels.JobLabelValues) KubernetesRulesConfigOption {
+	return func(config *KubernetesRulesConfig) {
+		jobLabelValues = jobLabelValues.WithDefaults()
+		config.KubeStateMetricsSelector = jobLabelValues.KubeStateMetrics
+		config.CAdvisorSelector = jobLabelValues.CAdvisor
+		config.NodeExporterSelector = jobLabelValues.NodeExporter
+		config.APIServerSelector = jobLabelValues.APIServer
+		config.KubeletSelector = jobLabelValues.Kubelet
+		config.ControllerManagerSelector = jobLabelValues.ControllerManager
+		config.SchedulerSelector = jobLabelValues.Scheduler
+		config.KubeProxySelector = jobLabelValues.KubeProxy
+	}
+}
+
+// NewKubernetesRulesBuilder creates a new Kubernetes rules builder.
+// Job selectors default to the default job label values of the Kubernetes dashboards.
+func NewKubernetesRulesBuilder(
+	namespace string,
+	labels map[string]string,
+	annotations map[string]string,
+	options ...KubernetesRulesConfigOption,
+) (promtheusrule.Builder, error) {
+	defaultJobs := k8sPanels.DefaultJobLabelValues()
+	config := KubernetesRulesConfig{
+		KubeStateMetricsSelector:  defaultJobs.KubeStateMetrics,
+		CAdvisorSelector:          defaultJobs.CAdvisor,
+		NodeExporterSelector:      defaultJobs.NodeExporter,
+		APIServerSelector:         defaultJobs.APIServer,
+		KubeletSelector:           defaultJobs.Kubelet,
+		ControllerManagerSelector: defaultJobs.ControllerManager,
+		SchedulerSelector:         defaultJobs.Scheduler,
+		KubeProxySelector:         defaultJobs.KubeProxy,
+	}
+	for _, option := range options {
+		option(&config)
+	}
+
+	promRule, err := promtheusrule.New(
+		"kubernetes-rules",
+		namespace,
+		promtheusrule.Labels(labels),
+		promtheusrule.Annotations(annotations),
+		promtheusrule.AddRuleGroup(
+			"k8s.rules.container_cpu_usage_seconds_total",
+			config.ContainerCPUUsageSecondsTotalGroup()...,
+		),
+		promtheusrule.AddRuleGroup(
+			"k8s.rules.container_memory",
+			config.ContainerMemoryGroup()...,
+		),
+		promtheusrule.AddRuleGroup(
+			"k8s.rules.container_resource",
+			config.ContainerResourceGroup()...,
+		),
+		promtheusrule.AddRuleGroup(
+			"k8s.rules.pod_owner",
+			config.PodOwnerGroup()...,
+		),
+		promtheusrule.AddRuleGroup(
+			"node.rules",
+			config.NodeGroup()...,
+		),
+		promtheusrule.AddRuleGroup(
+			"kube-apiserver-availability.rules",
+			config.APIServerAvailabilityGroup()...,
+		),
+		promtheusrule.AddRuleGroup(
+			"kube-apiserver-histogram.rules",
+			config.APIServerHistogramGroup()...,
+		),
+		promtheusrule.AddRuleGroup(
+			"kube-apiserver-burnrate.rules",
+			config.APIServerBurnrateGroup()...,
+		),
+		promtheusrule.AddRuleGroup(
+			"kubelet.rules",
+			config.KubeletGroup()...,
+		),
+		promtheusrule.AddRuleGroup(
+			"kubernetes-apps",
+			config.KubernetesAppsGroup()...,
+		),
+		promtheusrule.AddRuleGroup(
+			"kubernetes-resources",
+			config.KubernetesResourcesGroup()...,
+		),
+		promtheusrule.AddRuleGroup(
+			"kubernetes-storage",
+			config.KubernetesStorageGroup()...,
+		),
+		promtheusrule.AddRuleGroup(
+			"kubernetes-system",
+			config.KubernetesSystemGroup()...,
+		),
+		promtheusrule.AddRuleGroup(
+			"kube-apiserver-slos",
+			config.APIServerSLOsGroup()...,
+		),
+		promtheusrule.AddRuleGroup(
+			"kubernetes-system-apiserver",
+			config.KubernetesSystemAPIServerGroup()...,
+		),
+		promtheusrule.AddRuleGroup(
+			"kubernetes-system-kubelet",
+			config.KubernetesSystemKubeletGroup()...,
+		),
+		promtheusrule.AddRuleGroup(
+			"kubernetes-system-scheduler",
+			config.KubernetesSystemSchedulerGroup()...,
+		),
+		promtheusrule.AddRuleGroup(
+			"kubernetes-system-controller-manager",
+			config.KubernetesSystemControllerManagerGroup()...,
+		),
+		promtheusrule.AddRuleGroup(
+			"kube-proxy",
+			config.KubeProxyGroup()...,
+		),
+	)
+
+	return promRule, err
+}
+
+// BuildKubernetesRules builds the Kubernetes rules for the given namespace, dashboard URLs, runbook URL, labels, and annotations.
+func BuildKubernetesRules(
+	namespace string,
+	labels map[string]string,
+	annotations map[string]string,
+	options ...KubernetesRulesConfigOption,
+) rulehelpers.RuleResult {
+	promRule, err := NewKubernetesRulesBuilder(namespace, labels, annotations, options...)
+	if err != nil {
+		return rulehelpers.NewRuleResult(nil, err).Component("kubernetes")
+	}
+
+	return rulehelpers.NewRuleResult(
+		&promRule.PrometheusRule,
+		nil,
+	).Component("kubernetes")
+}
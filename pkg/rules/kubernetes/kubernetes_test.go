@@ -0,0 +1,39 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/perses/community-mixins/pkg/querytest"
+)
+
+func TestKubernetesRules(t *testing.T) {
+	result := BuildKubernetesRules("default", nil, nil)
+	require.NoError(t, result.Err())
+
+	querytest.RuleSuite{
+		SeriesFile: "../../panels/kubernetes/testdata/series.test",
+		Groups:     result.Rule().Spec.Groups,
+		Labels: map[string][]string{
+			"KubeCPUOvercommit":         {`{cluster="cluster-c"}`},
+			"KubeMemoryOvercommit":      {`{cluster="cluster-c"}`},
+			"KubeNodeNotReady":          {`{__name__="kube_node_status_condition", cluster="cluster-c", job="kube-state-metrics", instance="10.1.0.5:8080", node="node-c2", condition="Ready", status="true"}`},
+			"KubeNodeReadinessFlapping": {`{cluster="cluster-c", node="node-c3"}`},
+			"KubeSchedulerDown":         {`{}`},
+		},
+	}.Run(t)
+}
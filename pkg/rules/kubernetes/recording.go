@@ -0,0 +1,1061 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"strconv"
+	"time"
+
+	promqlbuilder "github.com/perses/promql-builder"
+	"github.com/perses/promql-builder/label"
+	"github.com/perses/promql-builder/matrix"
+	"github.com/perses/promql-builder/vector"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/recording"
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/rulegroup"
+)
+
+const (
+	apiServerReadVerbs  = "LIST|GET"
+	apiServerWriteVerbs = "POST|PUT|PATCH|DELETE"
+)
+
+// podNodeInfo returns one kube_pod_info series per pod, which is used to attach the
+// node label to per-pod series without running into many-to-many matching errors.
+func (k KubernetesRulesConfig) podNodeInfo() parser.Expr {
+	return promqlbuilder.TopK(
+		promqlbuilder.Max(
+			vector.New(
+				vector.WithMetricName("kube_pod_info"),
+				vector.WithLabelMatchers(
+					label.New("job").Equal(k.KubeStateMetricsSelector),
+					label.New("node").NotEqual(""),
+				),
+			),
+		).By("cluster", "namespace", "pod", "node"),
+		1,
+	).By("cluster", "namespace", "pod")
+}
+
+// activePods returns the pods which are either pending or running.
+func (k KubernetesRulesConfig) activePods() parser.Expr {
+	return promqlbuilder.Max(
+		promqlbuilder.Parenthesis(
+			promqlbuilder.Eqlc(
+				vector.New(
+					vector.WithMetricName("kube_pod_status_phase"),
+					vector.WithLabelMatchers(
+						label.New("job").Equal(k.KubeStateMetricsSelector),
+						label.New("phase").EqualRegexp("Pending|Running"),
+					),
+				),
+				promqlbuilder.NewNumber(1),
+			),
+		),
+	).By("namespace", "pod", "cluster")
+}
+
+func (k KubernetesRulesConfig) ContainerCPUUsageSecondsTotalGroup() []rulegroup.Option {
+	return []rulegroup.Option{
+		rulegroup.AddRule(
+			"node_namespace_pod_container:container_cpu_usage_seconds_total:sum_rate5m",
+			recording.Expr(
+				promqlbuilder.Mul(
+					promqlbuilder.Sum(
+						promqlbuilder.Rate(
+							matrix.New(
+								vector.New(
+									vector.WithMetricName("container_cpu_usage_seconds_total"),
+									vector.WithLabelMatchers(
+										label.New("job").Equal(k.CAdvisorSelector),
+										label.New("image").NotEqual(""),
+									),
+								),
+								matrix.WithRange(5*time.Minute),
+							),
+						),
+					).By("cluster", "namespace", "pod", "container"),
+					k.podNodeInfo(),
+				).On("cluster", "namespace", "pod").GroupLeft("node"),
+			),
+		),
+		rulegroup.AddRule(
+			"node_namespace_pod_container:container_cpu_usage_seconds_total:sum_irate",
+			recording.Expr(
+				promqlbuilder.Mul(
+					promqlbuilder.Sum(
+						promqlbuilder.IRate(
+							matrix.New(
+								vector.New(
+									vector.WithMetricName("container_cpu_usage_seconds_total"),
+									vector.WithLabelMatchers(
+										label.New("job").Equal(k.CAdvisorSelector),
+										label.New("image").NotEqual(""),
+									),
+								),
+								matrix.WithRange(5*time.Minute),
+							),
+						),
+					).By("cluster", "namespace", "pod", "container"),
+					k.podNodeInfo(),
+				).On("cluster", "namespace", "pod").GroupLeft("node"),
+			),
+		),
+	}
+}
+
+func (k KubernetesRulesConfig) ContainerMemoryGroup() []rulegroup.Option {
+	options := []rulegroup.Option{}
+	for _, metricName := range []string{
+		"container_memory_working_set_bytes",
+		"container_memory_rss",
+		"container_memory_cache",
+		"container_memory_swap",
+	} {
+		options = append(options,
+			rulegroup.AddRule(
+				"node_namespace_pod_container:"+metricName,
+				recording.Expr(
+					promqlbuilder.Mul(
+						vector.New(
+							vector.WithMetricName(metricName),
+							vector.WithLabelMatchers(
+								label.New("job").Equal(k.CAdvisorSelector),
+								label.New("image").NotEqual(""),
+							),
+						),
+						k.podNodeInfo(),
+					).On("cluster", "namespace", "pod").GroupLeft("node"),
+				),
+			),
+		)
+	}
+	return options
+}
+
+func (k KubernetesRulesConfig) ContainerResourceGroup() []rulegroup.Option {
+	options := []rulegroup.Option{}
+	for _, kind := range []string{"requests", "limits"} {
+		for _, resource := range []string{"memory", "cpu"} {
+			metricName := "kube_pod_container_resource_" + kind
+			options = append(options,
+				rulegroup.AddRule(
+					"cluster:namespace:pod_"+resource+":active:"+metricName,
+					recording.Expr(
+						promqlbuilder.Mul(
+							vector.New(
+								vector.WithMetricName(metricName),
+								vector.WithLabelMatchers(
+									label.New("job").Equal(k.KubeStateMetricsSelector),
+									label.New("resource").Equal(resource),
+								),
+							),
+							k.activePods(),
+						).On("namespace", "pod", "cluster").GroupLeft(),
+					),
+				),
+				rulegroup.AddRule(
+					"namespace_"+resource+":"+metricName+":sum",
+					recording.Expr(
+						promqlbuilder.Sum(
+							promqlbuilder.Sum(
+								promqlbuilder.Mul(
+									promqlbuilder.Max(
+										vector.New(
+											vector.WithMetricName(metricName),
+											vector.WithLabelMatchers(
+												label.New("job").Equal(k.KubeStateMetricsSelector),
+												label.New("resource").Equal(resource),
+											),
+										),
+									).By("namespace", "pod", "container", "cluster"),
+									k.activePods(),
+								).On("namespace", "pod", "cluster").GroupLeft(),
+							).By("namespace", "pod", "cluster"),
+						).By("namespace", "cluster"),
+					),
+				),
+			)
+		}
+	}
+	return options
+}
+
+// podOwner returns the kube_pod_owner series for the given owner kind.
+func (k KubernetesRulesConfig) podOwner(ownerKind string) *parser.VectorSelector {
+	return vector.New(
+		vector.WithMetricName("kube_pod_owner"),
+		vector.WithLabelMatchers(
+			label.New("job").Equal(k.KubeStateMetricsSelector),
+			label.New("owner_kind").Equal(ownerKind),
+		),
+	)
+}
+
+func (k KubernetesRulesConfig) PodOwnerGroup() []rulegroup.Option {
+	return []rulegroup.Option{
+		rulegroup.AddRule(
+			"namespace_workload_pod:kube_pod_owner:relabel",
+			recording.Expr(
+				promqlbuilder.Max(
+					promqlbuilder.LabelReplace(
+						promqlbuilder.Mul(
+							promqlbuilder.LabelReplace(
+								k.podOwner("ReplicaSet"),
+								"replicaset", "$1", "owner_name", "(.*)",
+							),
+							promqlbuilder.TopK(
+								promqlbuilder.Max(
+									vector.New(
+										vector.WithMetricName("kube_replicaset_owner"),
+										vector.WithLabelMatchers(
+											label.New("job").Equal(k.KubeStateMetricsSelector),
+											label.New("owner_kind").Equal("Deployment"),
+										),
+									),
+								).By("cluster", "replicaset", "namespace", "owner_name"),
+								1,
+							).By("cluster", "replicaset", "namespace"),
+						).On("cluster", "replicaset", "namespace").GroupLeft("owner_name"),
+						"workload", "$1", "owner_name", "(.*)",
+					),
+				).By("cluster", "namespace", "workload", "pod"),
+			),
+			recording.Labels(map[string]string{
+				"workload_type": "deployment",
+			}),
+		),
+		rulegroup.AddRule(
+			"namespace_workload_pod:kube_pod_owner:relabel",
+			recording.Expr(
+				promqlbuilder.Max(
+					promqlbuilder.LabelReplace(
+						promqlbuilder.Mul(
+							promqlbuilder.LabelReplace(
+								k.podOwner("ReplicaSet"),
+								"replicaset", "$1", "owner_name", "(.*)",
+							),
+							promqlbuilder.TopK(
+								promqlbuilder.Max(
+									vector.New(
+										vector.WithMetricName("kube_replicaset_owner"),
+										vector.WithLabelMatchers(
+											label.New("job").Equal(k.KubeStateMetricsSelector),
+											label.New("owner_kind").Equal(""),
+										),
+									),
+								).By("cluster", "replicaset", "namespace"),
+								1,
+							).By("cluster", "replicaset", "namespace"),
+						).On("cluster", "replicaset", "namespace").GroupLeft(),
+						"workload", "$1", "replicaset", "(.*)",
+					),
+				).By("cluster", "namespace", "workload", "pod"),
+			),
+			recording.Labels(map[string]string{
+				"workload_type": "replicaset",
+			}),
+		),
+		rulegroup.AddRule(
+			"namespace_workload_pod:kube_pod_owner:relabel",
+			recording.Expr(
+				promqlbuilder.Max(
+					promqlbuilder.LabelReplace(
+						k.podOwner("DaemonSet"),
+						"workload", "$1", "owner_name", "(.*)",
+					),
+				).By("cluster", "namespace", "workload", "pod"),
+			),
+			recording.Labels(map[string]string{
+				"workload_type": "daemonset",
+			}),
+		),
+		rulegroup.AddRule(
+			"namespace_workload_pod:kube_pod_owner:relabel",
+			recording.Expr(
+				promqlbuilder.Max(
+					promqlbuilder.LabelReplace(
+						k.podOwner("StatefulSet"),
+						"workload", "$1", "owner_name", "(.*)",
+					),
+				).By("cluster", "namespace", "workload", "pod"),
+			),
+			recording.Labels(map[string]string{
+				"workload_type": "statefulset",
+			}),
+		),
+		rulegroup.AddRule(
+			"namespace_workload_pod:kube_pod_owner:relabel",
+			recording.Expr(
+				promqlbuilder.Max(
+					promqlbuilder.LabelReplace(
+						promqlbuilder.Mul(
+							promqlbuilder.LabelReplace(
+								k.podOwner("Job"),
+								"job_name", "$1", "owner_name", "(.*)",
+							),
+							promqlbuilder.Max(
+								vector.New(
+									vector.WithMetricName("kube_job_owner"),
+									vector.WithLabelMatchers(
+										label.New("job").Equal(k.KubeStateMetricsSelector),
+										label.New("owner_kind").EqualRegexp("Pod|"),
+									),
+								),
+							).By("cluster", "namespace", "job_name"),
+						).On("cluster", "namespace", "job_name").GroupLeft(),
+						"workload", "$1", "job_name", "(.*)",
+					),
+				).By("cluster", "namespace", "workload", "pod"),
+			),
+			recording.Labels(map[string]string{
+				"workload_type": "job",
+			}),
+		),
+		rulegroup.AddRule(
+			"namespace_workload_pod:kube_pod_owner:relabel",
+			recording.Expr(
+				promqlbuilder.Max(
+					promqlbuilder.LabelReplace(
+						promqlbuilder.Mul(
+							promqlbuilder.LabelReplace(
+								k.podOwner("Job"),
+								"job_name", "$1", "owner_name", "(.*)",
+							),
+							promqlbuilder.TopK(
+								promqlbuilder.Max(
+									vector.New(
+										vector.WithMetricName("kube_job_owner"),
+										vector.WithLabelMatchers(
+											label.New("job").Equal(k.KubeStateMetricsSelector),
+											label.New("owner_kind").Equal("CronJob"),
+										),
+									),
+								).By("cluster", "namespace", "job_name", "owner_name"),
+								1,
+							).By("cluster", "namespace", "job_name"),
+						).On("cluster", "namespace", "job_name").GroupLeft("owner_name"),
+						"workload", "$1", "owner_name", "(.*)",
+					),
+				).By("cluster", "namespace", "workload", "pod"),
+			),
+			recording.Labels(map[string]string{
+				"workload_type": "cronjob",
+			}),
+		),
+	}
+}
+
+// nodeMemory returns the node exporter series of the given memory metric.
+func (k KubernetesRulesConfig) nodeMemory(metricName string) *parser.VectorSelector {
+	return vector.New(
+		vector.WithMetricName(metricName),
+		vector.WithLabelMatchers(
+			label.New("job").Equal(k.NodeExporterSelector),
+		),
+	)
+}
+
+func (k KubernetesRulesConfig) NodeGroup() []rulegroup.Option {
+	return []rulegroup.Option{
+		rulegroup.AddRule(
+			"node_namespace_pod:kube_pod_info:",
+			recording.Expr(k.podNodeInfo()),
+		),
+		rulegroup.AddRule(
+			":node_memory_MemAvailable_bytes:sum",
+			recording.Expr(
+				promqlbuilder.Sum(
+					promqlbuilder.Or(
+						k.nodeMemory("node_memory_MemAvailable_bytes"),
+						promqlbuilder.Parenthesis(
+							promqlbuilder.Add(
+								promqlbuilder.Add(
+									promqlbuilder.Add(
+										k.nodeMemory("node_memory_Buffers_bytes"),
+										k.nodeMemory("node_memory_Cached_bytes"),
+									),
+									k.nodeMemory("node_memory_MemFree_bytes"),
+								),
+								k.nodeMemory("node_memory_Slab_bytes"),
+							),
+						),
+					),
+				).By("cluster"),
+			),
+		),
+		rulegroup.AddRule(
+			"node:node_cpu_utilization:ratio_rate5m",
+			recording.Expr(
+				promqlbuilder.Avg(
+					promqlbuilder.Sum(
+						promqlbuilder.Rate(
+							matrix.New(
+								vector.New(
+									vector.WithMetricName("node_cpu_seconds_total"),
+									vector.WithLabelMatchers(
+										label.New("mode").NotEqual("idle"),
+										label.New("mode").NotEqual("iowait"),
+										label.New("mode").NotEqual("steal"),
+										label.New("job").Equal(k.NodeExporterSelector),
+									),
+								),
+								matrix.WithRange(5*time.Minute),
+							),
+						),
+					).Without("mode"),
+				).By("cluster", "node"),
+			),
+		),
+		rulegroup.AddRule(
+			"cluster:node_cpu:ratio_rate5m",
+			recording.Expr(
+				promqlbuilder.Avg(
+					vector.New(
+						vector.WithMetricName("node:node_cpu_utilization:ratio_rate5m"),
+					),
+				).By("cluster"),
+			),
+		),
+	}
+}
+
+// apiServerSLIBucket30d returns the 30d SLI bucket increase for the given verbs, scope and bucket.
+func apiServerSLIBucket30d(verbs string, scope *labels.Matcher, le string) parser.Expr {
+	return promqlbuilder.Sum(
+		vector.New(
+			vector.WithMetricName("cluster_verb_scope_le:apiserver_request_sli_duration_seconds_bucket:increase30d"),
+			vector.WithLabelMatchers(
+				label.New("verb").EqualRegexp(verbs),
+				scope,
+				label.New("le").EqualRegexp(le),
+			),
+		),
+	).By("cluster")
+}
+
+// apiServerSLICount30d returns the 30d SLI request count for the given verbs.
+func apiServerSLICount30d(verbs string) parser.Expr {
+	return promqlbuilder.Sum(
+		vector.New(
+			vector.WithMetricName("cluster_verb_scope:apiserver_request_sli_duration_seconds_count:increase30d"),
+			vector.WithLabelMatchers(
+				label.New("verb").EqualRegexp(verbs),
+			),
+		),
+	).By("cluster")
+}
+
+// apiServerWritesTooSlow returns the number of write requests slower than the 1s SLO over 30d.
+func apiServerWritesTooSlow() parser.Expr {
+	return promqlbuilder.Parenthesis(
+		promqlbuilder.Sub(
+			apiServerSLICount30d(apiServerWriteVerbs),
+			promqlbuilder.Sum(
+				promqlbuilder.Or(
+					vector.New(
+						vector.WithMetricName("cluster_verb_scope_le:apiserver_request_sli_duration_seconds_bucket:increase30d"),
+						vector.WithLabelMatchers(
+							label.New("verb").EqualRegexp(apiServerWriteVerbs),
+							label.New("le").EqualRegexp("1(\\.0)?"),
+						),
+					),
+					promqlbuilder.Vector(0),
+				),
+			).By("cluster"),
+		),
+	)
+}
+
+// apiServerReadsTooSlow returns the number of read requests slower than their scope's SLO over 30d.
+// Resource scoped reads have a 1s SLO, namespace scoped reads 5s and cluster scoped reads 30s.
+func apiServerReadsTooSlow() parser.Expr {
+	return promqlbuilder.Parenthesis(
+		promqlbuilder.Sub(
+			apiServerSLICount30d(apiServerReadVerbs),
+			promqlbuilder.Parenthesis(
+				promqlbuilder.Add(
+					promqlbuilder.Add(
+						promqlbuilder.Parenthesis(
+							promqlbuilder.Or(
+								apiServerSLIBucket30d(
+									apiServerReadVerbs,
+									label.New("scope").EqualRegexp("resource|"),
+									"1(\\.0)?",
+								),
+								promqlbuilder.Vector(0),
+							),
+						),
+						apiServerSLIBucket30d(
+							apiServerReadVerbs,
+							label.New("scope").Equal("namespace"),
+							"5(\\.0)?",
+						),
+					),
+					apiServerSLIBucket30d(
+						apiServerReadVerbs,
+						label.New("scope").Equal("cluster"),
+						"30(\\.0)?",
+					),
+				),
+			),
+		),
+	)
+}
+
+// apiServerRequests30d returns the 30d request count, optionally restricted to errors and to a verb class.
+func apiServerRequests30d(verb string, errorsOnly bool) parser.Expr {
+	matchers := []*labels.Matcher{}
+	if verb != "" {
+		matchers = append(matchers, label.New("verb").Equal(verb))
+	}
+	if !errorsOnly {
+		return promqlbuilder.Sum(
+			vector.New(
+				vector.WithMetricName("code:apiserver_request_total:increase30d"),
+				vector.WithLabelMatchers(matchers...),
+			),
+		).By("cluster")
+	}
+	return promqlbuilder.Sum(
+		promqlbuilder.Or(
+			vector.New(
+				vector.WithMetricName("code:apiserver_request_total:increase30d"),
+				vector.WithLabelMatchers(
+					append(matchers, label.New("code").EqualRegexp("5.."))...,
+				),
+			),
+			promqlbuilder.Vector(0),
+		),
+	).By("cluster")
+}
+
+func (k KubernetesRulesConfig) APIServerAvailabilityGroup() []rulegroup.Option {
+	options := []rulegroup.Option{
+		rulegroup.Interval("3m"),
+		rulegroup.AddRule(
+			"code_verb:apiserver_request_total:increase30d",
+			recording.Expr(
+				promqlbuilder.Mul(
+					promqlbuilder.Mul(
+						promqlbuilder.AvgOverTime(
+							matrix.New(
+								vector.New(
+									vector.WithMetricName("code_verb:apiserver_request_total:increase1h"),
+								),
+								matrix.WithRange(30*24*time.Hour),
+							),
+						),
+						promqlbuilder.NewNumber(24),
+					),
+					promqlbuilder.NewNumber(30),
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"code:apiserver_request_total:increase30d",
+			recording.Expr(
+				promqlbuilder.Sum(
+					vector.New(
+						vector.WithMetricName("code_verb:apiserver_request_total:increase30d"),
+						vector.WithLabelMatchers(
+							label.New("verb").EqualRegexp(apiServerReadVerbs),
+						),
+					),
+				).By("cluster", "code"),
+			),
+			recording.Labels(map[string]string{
+				"verb": "read",
+			}),
+		),
+		rulegroup.AddRule(
+			"code:apiserver_request_total:increase30d",
+			recording.Expr(
+				promqlbuilder.Sum(
+					vector.New(
+						vector.WithMetricName("code_verb:apiserver_request_total:increase30d"),
+						vector.WithLabelMatchers(
+							label.New("verb").EqualRegexp(apiServerWriteVerbs),
+						),
+					),
+				).By("cluster", "code"),
+			),
+			recording.Labels(map[string]string{
+				"verb": "write",
+			}),
+		),
+		rulegroup.AddRule(
+			"cluster_verb_scope:apiserver_request_sli_duration_seconds_count:increase1h",
+			recording.Expr(
+				promqlbuilder.Sum(
+					promqlbuilder.Increase(
+						matrix.New(
+							vector.New(
+								vector.WithMetricName("apiserver_request_sli_duration_seconds_count"),
+								vector.WithLabelMatchers(
+									label.New("job").Equal(k.APIServerSelector),
+								),
+							),
+							matrix.WithRange(time.Hour),
+						),
+					),
+				).By("cluster", "verb", "scope"),
+			),
+		),
+		rulegroup.AddRule(
+			"cluster_verb_scope:apiserver_request_sli_duration_seconds_count:increase30d",
+			recording.Expr(
+				promqlbuilder.Sum(
+					promqlbuilder.Mul(
+						promqlbuilder.Mul(
+							promqlbuilder.AvgOverTime(
+								matrix.New(
+									vector.New(
+										vector.WithMetricName("cluster_verb_scope:apiserver_request_sli_duration_seconds_count:increase1h"),
+									),
+									matrix.WithRange(30*24*time.Hour),
+								),
+							),
+							promqlbuilder.NewNumber(24),
+						),
+						promqlbuilder.NewNumber(30),
+					),
+				).By("cluster", "verb", "scope"),
+			),
+		),
+		rulegroup.AddRule(
+			"cluster_verb_scope_le:apiserver_request_sli_duration_seconds_bucket:increase1h",
+			recording.Expr(
+				promqlbuilder.Sum(
+					promqlbuilder.Increase(
+						matrix.New(
+							vector.New(
+								vector.WithMetricName("apiserver_request_sli_duration_seconds_bucket"),
+								vector.WithLabelMatchers(
+									label.New("job").Equal(k.APIServerSelector),
+								),
+							),
+							matrix.WithRange(time.Hour),
+						),
+					),
+				).By("cluster", "verb", "scope", "le"),
+			),
+		),
+		rulegroup.AddRule(
+			"cluster_verb_scope_le:apiserver_request_sli_duration_seconds_bucket:increase30d",
+			recording.Expr(
+				promqlbuilder.Sum(
+					promqlbuilder.Mul(
+						promqlbuilder.Mul(
+							promqlbuilder.AvgOverTime(
+								matrix.New(
+									vector.New(
+										vector.WithMetricName("cluster_verb_scope_le:apiserver_request_sli_duration_seconds_bucket:increase1h"),
+									),
+									matrix.WithRange(30*24*time.Hour),
+								),
+							),
+							promqlbuilder.NewNumber(24),
+						),
+						promqlbuilder.NewNumber(30),
+					),
+				).By("cluster", "verb", "scope", "le"),
+			),
+		),
+		rulegroup.AddRule(
+			"apiserver_request:availability30d",
+			recording.Expr(
+				promqlbuilder.Sub(
+					promqlbuilder.NewNumber(1),
+					promqlbuilder.Div(
+						promqlbuilder.Parenthesis(
+							promqlbuilder.Add(
+								promqlbuilder.Add(
+									apiServerWritesTooSlow(),
+									apiServerReadsTooSlow(),
+								),
+								apiServerRequests30d("", true),
+							),
+						),
+						apiServerRequests30d("", false),
+					),
+				),
+			),
+			recording.Labels(map[string]string{
+				"verb": "all",
+			}),
+		),
+		rulegroup.AddRule(
+			"apiserver_request:availability30d",
+			recording.Expr(
+				promqlbuilder.Sub(
+					promqlbuilder.NewNumber(1),
+					promqlbuilder.Div(
+						promqlbuilder.Parenthesis(
+							promqlbuilder.Add(
+								apiServerReadsTooSlow(),
+								apiServerRequests30d("read", true),
+							),
+						),
+						apiServerRequests30d("read", false),
+					),
+				),
+			),
+			recording.Labels(map[string]string{
+				"verb": "read",
+			}),
+		),
+		rulegroup.AddRule(
+			"apiserver_request:availability30d",
+			recording.Expr(
+				promqlbuilder.Sub(
+					promqlbuilder.NewNumber(1),
+					promqlbuilder.Div(
+						promqlbuilder.Parenthesis(
+							promqlbuilder.Add(
+								apiServerWritesTooSlow(),
+								apiServerRequests30d("write", true),
+							),
+						),
+						apiServerRequests30d("write", false),
+					),
+				),
+			),
+			recording.Labels(map[string]string{
+				"verb": "write",
+			}),
+		),
+		rulegroup.AddRule(
+			"code_resource:apiserver_request_total:rate5m",
+			recording.Expr(
+				promqlbuilder.Sum(
+					promqlbuilder.Rate(
+						matrix.New(
+							vector.New(
+								vector.WithMetricName("apiserver_request_total"),
+								vector.WithLabelMatchers(
+									label.New("job").Equal(k.APIServerSelector),
+									label.New("verb").EqualRegexp(apiServerReadVerbs),
+								),
+							),
+							matrix.WithRange(5*time.Minute),
+						),
+					),
+				).By("cluster", "code", "resource"),
+			),
+			recording.Labels(map[string]string{
+				"verb": "read",
+			}),
+		),
+		rulegroup.AddRule(
+			"code_resource:apiserver_request_total:rate5m",
+			recording.Expr(
+				promqlbuilder.Sum(
+					promqlbuilder.Rate(
+						matrix.New(
+							vector.New(
+								vector.WithMetricName("apiserver_request_total"),
+								vector.WithLabelMatchers(
+									label.New("job").Equal(k.APIServerSelector),
+									label.New("verb").EqualRegexp(apiServerWriteVerbs),
+								),
+							),
+							matrix.WithRange(5*time.Minute),
+						),
+					),
+				).By("cluster", "code", "resource"),
+			),
+			recording.Labels(map[string]string{
+				"verb": "write",
+			}),
+		),
+	}
+
+	for _, code := range []string{"2..", "3..", "4..", "5.."} {
+		options = append(options,
+			rulegroup.AddRule(
+				"code_verb:apiserver_request_total:increase1h",
+				recording.Expr(
+					promqlbuilder.Sum(
+						promqlbuilder.Increase(
+							matrix.New(
+								vector.New(
+									vector.WithMetricName("apiserver_request_total"),
+									vector.WithLabelMatchers(
+										label.New("job").Equal(k.APIServerSelector),
+										label.New("verb").EqualRegexp(apiServerReadVerbs+"|"+apiServerWriteVerbs),
+										label.New("code").EqualRegexp(code),
+									),
+								),
+								matrix.WithRange(time.Hour),
+							),
+						),
+					).By("cluster", "code", "verb"),
+				),
+			),
+		)
+	}
+
+	return options
+}
+
+func (k KubernetesRulesConfig) APIServerHistogramGroup() []rulegroup.Option {
+	return []rulegroup.Option{
+		rulegroup.AddRule(
+			"cluster_quantile:apiserver_request_sli_duration_seconds:histogram_quantile",
+			recording.Expr(
+				promqlbuilder.Gtr(
+					promqlbuilder.HistogramQuantile(0.99,
+						promqlbuilder.Sum(
+							promqlbuilder.Rate(
+								matrix.New(
+									vector.New(
+										vector.WithMetricName("apiserver_request_sli_duration_seconds_bucket"),
+										vector.WithLabelMatchers(
+											label.New("job").Equal(k.APIServerSelector),
+											label.New("verb").EqualRegexp(apiServerReadVerbs),
+											label.New("subresource").NotEqualRegexp("proxy|attach|log|exec|portforward"),
+										),
+									),
+									matrix.WithRange(5*time.Minute),
+								),
+							),
+						).By("cluster", "le", "resource"),
+					),
+					promqlbuilder.NewNumber(0),
+				),
+			),
+			recording.Labels(map[string]string{
+				"quantile": "0.99",
+				"verb":     "read",
+			}),
+		),
+		rulegroup.AddRule(
+			"cluster_quantile:apiserver_request_sli_duration_seconds:histogram_quantile",
+			recording.Expr(
+				promqlbuilder.Gtr(
+					promqlbuilder.HistogramQuantile(0.99,
+						promqlbuilder.Sum(
+							promqlbuilder.Rate(
+								matrix.New(
+									vector.New(
+										vector.WithMetricName("apiserver_request_sli_duration_seconds_bucket"),
+										vector.WithLabelMatchers(
+											label.New("job").Equal(k.APIServerSelector),
+											label.New("verb").EqualRegexp(apiServerWriteVerbs),
+											label.New("subresource").NotEqualRegexp("proxy|attach|log|exec|portforward"),
+										),
+									),
+									matrix.WithRange(5*time.Minute),
+								),
+							),
+						).By("cluster", "le", "resource"),
+					),
+					promqlbuilder.NewNumber(0),
+				),
+			),
+			recording.Labels(map[string]string{
+				"quantile": "0.99",
+				"verb":     "write",
+			}),
+		),
+	}
+}
+
+// apiServerBurnrateWindows are the windows used by the multi-window, multi-burn-rate
+// KubeAPIErrorBudgetBurn alerts.
+var apiServerBurnrateWindows = []struct {
+	name     string
+	duration time.Duration
+}{
+	{name: "1d", duration: 24 * time.Hour},
+	{name: "1h", duration: time.Hour},
+	{name: "2h", duration: 2 * time.Hour},
+	{name: "30m", duration: 30 * time.Minute},
+	{name: "3d", duration: 3 * 24 * time.Hour},
+	{name: "5m", duration: 5 * time.Minute},
+	{name: "6h", duration: 6 * time.Hour},
+}
+
+// apiServerSLIRate returns the per-cluster rate of the given apiserver SLI series over the window.
+func (k KubernetesRulesConfig) apiServerSLIRate(metricName string, window time.Duration, matchers ...*labels.Matcher) parser.Expr {
+	return promqlbuilder.Sum(
+		promqlbuilder.Rate(
+			matrix.New(
+				vector.New(
+					vector.WithMetricName(metricName),
+					vector.WithLabelMatchers(
+						append([]*labels.Matcher{label.New("job").Equal(k.APIServerSelector)}, matchers...)...,
+					),
+				),
+				matrix.WithRange(window),
+			),
+		),
+	).By("cluster")
+}
+
+func (k KubernetesRulesConfig) APIServerBurnrateGroup() []rulegroup.Option {
+	options := []rulegroup.Option{}
+	for _, window := range apiServerBurnrateWindows {
+		options = append(options,
+			rulegroup.AddRule(
+				"apiserver_request:burnrate"+window.name,
+				recording.Expr(
+					promqlbuilder.Div(
+						promqlbuilder.Parenthesis(
+							promqlbuilder.Add(
+								promqlbuilder.Parenthesis(
+									promqlbuilder.Sub(
+										k.apiServerSLIRate(
+											"apiserver_request_sli_duration_seconds_count", window.duration,
+											label.New("verb").EqualRegexp(apiServerReadVerbs),
+											label.New("subresource").NotEqualRegexp("proxy|attach|log|exec|portforward"),
+										),
+										promqlbuilder.Parenthesis(
+											promqlbuilder.Add(
+												promqlbuilder.Add(
+													promqlbuilder.Parenthesis(
+														promqlbuilder.Or(
+															k.apiServerSLIRate(
+																"apiserver_request_sli_duration_seconds_bucket", window.duration,
+																label.New("verb").EqualRegexp(apiServerReadVerbs),
+																label.New("subresource").NotEqualRegexp("proxy|attach|log|exec|portforward"),
+																label.New("scope").EqualRegexp("resource|"),
+																label.New("le").EqualRegexp("1(\\.0)?"),
+															),
+															promqlbuilder.Vector(0),
+														),
+													),
+													k.apiServerSLIRate(
+														"apiserver_request_sli_duration_seconds_bucket", window.duration,
+														label.New("verb").EqualRegexp(apiServerReadVerbs),
+														label.New("subresource").NotEqualRegexp("proxy|attach|log|exec|portforward"),
+														label.New("scope").Equal("namespace"),
+														label.New("le").EqualRegexp("5(\\.0)?"),
+													),
+												),
+												k.apiServerSLIRate(
+													"apiserver_request_sli_duration_seconds_bucket", window.duration,
+													label.New("verb").EqualRegexp(apiServerReadVerbs),
+													label.New("subresource").NotEqualRegexp("proxy|attach|log|exec|portforward"),
+													label.New("scope").Equal("cluster"),
+													label.New("le").EqualRegexp("30(\\.0)?"),
+												),
+											),
+										),
+									),
+								),
+								k.apiServerSLIRate(
+									"apiserver_request_total", window.duration,
+									label.New("verb").EqualRegexp(apiServerReadVerbs),
+									label.New("code").EqualRegexp("5.."),
+								),
+							),
+						),
+						k.apiServerSLIRate(
+							"apiserver_request_total", window.duration,
+							label.New("verb").EqualRegexp(apiServerReadVerbs),
+						),
+					),
+				),
+				recording.Labels(map[string]string{
+					"verb": "read",
+				}),
+			),
+			rulegroup.AddRule(
+				"apiserver_request:burnrate"+window.name,
+				recording.Expr(
+					promqlbuilder.Div(
+						promqlbuilder.Parenthesis(
+							promqlbuilder.Add(
+								promqlbuilder.Parenthesis(
+									promqlbuilder.Sub(
+										k.apiServerSLIRate(
+											"apiserver_request_sli_duration_seconds_count", window.duration,
+											label.New("verb").EqualRegexp(apiServerWriteVerbs),
+											label.New("subresource").NotEqualRegexp("proxy|attach|log|exec|portforward"),
+										),
+										k.apiServerSLIRate(
+											"apiserver_request_sli_duration_seconds_bucket", window.duration,
+											label.New("verb").EqualRegexp(apiServerWriteVerbs),
+											label.New("subresource").NotEqualRegexp("proxy|attach|log|exec|portforward"),
+											label.New("le").EqualRegexp("1(\\.0)?"),
+										),
+									),
+								),
+								k.apiServerSLIRate(
+									"apiserver_request_total", window.duration,
+									label.New("verb").EqualRegexp(apiServerWriteVerbs),
+									label.New("code").EqualRegexp("5.."),
+								),
+							),
+						),
+						k.apiServerSLIRate(
+							"apiserver_request_total", window.duration,
+							label.New("verb").EqualRegexp(apiServerWriteVerbs),
+						),
+					),
+				),
+				recording.Labels(map[string]string{
+					"verb": "write",
+				}),
+			),
+		)
+	}
+	return options
+}
+
+func (k KubernetesRulesConfig) KubeletGroup() []rulegroup.Option {
+	options := []rulegroup.Option{}
+	for _, quantile := range []float64{0.99, 0.9, 0.5} {
+		options = append(options,
+			rulegroup.AddRule(
+				"node_quantile:kubelet_pleg_relist_duration_seconds:histogram_quantile",
+				recording.Expr(
+					promqlbuilder.HistogramQuantile(quantile,
+						promqlbuilder.Mul(
+							promqlbuilder.Sum(
+								promqlbuilder.Rate(
+									matrix.New(
+										vector.New(
+											vector.WithMetricName("kubelet_pleg_relist_duration_seconds_bucket"),
+											vector.WithLabelMatchers(
+												label.New("job").Equal(k.KubeletSelector),
+											),
+										),
+										matrix.WithRange(5*time.Minute),
+									),
+								),
+							).By("cluster", "instance", "le"),
+							promqlbuilder.Max(
+								vector.New(
+									vector.WithMetricName("kubelet_node_name"),
+									vector.WithLabelMatchers(
+										label.New("job").Equal(k.KubeletSelector),
+									),
+								),
+							).By("cluster", "instance", "node"),
+						).On("cluster", "instance").GroupLeft("node"),
+					),
+				),
+				recording.Labels(map[string]string{
+					"quantile": strconv.FormatFloat(quantile, 'f', -1, 64),
+				}),
+			),
+		)
+	}
+	return options
+}
@@ -0,0 +1,939 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"time"
+
+	promqlbuilder "github.com/perses/promql-builder"
+	"github.com/perses/promql-builder/label"
+	"github.com/perses/promql-builder/matrix"
+	"github.com/perses/promql-builder/vector"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/alerting"
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/common"
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/rulegroup"
+)
+
+// apiServer returns a selector for the given kube-apiserver series.
+func (k KubernetesRulesConfig) apiServer(metricName string, matchers ...*labels.Matcher) *parser.VectorSelector {
+	return vector.New(
+		vector.WithMetricName(metricName),
+		vector.WithLabelMatchers(
+			append([]*labels.Matcher{label.New("job").Equal(k.APIServerSelector)}, matchers...)...,
+		),
+	)
+}
+
+// targetDown returns an expression firing when no target of the given job is up.
+func targetDown(job string) parser.Expr {
+	return promqlbuilder.Absent(
+		promqlbuilder.Eqlc(
+			vector.New(
+				vector.WithMetricName("up"),
+				vector.WithLabelMatchers(
+					label.New("job").Equal(job),
+				),
+			),
+			promqlbuilder.NewNumber(1),
+		),
+	)
+}
+
+// apiServerErrorBudgetBurn returns a multi-window burn rate alert on the apiserver error budget.
+func (k KubernetesRulesConfig) apiServerErrorBudgetBurn(long, short string, factor float64, forDuration, severity string) rulegroup.Option {
+	return rulegroup.AddRule(
+		"KubeAPIErrorBudgetBurn",
+		alerting.Expr(
+			promqlbuilder.And(
+				promqlbuilder.Gtr(
+					promqlbuilder.Sum(
+						vector.New(
+							vector.WithMetricName("apiserver_request:burnrate"+long),
+						),
+					).By("cluster"),
+					promqlbuilder.Parenthesis(
+						promqlbuilder.Mul(
+							promqlbuilder.NewNumber(factor),
+							promqlbuilder.NewNumber(0.01),
+						),
+					),
+				),
+				promqlbuilder.Gtr(
+					promqlbuilder.Sum(
+						vector.New(
+							vector.WithMetricName("apiserver_request:burnrate"+short),
+						),
+					).By("cluster"),
+					promqlbuilder.Parenthesis(
+						promqlbuilder.Mul(
+							promqlbuilder.NewNumber(factor),
+							promqlbuilder.NewNumber(0.01),
+						),
+					),
+				),
+			).On("cluster"),
+		),
+		alerting.For(forDuration),
+		alerting.Labels(
+			common.MergeMaps(
+				map[string]string{
+					"severity": severity,
+					"long":     long,
+					"short":    short,
+				},
+				k.AdditionalAlertLabels,
+			),
+		),
+		alerting.Annotations(
+			common.MergeMaps(
+				common.BuildAnnotations(
+					k.APIServerDashboardURL,
+					k.RunbookURL,
+					runbookKubeAPIErrorBudgetBurn,
+					"The API server is burning too much error budget on cluster {{ $labels.cluster }}.",
+					"The API server is burning too much error budget.",
+				),
+				k.AdditionalAlertAnnotations,
+			),
+		),
+	)
+}
+
+func (k KubernetesRulesConfig) KubernetesSystemGroup() []rulegroup.Option {
+	return []rulegroup.Option{
+		rulegroup.AddRule(
+			"KubeVersionMismatch",
+			alerting.Expr(
+				promqlbuilder.Gtr(
+					promqlbuilder.Count(
+						promqlbuilder.Count(
+							promqlbuilder.LabelReplace(
+								vector.New(
+									vector.WithMetricName("kubernetes_build_info"),
+									vector.WithLabelMatchers(
+										label.New("job").NotEqualRegexp("kube-dns|coredns"),
+									),
+								),
+								"git_version", "$1", "git_version", "(v[0-9]*.[0-9]*).*",
+							),
+						).By("git_version", "cluster"),
+					).By("cluster"),
+					promqlbuilder.NewNumber(1),
+				),
+			),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.ClusterDashboardURL,
+						k.RunbookURL,
+						runbookKubeVersionMismatch,
+						"There are {{ $value }} different semantic versions of Kubernetes components running on cluster {{ $labels.cluster }}.",
+						"Different semantic versions of Kubernetes components running.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubeClientErrors",
+			alerting.Expr(
+				promqlbuilder.Gtr(
+					promqlbuilder.Parenthesis(
+						promqlbuilder.Div(
+							promqlbuilder.Sum(
+								promqlbuilder.Rate(
+									matrix.New(
+										k.apiServer(
+											"rest_client_requests_total",
+											label.New("code").EqualRegexp("5.."),
+										),
+										matrix.WithRange(5*time.Minute),
+									),
+								),
+							).By("cluster", "instance", "job", "namespace"),
+							promqlbuilder.Sum(
+								promqlbuilder.Rate(
+									matrix.New(
+										k.apiServer("rest_client_requests_total"),
+										matrix.WithRange(5*time.Minute),
+									),
+								),
+							).By("cluster", "instance", "job", "namespace"),
+						),
+					),
+					promqlbuilder.NewNumber(0.01),
+				),
+			),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.APIServerDashboardURL,
+						k.RunbookURL,
+						runbookKubeClientErrors,
+						"Kubernetes API server client '{{ $labels.job }}/{{ $labels.instance }}' is experiencing {{ $value | humanizePercentage }} errors on cluster {{ $labels.cluster }}.",
+						"Kubernetes API server client is experiencing errors.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+	}
+}
+
+func (k KubernetesRulesConfig) APIServerSLOsGroup() []rulegroup.Option {
+	return []rulegroup.Option{
+		k.apiServerErrorBudgetBurn("1h", "5m", 14.4, "2m", "critical"),
+		k.apiServerErrorBudgetBurn("6h", "30m", 6, "15m", "critical"),
+		k.apiServerErrorBudgetBurn("1d", "2h", 3, "1h", "warning"),
+		k.apiServerErrorBudgetBurn("3d", "6h", 1, "3h", "warning"),
+	}
+}
+
+// apiServerClientCertificateExpiration returns an alert on apiserver client certificates expiring within the given number of seconds.
+func (k KubernetesRulesConfig) apiServerClientCertificateExpiration(seconds float64, severity, description string) rulegroup.Option {
+	return rulegroup.AddRule(
+		"KubeClientCertificateExpiration",
+		alerting.Expr(
+			promqlbuilder.And(
+				promqlbuilder.Lss(
+					promqlbuilder.HistogramQuantile(0.01,
+						promqlbuilder.Sum(
+							promqlbuilder.Rate(
+								matrix.New(
+									k.apiServer("apiserver_client_certificate_expiration_seconds_bucket"),
+									matrix.WithRange(5*time.Minute),
+								),
+							),
+						).Without("namespace", "service", "endpoint"),
+					),
+					promqlbuilder.NewNumber(seconds),
+				),
+				promqlbuilder.Gtr(
+					k.apiServer("apiserver_client_certificate_expiration_seconds_count"),
+					promqlbuilder.NewNumber(0),
+				),
+			).On("job", "cluster", "instance"),
+		),
+		alerting.For("5m"),
+		alerting.Labels(
+			common.MergeMaps(
+				map[string]string{
+					"severity": severity,
+				},
+				k.AdditionalAlertLabels,
+			),
+		),
+		alerting.Annotations(
+			common.MergeMaps(
+				common.BuildAnnotations(
+					k.APIServerDashboardURL,
+					k.RunbookURL,
+					runbookKubeClientCertificateExpiration,
+					description,
+					"Client certificate is about to expire.",
+				),
+				k.AdditionalAlertAnnotations,
+			),
+		),
+	)
+}
+
+func (k KubernetesRulesConfig) KubernetesSystemAPIServerGroup() []rulegroup.Option {
+	return []rulegroup.Option{
+		k.apiServerClientCertificateExpiration(
+			604800,
+			"warning",
+			"A client certificate used to authenticate to kubernetes apiserver is expiring in less than 7.0 days on cluster {{ $labels.cluster }}.",
+		),
+		k.apiServerClientCertificateExpiration(
+			86400,
+			"critical",
+			"A client certificate used to authenticate to kubernetes apiserver is expiring in less than 24.0 hours on cluster {{ $labels.cluster }}.",
+		),
+		rulegroup.AddRule(
+			"KubeAggregatedAPIErrors",
+			alerting.Expr(
+				promqlbuilder.Gtr(
+					promqlbuilder.Sum(
+						promqlbuilder.Increase(
+							matrix.New(
+								k.apiServer("aggregator_unavailable_apiservice_total"),
+								matrix.WithRange(time.Minute),
+							),
+						),
+					).By("cluster", "instance", "name", "reason"),
+					promqlbuilder.NewNumber(0),
+				),
+			),
+			alerting.For("10m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.APIServerDashboardURL,
+						k.RunbookURL,
+						runbookKubeAggregatedAPIErrors,
+						"Kubernetes aggregated API {{ $labels.instance }}/{{ $labels.name }} has reported {{ $labels.reason }} errors on cluster {{ $labels.cluster }}.",
+						"Kubernetes aggregated API has reported errors.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubeAggregatedAPIDown",
+			alerting.Expr(
+				promqlbuilder.Lss(
+					promqlbuilder.Mul(
+						promqlbuilder.Parenthesis(
+							promqlbuilder.Sub(
+								promqlbuilder.NewNumber(1),
+								promqlbuilder.Max(
+									promqlbuilder.AvgOverTime(
+										matrix.New(
+											k.apiServer("aggregator_unavailable_apiservice"),
+											matrix.WithRange(10*time.Minute),
+										),
+									),
+								).By("name", "namespace", "cluster"),
+							),
+						),
+						promqlbuilder.NewNumber(100),
+					),
+					promqlbuilder.NewNumber(85),
+				),
+			),
+			alerting.For("5m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.APIServerDashboardURL,
+						k.RunbookURL,
+						runbookKubeAggregatedAPIDown,
+						"Kubernetes aggregated API {{ $labels.name }}/{{ $labels.namespace }} has been only {{ $value | humanize }}% available over the last 10m on cluster {{ $labels.cluster }}.",
+						"Kubernetes aggregated API is down.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubeAPIDown",
+			alerting.Expr(targetDown(k.APIServerSelector)),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "critical",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.APIServerDashboardURL,
+						k.RunbookURL,
+						runbookKubeAPIDown,
+						"KubeAPI has disappeared from Prometheus target discovery.",
+						"Target disappeared from Prometheus target discovery.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubeAPITerminatedRequests",
+			alerting.Expr(
+				promqlbuilder.Gtr(
+					promqlbuilder.Div(
+						promqlbuilder.Sum(
+							promqlbuilder.Rate(
+								matrix.New(
+									k.apiServer("apiserver_request_terminations_total"),
+									matrix.WithRange(10*time.Minute),
+								),
+							),
+						).By("cluster"),
+						promqlbuilder.Parenthesis(
+							promqlbuilder.Add(
+								promqlbuilder.Sum(
+									promqlbuilder.Rate(
+										matrix.New(
+											k.apiServer("apiserver_request_total"),
+											matrix.WithRange(10*time.Minute),
+										),
+									),
+								).By("cluster"),
+								promqlbuilder.Sum(
+									promqlbuilder.Rate(
+										matrix.New(
+											k.apiServer("apiserver_request_terminations_total"),
+											matrix.WithRange(10*time.Minute),
+										),
+									),
+								).By("cluster"),
+							),
+						),
+					),
+					promqlbuilder.NewNumber(0.20),
+				),
+			),
+			alerting.For("5m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.APIServerDashboardURL,
+						k.RunbookURL,
+						runbookKubeAPITerminatedRequests,
+						"The kubernetes apiserver has terminated {{ $value | humanizePercentage }} of its incoming requests on cluster {{ $labels.cluster }}.",
+						"The kubernetes apiserver has terminated {{ $value | humanizePercentage }} of its incoming requests.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+	}
+}
+
+// kubeletCertificateExpiration returns an alert on kubelet certificates of the given kind ("client" or "server")
+// expiring within the given number of seconds.
+func (k KubernetesRulesConfig) kubeletCertificateExpiration(alertName, runbookFragment, kind string, seconds float64, severity string) rulegroup.Option {
+	return rulegroup.AddRule(
+		alertName,
+		alerting.Expr(
+			promqlbuilder.Lss(
+				k.kubelet("kubelet_certificate_manager_"+kind+"_ttl_seconds"),
+				promqlbuilder.NewNumber(seconds),
+			),
+		),
+		alerting.Labels(
+			common.MergeMaps(
+				map[string]string{
+					"severity": severity,
+				},
+				k.AdditionalAlertLabels,
+			),
+		),
+		alerting.Annotations(
+			common.MergeMaps(
+				common.BuildAnnotations(
+					k.KubeletDashboardURL,
+					k.RunbookURL,
+					runbookFragment,
+					"The "+kind+" certificate for Kubelet on node {{ $labels.node }} expires in {{ $value | humanizeDuration }} on cluster {{ $labels.cluster }}.",
+					"Kubelet "+kind+" certificate is about to expire.",
+				),
+				k.AdditionalAlertAnnotations,
+			),
+		),
+	)
+}
+
+func (k KubernetesRulesConfig) KubernetesSystemKubeletGroup() []rulegroup.Option {
+	return []rulegroup.Option{
+		rulegroup.AddRule(
+			"KubeNodeNotReady",
+			alerting.Expr(
+				promqlbuilder.And(
+					promqlbuilder.Eqlc(
+						k.kubeStateMetrics(
+							"kube_node_status_condition",
+							label.New("condition").Equal("Ready"),
+							label.New("status").Equal("true"),
+						),
+						promqlbuilder.NewNumber(0),
+					),
+					promqlbuilder.Eqlc(
+						k.kubeStateMetrics("kube_node_spec_unschedulable"),
+						promqlbuilder.NewNumber(0),
+					),
+				).On("cluster", "node"),
+			),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.KubeletDashboardURL,
+						k.RunbookURL,
+						runbookKubeNodeNotReady,
+						"{{ $labels.node }} has been unready for more than 15 minutes on cluster {{ $labels.cluster }}.",
+						"Node is not ready.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubeNodeUnreachable",
+			alerting.Expr(
+				promqlbuilder.Eqlc(
+					promqlbuilder.Parenthesis(
+						promqlbuilder.Unless(
+							k.kubeStateMetrics(
+								"kube_node_spec_taint",
+								label.New("key").Equal("node.kubernetes.io/unreachable"),
+								label.New("effect").Equal("NoSchedule"),
+							),
+							k.kubeStateMetrics(
+								"kube_node_spec_taint",
+								label.New("key").EqualRegexp("ToBeDeletedByClusterAutoscaler|cloud.google.com/impending-node-termination|aws-node-termination-handler/spot-itn"),
+							),
+						).Ignoring("key", "value"),
+					),
+					promqlbuilder.NewNumber(1),
+				),
+			),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.KubeletDashboardURL,
+						k.RunbookURL,
+						runbookKubeNodeUnreachable,
+						"{{ $labels.node }} is unreachable and some workloads may be rescheduled on cluster {{ $labels.cluster }}.",
+						"Node is unreachable.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubeletTooManyPods",
+			alerting.Expr(
+				promqlbuilder.Gtr(
+					promqlbuilder.Div(
+						promqlbuilder.Parenthesis(
+							promqlbuilder.Mul(
+								promqlbuilder.Max(
+									promqlbuilder.Gtr(
+										k.kubelet("kubelet_running_pods"),
+										promqlbuilder.NewNumber(1),
+									),
+								).By("cluster", "instance"),
+								promqlbuilder.Max(
+									k.kubelet("kubelet_node_name"),
+								).By("cluster", "instance", "node"),
+							).On("cluster", "instance").GroupLeft("node"),
+						),
+						promqlbuilder.Max(
+							promqlbuilder.Neq(
+								k.kubeStateMetrics(
+									"kube_node_status_capacity",
+									label.New("resource").Equal("pods"),
+								),
+								promqlbuilder.NewNumber(1),
+							),
+						).By("cluster", "node"),
+					).On("cluster", "node").GroupLeft(),
+					promqlbuilder.NewNumber(0.95),
+				),
+			),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "info",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.KubeletDashboardURL,
+						k.RunbookURL,
+						runbookKubeletTooManyPods,
+						"Kubelet '{{ $labels.node }}' is running at {{ $value | humanizePercentage }} of its Pod capacity on cluster {{ $labels.cluster }}.",
+						"Kubelet is running at capacity.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubeNodeReadinessFlapping",
+			alerting.Expr(
+				promqlbuilder.And(
+					promqlbuilder.Gtr(
+						promqlbuilder.Sum(
+							promqlbuilder.Changes(
+								matrix.New(
+									k.kubeStateMetrics(
+										"kube_node_status_condition",
+										label.New("condition").Equal("Ready"),
+										label.New("status").Equal("true"),
+									),
+									matrix.WithRange(15*time.Minute),
+								),
+							),
+						).By("cluster", "node"),
+						promqlbuilder.NewNumber(2),
+					),
+					promqlbuilder.Eqlc(
+						k.kubeStateMetrics("kube_node_spec_unschedulable"),
+						promqlbuilder.NewNumber(0),
+					),
+				).On("cluster", "node"),
+			),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.KubeletDashboardURL,
+						k.RunbookURL,
+						runbookKubeNodeReadinessFlapping,
+						"The readiness status of node {{ $labels.node }} has changed {{ $value }} times in the last 15 minutes on cluster {{ $labels.cluster }}.",
+						"Node readiness status is flapping.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubeletPlegDurationHigh",
+			alerting.Expr(
+				promqlbuilder.Gte(
+					vector.New(
+						vector.WithMetricName("node_quantile:kubelet_pleg_relist_duration_seconds:histogram_quantile"),
+						vector.WithLabelMatchers(
+							label.New("quantile").Equal("0.99"),
+						),
+					),
+					promqlbuilder.NewNumber(10),
+				),
+			),
+			alerting.For("5m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.KubeletDashboardURL,
+						k.RunbookURL,
+						runbookKubeletPlegDurationHigh,
+						"The Kubelet Pod Lifecycle Event Generator has a 99th percentile duration of {{ $value }} seconds on node {{ $labels.node }} on cluster {{ $labels.cluster }}.",
+						"Kubelet Pod Lifecycle Event Generator is taking too long to relist.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubeletPodStartUpLatencyHigh",
+			alerting.Expr(
+				promqlbuilder.Gtr(
+					promqlbuilder.Mul(
+						promqlbuilder.HistogramQuantile(0.99,
+							promqlbuilder.Sum(
+								promqlbuilder.Rate(
+									matrix.New(
+										k.kubelet("kubelet_pod_worker_duration_seconds_bucket"),
+										matrix.WithRange(5*time.Minute),
+									),
+								),
+							).By("cluster", "instance", "le"),
+						),
+						k.kubelet("kubelet_node_name"),
+					).On("cluster", "instance").GroupLeft("node"),
+					promqlbuilder.NewNumber(60),
+				),
+			),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.KubeletDashboardURL,
+						k.RunbookURL,
+						runbookKubeletPodStartUpLatencyHigh,
+						"Kubelet Pod startup 99th percentile latency is {{ $value }} seconds on node {{ $labels.node }} on cluster {{ $labels.cluster }}.",
+						"Kubelet Pod startup latency is too high.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		k.kubeletCertificateExpiration("KubeletClientCertificateExpiration", runbookKubeletClientCertificateExpiration, "client", 604800, "warning"),
+		k.kubeletCertificateExpiration("KubeletClientCertificateExpiration", runbookKubeletClientCertificateExpiration, "client", 86400, "critical"),
+		k.kubeletCertificateExpiration("KubeletServerCertificateExpiration", runbookKubeletServerCertificateExpiration, "server", 604800, "warning"),
+		k.kubeletCertificateExpiration("KubeletServerCertificateExpiration", runbookKubeletServerCertificateExpiration, "server", 86400, "critical"),
+		rulegroup.AddRule(
+			"KubeletClientCertificateRenewalErrors",
+			alerting.Expr(
+				promqlbuilder.Gtr(
+					promqlbuilder.Increase(
+						matrix.New(
+							k.kubelet("kubelet_certificate_manager_client_expiration_renew_errors"),
+							matrix.WithRange(5*time.Minute),
+						),
+					),
+					promqlbuilder.NewNumber(0),
+				),
+			),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.KubeletDashboardURL,
+						k.RunbookURL,
+						runbookKubeletClientCertificateRenewalErrors,
+						"Kubelet on node {{ $labels.node }} has failed to renew its client certificate ({{ $value | humanize }} errors in the last 5 minutes) on cluster {{ $labels.cluster }}.",
+						"Kubelet has failed to renew its client certificate.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubeletServerCertificateRenewalErrors",
+			alerting.Expr(
+				promqlbuilder.Gtr(
+					promqlbuilder.Increase(
+						matrix.New(
+							k.kubelet("kubelet_server_expiration_renew_errors"),
+							matrix.WithRange(5*time.Minute),
+						),
+					),
+					promqlbuilder.NewNumber(0),
+				),
+			),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.KubeletDashboardURL,
+						k.RunbookURL,
+						runbookKubeletServerCertificateRenewalErrors,
+						"Kubelet on node {{ $labels.node }} has failed to renew its server certificate ({{ $value | humanize }} errors in the last 5 minutes) on cluster {{ $labels.cluster }}.",
+						"Kubelet has failed to renew its server certificate.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"KubeletDown",
+			alerting.Expr(targetDown(k.KubeletSelector)),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "critical",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.KubeletDashboardURL,
+						k.RunbookURL,
+						runbookKubeletDown,
+						"Kubelet has disappeared from Prometheus target discovery.",
+						"Target disappeared from Prometheus target discovery.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+	}
+}
+
+func (k KubernetesRulesConfig) KubernetesSystemSchedulerGroup() []rulegroup.Option {
+	return []rulegroup.Option{
+		rulegroup.AddRule(
+			"KubeSchedulerDown",
+			alerting.Expr(targetDown(k.SchedulerSelector)),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "critical",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.SchedulerDashboardURL,
+						k.RunbookURL,
+						runbookKubeSchedulerDown,
+						"KubeScheduler has disappeared from Prometheus target discovery.",
+						"Target disappeared from Prometheus target discovery.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+	}
+}
+
+func (k KubernetesRulesConfig) KubernetesSystemControllerManagerGroup() []rulegroup.Option {
+	return []rulegroup.Option{
+		rulegroup.AddRule(
+			"KubeControllerManagerDown",
+			alerting.Expr(targetDown(k.ControllerManagerSelector)),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "critical",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.ControllerManagerDashboardURL,
+						k.RunbookURL,
+						runbookKubeControllerManagerDown,
+						"KubeControllerManager has disappeared from Prometheus target discovery.",
+						"Target disappeared from Prometheus target discovery.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+	}
+}
+
+func (k KubernetesRulesConfig) KubeProxyGroup() []rulegroup.Option {
+	return []rulegroup.Option{
+		rulegroup.AddRule(
+			"KubeProxyDown",
+			alerting.Expr(targetDown(k.KubeProxySelector)),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "critical",
+					},
+					k.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						k.ProxyDashboardURL,
+						k.RunbookURL,
+						runbookKubeProxyDown,
+						"KubeProxy has disappeared from Prometheus target discovery.",
+						"Target disappeared from Prometheus target discovery.",
+					),
+					k.AdditionalAlertAnnotations,
+				),
+			),
+		),
+	}
+}
@@ -0,0 +1,858 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodeexporter
+
+import (
+	"time"
+
+	promqlbuilder "github.com/perses/promql-builder"
+	"github.com/perses/promql-builder/label"
+	"github.com/perses/promql-builder/matrix"
+	"github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/alerting"
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/common"
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/rulegroup"
+)
+
+// filesystem returns a selector for the given filesystem series, ignoring pseudo filesystems.
+func (n NodeExporterRulesConfig) filesystem(metricName string) *parser.VectorSelector {
+	return n.nodeExporter(
+		metricName,
+		label.New("fstype").NotEqual(""),
+		label.New("mountpoint").NotEqual(""),
+	)
+}
+
+// filesystemFreePercentage returns the percentage of the filesystem resource which is still available.
+func (n NodeExporterRulesConfig) filesystemFreePercentage(availableMetric, sizeMetric string) parser.Expr {
+	return promqlbuilder.Mul(
+		promqlbuilder.Div(
+			n.filesystem(availableMetric),
+			n.filesystem(sizeMetric),
+		),
+		promqlbuilder.NewNumber(100),
+	)
+}
+
+// filesystemWritable filters the expression down to read-write filesystems.
+func (n NodeExporterRulesConfig) filesystemWritable(expr parser.Expr) parser.Expr {
+	return promqlbuilder.And(
+		expr,
+		promqlbuilder.Eqlc(
+			n.filesystem("node_filesystem_readonly"),
+			promqlbuilder.NewNumber(0),
+		),
+	)
+}
+
+// filesystemFillingUp returns an alert firing when a filesystem resource is below the threshold
+// and is predicted to be exhausted within the given number of hours.
+func (n NodeExporterRulesConfig) filesystemFillingUp(
+	alertName, runbookFragment, availableMetric, sizeMetric string,
+	threshold, hours float64,
+	severity, description, summary string,
+) rulegroup.Option {
+	return rulegroup.AddRule(
+		alertName,
+		alerting.Expr(
+			n.filesystemWritable(
+				promqlbuilder.And(
+					promqlbuilder.Lss(
+						n.filesystemFreePercentage(availableMetric, sizeMetric),
+						promqlbuilder.NewNumber(threshold),
+					),
+					promqlbuilder.Lss(
+						promqlbuilder.PredictLinear(
+							matrix.New(
+								n.filesystem(availableMetric),
+								matrix.WithRange(6*time.Hour),
+							),
+							hours*60*60,
+						),
+						promqlbuilder.NewNumber(0),
+					),
+				),
+			),
+		),
+		alerting.For("1h"),
+		alerting.Labels(
+			common.MergeMaps(
+				map[string]string{
+					"severity": severity,
+				},
+				n.AdditionalAlertLabels,
+			),
+		),
+		alerting.Annotations(
+			common.MergeMaps(
+				common.BuildAnnotations(
+					n.DashboardURL,
+					n.RunbookURL,
+					runbookFragment,
+					description,
+					summary,
+				),
+				n.AdditionalAlertAnnotations,
+			),
+		),
+	)
+}
+
+// filesystemAlmostOut returns an alert firing when a filesystem resource is below the threshold.
+func (n NodeExporterRulesConfig) filesystemAlmostOut(
+	alertName, runbookFragment, availableMetric, sizeMetric string,
+	threshold float64,
+	forDuration, severity, description, summary string,
+) rulegroup.Option {
+	return rulegroup.AddRule(
+		alertName,
+		alerting.Expr(
+			n.filesystemWritable(
+				promqlbuilder.Lss(
+					n.filesystemFreePercentage(availableMetric, sizeMetric),
+					promqlbuilder.NewNumber(threshold),
+				),
+			),
+		),
+		alerting.For(forDuration),
+		alerting.Labels(
+			common.MergeMaps(
+				map[string]string{
+					"severity": severity,
+				},
+				n.AdditionalAlertLabels,
+			),
+		),
+		alerting.Annotations(
+			common.MergeMaps(
+				common.BuildAnnotations(
+					n.DashboardURL,
+					n.RunbookURL,
+					runbookFragment,
+					description,
+					summary,
+				),
+				n.AdditionalAlertAnnotations,
+			),
+		),
+	)
+}
+
+func (n NodeExporterRulesConfig) NodeExporterFilesystemGroup() []rulegroup.Option {
+	return []rulegroup.Option{
+		n.filesystemFillingUp(
+			"NodeFilesystemSpaceFillingUp",
+			runbookNodeFilesystemSpaceFillingUp,
+			"node_filesystem_avail_bytes",
+			"node_filesystem_size_bytes",
+			15, 24,
+			"warning",
+			"Filesystem on {{ $labels.device }}, mounted on {{ $labels.mountpoint }}, at {{ $labels.instance }} has only {{ printf \"%.2f\" $value }}% available space left and is filling up.",
+			"Filesystem is predicted to run out of space within the next 24 hours.",
+		),
+		n.filesystemFillingUp(
+			"NodeFilesystemSpaceFillingUp",
+			runbookNodeFilesystemSpaceFillingUp,
+			"node_filesystem_avail_bytes",
+			"node_filesystem_size_bytes",
+			10, 4,
+			"critical",
+			"Filesystem on {{ $labels.device }}, mounted on {{ $labels.mountpoint }}, at {{ $labels.instance }} has only {{ printf \"%.2f\" $value }}% available space left and is filling up fast.",
+			"Filesystem is predicted to run out of space within the next 4 hours.",
+		),
+		n.filesystemAlmostOut(
+			"NodeFilesystemAlmostOutOfSpace",
+			runbookNodeFilesystemAlmostOutOfSpace,
+			"node_filesystem_avail_bytes",
+			"node_filesystem_size_bytes",
+			5,
+			"30m",
+			"warning",
+			"Filesystem on {{ $labels.device }}, mounted on {{ $labels.mountpoint }}, at {{ $labels.instance }} has only {{ printf \"%.2f\" $value }}% available space left.",
+			"Filesystem has less than 5% space left.",
+		),
+		n.filesystemAlmostOut(
+			"NodeFilesystemAlmostOutOfSpace",
+			runbookNodeFilesystemAlmostOutOfSpace,
+			"node_filesystem_avail_bytes",
+			"node_filesystem_size_bytes",
+			3,
+			"30m",
+			"critical",
+			"Filesystem on {{ $labels.device }}, mounted on {{ $labels.mountpoint }}, at {{ $labels.instance }} has only {{ printf \"%.2f\" $value }}% available space left.",
+			"Filesystem has less than 3% space left.",
+		),
+		n.filesystemFillingUp(
+			"NodeFilesystemFilesFillingUp",
+			runbookNodeFilesystemFilesFillingUp,
+			"node_filesystem_files_free",
+			"node_filesystem_files",
+			40, 24,
+			"warning",
+			"Filesystem on {{ $labels.device }}, mounted on {{ $labels.mountpoint }}, at {{ $labels.instance }} has only {{ printf \"%.2f\" $value }}% available inodes left and is filling up.",
+			"Filesystem is predicted to run out of inodes within the next 24 hours.",
+		),
+		n.filesystemFillingUp(
+			"NodeFilesystemFilesFillingUp",
+			runbookNodeFilesystemFilesFillingUp,
+			"node_filesystem_files_free",
+			"node_filesystem_files",
+			20, 4,
+			"critical",
+			"Filesystem on {{ $labels.device }}, mounted on {{ $labels.mountpoint }}, at {{ $labels.instance }} has only {{ printf \"%.2f\" $value }}% available inodes left and is filling up fast.",
+			"Filesystem is predicted to run out of inodes within the next 4 hours.",
+		),
+		n.filesystemAlmostOut(
+			"NodeFilesystemAlmostOutOfFiles",
+			runbookNodeFilesystemAlmostOutOfFiles,
+			"node_filesystem_files_free",
+			"node_filesystem_files",
+			5,
+			"1h",
+			"warning",
+			"Filesystem on {{ $labels.device }}, mounted on {{ $labels.mountpoint }}, at {{ $labels.instance }} has only {{ printf \"%.2f\" $value }}% available inodes left.",
+			"Filesystem has less than 5% inodes left.",
+		),
+		n.filesystemAlmostOut(
+			"NodeFilesystemAlmostOutOfFiles",
+			runbookNodeFilesystemAlmostOutOfFiles,
+			"node_filesystem_files_free",
+			"node_filesystem_files",
+			3,
+			"1h",
+			"critical",
+			"Filesystem on {{ $labels.device }}, mounted on {{ $labels.mountpoint }}, at {{ $labels.instance }} has only {{ printf \"%.2f\" $value }}% available inodes left.",
+			"Filesystem has less than 3% inodes left.",
+		),
+	}
+}
+
+// networkErrorRatio returns the ratio of errored packets for the given direction ("receive" or "transmit").
+func (n NodeExporterRulesConfig) networkErrorRatio(direction string) parser.Expr {
+	return promqlbuilder.Div(
+		promqlbuilder.Rate(
+			matrix.New(
+				n.nodeExporter("node_network_"+direction+"_errs_total"),
+				matrix.WithRange(2*time.Minute),
+			),
+		),
+		promqlbuilder.Rate(
+			matrix.New(
+				n.nodeExporter("node_network_"+direction+"_packets_total"),
+				matrix.WithRange(2*time.Minute),
+			),
+		),
+	)
+}
+
+// fileDescriptorLimit returns an alert firing when the allocated file descriptors exceed the given percentage.
+func (n NodeExporterRulesConfig) fileDescriptorLimit(threshold float64, severity string) rulegroup.Option {
+	return rulegroup.AddRule(
+		"NodeFileDescriptorLimit",
+		alerting.Expr(
+			promqlbuilder.Parenthesis(
+				promqlbuilder.Gtr(
+					promqlbuilder.Div(
+						promqlbuilder.Mul(
+							n.nodeExporter("node_filefd_allocated"),
+							promqlbuilder.NewNumber(100),
+						),
+						n.nodeExporter("node_filefd_maximum"),
+					),
+					promqlbuilder.NewNumber(threshold),
+				),
+			),
+		),
+		alerting.For("15m"),
+		alerting.Labels(
+			common.MergeMaps(
+				map[string]string{
+					"severity": severity,
+				},
+				n.AdditionalAlertLabels,
+			),
+		),
+		alerting.Annotations(
+			common.MergeMaps(
+				common.BuildAnnotations(
+					n.DashboardURL,
+					n.RunbookURL,
+					runbookNodeFileDescriptorLimit,
+					"File descriptors limit at {{ $labels.instance }} is currently at {{ printf \"%.2f\" $value }}%.",
+					"Kernel is predicted to exhaust file descriptors limit soon.",
+				),
+				n.AdditionalAlertAnnotations,
+			),
+		),
+	)
+}
+
+func (n NodeExporterRulesConfig) NodeExporterGroup() []rulegroup.Option {
+	return []rulegroup.Option{
+		rulegroup.AddRule(
+			"NodeNetworkReceiveErrs",
+			alerting.Expr(
+				promqlbuilder.Gtr(
+					n.networkErrorRatio("receive"),
+					promqlbuilder.NewNumber(0.01),
+				),
+			),
+			alerting.For("1h"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					n.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						n.DashboardURL,
+						n.RunbookURL,
+						runbookNodeNetworkReceiveErrs,
+						"{{ $labels.instance }} interface {{ $labels.device }} has encountered {{ printf \"%.0f\" $value }} receive errors in the last two minutes.",
+						"Network interface is reporting many receive errors.",
+					),
+					n.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"NodeNetworkTransmitErrs",
+			alerting.Expr(
+				promqlbuilder.Gtr(
+					n.networkErrorRatio("transmit"),
+					promqlbuilder.NewNumber(0.01),
+				),
+			),
+			alerting.For("1h"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					n.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						n.DashboardURL,
+						n.RunbookURL,
+						runbookNodeNetworkTransmitErrs,
+						"{{ $labels.instance }} interface {{ $labels.device }} has encountered {{ printf \"%.0f\" $value }} transmit errors in the last two minutes.",
+						"Network interface is reporting many transmit errors.",
+					),
+					n.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"NodeHighNumberConntrackEntriesUsed",
+			alerting.Expr(
+				promqlbuilder.Gtr(
+					promqlbuilder.Parenthesis(
+						promqlbuilder.Div(
+							n.nodeExporter("node_nf_conntrack_entries"),
+							n.nodeExporter("node_nf_conntrack_entries_limit"),
+						),
+					),
+					promqlbuilder.NewNumber(0.75),
+				),
+			),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					n.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						n.DashboardURL,
+						n.RunbookURL,
+						runbookNodeHighNumberConntrackEntries,
+						"{{ $labels.instance }} has {{ $value | humanizePercentage }} of its conntrack entries used.",
+						"Number of conntrack are getting close to the limit.",
+					),
+					n.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"NodeTextFileCollectorScrapeError",
+			alerting.Expr(
+				promqlbuilder.Eqlc(
+					n.nodeExporter("node_textfile_scrape_error"),
+					promqlbuilder.NewNumber(1),
+				),
+			),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					n.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						n.DashboardURL,
+						n.RunbookURL,
+						runbookNodeTextFileCollectorScrapeError,
+						"Node Exporter text file collector on {{ $labels.instance }} failed to scrape.",
+						"Node Exporter text file collector failed to scrape.",
+					),
+					n.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"NodeClockSkewDetected",
+			alerting.Expr(
+				promqlbuilder.Or(
+					promqlbuilder.Parenthesis(
+						promqlbuilder.And(
+							promqlbuilder.Gtr(
+								n.nodeExporter("node_timex_offset_seconds"),
+								promqlbuilder.NewNumber(0.05),
+							),
+							promqlbuilder.Gte(
+								promqlbuilder.Deriv(
+									matrix.New(
+										n.nodeExporter("node_timex_offset_seconds"),
+										matrix.WithRange(5*time.Minute),
+									),
+								),
+								promqlbuilder.NewNumber(0),
+							),
+						),
+					),
+					promqlbuilder.Parenthesis(
+						promqlbuilder.And(
+							promqlbuilder.Lss(
+								n.nodeExporter("node_timex_offset_seconds"),
+								promqlbuilder.NewNumber(-0.05),
+							),
+							promqlbuilder.Lte(
+								promqlbuilder.Deriv(
+									matrix.New(
+										n.nodeExporter("node_timex_offset_seconds"),
+										matrix.WithRange(5*time.Minute),
+									),
+								),
+								promqlbuilder.NewNumber(0),
+							),
+						),
+					),
+				),
+			),
+			alerting.For("10m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					n.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						n.DashboardURL,
+						n.RunbookURL,
+						runbookNodeClockSkewDetected,
+						"Clock at {{ $labels.instance }} is out of sync by more than 0.05s. Ensure NTP is configured correctly on this host.",
+						"Clock skew detected.",
+					),
+					n.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"NodeClockNotSynchronising",
+			alerting.Expr(
+				promqlbuilder.And(
+					promqlbuilder.Eqlc(
+						promqlbuilder.MinOverTime(
+							matrix.New(
+								n.nodeExporter("node_timex_sync_status"),
+								matrix.WithRange(5*time.Minute),
+							),
+						),
+						promqlbuilder.NewNumber(0),
+					),
+					promqlbuilder.Gte(
+						n.nodeExporter("node_timex_maxerror_seconds"),
+						promqlbuilder.NewNumber(16),
+					),
+				),
+			),
+			alerting.For("10m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					n.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						n.DashboardURL,
+						n.RunbookURL,
+						runbookNodeClockNotSynchronising,
+						"Clock at {{ $labels.instance }} is not synchronising. Ensure NTP is configured on this host.",
+						"Clock not synchronising.",
+					),
+					n.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"NodeRAIDDegraded",
+			alerting.Expr(
+				promqlbuilder.Gtr(
+					promqlbuilder.Sub(
+						n.nodeExporter("node_md_disks_required", label.New("device").EqualRegexp(n.DiskDeviceSelector)),
+						promqlbuilder.Parenthesis(
+							n.nodeExporter(
+								"node_md_disks",
+								label.New("state").Equal("active"),
+								label.New("device").EqualRegexp(n.DiskDeviceSelector),
+							),
+						),
+					).Ignoring("state"),
+					promqlbuilder.NewNumber(0),
+				),
+			),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "critical",
+					},
+					n.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						n.DashboardURL,
+						n.RunbookURL,
+						runbookNodeRAIDDegraded,
+						"RAID array '{{ $labels.device }}' at {{ $labels.instance }} is in degraded state due to one or more disks failures. Number of spare drives is insufficient to fix issue automatically.",
+						"RAID Array is degraded.",
+					),
+					n.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"NodeRAIDDiskFailure",
+			alerting.Expr(
+				promqlbuilder.Gtr(
+					n.nodeExporter(
+						"node_md_disks",
+						label.New("state").Equal("failed"),
+						label.New("device").EqualRegexp(n.DiskDeviceSelector),
+					),
+					promqlbuilder.NewNumber(0),
+				),
+			),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					n.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						n.DashboardURL,
+						n.RunbookURL,
+						runbookNodeRAIDDiskFailure,
+						"At least one device in RAID array at {{ $labels.instance }} failed. Array '{{ $labels.device }}' needs attention and possibly a disk swap.",
+						"Failed device in RAID array.",
+					),
+					n.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		n.fileDescriptorLimit(70, "warning"),
+		n.fileDescriptorLimit(90, "critical"),
+		rulegroup.AddRule(
+			"NodeCPUHighUsage",
+			alerting.Expr(
+				promqlbuilder.Gtr(
+					promqlbuilder.Mul(
+						promqlbuilder.Sum(
+							promqlbuilder.Avg(
+								promqlbuilder.Rate(
+									matrix.New(
+										n.nodeExporter("node_cpu_seconds_total", label.New("mode").NotEqualRegexp("idle|iowait")),
+										matrix.WithRange(2*time.Minute),
+									),
+								),
+							).Without("cpu"),
+						).Without("mode"),
+						promqlbuilder.NewNumber(100),
+					),
+					promqlbuilder.NewNumber(90),
+				),
+			),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "info",
+					},
+					n.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						n.DashboardURL,
+						n.RunbookURL,
+						runbookNodeCPUHighUsage,
+						"CPU usage at {{ $labels.instance }} has been above 90% for the last 15 minutes, is currently at {{ printf \"%.2f\" $value }}%.",
+						"High CPU usage.",
+					),
+					n.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"NodeSystemSaturation",
+			alerting.Expr(
+				promqlbuilder.Gtr(
+					promqlbuilder.Div(
+						n.nodeExporter("node_load1"),
+						promqlbuilder.Count(
+							n.nodeExporter("node_cpu_seconds_total", label.New("mode").Equal("idle")),
+						).Without("cpu", "mode"),
+					),
+					promqlbuilder.NewNumber(2),
+				),
+			),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					n.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						n.DashboardURL,
+						n.RunbookURL,
+						runbookNodeSystemSaturation,
+						"System load per core at {{ $labels.instance }} has been above 2 for the last 15 minutes, is currently at {{ printf \"%.2f\" $value }}. This might indicate this instance resources saturation and can cause it becoming unresponsive.",
+						"System saturated, load per core is very high.",
+					),
+					n.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"NodeMemoryMajorPagesFaults",
+			alerting.Expr(
+				promqlbuilder.Gtr(
+					promqlbuilder.Rate(
+						matrix.New(
+							n.nodeExporter("node_vmstat_pgmajfault"),
+							matrix.WithRange(5*time.Minute),
+						),
+					),
+					promqlbuilder.NewNumber(500),
+				),
+			),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					n.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						n.DashboardURL,
+						n.RunbookURL,
+						runbookNodeMemoryMajorPagesFaults,
+						"Memory major pages are occurring at very high rate at {{ $labels.instance }}, 500 major page faults per second for the last 15 minutes, is currently at {{ printf \"%.2f\" $value }}. Please check that there is enough memory available at this instance.",
+						"Memory major page faults are occurring at very high rate.",
+					),
+					n.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"NodeMemoryHighUtilization",
+			alerting.Expr(
+				promqlbuilder.Gtr(
+					promqlbuilder.Sub(
+						promqlbuilder.NewNumber(100),
+						promqlbuilder.Parenthesis(
+							promqlbuilder.Mul(
+								promqlbuilder.Div(
+									n.nodeExporter("node_memory_MemAvailable_bytes"),
+									n.nodeExporter("node_memory_MemTotal_bytes"),
+								),
+								promqlbuilder.NewNumber(100),
+							),
+						),
+					),
+					promqlbuilder.NewNumber(90),
+				),
+			),
+			alerting.For("15m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					n.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						n.DashboardURL,
+						n.RunbookURL,
+						runbookNodeMemoryHighUtilization,
+						"Memory is filling up at {{ $labels.instance }}, has been above 90% for the last 15 minutes, is currently at {{ printf \"%.2f\" $value }}%.",
+						"Host is running out of memory.",
+					),
+					n.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"NodeDiskIOSaturation",
+			alerting.Expr(
+				promqlbuilder.Gtr(
+					promqlbuilder.Rate(
+						matrix.New(
+							n.nodeExporter("node_disk_io_time_weighted_seconds_total", label.New("device").EqualRegexp(n.DiskDeviceSelector)),
+							matrix.WithRange(5*time.Minute),
+						),
+					),
+					promqlbuilder.NewNumber(10),
+				),
+			),
+			alerting.For("30m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					n.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						n.DashboardURL,
+						n.RunbookURL,
+						runbookNodeDiskIOSaturation,
+						"Disk IO queue (aqu-sq) is high on {{ $labels.device }} at {{ $labels.instance }}, has been above 10 for the last 30 minutes, is currently at {{ printf \"%.2f\" $value }}. This symptom might indicate disk saturation.",
+						"Disk IO queue is high.",
+					),
+					n.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"NodeSystemdServiceFailed",
+			alerting.Expr(
+				promqlbuilder.Eqlc(
+					n.nodeExporter("node_systemd_unit_state", label.New("state").Equal("failed")),
+					promqlbuilder.NewNumber(1),
+				),
+			),
+			alerting.For("5m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					n.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						n.DashboardURL,
+						n.RunbookURL,
+						runbookNodeSystemdServiceFailed,
+						"Systemd service {{ $labels.name }} has entered failed state at {{ $labels.instance }}.",
+						"Systemd service has entered failed state.",
+					),
+					n.AdditionalAlertAnnotations,
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"NodeBondingDegraded",
+			alerting.Expr(
+				promqlbuilder.Neq(
+					promqlbuilder.Parenthesis(
+						promqlbuilder.Sub(
+							n.nodeExporter("node_bonding_slaves"),
+							n.nodeExporter("node_bonding_active"),
+						),
+					),
+					promqlbuilder.NewNumber(0),
+				),
+			),
+			alerting.For("5m"),
+			alerting.Labels(
+				common.MergeMaps(
+					map[string]string{
+						"severity": "warning",
+					},
+					n.AdditionalAlertLabels,
+				),
+			),
+			alerting.Annotations(
+				common.MergeMaps(
+					common.BuildAnnotations(
+						n.DashboardURL,
+						n.RunbookURL,
+						runbookNodeBondingDegraded,
+						"Bonding interface {{ $labels.master }} on {{ $labels.instance }} is in degraded state due to one or more slave failures.",
+						"Bonding interface is degraded.",
+					),
+					n.AdditionalAlertAnnotations,
+				),
+			),
+		),
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodeexporter
+
+import (
+	nodeExporterPanels "github.com/perses/community-mixins/pkg/panels/node_exporter"
+	rulehelpers "github.com/perses/community-mixins/pkg/rules"
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/promtheusrule"
+)
+
+// Runbook fragments
+const (
+	runbookNodeFilesystemSpaceFillingUp     = "/nodefilesystemspacefillingup"
+	runbookNodeFilesystemAlmostOutOfSpace   = "/nodefilesystemalmostoutofspace"
+	runbookNodeFilesystemFilesFillingUp     = "/nodefilesystemfilesfillingup"
+	runbookNodeFilesystemAlmostOutOfFiles   = "/nodefilesystemalmostoutoffiles"
+	runbookNodeNetworkReceiveErrs           = "/nodenetworkreceiveerrs"
+	runbookNodeNetworkTransmitErrs          = "/nodenetworktransmiterrs"
+	runbookNodeHighNumberConntrackEntries   = "/nodehighnumberconntrackentriesused"
+	runbookNodeTextFileCollectorScrapeError = "/nodetextfilecollectorscrapeerror"
+	runbookNodeClockSkewDetected            = "/nodeclockskewdetected"
+	runbookNodeClockNotSynchronising        = "/nodeclocknotsynchronising"
+	runbookNodeRAIDDegraded                 = "/noderaiddegraded"
+	runbookNodeRAIDDiskFailure              = "/noderaiddiskfailure"
+	runbookNodeFileDescriptorLimit          = "/nodefiledescriptorlimit"
+	runbookNodeCPUHighUsage                 = "/nodecpuhighusage"
+	runbookNodeSystemSaturation             = "/nodesystemsaturation"
+	runbookNodeMemoryMajorPagesFaults       = "/nodememorymajorpagesfaults"
+	runbookNodeMemoryHighUtilization        = "/nodememoryhighutilization"
+	runbookNodeDiskIOSaturation             = "/nodediskiosaturation"
+	runbookNodeSystemdServiceFailed         = "/nodesystemdservicefailed"
+	runbookNodeBondingDegraded              = "/nodebondingdegraded"
+)
+
+type NodeExporterRulesConfig struct {
+	RunbookURL   string
+	DashboardURL string
+
+	NodeExporterSelector string
+	DiskDeviceSelector   string
+
+	AdditionalAlertLabels      map[string]string
+	AdditionalAlertAnnotations map[string]string
+}
+
+type NodeExporterRulesConfigOption func(*NodeExporterRulesConfig)
+
+func WithRunbookURL(runbookURL string) NodeExporterRulesConfigOption {
+	return func(nodeExporterRulesConfig *NodeExporterRulesConfig) {
+		nodeExporterRulesConfig.RunbookURL = runbookURL
+	}
+}
+
+func WithDashboardURL(dashboardURL string) NodeExporterRulesConfigOption {
+	return func(nodeExporterRulesConfig *NodeExporterRulesConfig) {
+		nodeExporterRulesConfig.DashboardURL = dashboardURL
+	}
+}
+
+func WithNodeExporterSelector(nodeExporterSelector string) NodeExporterRulesConfigOption {
+	return func(nodeExporterRulesConfig *NodeExporterRulesConfig) {
+		if nodeExporterSelector == "" {
+			nodeExporterSelector = nodeExporterPanels.DefaultJobLabelValue
+		}
+		nodeExporterRulesConfig.NodeExporterSelector = nodeExporterSelector
+	}
+}
+
+func WithDiskDeviceSelectorRegexp(diskDeviceSelector string) NodeExporterRulesConfigOption {
+	return func(nodeExporterRulesConfig *NodeExporterRulesConfig) {
+		if diskDeviceSelector == "" {
+			diskDeviceSelector = defaultDiskDeviceSelector
+		}
+		nodeExporterRulesConfig.DiskDeviceSelector = diskDeviceSelector
+	}
+}
+
+func WithAdditionalAlertLabels(additionalAlertLabels map[string]string) NodeExporterRulesConfigOption {
+	return func(nodeExporterRulesConfig *NodeExporterRulesConfig) {
+		nodeExporterRulesConfig.AdditionalAlertLabels = additionalAlertLabels
+	}
+}
+
+func WithAdditionalAlertAnnotations(additionalAlertAnnotations map[string]string) NodeExporterRulesConfigOption {
+	return func(nodeExporterRulesConfig *NodeExporterRulesConfig) {
+		nodeExporterRulesConfig.AdditionalAlertAnnotations = additionalAlertAnnotations
+	}
+}
+
+// defaultDiskDeviceSelector matches the block devices node-mixin considers as disks.
+const defaultDiskDeviceSelector = "(/dev/)?(mmcblk.p.+|nvme.+|rbd.+|sd.+|vd.+|xvd.+|dm-.+|md.+|dasd.+)"
+
+// NewNodeExporterRulesBuilder creates a new Node Exporter rules builder.
+// The job selector defaults to the default job label value of the Node Exporter dashboards.
+// Pass the same value to WithNodeExporterSelector and to the dashboards' WithJobLabelValue
+// so that the generated rules and the dashboards look at the same targets.
+func NewNodeExporterRulesBuilder(
+	namespace string,
+	labels map[string]string,
+	annotations map[string]string,
+	options ...NodeExporterRulesConfigOption,
+) (promtheusrule.Builder, error) {
+	nodeExporterRulesConfig := NodeExporterRulesConfig{
+		NodeExporterSelector: nodeExporterPanels.DefaultJobLabelValue,
+		DiskDeviceSelector:   defaultDiskDeviceSelector,
+	}
+	for _, option := range options {
+		option(&nodeExporterRulesConfig)
+	}
+
+	promRule, err := promtheusrule.New(
+		"node-exporter-rules",
+		namespace,
+		promtheusrule.Labels(labels),
+		promtheusrule.Annotations(annotations),
+		promtheusrule.AddRuleGroup(
+			"node-exporter.rules",
+			nodeExporterRulesConfig.NodeExporterRecordingGroup()...,
+		),
+		promtheusrule.AddRuleGroup(
+			"node-exporter-filesystem",
+			nodeExporterRulesConfig.NodeExporterFilesystemGroup()...,
+		),
+		promtheusrule.AddRuleGroup(
+			"node-exporter",
+			nodeExporterRulesConfig.NodeExporterGroup()...,
+		),
+	)
+
+	return promRule, err
+}
+
+// BuildNodeExporterRules builds the Node Exporter rules for the given namespace, dashboard URL, runbook URL, labels, and annotations.
+func BuildNodeExporterRules(
+	namespace string,
+	labels map[string]string,
+	annotations map[string]string,
+	options ...NodeExporterRulesConfigOption,
+) rulehelpers.RuleResult {
+	promRule, err := NewNodeExporterRulesBuilder(namespace, labels, annotations, options...)
+	if err != nil {
+		return rulehelpers.NewRuleResult(nil, err).Component("node-exporter")
+	}
+
+	return rulehelpers.NewRuleResult(
+		&promRule.PrometheusRule,
+		nil,
+	).Component("node-exporter")
+}
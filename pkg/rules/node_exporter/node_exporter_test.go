@@ -0,0 +1,39 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodeexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/perses/community-mixins/pkg/querytest"
+)
+
+func TestNodeExporterRules(t *testing.T) {
+	result := BuildNodeExporterRules("default", nil, nil)
+	require.NoError(t, result.Err())
+
+	querytest.RuleSuite{
+		SeriesFile: "../../panels/node_exporter/testdata/series.test",
+		Groups:     result.Rule().Spec.Groups,
+		Labels: map[string][]string{
+			"NodeFilesystemAlmostOutOfSpace": {`{job="node", instance="10.0.0.2:9100", device="/dev/sda1", fstype="ext4", mountpoint="/"}`},
+			"NodeNetworkReceiveErrs":         {`{job="node", instance="10.0.0.2:9100", device="eth0"}`},
+			"NodeCPUHighUsage":               {`{job="node", instance="10.0.0.2:9100"}`},
+			"NodeRAIDDegraded":               {`{job="node", instance="10.0.0.2:9100", device="md0"}`},
+			"NodeSystemdServiceFailed":       {`{__name__="node_systemd_unit_state", job="node", instance="10.0.0.2:9100", name="backup.service", state="failed"}`},
+		},
+	}.Run(t)
+}
@@ -0,0 +1,171 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodeexporter
+
+import (
+	"time"
+
+	promqlbuilder "github.com/perses/promql-builder"
+	"github.com/perses/promql-builder/label"
+	"github.com/perses/promql-builder/matrix"
+	"github.com/perses/promql-builder/vector"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/recording"
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/rulegroup"
+)
+
+// nodeExporter returns a selector for the given Node Exporter series.
+func (n NodeExporterRulesConfig) nodeExporter(metricName string, matchers ...*labels.Matcher) *parser.VectorSelector {
+	return vector.New(
+		vector.WithMetricName(metricName),
+		vector.WithLabelMatchers(
+			append([]*labels.Matcher{label.New("job").Equal(n.NodeExporterSelector)}, matchers...)...,
+		),
+	)
+}
+
+// networkRateExcludingLo returns the per-instance rate of the given network counter, ignoring the loopback device.
+func (n NodeExporterRulesConfig) networkRateExcludingLo(metricName string) parser.Expr {
+	return promqlbuilder.Sum(
+		promqlbuilder.Rate(
+			matrix.New(
+				n.nodeExporter(metricName, label.New("device").NotEqual("lo")),
+				matrix.WithRange(5*time.Minute),
+			),
+		),
+	).Without("device")
+}
+
+func (n NodeExporterRulesConfig) NodeExporterRecordingGroup() []rulegroup.Option {
+	return []rulegroup.Option{
+		rulegroup.AddRule(
+			"instance:node_num_cpu:sum",
+			recording.Expr(
+				promqlbuilder.Count(
+					n.nodeExporter("node_cpu_seconds_total", label.New("mode").Equal("idle")),
+				).Without("cpu", "mode"),
+			),
+		),
+		rulegroup.AddRule(
+			"instance:node_cpu_utilisation:rate5m",
+			recording.Expr(
+				promqlbuilder.Sub(
+					promqlbuilder.NewNumber(1),
+					promqlbuilder.Avg(
+						promqlbuilder.Sum(
+							promqlbuilder.Rate(
+								matrix.New(
+									n.nodeExporter("node_cpu_seconds_total", label.New("mode").EqualRegexp("idle|iowait|steal")),
+									matrix.WithRange(5*time.Minute),
+								),
+							),
+						).Without("mode"),
+					).Without("cpu"),
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"instance:node_load1_per_cpu:ratio",
+			recording.Expr(
+				promqlbuilder.Parenthesis(
+					promqlbuilder.Div(
+						n.nodeExporter("node_load1"),
+						n.nodeExporter("instance:node_num_cpu:sum"),
+					),
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"instance:node_memory_utilisation:ratio",
+			recording.Expr(
+				promqlbuilder.Sub(
+					promqlbuilder.NewNumber(1),
+					promqlbuilder.Parenthesis(
+						promqlbuilder.Div(
+							promqlbuilder.Parenthesis(
+								promqlbuilder.Or(
+									n.nodeExporter("node_memory_MemAvailable_bytes"),
+									promqlbuilder.Parenthesis(
+										promqlbuilder.Add(
+											promqlbuilder.Add(
+												promqlbuilder.Add(
+													n.nodeExporter("node_memory_Buffers_bytes"),
+													n.nodeExporter("node_memory_Cached_bytes"),
+												),
+												n.nodeExporter("node_memory_MemFree_bytes"),
+											),
+											n.nodeExporter("node_memory_Slab_bytes"),
+										),
+									),
+								),
+							),
+							n.nodeExporter("node_memory_MemTotal_bytes"),
+						),
+					),
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"instance:node_vmstat_pgmajfault:rate5m",
+			recording.Expr(
+				promqlbuilder.Rate(
+					matrix.New(
+						n.nodeExporter("node_vmstat_pgmajfault"),
+						matrix.WithRange(5*time.Minute),
+					),
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"instance_device:node_disk_io_time_seconds:rate5m",
+			recording.Expr(
+				promqlbuilder.Rate(
+					matrix.New(
+						n.nodeExporter("node_disk_io_time_seconds_total", label.New("device").EqualRegexp(n.DiskDeviceSelector)),
+						matrix.WithRange(5*time.Minute),
+					),
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"instance_device:node_disk_io_time_weighted_seconds:rate5m",
+			recording.Expr(
+				promqlbuilder.Rate(
+					matrix.New(
+						n.nodeExporter("node_disk_io_time_weighted_seconds_total", label.New("device").EqualRegexp(n.DiskDeviceSelector)),
+						matrix.WithRange(5*time.Minute),
+					),
+				),
+			),
+		),
+		rulegroup.AddRule(
+			"instance:node_network_receive_bytes_excluding_lo:rate5m",
+			recording.Expr(n.networkRateExcludingLo("node_network_receive_bytes_total")),
+		),
+		rulegroup.AddRule(
+			"instance:node_network_transmit_bytes_excluding_lo:rate5m",
+			recording.Expr(n.networkRateExcludingLo("node_network_transmit_bytes_total")),
+		),
+		rulegroup.AddRule(
+			"instance:node_network_receive_drop_excluding_lo:rate5m",
+			recording.Expr(n.networkRateExcludingLo("node_network_receive_drop_total")),
+		),
+		rulegroup.AddRule(
+			"instance:node_network_transmit_drop_excluding_lo:rate5m",
+			recording.Expr(n.networkRateExcludingLo("node_network_transmit_drop_total")),
+		),
+	}
+}
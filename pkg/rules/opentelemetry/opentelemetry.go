@@ -0,0 +1,308 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opentelemetry
+
+import (
+	"time"
+
+	promqlbuilder "github.com/perses/promql-builder"
+	"github.com/perses/promql-builder/label"
+	"github.com/perses/promql-builder/matrix"
+	"github.com/perses/promql-builder/vector"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+
+	rulehelpers "github.com/perses/community-mixins/pkg/rules"
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/alerting"
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/common"
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/promtheusrule"
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/rulegroup"
+)
+
+// Runbook fragments
+const (
+	runbookOtelcolExporterQueueNearlyFull = "#otelcolexporterqueuenearlyfull"
+	runbookOtelcolExporterSendFailures    = "#otelcolexportersendfailures"
+	runbookOtelcolReceiverRefusedData     = "#otelcolreceiverrefuseddata"
+	runbookOtelcolProcessorDroppedItems   = "#otelcolprocessordroppeditems"
+	runbookOtelcolDown                    = "#otelcoldown"
+)
+
+// signals lists the telemetry signals as named by the receiver and exporter otelcol_* metrics.
+var signals = []struct {
+	name   string
+	suffix string
+}{
+	{name: "spans", suffix: "spans_total"},
+	{name: "metric points", suffix: "metric_points_total"},
+	{name: "log records", suffix: "log_records_total"},
+}
+
+type OpenTelemetryCollectorRulesConfig struct {
+	RunbookURL   string
+	DashboardURL string
+
+	JobSelector string
+
+	AdditionalAlertLabels      map[string]string
+	AdditionalAlertAnnotations map[string]string
+}
+
+type OpenTelemetryCollectorRulesConfigOption func(*OpenTelemetryCollectorRulesConfig)
+
+func WithRunbookURL(runbookURL string) OpenTelemetryCollectorRulesConfigOption {
+	return func(openTelemetryCollectorRulesConfig *OpenTelemetryCollectorRulesConfig) {
+		openTelemetryCollectorRulesConfig.RunbookURL = runbookURL
+	}
+}
+
+func WithDashboardURL(dashboardURL string) OpenTelemetryCollectorRulesConfigOption {
+	return func(openTelemetryCollectorRulesConfig *OpenTelemetryCollectorRulesConfig) {
+		openTelemetryCollectorRulesConfig.DashboardURL = dashboardURL
+	}
+}
+
+// WithJobSelectorRegexp sets the job regexp used to select the collectors.
+func WithJobSelectorRegexp(jobSelector string) OpenTelemetryCollectorRulesConfigOption {
+	return func(openTelemetryCollectorRulesConfig *OpenTelemetryCollectorRulesConfig) {
+		if jobSelector == "" {
+			jobSelector = defaultJobSelector
+		}
+		openTelemetryCollectorRulesConfig.JobSelector = jobSelector
+	}
+}
+
+func WithAdditionalAlertLabels(additionalAlertLabels map[string]string) OpenTelemetryCollectorRulesConfigOption {
+	return func(openTelemetryCollectorRulesConfig *OpenTelemetryCollectorRulesConfig) {
+		openTelemetryCollectorRulesConfig.AdditionalAlertLabels = additionalAlertLabels
+	}
+}
+
+func WithAdditionalAlertAnnotations(additionalAlertAnnotations map[string]string) OpenTelemetryCollectorRulesConfigOption {
+	return func(openTelemetryCollectorRulesConfig *OpenTelemetryCollectorRulesConfig) {
+		openTelemetryCollectorRulesConfig.AdditionalAlertAnnotations = additionalAlertAnnotations
+	}
+}
+
+// defaultJobSelector matches the job names of the collectors deployed by the OpenTelemetry Helm chart and Operator.
+const defaultJobSelector = ".*collector.*"
+
+// NewOpenTelemetryCollectorRulesBuilder creates a new OpenTelemetry Collector rules builder.
+func NewOpenTelemetryCollectorRulesBuilder(
+	namespace string,
+	labels map[string]string,
+	annotations map[string]string,
+	options ...OpenTelemetryCollectorRulesConfigOption,
+) (promtheusrule.Builder, error) {
+	openTelemetryCollectorRulesConfig := OpenTelemetryCollectorRulesConfig{
+		JobSelector: defaultJobSelector,
+	}
+	for _, option := range options {
+		option(&openTelemetryCollectorRulesConfig)
+	}
+
+	promRule, err := promtheusrule.New(
+		"opentelemetry-collector-rules",
+		namespace,
+		promtheusrule.Labels(labels),
+		promtheusrule.Annotations(annotations),
+		promtheusrule.AddRuleGroup(
+			"opentelemetry-collector",
+			openTelemetryCollectorRulesConfig.OpenTelemetryCollectorGroup()...,
+		),
+	)
+
+	return promRule, err
+}
+
+// BuildOpenTelemetryCollectorRules builds the OpenTelemetry Collector rules for the given namespace, dashboard URL, runbook URL, labels, and annotations.
+func BuildOpenTelemetryCollectorRules(
+	namespace string,
+	labels map[string]string,
+	annotations map[string]string,
+	options ...OpenTelemetryCollectorRulesConfigOption,
+) rulehelpers.RuleResult {
+	promRule, err := NewOpenTelemetryCollectorRulesBuilder(namespace, labels, annotations, options...)
+	if err != nil {
+		return rulehelpers.NewRuleResult(nil, err).Component("opentelemetry-collector")
+	}
+
+	return rulehelpers.NewRuleResult(
+		&promRule.PrometheusRule,
+		nil,
+	).Component("opentelemetry-collector")
+}
+
+// collector returns a selector for the given series, scoped to the collector jobs.
+func (o OpenTelemetryCollectorRulesConfig) collector(metricName string, matchers ...*labels.Matcher) *parser.VectorSelector {
+	return vector.New(
+		vector.WithMetricName(metricName),
+		vector.WithLabelMatchers(
+			append([]*labels.Matcher{label.New("job").EqualRegexp(o.JobSelector)}, matchers...)...,
+		),
+	)
+}
+
+// sumRate returns the 5m rate of the given counter, aggregated by the given labels.
+func (o OpenTelemetryCollectorRulesConfig) sumRate(metricName string, by []string, matchers ...*labels.Matcher) parser.Expr {
+	return promqlbuilder.Sum(
+		promqlbuilder.Rate(
+			matrix.New(
+				o.collector(metricName, matchers...),
+				matrix.WithRange(5*time.Minute),
+			),
+		),
+	).By(by...)
+}
+
+// failureRatio returns the ratio of the failed counter over the sum of the succeeded and failed counters.
+func (o OpenTelemetryCollectorRulesConfig) failureRatio(failedMetric, succeededMetric string, by []string) parser.Expr {
+	return promqlbuilder.Div(
+		o.sumRate(failedMetric, by),
+		promqlbuilder.Parenthesis(
+			promqlbuilder.Add(
+				o.sumRate(succeededMetric, by),
+				o.sumRate(failedMetric, by),
+			),
+		),
+	)
+}
+
+func (o OpenTelemetryCollectorRulesConfig) alert(
+	alertName, runbookFragment string,
+	expr parser.Expr,
+	forDuration, severity, description, summary string,
+) rulegroup.Option {
+	return rulegroup.AddRule(
+		alertName,
+		alerting.Expr(expr),
+		alerting.For(forDuration),
+		alerting.Labels(
+			common.MergeMaps(
+				map[string]string{
+					"severity": severity,
+				},
+				o.AdditionalAlertLabels,
+			),
+		),
+		alerting.Annotations(
+			common.MergeMaps(
+				common.BuildAnnotations(
+					o.DashboardURL,
+					o.RunbookURL,
+					runbookFragment,
+					description,
+					summary,
+				),
+				o.AdditionalAlertAnnotations,
+			),
+		),
+	)
+}
+
+func (o OpenTelemetryCollectorRulesConfig) OpenTelemetryCollectorGroup() []rulegroup.Option {
+	options := []rulegroup.Option{
+		o.alert(
+			"OtelcolExporterQueueNearlyFull",
+			runbookOtelcolExporterQueueNearlyFull,
+			promqlbuilder.Gtr(
+				promqlbuilder.Div(
+					promqlbuilder.Max(o.collector("otelcol_exporter_queue_size")).By("job", "instance", "exporter"),
+					promqlbuilder.Min(o.collector("otelcol_exporter_queue_capacity")).By("job", "instance", "exporter"),
+				),
+				promqlbuilder.NewNumber(0.8),
+			),
+			"15m",
+			"warning",
+			"The sending queue of exporter {{ $labels.exporter }} on collector {{ $labels.job }}/{{ $labels.instance }} is {{ $value | humanizePercentage }} full. The collector drops data once the queue is full.",
+			"OpenTelemetry Collector exporter queue is nearly full.",
+		),
+	}
+
+	for _, signal := range signals {
+		options = append(options, o.alert(
+			"OtelcolExporterSendFailures",
+			runbookOtelcolExporterSendFailures,
+			promqlbuilder.Gtr(
+				o.failureRatio(
+					"otelcol_exporter_send_failed_"+signal.suffix,
+					"otelcol_exporter_sent_"+signal.suffix,
+					[]string{"job", "exporter"},
+				),
+				promqlbuilder.NewNumber(0.01),
+			),
+			"15m",
+			"warning",
+			"Exporter {{ $labels.exporter }} on collector {{ $labels.job }} fails to send {{ $value | humanizePercentage }} of its "+signal.name+".",
+			"OpenTelemetry Collector exporter is failing to send data.",
+		))
+	}
+
+	for _, signal := range signals {
+		options = append(options, o.alert(
+			"OtelcolReceiverRefusedData",
+			runbookOtelcolReceiverRefusedData,
+			promqlbuilder.Gtr(
+				o.failureRatio(
+					"otelcol_receiver_refused_"+signal.suffix,
+					"otelcol_receiver_accepted_"+signal.suffix,
+					[]string{"job", "receiver"},
+				),
+				promqlbuilder.NewNumber(0.01),
+			),
+			"15m",
+			"warning",
+			"Receiver {{ $labels.receiver }} on collector {{ $labels.job }} refuses {{ $value | humanizePercentage }} of the incoming "+signal.name+".",
+			"OpenTelemetry Collector receiver is refusing data.",
+		))
+	}
+
+	processorBy := []string{"job", "processor", "otel_signal"}
+	options = append(options,
+		o.alert(
+			"OtelcolProcessorDroppedItems",
+			runbookOtelcolProcessorDroppedItems,
+			promqlbuilder.Gtr(
+				promqlbuilder.Div(
+					promqlbuilder.Parenthesis(
+						promqlbuilder.Sub(
+							o.sumRate("otelcol_processor_incoming_items_total", processorBy),
+							o.sumRate("otelcol_processor_outgoing_items_total", processorBy),
+						),
+					),
+					o.sumRate("otelcol_processor_incoming_items_total", processorBy),
+				),
+				promqlbuilder.NewNumber(0.05),
+			),
+			"15m",
+			"warning",
+			"Processor {{ $labels.processor }} on collector {{ $labels.job }} drops {{ $value | humanizePercentage }} of the incoming {{ $labels.otel_signal }}. This is expected for processors filtering or sampling data.",
+			"OpenTelemetry Collector processor is dropping data.",
+		),
+		o.alert(
+			"OtelcolDown",
+			runbookOtelcolDown,
+			promqlbuilder.Eqlc(
+				o.collector("up"),
+				promqlbuilder.NewNumber(0),
+			),
+			"5m",
+			"critical",
+			"Prometheus fails to scrape collector {{ $labels.job }}/{{ $labels.instance }}.",
+			"OpenTelemetry Collector is down.",
+		),
+	)
+
+	return options
+}
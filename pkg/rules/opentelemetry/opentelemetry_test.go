@@ -0,0 +1,43 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opentelemetry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/perses/community-mixins/pkg/querytest"
+)
+
+func TestOpenTelemetryCollectorRules(t *testing.T) {
+	result := BuildOpenTelemetryCollectorRules("default", nil, nil)
+	require.NoError(t, result.Err())
+
+	querytest.RuleSuite{
+		SeriesFile: "../../panels/opentelemetry/testdata/series.test",
+		Groups:     result.Rule().Spec.Groups,
+		Labels: map[string][]string{
+			"OtelcolExporterQueueNearlyFull": {`{job="otel-collector", instance="otel-collector-0:8888", exporter="otlp"}`},
+			"OtelcolExporterSendFailures":    {`{job="otel-collector", exporter="otlp"}`},
+			"OtelcolReceiverRefusedData":     {`{job="otel-collector", receiver="otlp"}`},
+			"OtelcolProcessorDroppedItems": {
+				`{job="otel-collector", processor="memory_limiter", otel_signal="traces"}`,
+				`{job="otel-collector", processor="memory_limiter", otel_signal="metrics"}`,
+				`{job="otel-collector", processor="memory_limiter", otel_signal="logs"}`,
+			},
+			"OtelcolDown": {`{__name__="up", job="otel-collector", instance="otel-collector-1:8888"}`},
+		},
+	}.Run(t)
+}
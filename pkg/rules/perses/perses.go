@@ -0,0 +1,269 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package perses
+
+import (
+	"time"
+
+	promqlbuilder "github.com/perses/promql-builder"
+	"github.com/perses/promql-builder/label"
+	"github.com/perses/promql-builder/matrix"
+	"github.com/perses/promql-builder/vector"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+
+	rulehelpers "github.com/perses/community-mixins/pkg/rules"
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/alerting"
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/common"
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/promtheusrule"
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/rulegroup"
+)
+
+// Runbook fragments
+const (
+	runbookPersesHighHTTPErrorRate        = "#perseshighhttperrorrate"
+	runbookPersesHighHTTPLatency          = "#perseshighhttplatency"
+	runbookPersesFileDescriptorExhaustion = "#persesfiledescriptorexhaustion"
+	runbookPersesPluginSchemaLoadFailures = "#persespluginschemaloadfailures"
+	runbookPersesDown                     = "#persesdown"
+)
+
+type PersesRulesConfig struct {
+	RunbookURL   string
+	DashboardURL string
+
+	PersesSelector string
+
+	AdditionalAlertLabels      map[string]string
+	AdditionalAlertAnnotations map[string]string
+}
+
+type PersesRulesConfigOption func(*PersesRulesConfig)
+
+func WithRunbookURL(runbookURL string) PersesRulesConfigOption {
+	return func(persesRulesConfig *PersesRulesConfig) {
+		persesRulesConfig.RunbookURL = runbookURL
+	}
+}
+
+func WithDashboardURL(dashboardURL string) PersesRulesConfigOption {
+	return func(persesRulesConfig *PersesRulesConfig) {
+		persesRulesConfig.DashboardURL = dashboardURL
+	}
+}
+
+func WithPersesSelector(persesSelector string) PersesRulesConfigOption {
+	return func(persesRulesConfig *PersesRulesConfig) {
+		if persesSelector == "" {
+			persesSelector = "perses"
+		}
+		persesRulesConfig.PersesSelector = persesSelector
+	}
+}
+
+func WithAdditionalAlertLabels(additionalAlertLabels map[string]string) PersesRulesConfigOption {
+	return func(persesRulesConfig *PersesRulesConfig) {
+		persesRulesConfig.AdditionalAlertLabels = additionalAlertLabels
+	}
+}
+
+func WithAdditionalAlertAnnotations(additionalAlertAnnotations map[string]string) PersesRulesConfigOption {
+	return func(persesRulesConfig *PersesRulesConfig) {
+		persesRulesConfig.AdditionalAlertAnnotations = additionalAlertAnnotations
+	}
+}
+
+// NewPersesRulesBuilder creates a new Perses rules builder.
+func NewPersesRulesBuilder(
+	namespace string,
+	labels map[string]string,
+	annotations map[string]string,
+	options ...PersesRulesConfigOption,
+) (promtheusrule.Builder, error) {
+	persesRulesConfig := PersesRulesConfig{
+		PersesSelector: "perses",
+	}
+	for _, option := range options {
+		option(&persesRulesConfig)
+	}
+
+	promRule, err := promtheusrule.New(
+		"perses-rules",
+		namespace,
+		promtheusrule.Labels(labels),
+		promtheusrule.Annotations(annotations),
+		promtheusrule.AddRuleGroup(
+			"perses",
+			persesRulesConfig.PersesGroup()...,
+		),
+	)
+
+	return promRule, err
+}
+
+// BuildPersesRules builds the Perses rules for the given namespace, dashboard URL, runbook URL, labels, and annotations.
+func BuildPersesRules(
+	namespace string,
+	labels map[string]string,
+	annotations map[string]string,
+	options ...PersesRulesConfigOption,
+) rulehelpers.RuleResult {
+	promRule, err := NewPersesRulesBuilder(namespace, labels, annotations, options...)
+	if err != nil {
+		return rulehelpers.NewRuleResult(nil, err).Component("perses")
+	}
+
+	return rulehelpers.NewRuleResult(
+		&promRule.PrometheusRule,
+		nil,
+	).Component("perses")
+}
+
+// perses returns a selector for the given series, scoped to the Perses job.
+func (p PersesRulesConfig) perses(metricName string, matchers ...*labels.Matcher) *parser.VectorSelector {
+	return vector.New(
+		vector.WithMetricName(metricName),
+		vector.WithLabelMatchers(
+			append([]*labels.Matcher{label.New("job").Equal(p.PersesSelector)}, matchers...)...,
+		),
+	)
+}
+
+// sumRate returns the 5m rate of the given counter, aggregated by the given labels.
+func (p PersesRulesConfig) sumRate(metricName string, by []string, matchers ...*labels.Matcher) parser.Expr {
+	return promqlbuilder.Sum(
+		promqlbuilder.Rate(
+			matrix.New(
+				p.perses(metricName, matchers...),
+				matrix.WithRange(5*time.Minute),
+			),
+		),
+	).By(by...)
+}
+
+func (p PersesRulesConfig) alert(
+	alertName, runbookFragment string,
+	expr parser.Expr,
+	forDuration, severity, description, summary string,
+) rulegroup.Option {
+	return rulegroup.AddRule(
+		alertName,
+		alerting.Expr(expr),
+		alerting.For(forDuration),
+		alerting.Labels(
+			common.MergeMaps(
+				map[string]string{
+					"severity": severity,
+				},
+				p.AdditionalAlertLabels,
+			),
+		),
+		alerting.Annotations(
+			common.MergeMaps(
+				common.BuildAnnotations(
+					p.DashboardURL,
+					p.RunbookURL,
+					runbookFragment,
+					description,
+					summary,
+				),
+				p.AdditionalAlertAnnotations,
+			),
+		),
+	)
+}
+
+func (p PersesRulesConfig) PersesGroup() []rulegroup.Option {
+	return []rulegroup.Option{
+		p.alert(
+			"PersesHighHTTPErrorRate",
+			runbookPersesHighHTTPErrorRate,
+			promqlbuilder.Gtr(
+				promqlbuilder.Div(
+					p.sumRate("perses_http_request_total", []string{"job", "instance"}, label.New("code").EqualRegexp("5..")),
+					p.sumRate("perses_http_request_total", []string{"job", "instance"}),
+				),
+				promqlbuilder.NewNumber(0.05),
+			),
+			"15m",
+			"warning",
+			"{{ $value | humanizePercentage }} of the HTTP requests served by Perses {{ $labels.instance }} are failing with 5xx responses.",
+			"Perses is failing to serve HTTP requests.",
+		),
+		p.alert(
+			"PersesHighHTTPLatency",
+			runbookPersesHighHTTPLatency,
+			promqlbuilder.Gtr(
+				promqlbuilder.HistogramQuantile(
+					0.99,
+					p.sumRate("perses_http_request_duration_second_bucket", []string{"job", "instance", "le"}),
+				),
+				promqlbuilder.NewNumber(1),
+			),
+			"15m",
+			"warning",
+			"The 99th percentile latency of the HTTP requests served by Perses {{ $labels.instance }} is {{ $value | humanizeDuration }}.",
+			"Perses is slow to serve HTTP requests.",
+		),
+		p.alert(
+			"PersesFileDescriptorExhaustion",
+			runbookPersesFileDescriptorExhaustion,
+			promqlbuilder.Gtr(
+				promqlbuilder.Div(
+					p.perses("process_open_fds"),
+					p.perses("process_max_fds"),
+				),
+				promqlbuilder.NewNumber(0.8),
+			),
+			"15m",
+			"warning",
+			"Perses {{ $labels.instance }} uses {{ $value | humanizePercentage }} of its available file descriptors.",
+			"Perses is running out of file descriptors.",
+		),
+		p.alert(
+			"PersesPluginSchemaLoadFailures",
+			runbookPersesPluginSchemaLoadFailures,
+			promqlbuilder.Gtr(
+				promqlbuilder.Increase(
+					matrix.New(
+						p.perses(
+							"perses_plugin_schemas_load_attempts",
+							label.New("status").NotEqual("success"),
+						),
+						matrix.WithRange(10*time.Minute),
+					),
+				),
+				promqlbuilder.NewNumber(0),
+			),
+			"15m",
+			"warning",
+			"Perses {{ $labels.instance }} fails to load the plugin schema {{ $labels.schema }}.",
+			"Perses is failing to load plugin schemas.",
+		),
+		p.alert(
+			"PersesDown",
+			runbookPersesDown,
+			promqlbuilder.Absent(
+				promqlbuilder.Eqlc(
+					p.perses("up"),
+					promqlbuilder.NewNumber(1),
+				),
+			),
+			"5m",
+			"critical",
+			"Perses has disappeared from Prometheus target discovery.",
+			"Perses is down.",
+		),
+	}
+}
@@ -0,0 +1,416 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"fmt"
+	"time"
+
+	promqlbuilder "github.com/perses/promql-builder"
+	"github.com/perses/promql-builder/label"
+	"github.com/perses/promql-builder/matrix"
+	"github.com/perses/promql-builder/vector"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/alerting"
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/common"
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/rulegroup"
+)
+
+// prometheus returns a selector for the given series, scoped to the Prometheus job.
+func (p PrometheusRulesConfig) prometheus(metricName string, matchers ...*labels.Matcher) *parser.VectorSelector {
+	return vector.New(
+		vector.WithMetricName(metricName),
+		vector.WithLabelMatchers(
+			append([]*labels.Matcher{label.New("job").Equal(p.PrometheusSelector)}, matchers...)...,
+		),
+	)
+}
+
+// prometheusRange returns a range selector for the given series, scoped to the Prometheus job.
+func (p PrometheusRulesConfig) prometheusRange(metricName string, rangeDuration time.Duration) *parser.MatrixSelector {
+	return matrix.New(
+		p.prometheus(metricName),
+		matrix.WithRange(rangeDuration),
+	)
+}
+
+// increased returns an expression matching when the given counter increased over the range.
+func (p PrometheusRulesConfig) increased(metricName string, rangeDuration time.Duration) parser.Expr {
+	return promqlbuilder.Gtr(
+		promqlbuilder.Increase(p.prometheusRange(metricName, rangeDuration)),
+		promqlbuilder.NewNumber(0),
+	)
+}
+
+// remoteStorageRate returns the rate of a remote storage counter under both its legacy and current name,
+// so that the alerts work against older and newer Prometheus versions.
+func (p PrometheusRulesConfig) remoteStorageRate(oldMetricName, metricName string) parser.Expr {
+	return promqlbuilder.Parenthesis(
+		promqlbuilder.Or(
+			promqlbuilder.Rate(p.prometheusRange(oldMetricName, 5*time.Minute)),
+			promqlbuilder.Rate(p.prometheusRange(metricName, 5*time.Minute)),
+		),
+	)
+}
+
+func (p PrometheusRulesConfig) alert(
+	alertName, runbookFragment, dashboardURL string,
+	expr parser.Expr,
+	forDuration, severity, description, summary string,
+) rulegroup.Option {
+	return rulegroup.AddRule(
+		alertName,
+		alerting.Expr(expr),
+		alerting.For(forDuration),
+		alerting.Labels(
+			common.MergeMaps(
+				map[string]string{
+					"severity": severity,
+				},
+				p.AdditionalAlertLabels,
+			),
+		),
+		alerting.Annotations(
+			common.MergeMaps(
+				common.BuildAnnotations(
+					dashboardURL,
+					p.RunbookURL,
+					runbookFragment,
+					description,
+					summary,
+				),
+				p.AdditionalAlertAnnotations,
+			),
+		),
+	)
+}
+
+func (p PrometheusRulesConfig) PrometheusGroup() []rulegroup.Option {
+	return []rulegroup.Option{
+		p.alert(
+			"PrometheusBadConfig",
+			runbookPrometheusBadConfig,
+			p.OverviewDashboardURL,
+			promqlbuilder.Eqlc(
+				promqlbuilder.MaxOverTime(p.prometheusRange("prometheus_config_last_reload_successful", 5*time.Minute)),
+				promqlbuilder.NewNumber(0),
+			),
+			"10m",
+			"critical",
+			"Prometheus {{$labels.instance}} has failed to reload its configuration.",
+			"Failed Prometheus configuration reload.",
+		),
+		p.alert(
+			"PrometheusSDRefreshFailure",
+			runbookPrometheusSDRefreshFailure,
+			p.OverviewDashboardURL,
+			p.increased("prometheus_sd_refresh_failures_total", 10*time.Minute),
+			"20m",
+			"warning",
+			"Prometheus {{$labels.instance}} has failed to refresh SD with mechanism {{$labels.mechanism}}.",
+			"Failed Prometheus SD refresh.",
+		),
+		p.alert(
+			"PrometheusNotificationQueueRunningFull",
+			runbookPrometheusNotificationQueueRunningFull,
+			p.OverviewDashboardURL,
+			promqlbuilder.Gtr(
+				promqlbuilder.PredictLinear(p.prometheusRange("prometheus_notifications_queue_length", 5*time.Minute), 60*30),
+				promqlbuilder.MinOverTime(p.prometheusRange("prometheus_notifications_queue_capacity", 5*time.Minute)),
+			),
+			"15m",
+			"warning",
+			"Alert notification queue of Prometheus {{$labels.instance}} is running full.",
+			"Prometheus alert notification queue predicted to run full in less than 30m.",
+		),
+		p.alert(
+			"PrometheusErrorSendingAlertsToSomeAlertmanagers",
+			runbookPrometheusErrorSendingAlertsToSomeAlertmanagers,
+			p.OverviewDashboardURL,
+			promqlbuilder.Gtr(
+				promqlbuilder.Mul(
+					promqlbuilder.Parenthesis(
+						promqlbuilder.Div(
+							promqlbuilder.Rate(p.prometheusRange("prometheus_notifications_errors_total", 5*time.Minute)),
+							promqlbuilder.Rate(p.prometheusRange("prometheus_notifications_sent_total", 5*time.Minute)),
+						),
+					),
+					promqlbuilder.NewNumber(100),
+				),
+				promqlbuilder.NewNumber(1),
+			),
+			"15m",
+			"warning",
+			"{{ printf \"%.1f\" $value }}% errors while sending alerts from Prometheus {{$labels.instance}} to Alertmanager {{$labels.alertmanager}}.",
+			"Prometheus has encountered more than 1% errors sending alerts to a specific Alertmanager.",
+		),
+		p.alert(
+			"PrometheusErrorSendingAlertsToAnyAlertmanager",
+			runbookPrometheusErrorSendingAlertsToAnyAlertmanager,
+			p.OverviewDashboardURL,
+			promqlbuilder.Gtr(
+				promqlbuilder.Mul(
+					promqlbuilder.Min(
+						promqlbuilder.Div(
+							promqlbuilder.Rate(p.prometheusRange("prometheus_notifications_errors_total", 5*time.Minute)),
+							promqlbuilder.Rate(p.prometheusRange("prometheus_notifications_sent_total", 5*time.Minute)),
+						),
+					).Without("alertmanager"),
+					promqlbuilder.NewNumber(100),
+				),
+				promqlbuilder.NewNumber(3),
+			),
+			"15m",
+			"critical",
+			"{{ printf \"%.1f\" $value }}% minimum errors while sending alerts from Prometheus {{$labels.instance}} to any Alertmanager.",
+			"Prometheus encounters more than 3% errors sending alerts to any Alertmanager.",
+		),
+		p.alert(
+			"PrometheusNotConnectedToAlertmanagers",
+			runbookPrometheusNotConnectedToAlertmanagers,
+			p.OverviewDashboardURL,
+			promqlbuilder.Lss(
+				promqlbuilder.MaxOverTime(p.prometheusRange("prometheus_notifications_alertmanagers_discovered", 5*time.Minute)),
+				promqlbuilder.NewNumber(1),
+			),
+			"10m",
+			"warning",
+			"Prometheus {{$labels.instance}} is not connected to any Alertmanagers.",
+			"Prometheus is not connected to any Alertmanagers.",
+		),
+		p.alert(
+			"PrometheusTSDBReloadsFailing",
+			runbookPrometheusTSDBReloadsFailing,
+			p.OverviewDashboardURL,
+			p.increased("prometheus_tsdb_reloads_failures_total", 3*time.Hour),
+			"4h",
+			"warning",
+			"Prometheus {{$labels.instance}} has detected {{$value | humanize}} reload failures over the last 3h.",
+			"Prometheus has issues reloading blocks from disk.",
+		),
+		p.alert(
+			"PrometheusTSDBCompactionsFailing",
+			runbookPrometheusTSDBCompactionsFailing,
+			p.OverviewDashboardURL,
+			p.increased("prometheus_tsdb_compactions_failed_total", 3*time.Hour),
+			"4h",
+			"warning",
+			"Prometheus {{$labels.instance}} has detected {{$value | humanize}} compaction failures over the last 3h.",
+			"Prometheus has issues compacting blocks.",
+		),
+		p.alert(
+			"PrometheusNotIngestingSamples",
+			runbookPrometheusNotIngestingSamples,
+			p.OverviewDashboardURL,
+			promqlbuilder.And(
+				promqlbuilder.Lte(
+					promqlbuilder.Sum(
+						promqlbuilder.Rate(p.prometheusRange("prometheus_tsdb_head_samples_appended_total", 5*time.Minute)),
+					).Without("type"),
+					promqlbuilder.NewNumber(0),
+				),
+				promqlbuilder.Parenthesis(
+					promqlbuilder.Or(
+						promqlbuilder.Gtr(
+							promqlbuilder.Sum(p.prometheus("prometheus_target_metadata_cache_entries")).Without("scrape_job"),
+							promqlbuilder.NewNumber(0),
+						),
+						promqlbuilder.Gtr(
+							promqlbuilder.Sum(p.prometheus("prometheus_rule_group_rules")).Without("rule_group"),
+							promqlbuilder.NewNumber(0),
+						),
+					),
+				),
+			),
+			"10m",
+			"warning",
+			"Prometheus {{$labels.instance}} is not ingesting samples.",
+			"Prometheus is not ingesting samples.",
+		),
+		p.alert(
+			"PrometheusDuplicateTimestamps",
+			runbookPrometheusDuplicateTimestamps,
+			p.OverviewDashboardURL,
+			promqlbuilder.Gtr(
+				promqlbuilder.Rate(p.prometheusRange("prometheus_target_scrapes_sample_duplicate_timestamp_total", 5*time.Minute)),
+				promqlbuilder.NewNumber(0),
+			),
+			"10m",
+			"warning",
+			"Prometheus {{$labels.instance}} is dropping {{ printf \"%.4g\" $value }} samples/s with different values but duplicated timestamp.",
+			"Prometheus is dropping samples with duplicate timestamps.",
+		),
+		p.alert(
+			"PrometheusOutOfOrderTimestamps",
+			runbookPrometheusOutOfOrderTimestamps,
+			p.OverviewDashboardURL,
+			promqlbuilder.Gtr(
+				promqlbuilder.Rate(p.prometheusRange("prometheus_target_scrapes_sample_out_of_order_total", 5*time.Minute)),
+				promqlbuilder.NewNumber(0),
+			),
+			"10m",
+			"warning",
+			"Prometheus {{$labels.instance}} is dropping {{ printf \"%.4g\" $value }} samples/s with timestamps arriving out of order.",
+			"Prometheus drops samples with out-of-order timestamps.",
+		),
+		p.alert(
+			"PrometheusRemoteStorageFailures",
+			runbookPrometheusRemoteStorageFailures,
+			p.RemoteWriteDashboardURL,
+			promqlbuilder.Gtr(
+				promqlbuilder.Mul(
+					promqlbuilder.Parenthesis(
+						promqlbuilder.Div(
+							p.remoteStorageRate("prometheus_remote_storage_failed_samples_total", "prometheus_remote_storage_samples_failed_total"),
+							promqlbuilder.Parenthesis(
+								promqlbuilder.Add(
+									p.remoteStorageRate("prometheus_remote_storage_failed_samples_total", "prometheus_remote_storage_samples_failed_total"),
+									p.remoteStorageRate("prometheus_remote_storage_succeeded_samples_total", "prometheus_remote_storage_samples_total"),
+								),
+							),
+						),
+					),
+					promqlbuilder.NewNumber(100),
+				),
+				promqlbuilder.NewNumber(1),
+			),
+			"15m",
+			"critical",
+			"Prometheus {{$labels.instance}} failed to send {{ printf \"%.1f\" $value }}% of the samples to {{ $labels.remote_name}}:{{ $labels.url }}",
+			"Prometheus fails to send samples to remote storage.",
+		),
+		p.alert(
+			"PrometheusRemoteWriteBehind",
+			runbookPrometheusRemoteWriteBehind,
+			p.RemoteWriteDashboardURL,
+			promqlbuilder.Gtr(
+				promqlbuilder.Parenthesis(
+					promqlbuilder.Sub(
+						promqlbuilder.MaxOverTime(p.prometheusRange("prometheus_remote_storage_highest_timestamp_in_seconds", 5*time.Minute)),
+						promqlbuilder.MaxOverTime(p.prometheusRange("prometheus_remote_storage_queue_highest_sent_timestamp_seconds", 5*time.Minute)),
+					).Ignoring("remote_name", "url").GroupRight(),
+				),
+				promqlbuilder.NewNumber(120),
+			),
+			"15m",
+			"critical",
+			"Prometheus {{$labels.instance}} remote write is {{ printf \"%.1f\" $value }}s behind for {{ $labels.remote_name}}:{{ $labels.url }}.",
+			"Prometheus remote write is behind.",
+		),
+		p.alert(
+			"PrometheusRemoteWriteDesiredShards",
+			runbookPrometheusRemoteWriteDesiredShards,
+			p.RemoteWriteDashboardURL,
+			promqlbuilder.Gtr(
+				promqlbuilder.MaxOverTime(p.prometheusRange("prometheus_remote_storage_shards_desired", 5*time.Minute)),
+				promqlbuilder.MaxOverTime(p.prometheusRange("prometheus_remote_storage_shards_max", 5*time.Minute)),
+			),
+			"15m",
+			"warning",
+			fmt.Sprintf(
+				"Prometheus {{$labels.instance}} remote write desired shards calculation wants to run {{ $value }} shards for queue {{ $labels.remote_name}}:{{ $labels.url }}, which is more than the max of {{ printf `prometheus_remote_storage_shards_max{instance=\"%%s\",job=\"%s\"}` $labels.instance | query | first | value }}.",
+				p.PrometheusSelector,
+			),
+			"Prometheus remote write desired shards calculation wants to run more than configured max shards.",
+		),
+		p.alert(
+			"PrometheusRuleFailures",
+			runbookPrometheusRuleFailures,
+			p.OverviewDashboardURL,
+			p.increased("prometheus_rule_evaluation_failures_total", 5*time.Minute),
+			"15m",
+			"critical",
+			"Prometheus {{$labels.instance}} has failed to evaluate {{ printf \"%.0f\" $value }} rules in the last 5m.",
+			"Prometheus is failing rule evaluations.",
+		),
+		p.alert(
+			"PrometheusMissingRuleEvaluations",
+			runbookPrometheusMissingRuleEvaluations,
+			p.OverviewDashboardURL,
+			p.increased("prometheus_rule_group_iterations_missed_total", 5*time.Minute),
+			"15m",
+			"warning",
+			"Prometheus {{$labels.instance}} has missed {{ printf \"%.0f\" $value }} rule group evaluations in the last 5m.",
+			"Prometheus is missing rule evaluations due to slow rule group evaluation.",
+		),
+		p.alert(
+			"PrometheusTargetLimitHit",
+			runbookPrometheusTargetLimitHit,
+			p.OverviewDashboardURL,
+			p.increased("prometheus_target_scrape_pool_exceeded_target_limit_total", 5*time.Minute),
+			"15m",
+			"warning",
+			"Prometheus {{$labels.instance}} has dropped {{ printf \"%.0f\" $value }} targets because the number of targets exceeded the configured target_limit.",
+			"Prometheus has dropped targets because some scrape configs have exceeded the targets limit.",
+		),
+		p.alert(
+			"PrometheusLabelLimitHit",
+			runbookPrometheusLabelLimitHit,
+			p.OverviewDashboardURL,
+			p.increased("prometheus_target_scrape_pool_exceeded_label_limits_total", 5*time.Minute),
+			"15m",
+			"warning",
+			"Prometheus {{$labels.instance}} has dropped {{ printf \"%.0f\" $value }} targets because some samples exceeded the configured label_limit, label_name_length_limit or label_value_length_limit.",
+			"Prometheus has dropped targets because some scrape configs have exceeded the labels limit.",
+		),
+		p.alert(
+			"PrometheusScrapeBodySizeLimitHit",
+			runbookPrometheusScrapeBodySizeLimitHit,
+			p.OverviewDashboardURL,
+			p.increased("prometheus_target_scrapes_exceeded_body_size_limit_total", 5*time.Minute),
+			"15m",
+			"warning",
+			"Prometheus {{$labels.instance}} has failed {{ printf \"%.0f\" $value }} scrapes in the last 5m because some targets exceeded the configured body_size_limit.",
+			"Prometheus has dropped some targets that exceeded body size limit.",
+		),
+		p.alert(
+			"PrometheusScrapeSampleLimitHit",
+			runbookPrometheusScrapeSampleLimitHit,
+			p.OverviewDashboardURL,
+			p.increased("prometheus_target_scrapes_exceeded_sample_limit_total", 5*time.Minute),
+			"15m",
+			"warning",
+			"Prometheus {{$labels.instance}} has failed {{ printf \"%.0f\" $value }} scrapes in the last 5m because some targets exceeded the configured sample_limit.",
+			"Prometheus has failed scrapes that have exceeded the configured sample limit.",
+		),
+		p.alert(
+			"PrometheusTargetSyncFailure",
+			runbookPrometheusTargetSyncFailure,
+			p.OverviewDashboardURL,
+			p.increased("prometheus_target_sync_failed_total", 30*time.Minute),
+			"5m",
+			"critical",
+			"{{ printf \"%.0f\" $value }} targets in Prometheus {{$labels.instance}} have failed to sync because invalid configuration was supplied.",
+			"Prometheus has failed to sync targets.",
+		),
+		p.alert(
+			"PrometheusHighQueryLoad",
+			runbookPrometheusHighQueryLoad,
+			p.OverviewDashboardURL,
+			promqlbuilder.Gtr(
+				promqlbuilder.Div(
+					promqlbuilder.AvgOverTime(p.prometheusRange("prometheus_engine_queries", 5*time.Minute)),
+					promqlbuilder.MaxOverTime(p.prometheusRange("prometheus_engine_queries_concurrent_max", 5*time.Minute)),
+				),
+				promqlbuilder.NewNumber(0.8),
+			),
+			"15m",
+			"warning",
+			"Prometheus {{$labels.instance}} query API has less than 20% available capacity in its query engine for the last 15 minutes.",
+			"Prometheus is reaching its maximum capacity serving concurrent requests.",
+		),
+	}
+}
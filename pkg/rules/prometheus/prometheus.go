@@ -0,0 +1,148 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	rulehelpers "github.com/perses/community-mixins/pkg/rules"
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/promtheusrule"
+)
+
+// Runbook fragments
+const (
+	runbookPrometheusBadConfig                             = "/prometheusbadconfig"
+	runbookPrometheusSDRefreshFailure                      = "/prometheussdrefreshfailure"
+	runbookPrometheusNotificationQueueRunningFull          = "/prometheusnotificationqueuerunningfull"
+	runbookPrometheusErrorSendingAlertsToSomeAlertmanagers = "/prometheuserrorsendingalertstosomealertmanagers"
+	runbookPrometheusErrorSendingAlertsToAnyAlertmanager   = "/prometheuserrorsendingalertstoanyalertmanager"
+	runbookPrometheusNotConnectedToAlertmanagers           = "/prometheusnotconnectedtoalertmanagers"
+	runbookPrometheusTSDBReloadsFailing                    = "/prometheustsdbreloadsfailing"
+	runbookPrometheusTSDBCompactionsFailing                = "/prometheustsdbcompactionsfailing"
+	runbookPrometheusNotIngestingSamples                   = "/prometheusnotingestingsamples"
+	runbookPrometheusDuplicateTimestamps                   = "/prometheusduplicatetimestamps"
+	runbookPrometheusOutOfOrderTimestamps                  = "/prometheusoutofordertimestamps"
+	runbookPrometheusRemoteStorageFailures                 = "/prometheusremotestoragefailures"
+	runbookPrometheusRemoteWriteBehind                     = "/prometheusremotewritebehind"
+	runbookPrometheusRemoteWriteDesiredShards              = "/prometheusremotewritedesiredshards"
+	runbookPrometheusRuleFailures                          = "/prometheusrulefailures"
+	runbookPrometheusMissingRuleEvaluations                = "/prometheusmissingruleevaluations"
+	runbookPrometheusTargetLimitHit                        = "/prometheustargetlimithit"
+	runbookPrometheusLabelLimitHit                         = "/prometheuslabellimithit"
+	runbookPrometheusScrapeBodySizeLimitHit                = "/prometheusscrapebodysizelimithit"
+	runbookPrometheusScrapeSampleLimitHit                  = "/prometheusscrapesamplelimithit"
+	runbookPrometheusTargetSyncFailure                     = "/prometheustargetsyncfailure"
+	runbookPrometheusHighQueryLoad                         = "/prometheushighqueryload"
+)
+
+type PrometheusRulesConfig struct {
+	RunbookURL              string
+	OverviewDashboardURL    string
+	RemoteWriteDashboardURL string
+
+	PrometheusSelector string
+
+	AdditionalAlertLabels      map[string]string
+	AdditionalAlertAnnotations map[string]string
+}
+
+type PrometheusRulesConfigOption func(*PrometheusRulesConfig)
+
+func WithRunbookURL(runbookURL string) PrometheusRulesConfigOption {
+	return func(prometheusRulesConfig *PrometheusRulesConfig) {
+		prometheusRulesConfig.RunbookURL = runbookURL
+	}
+}
+
+// WithOverviewDashboardURL sets the dashboard linked from the server, TSDB, rule and scrape alerts.
+func WithOverviewDashboardURL(overviewDashboardURL string) PrometheusRulesConfigOption {
+	return func(prometheusRulesConfig *PrometheusRulesConfig) {
+		prometheusRulesConfig.OverviewDashboardURL = overviewDashboardURL
+	}
+}
+
+// WithRemoteWriteDashboardURL sets the dashboard linked from the remote-write alerts.
+func WithRemoteWriteDashboardURL(remoteWriteDashboardURL string) PrometheusRulesConfigOption {
+	return func(prometheusRulesConfig *PrometheusRulesConfig) {
+		prometheusRulesConfig.RemoteWriteDashboardURL = remoteWriteDashboardURL
+	}
+}
+
+func WithPrometheusSelector(prometheusSelector string) PrometheusRulesConfigOption {
+	return func(prometheusRulesConfig *PrometheusRulesConfig) {
+		if prometheusSelector == "" {
+			prometheusSelector = defaultPrometheusSelector
+		}
+		prometheusRulesConfig.PrometheusSelector = prometheusSelector
+	}
+}
+
+func WithAdditionalAlertLabels(additionalAlertLabels map[string]string) PrometheusRulesConfigOption {
+	return func(prometheusRulesConfig *PrometheusRulesConfig) {
+		prometheusRulesConfig.AdditionalAlertLabels = additionalAlertLabels
+	}
+}
+
+func WithAdditionalAlertAnnotations(additionalAlertAnnotations map[string]string) PrometheusRulesConfigOption {
+	return func(prometheusRulesConfig *PrometheusRulesConfig) {
+		prometheusRulesConfig.AdditionalAlertAnnotations = additionalAlertAnnotations
+	}
+}
+
+// defaultPrometheusSelector is the job label value prometheus-mixin uses for Prometheus servers.
+const defaultPrometheusSelector = "prometheus"
+
+// NewPrometheusRulesBuilder creates a new Prometheus rules builder.
+func NewPrometheusRulesBuilder(
+	namespace string,
+	labels map[string]string,
+	annotations map[string]string,
+	options ...PrometheusRulesConfigOption,
+) (promtheusrule.Builder, error) {
+	prometheusRulesConfig := PrometheusRulesConfig{
+		PrometheusSelector: defaultPrometheusSelector,
+	}
+	for _, option := range options {
+		option(&prometheusRulesConfig)
+	}
+
+	promRule, err := promtheusrule.New(
+		"prometheus-rules",
+		namespace,
+		promtheusrule.Labels(labels),
+		promtheusrule.Annotations(annotations),
+		promtheusrule.AddRuleGroup(
+			"prometheus",
+			prometheusRulesConfig.PrometheusGroup()...,
+		),
+	)
+
+	return promRule, err
+}
+
+// BuildPrometheusRules builds the Prometheus rules for the given namespace, dashboard URLs, runbook URL, labels, and annotations.
+func BuildPrometheusRules(
+	namespace string,
+	labels map[string]string,
+	annotations map[string]string,
+	options ...PrometheusRulesConfigOption,
+) rulehelpers.RuleResult {
+	promRule, err := NewPrometheusRulesBuilder(namespace, labels, annotations, options...)
+	if err != nil {
+		return rulehelpers.NewRuleResult(nil, err).Component("prometheus")
+	}
+
+	return rulehelpers.NewRuleResult(
+		&promRule.PrometheusRule,
+		nil,
+	).Component("prometheus")
+}
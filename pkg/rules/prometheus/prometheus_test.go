@@ -0,0 +1,41 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/perses/community-mixins/pkg/querytest"
+)
+
+func TestPrometheusRules(t *testing.T) {
+	result := BuildPrometheusRules("default", nil, nil)
+	require.NoError(t, result.Err())
+
+	querytest.RuleSuite{
+		SeriesFile: "../../panels/prometheus/testdata/series.test",
+		Groups:     result.Rule().Spec.Groups,
+		Labels: map[string][]string{
+			"PrometheusBadConfig":                           {`{job="prometheus", instance="prometheus-1:9090"}`},
+			"PrometheusErrorSendingAlertsToAnyAlertmanager": {`{job="prometheus", instance="prometheus-1:9090"}`},
+			"PrometheusNotIngestingSamples":                 {`{job="prometheus", instance="prometheus-1:9090"}`},
+			"PrometheusTSDBCompactionsFailing":              {`{job="prometheus", instance="prometheus-1:9090"}`},
+			"PrometheusRemoteWriteBehind": {
+				`{job="prometheus", instance="prometheus-1:9090", remote_name="remote", url="https://remote.example.com/api/v1/write"}`,
+			},
+		},
+	}.Run(t)
+}
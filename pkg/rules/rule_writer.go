@@ -14,7 +14,11 @@
 package rules
 
 import (
+	"errors"
+
+	"github.com/perses/community-mixins/pkg/sink"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 type RuleWriter struct {
@@ -35,27 +39,87 @@ func NewRuleResult(rule *monitoringv1.PrometheusRule, err error) RuleResult {
 	}
 }
 
-// Components sets the component field of the RuleResult.
+// Rule returns the PrometheusRule from the result.
+func (d RuleResult) Rule() *monitoringv1.PrometheusRule {
+	return d.rule
+}
+
+// Err returns any error from building the rule.
+func (d RuleResult) Err() error {
+	return d.err
+}
+
+// Component sets the component field of the RuleResult.
 // This component field is used by RuleWriter, as the subdirectory name for the rule.
 func (d RuleResult) Component(component string) RuleResult {
 	d.component = component
 	return d
 }
 
+// ComponentName returns the component the rule was built for, as set with Component.
+func (d RuleResult) ComponentName() string {
+	return d.component
+}
+
+// NewRuleWriter returns a RuleWriter writing rules in the format of the --output-rules flag under the directory of
+// the --output-rules-dir flag.
+//
+// Deprecated: use NewRuleWriterWithDir, which doesn't depend on the command line flags.
 func NewRuleWriter() *RuleWriter {
 	return &RuleWriter{
 		executor: NewExec(),
 	}
 }
 
+// NewRuleWriterWithDir returns a RuleWriter writing rules in outputFormat,
+// one of YAMLOutput, JSONOutput, OperatorOutput or OperatorJSONOutput, under outputDir/<component>.
+func NewRuleWriterWithDir(outputFormat string, outputDir string) *RuleWriter {
+	return &RuleWriter{
+		executor: NewExecWithDir(outputFormat, outputDir),
+	}
+}
+
+// NewRuleWriterWithSink returns a RuleWriter writing rules in outputFormat to out.
+func NewRuleWriterWithSink(outputFormat string, out sink.Sink) *RuleWriter {
+	return &RuleWriter{
+		executor: NewExecWithSink(outputFormat, out),
+	}
+}
+
 // Add adds a rule to the writer.
 func (w *RuleWriter) Add(dr RuleResult) {
 	w.ruleResults = append(w.ruleResults, dr)
 }
 
-// Write writes the rules to the output directory.
-func (w *RuleWriter) Write() {
+// Write writes the rules to the sink of the writer.
+// It writes every rule it can and returns the errors of all the others joined together,
+// each one being a *RuleError.
+func (w *RuleWriter) Write() error {
+	var errs []error
 	for _, result := range w.ruleResults {
-		w.executor.BuildRule(result)
+		if err := w.executor.BuildRule(result); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// OperatorResources returns the PrometheusRules added to the writer.
+// When some rules fail, it returns the errors of all of them joined together,
+// each one being a *RuleError.
+func (w *RuleWriter) OperatorResources() ([]runtime.Object, error) {
+	operatorResources := []runtime.Object{}
+	var errs []error
+	for _, result := range w.ruleResults {
+		resource, err := w.executor.BuildRuleOperatorResource(result)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		operatorResources = append(operatorResources, resource)
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
 	}
+	return operatorResources, nil
 }
@@ -0,0 +1,310 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tempo
+
+import (
+	"time"
+
+	promqlbuilder "github.com/perses/promql-builder"
+	"github.com/perses/promql-builder/label"
+	"github.com/perses/promql-builder/matrix"
+	"github.com/perses/promql-builder/vector"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+
+	tempoPanels "github.com/perses/community-mixins/pkg/panels/tempo"
+	rulehelpers "github.com/perses/community-mixins/pkg/rules"
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/alerting"
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/common"
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/promtheusrule"
+	"github.com/perses/community-mixins/pkg/rules/rule-sdk/rulegroup"
+)
+
+// Runbook fragments
+const (
+	runbookTempoDistributorKafkaAppendFailing = "#tempodistributorkafkaappendfailing"
+	runbookTempoCompactionsFailing            = "#tempocompactionsfailing"
+	runbookTempoBlocklistGrowing              = "#tempoblocklistgrowing"
+	runbookTempoIngesterFlushFailing          = "#tempoingesterflushfailing"
+	runbookTempoTenantDiscardingSpans         = "#tempotenantdiscardingspans"
+)
+
+type TempoRulesConfig struct {
+	RunbookURL         string
+	WritesDashboardURL string
+	TenantDashboardURL string
+
+	TenantLabelName string
+
+	AdditionalAlertLabels      map[string]string
+	AdditionalAlertAnnotations map[string]string
+}
+
+type TempoRulesConfigOption func(*TempoRulesConfig)
+
+func WithRunbookURL(runbookURL string) TempoRulesConfigOption {
+	return func(tempoRulesConfig *TempoRulesConfig) {
+		tempoRulesConfig.RunbookURL = runbookURL
+	}
+}
+
+// WithWritesDashboardURL sets the dashboard linked from the ingestion and compaction alerts.
+func WithWritesDashboardURL(writesDashboardURL string) TempoRulesConfigOption {
+	return func(tempoRulesConfig *TempoRulesConfig) {
+		tempoRulesConfig.WritesDashboardURL = writesDashboardURL
+	}
+}
+
+// WithTenantDashboardURL sets the dashboard linked from the per-tenant alerts.
+func WithTenantDashboardURL(tenantDashboardURL string) TempoRulesConfigOption {
+	return func(tempoRulesConfig *TempoRulesConfig) {
+		tempoRulesConfig.TenantDashboardURL = tenantDashboardURL
+	}
+}
+
+// WithTenantLabelName sets the name of the label carrying the tenant on Tempo metrics.
+func WithTenantLabelName(tenantLabelName string) TempoRulesConfigOption {
+	return func(tempoRulesConfig *TempoRulesConfig) {
+		if tenantLabelName == "" {
+			tenantLabelName = tempoPanels.DefaultTenantLabelName
+		}
+		tempoRulesConfig.TenantLabelName = tenantLabelName
+	}
+}
+
+func WithAdditionalAlertLabels(additionalAlertLabels map[string]string) TempoRulesConfigOption {
+	return func(tempoRulesConfig *TempoRulesConfig) {
+		tempoRulesConfig.AdditionalAlertLabels = additionalAlertLabels
+	}
+}
+
+func WithAdditionalAlertAnnotations(additionalAlertAnnotations map[string]string) TempoRulesConfigOption {
+	return func(tempoRulesConfig *TempoRulesConfig) {
+		tempoRulesConfig.AdditionalAlertAnnotations = additionalAlertAnnotations
+	}
+}
+
+// NewTempoRulesBuilder creates a new Tempo rules builder.
+// The tenant label defaults to the one of the Tempo Tenant dashboard,
+// so the per-tenant alerts match its tenant variable.
+func NewTempoRulesBuilder(
+	namespace string,
+	labels map[string]string,
+	annotations map[string]string,
+	options ...TempoRulesConfigOption,
+) (promtheusrule.Builder, error) {
+	tempoRulesConfig := TempoRulesConfig{
+		TenantLabelName: tempoPanels.DefaultTenantLabelName,
+	}
+	for _, option := range options {
+		option(&tempoRulesConfig)
+	}
+
+	promRule, err := promtheusrule.New(
+		"tempo-rules",
+		namespace,
+		promtheusrule.Labels(labels),
+		promtheusrule.Annotations(annotations),
+		promtheusrule.AddRuleGroup(
+			"tempo",
+			tempoRulesConfig.TempoGroup()...,
+		),
+	)
+
+	return promRule, err
+}
+
+// BuildTempoRules builds the Tempo rules for the given namespace, dashboard URLs, runbook URL, labels, and annotations.
+func BuildTempoRules(
+	namespace string,
+	labels map[string]string,
+	annotations map[string]string,
+	options ...TempoRulesConfigOption,
+) rulehelpers.RuleResult {
+	promRule, err := NewTempoRulesBuilder(namespace, labels, annotations, options...)
+	if err != nil {
+		return rulehelpers.NewRuleResult(nil, err).Component("tempo")
+	}
+
+	return rulehelpers.NewRuleResult(
+		&promRule.PrometheusRule,
+		nil,
+	).Component("tempo")
+}
+
+// tempo returns a selector for the given series, scoped to the jobs of the given Tempo component.
+func tempo(metricName, component string, matchers ...*labels.Matcher) *parser.VectorSelector {
+	return vector.New(
+		vector.WithMetricName(metricName),
+		vector.WithLabelMatchers(
+			append([]*labels.Matcher{label.New("job").EqualRegexp(".+/" + component)}, matchers...)...,
+		),
+	)
+}
+
+// sumIncrease returns the increase of the given counter over the range, aggregated by cluster and namespace.
+func sumIncrease(metricName, component string, rangeDuration time.Duration) parser.Expr {
+	return promqlbuilder.Sum(
+		promqlbuilder.Increase(
+			matrix.New(
+				tempo(metricName, component),
+				matrix.WithRange(rangeDuration),
+			),
+		),
+	).By("cluster", "namespace")
+}
+
+// failingForAnHour returns an expression matching when the given failure counter increased
+// more than twice over the last hour and is still increasing.
+func failingForAnHour(metricName, component string) parser.Expr {
+	return promqlbuilder.And(
+		promqlbuilder.Gtr(
+			sumIncrease(metricName, component, time.Hour),
+			promqlbuilder.NewNumber(2),
+		),
+		promqlbuilder.Gtr(
+			sumIncrease(metricName, component, 5*time.Minute),
+			promqlbuilder.NewNumber(0),
+		),
+	)
+}
+
+// sumRate returns the 5m rate of the given counter, aggregated by the given labels.
+func sumRate(metricName, component string, by []string, matchers ...*labels.Matcher) parser.Expr {
+	return promqlbuilder.Sum(
+		promqlbuilder.Rate(
+			matrix.New(
+				tempo(metricName, component, matchers...),
+				matrix.WithRange(5*time.Minute),
+			),
+		),
+	).By(by...)
+}
+
+func (t TempoRulesConfig) alert(
+	alertName, runbookFragment, dashboardURL string,
+	expr parser.Expr,
+	forDuration, severity, description, summary string,
+) rulegroup.Option {
+	return rulegroup.AddRule(
+		alertName,
+		alerting.Expr(expr),
+		alerting.For(forDuration),
+		alerting.Labels(
+			common.MergeMaps(
+				map[string]string{
+					"severity": severity,
+				},
+				t.AdditionalAlertLabels,
+			),
+		),
+		alerting.Annotations(
+			common.MergeMaps(
+				common.BuildAnnotations(
+					dashboardURL,
+					t.RunbookURL,
+					runbookFragment,
+					description,
+					summary,
+				),
+				t.AdditionalAlertAnnotations,
+			),
+		),
+	)
+}
+
+func (t TempoRulesConfig) TempoGroup() []rulegroup.Option {
+	tenantBy := []string{"cluster", "namespace", t.TenantLabelName}
+
+	blocklistLengthLastWeek := tempo("tempodb_blocklist_length", "compactor")
+	blocklistLengthLastWeek.OriginalOffset = 7 * 24 * time.Hour
+
+	return []rulegroup.Option{
+		t.alert(
+			"TempoDistributorKafkaAppendFailing",
+			runbookTempoDistributorKafkaAppendFailing,
+			t.WritesDashboardURL,
+			promqlbuilder.Gtr(
+				promqlbuilder.Div(
+					sumRate(
+						"tempo_distributor_kafka_appends_total", "distributor",
+						[]string{"cluster", "namespace"},
+						label.New("status").Equal("fail"),
+					),
+					sumRate(
+						"tempo_distributor_kafka_appends_total", "distributor",
+						[]string{"cluster", "namespace"},
+					),
+				),
+				promqlbuilder.NewNumber(0.01),
+			),
+			"15m",
+			"critical",
+			"{{ $value | humanizePercentage }} of the Kafka appends of the Tempo distributors in {{ $labels.cluster }}/{{ $labels.namespace }} are failing.",
+			"Tempo distributors are failing to append to Kafka.",
+		),
+		t.alert(
+			"TempoCompactionsFailing",
+			runbookTempoCompactionsFailing,
+			t.WritesDashboardURL,
+			failingForAnHour("tempodb_compaction_errors_total", "compactor"),
+			"1h",
+			"critical",
+			"Greater than 2 compactions have failed in the past hour in {{ $labels.cluster }}/{{ $labels.namespace }}.",
+			"Tempo compactions are failing.",
+		),
+		t.alert(
+			"TempoBlocklistGrowing",
+			runbookTempoBlocklistGrowing,
+			t.TenantDashboardURL,
+			promqlbuilder.Gtr(
+				promqlbuilder.Div(
+					promqlbuilder.Avg(tempo("tempodb_blocklist_length", "compactor")).By(tenantBy...),
+					promqlbuilder.Avg(blocklistLengthLastWeek).By(tenantBy...),
+				),
+				promqlbuilder.NewNumber(1.4),
+			),
+			"15m",
+			"warning",
+			"The blocklist of tenant {{ $labels."+t.TenantLabelName+" }} in {{ $labels.cluster }}/{{ $labels.namespace }} is at {{ $value | humanizePercentage }} of its length a week ago. Compaction might not keep up with ingestion.",
+			"Tempo blocklist length is growing.",
+		),
+		t.alert(
+			"TempoIngesterFlushFailing",
+			runbookTempoIngesterFlushFailing,
+			t.WritesDashboardURL,
+			failingForAnHour("tempo_ingester_flush_failed_retries_total", "ingester"),
+			"5m",
+			"critical",
+			"Greater than 2 flush retries have failed in the past hour in {{ $labels.cluster }}/{{ $labels.namespace }}.",
+			"Tempo ingesters are failing to flush blocks.",
+		),
+		t.alert(
+			"TempoTenantDiscardingSpans",
+			runbookTempoTenantDiscardingSpans,
+			t.TenantDashboardURL,
+			promqlbuilder.Gtr(
+				promqlbuilder.Div(
+					sumRate("tempo_discarded_spans_total", "distributor", tenantBy),
+					sumRate("tempo_distributor_spans_received_total", "distributor", tenantBy),
+				),
+				promqlbuilder.NewNumber(0.01),
+			),
+			"15m",
+			"warning",
+			"Tempo discards {{ $value | humanizePercentage }} of the spans of tenant {{ $labels."+t.TenantLabelName+" }} in {{ $labels.cluster }}/{{ $labels.namespace }}.",
+			"Tempo is discarding spans of a tenant.",
+		),
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tempo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/perses/community-mixins/pkg/querytest"
+)
+
+func TestTempoRules(t *testing.T) {
+	result := BuildTempoRules("default", nil, nil)
+	require.NoError(t, result.Err())
+
+	querytest.RuleSuite{
+		SeriesFile: "../../panels/tempo/testdata/series.test",
+		Groups:     result.Rule().Spec.Groups,
+		Labels: map[string][]string{
+			"TempoDistributorKafkaAppendFailing": {`{cluster="cluster-a", namespace="tempo"}`},
+			"TempoCompactionsFailing":            {`{cluster="cluster-a", namespace="tempo"}`},
+			"TempoIngesterFlushFailing":          {`{cluster="cluster-a", namespace="tempo"}`},
+			"TempoTenantDiscardingSpans":         {`{cluster="cluster-a", namespace="tempo", tenant="tenant-a"}`},
+		},
+		Skip: map[string]string{
+			"TempoBlocklistGrowing": "compares the blocklists with those of a week before, older than the series",
+		},
+	}.Run(t)
+}
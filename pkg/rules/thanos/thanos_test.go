@@ -0,0 +1,44 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thanos
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/perses/community-mixins/pkg/querytest"
+)
+
+func TestThanosRules(t *testing.T) {
+	result := BuildThanosRules("default", nil, nil)
+	require.NoError(t, result.Err())
+
+	querytest.RuleSuite{
+		SeriesFile: "../../panels/thanos/testdata/series.test",
+		Groups:     result.Rule().Spec.Groups,
+		Labels: map[string][]string{
+			"ThanosCompactIsDown":                  {`{}`},
+			"ThanosReceiveNoUpload":                {`{namespace="thanos", job="thanos-receive-ingester", instance="thanos-receive-ingester-0:10902"}`},
+			"ThanosReceiveHeadSeriesReachingLimit": {`{tenant="team-a"}`},
+			"ThanosRuleHighRuleEvaluationFailures": {`{namespace="thanos", job="thanos-ruler", instance="thanos-ruler-1:10902"}`},
+			"ThanosRuleNoEvaluationFor10Intervals": {`{namespace="thanos", job="thanos-ruler", instance="thanos-ruler-0:10902"}`},
+			"ThanosNoRuleEvaluations":              {`{namespace="thanos", job="thanos-ruler", instance="thanos-ruler-0:10902"}`},
+		},
+		Skip: map[string]string{
+			"ThanosCompactMultipleRunning":         "compares each up series with 1 instead of their sum, and up is never greater than 1",
+			"ThanosReceiveHighReplicationFailures": "matches the replication factor, with its instance label, with sums by namespace and job",
+		},
+	}.Run(t)
+}
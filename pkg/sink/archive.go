@@ -0,0 +1,84 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Tar writes every file as an entry of a tar archive, in the same layout as Dir.
+// Close must be called once every file is written, to flush the archive.
+type Tar struct {
+	tw *tar.Writer
+}
+
+func NewTar(w io.Writer) *Tar {
+	return &Tar{tw: tar.NewWriter(w)}
+}
+
+func (t *Tar) Write(component string, name string, ext string, data []byte) error {
+	header := &tar.Header{
+		Name:     FilePath(component, name, ext),
+		Mode:     0o644,
+		Size:     int64(len(data)),
+		ModTime:  time.Unix(0, 0),
+		Typeflag: tar.TypeReg,
+	}
+	if err := t.tw.WriteHeader(header); err != nil {
+		return fmt.Errorf("failed to write tar header: %w", err)
+	}
+	if _, err := t.tw.Write(data); err != nil {
+		return fmt.Errorf("failed to write tar entry: %w", err)
+	}
+	return nil
+}
+
+// Close writes the tar footer. It does not close the underlying writer.
+func (t *Tar) Close() error {
+	return t.tw.Close()
+}
+
+// Zip writes every file as an entry of a zip archive, in the same layout as Dir.
+// Close must be called once every file is written, to flush the archive.
+type Zip struct {
+	zw *zip.Writer
+}
+
+func NewZip(w io.Writer) *Zip {
+	return &Zip{zw: zip.NewWriter(w)}
+}
+
+func (z *Zip) Write(component string, name string, ext string, data []byte) error {
+	f, err := z.zw.CreateHeader(&zip.FileHeader{
+		Name:     FilePath(component, name, ext),
+		Method:   zip.Deflate,
+		Modified: time.Unix(0, 0),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create zip entry: %w", err)
+	}
+	if _, err := f.Write(data); err != nil {
+		return fmt.Errorf("failed to write zip entry: %w", err)
+	}
+	return nil
+}
+
+// Close writes the zip central directory. It does not close the underlying writer.
+func (z *Zip) Close() error {
+	return z.zw.Close()
+}
@@ -0,0 +1,199 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"bytes"
+	"fmt"
+	"maps"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+	k8syaml "sigs.k8s.io/yaml"
+)
+
+// Placeholders set in the resources before they are marshalled, and replaced by template actions afterwards.
+const (
+	helmNamespacePlaceholder  = "__HELM_NAMESPACE__"
+	helmLabelsPlaceholder     = "__HELM_LABELS__"
+	helmDatasourcePlaceholder = "__HELM_DATASOURCE__"
+)
+
+var helmLabelsLine = regexp.MustCompile(`(?m)^( *)` + helmLabelsPlaceholder + `:.*$`)
+
+// helmEscaper escapes the template delimiters already in the resources, such as the ones of
+// the alert annotations and of the series name formats, so that Helm renders them as is.
+var helmEscaper = strings.NewReplacer("{{", `{{ "{{" }}`, "}}", `{{ "}}" }}`)
+
+// HelmChart describes the chart written by Helm.
+type HelmChart struct {
+	Name    string
+	Version string
+	// Datasource is the datasource name the dashboards were generated with. When set, it is
+	// exposed as the datasource value of the chart. It is left empty for rules.
+	Datasource string
+}
+
+type helmChartFile struct {
+	APIVersion  string `json:"apiVersion"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Type        string `json:"type"`
+	Version     string `json:"version"`
+}
+
+// Helm writes every Kubernetes resource as a template of a Helm chart to another sink, under
+// templates/<component>/<name>.yaml, and on Finish the Chart.yaml and values.yaml of the chart.
+// The values expose the namespace and extra labels of the resources, the datasource of the
+// dashboards and an enabled flag per component.
+type Helm struct {
+	out        Sink
+	chart      HelmChart
+	components map[string]bool
+}
+
+func NewHelm(out Sink, chart HelmChart) *Helm {
+	return &Helm{
+		out:        out,
+		chart:      chart,
+		components: map[string]bool{},
+	}
+}
+
+func (h *Helm) Write(component string, name string, _ string, data []byte) error {
+	if component == "" {
+		return fmt.Errorf("file %s has no component, helm output needs one per resource", name)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("failed to parse resource: %w", err)
+	}
+	if len(doc.Content) != 1 || doc.Content[0].Kind != yaml.MappingNode {
+		return fmt.Errorf("%s/%s is not a Kubernetes resource", component, name)
+	}
+	resource := doc.Content[0]
+	metadata := mappingValue(resource, "metadata", yaml.MappingNode)
+	setMappingValue(metadata, "namespace", helmNamespacePlaceholder)
+	labels := mappingValue(metadata, "labels", yaml.MappingNode)
+	setMappingValue(labels, helmLabelsPlaceholder, "")
+	if h.chart.Datasource != "" {
+		replaceDatasourceName(resource, h.chart.Datasource)
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&doc); err != nil {
+		return fmt.Errorf("failed to marshal resource: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("failed to marshal resource: %w", err)
+	}
+
+	rendered := helmEscaper.Replace(buf.String())
+	rendered = strings.ReplaceAll(rendered, helmNamespacePlaceholder, "{{ .Values.namespace | default .Release.Namespace }}")
+	rendered = strings.ReplaceAll(rendered, helmDatasourcePlaceholder, "{{ .Values.datasource }}")
+	rendered = helmLabelsLine.ReplaceAllStringFunc(rendered, func(line string) string {
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+		return fmt.Sprintf("%[1]s{{- with .Values.labels }}\n%[1]s{{- toYaml . | nindent %[2]d }}\n%[1]s{{- end }}", strings.Repeat(" ", indent), indent)
+	})
+
+	template := fmt.Sprintf("{{- if (index .Values.components %q).enabled }}\n%s{{- end }}\n", component, rendered)
+	if err := h.out.Write(path.Join("templates", component), name, "yaml", []byte(template)); err != nil {
+		return err
+	}
+	h.components[component] = true
+	return nil
+}
+
+// Finish writes the Chart.yaml and values.yaml files. It must be called once every file is written.
+func (h *Helm) Finish() error {
+	chart, err := k8syaml.Marshal(helmChartFile{
+		APIVersion:  "v2",
+		Name:        h.chart.Name,
+		Description: "Generated by the Perses community mixins",
+		Type:        "application",
+		Version:     h.chart.Version,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal Chart.yaml: %w", err)
+	}
+	if err := h.out.Write("", "Chart", "yaml", chart); err != nil {
+		return err
+	}
+
+	var values strings.Builder
+	values.WriteString("# Namespace the resources are installed into, defaults to the namespace of the release.\n")
+	values.WriteString("namespace: \"\"\n")
+	values.WriteString("# Extra labels added to every resource.\n")
+	values.WriteString("labels: {}\n")
+	if h.chart.Datasource != "" {
+		values.WriteString("# Name of the datasource the dashboards query.\n")
+		fmt.Fprintf(&values, "datasource: %q\n", h.chart.Datasource)
+	}
+	values.WriteString("# Components to install.\n")
+	values.WriteString("components:\n")
+	for _, component := range slices.Sorted(maps.Keys(h.components)) {
+		fmt.Fprintf(&values, "  %s:\n    enabled: true\n", component)
+	}
+	return h.out.Write("", "values", "yaml", []byte(values.String()))
+}
+
+// mappingValue returns the value of key in the mapping node, adding it with the given kind when missing.
+func mappingValue(mapping *yaml.Node, key string, kind yaml.Kind) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			value := mapping.Content[i+1]
+			// A null value, such as "labels:" without entries, is turned into the expected kind.
+			if value.Kind == yaml.ScalarNode && value.Tag == "!!null" {
+				*value = yaml.Node{Kind: kind}
+			}
+			return value
+		}
+	}
+	value := &yaml.Node{Kind: kind}
+	mapping.Content = append(mapping.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, value)
+	return value
+}
+
+// setMappingValue sets key to the string value in the mapping node.
+func setMappingValue(mapping *yaml.Node, key string, value string) {
+	node := mappingValue(mapping, key, yaml.ScalarNode)
+	*node = yaml.Node{Kind: yaml.ScalarNode, Value: value}
+}
+
+// replaceDatasourceName replaces the name of every datasource reference, that is every
+// "datasource" mapping whose name is datasource, with the datasource placeholder.
+func replaceDatasourceName(node *yaml.Node, datasource string) {
+	if node.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value != "datasource" || node.Content[i+1].Kind != yaml.MappingNode {
+				continue
+			}
+			ref := node.Content[i+1]
+			for j := 0; j+1 < len(ref.Content); j += 2 {
+				if ref.Content[j].Value == "name" && ref.Content[j+1].Value == datasource {
+					ref.Content[j+1].Value = helmDatasourcePlaceholder
+				}
+			}
+		}
+	}
+	for _, child := range node.Content {
+		replaceDatasourceName(child, datasource)
+	}
+}
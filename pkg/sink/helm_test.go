@@ -0,0 +1,91 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"strings"
+	"testing"
+)
+
+const testDashboardResource = `apiVersion: perses.dev/v1alpha2
+kind: PersesDashboard
+metadata:
+  labels:
+    app.kubernetes.io/name: perses-dashboard
+  name: etcd-overview
+  namespace: perses-dev
+spec:
+  config:
+    panels:
+      up:
+        spec:
+          queries:
+            - spec:
+                plugin:
+                  spec:
+                    datasource:
+                      kind: PrometheusDatasource
+                      name: prometheus-datasource
+                    query: up{job="etcd"}
+                    seriesNameFormat: '{{instance}}'
+`
+
+func TestHelm(t *testing.T) {
+	out := NewMemory()
+	h := NewHelm(out, HelmChart{Name: "mixins", Version: "1.2.3", Datasource: "prometheus-datasource"})
+	if err := h.Write("etcd", "etcd-overview", "yaml", []byte(testDashboardResource)); err != nil {
+		t.Fatalf("Write() returned error: %v", err)
+	}
+	if err := h.Finish(); err != nil {
+		t.Fatalf("Finish() returned error: %v", err)
+	}
+
+	template, ok := out.Get("templates/etcd", "etcd-overview")
+	if !ok {
+		t.Fatal("template was not written under templates/etcd")
+	}
+	for _, want := range []string{
+		"{{- if (index .Values.components \"etcd\").enabled }}\n",
+		"  namespace: {{ .Values.namespace | default .Release.Namespace }}\n",
+		"    app.kubernetes.io/name: perses-dashboard\n    {{- with .Values.labels }}\n    {{- toYaml . | nindent 4 }}\n    {{- end }}\n",
+		"name: {{ .Values.datasource }}\n",
+		`seriesNameFormat: '{{ "{{" }}instance{{ "}}" }}'`,
+		"{{- end }}\n",
+	} {
+		if !strings.Contains(string(template), want) {
+			t.Errorf("template does not contain %q:\n%s", want, template)
+		}
+	}
+	if strings.Contains(string(template), "__HELM") {
+		t.Errorf("template contains a placeholder:\n%s", template)
+	}
+
+	chart, _ := out.Get("", "Chart")
+	if !strings.Contains(string(chart), "name: mixins\n") || !strings.Contains(string(chart), "version: 1.2.3\n") {
+		t.Errorf("Chart.yaml = %q", chart)
+	}
+	values, _ := out.Get("", "values")
+	for _, want := range []string{"namespace: \"\"\n", "labels: {}\n", "datasource: \"prometheus-datasource\"\n", "components:\n  etcd:\n    enabled: true\n"} {
+		if !strings.Contains(string(values), want) {
+			t.Errorf("values.yaml does not contain %q:\n%s", want, values)
+		}
+	}
+}
+
+func TestHelm_NotAResource(t *testing.T) {
+	h := NewHelm(NewMemory(), HelmChart{Name: "mixins", Version: "0.1.0"})
+	if err := h.Write("etcd", "etcd-rules", "yaml", []byte("- not\n- a resource\n")); err == nil {
+		t.Error("Write() accepted a document that is not a Kubernetes resource")
+	}
+}
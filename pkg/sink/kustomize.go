@@ -0,0 +1,79 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"sigs.k8s.io/yaml"
+)
+
+// KustomizationFileName is the name of the files Kustomize looks for in a directory.
+const KustomizationFileName = "kustomization"
+
+type kustomization struct {
+	APIVersion string   `json:"apiVersion"`
+	Kind       string   `json:"kind"`
+	Resources  []string `json:"resources"`
+}
+
+// Kustomize writes every file to another sink, and on Finish a kustomization.yaml per component
+// listing its files, plus a root kustomization.yaml listing the components.
+type Kustomize struct {
+	out       Sink
+	resources map[string][]string
+}
+
+func NewKustomize(out Sink) *Kustomize {
+	return &Kustomize{
+		out:       out,
+		resources: map[string][]string{},
+	}
+}
+
+func (k *Kustomize) Write(component string, name string, ext string, data []byte) error {
+	if component == "" {
+		return fmt.Errorf("file %s.%s has no component, kustomize output needs one directory per component", name, ext)
+	}
+	if err := k.out.Write(component, name, ext, data); err != nil {
+		return err
+	}
+	k.resources[component] = append(k.resources[component], name+"."+ext)
+	return nil
+}
+
+// Finish writes the kustomization files. It must be called once every file is written.
+func (k *Kustomize) Finish() error {
+	components := slices.Sorted(maps.Keys(k.resources))
+	for _, component := range components {
+		if err := k.writeKustomization(component, slices.Sorted(slices.Values(k.resources[component]))); err != nil {
+			return err
+		}
+	}
+	return k.writeKustomization("", components)
+}
+
+func (k *Kustomize) writeKustomization(component string, resources []string) error {
+	data, err := yaml.Marshal(kustomization{
+		APIVersion: "kustomize.config.k8s.io/v1beta1",
+		Kind:       "Kustomization",
+		Resources:  resources,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal kustomization: %w", err)
+	}
+	return k.out.Write(component, KustomizationFileName, "yaml", data)
+}
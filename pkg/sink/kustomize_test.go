@@ -0,0 +1,54 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"testing"
+)
+
+func TestKustomize(t *testing.T) {
+	out := NewMemory()
+	k := NewKustomize(out)
+	for _, f := range []file{
+		{"kubernetes", "kubelet-overview", "yaml", "kind: PersesDashboard\n"},
+		{"etcd", "etcd-overview", "yaml", "kind: PersesDashboard\n"},
+		{"kubernetes", "apiserver-overview", "yaml", "kind: PersesDashboard\n"},
+	} {
+		if err := k.Write(f.component, f.name, f.ext, []byte(f.data)); err != nil {
+			t.Fatalf("Write() returned error: %v", err)
+		}
+	}
+	if err := k.Finish(); err != nil {
+		t.Fatalf("Finish() returned error: %v", err)
+	}
+
+	files := out.Files()
+	for key, want := range map[string]string{
+		"kustomization":            "apiVersion: kustomize.config.k8s.io/v1beta1\nkind: Kustomization\nresources:\n- etcd\n- kubernetes\n",
+		"kubernetes/kustomization": "apiVersion: kustomize.config.k8s.io/v1beta1\nkind: Kustomization\nresources:\n- apiserver-overview.yaml\n- kubelet-overview.yaml\n",
+		"etcd/kustomization":       "apiVersion: kustomize.config.k8s.io/v1beta1\nkind: Kustomization\nresources:\n- etcd-overview.yaml\n",
+	} {
+		got, ok := files[key]
+		if !ok {
+			t.Errorf("%s was not written", key)
+			continue
+		}
+		if string(got) != want {
+			t.Errorf("%s = %q, want %q", key, got, want)
+		}
+	}
+	if _, ok := out.Get("etcd", "etcd-overview"); !ok {
+		t.Error("resource was not written through")
+	}
+}
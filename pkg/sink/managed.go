@@ -0,0 +1,240 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// ManifestFileName is the file, at the root of a managed directory, listing the files the
+// generator owns there.
+const ManifestFileName = ".manifest"
+
+// Report lists the files of a managed directory by what a run did, or would do, to them.
+// Paths are relative to the directory root.
+type Report struct {
+	Added     []string
+	Changed   []string
+	Unchanged []string
+	Removed   []string
+}
+
+// HasChanges reports whether the run added, changed or removed any file.
+func (r Report) HasChanges() bool {
+	return len(r.Added) > 0 || len(r.Changed) > 0 || len(r.Removed) > 0
+}
+
+// WriteSummary writes the number of files in each category, followed by every file that is not unchanged.
+func (r Report) WriteSummary(w io.Writer) error {
+	if _, err := fmt.Fprintf(w, "%d added, %d changed, %d unchanged, %d removed\n", len(r.Added), len(r.Changed), len(r.Unchanged), len(r.Removed)); err != nil {
+		return err
+	}
+	for _, group := range []struct {
+		status string
+		paths  []string
+	}{
+		{"added", r.Added},
+		{"changed", r.Changed},
+		{"removed", r.Removed},
+	} {
+		for _, p := range group.paths {
+			if _, err := fmt.Fprintf(w, "  %-8s %s\n", group.status, p); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Managed writes files under a root directory like Dir, and keeps track of them in a manifest,
+// so that Finish can remove the files a previous run wrote and this run did not.
+// Files the manifest does not list are never removed.
+//
+// In check mode nothing is written or removed, and the Report only tells what would change.
+type Managed struct {
+	root    string
+	check   bool
+	scope   []string
+	written map[string]bool
+	report  Report
+}
+
+func NewManaged(root string, check bool) *Managed {
+	return &Managed{
+		root:    root,
+		check:   check,
+		written: map[string]bool{},
+	}
+}
+
+// Scope restricts Finish to the files of the given components. Files of the other components
+// listed in the manifest are neither removed nor dropped from it. The component of a file is the
+// directory it is in, so that <component>/<name> as well as the templates/<component>/<name> of
+// Helm are matched.
+func (m *Managed) Scope(components ...string) *Managed {
+	m.scope = components
+	return m
+}
+
+func (m *Managed) Write(component string, name string, ext string, data []byte) error {
+	p := FilePath(component, name, ext)
+	if m.written[p] {
+		return fmt.Errorf("file %s is written twice", p)
+	}
+	m.written[p] = true
+
+	current, err := os.ReadFile(filepath.Join(m.root, filepath.FromSlash(p)))
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		m.report.Added = append(m.report.Added, p)
+	case err != nil:
+		return fmt.Errorf("failed to read file: %w", err)
+	case bytes.Equal(current, data):
+		m.report.Unchanged = append(m.report.Unchanged, p)
+		return nil
+	default:
+		m.report.Changed = append(m.report.Changed, p)
+	}
+
+	if m.check {
+		return nil
+	}
+	return NewDir(m.root).Write(component, name, ext, data)
+}
+
+// Finish removes the files listed in the previous manifest that were not written since the
+// Managed was created, records the written files in the manifest and returns the report of the run.
+// It must only be called once every file was written successfully, otherwise the files that
+// failed would be removed.
+func (m *Managed) Finish() (Report, error) {
+	previous, err := m.readManifest()
+	if err != nil {
+		return Report{}, err
+	}
+
+	manifest := []string{}
+	for p := range m.written {
+		manifest = append(manifest, p)
+	}
+	for _, p := range previous {
+		if m.written[p] {
+			continue
+		}
+		if !m.inScope(p) {
+			manifest = append(manifest, p)
+			continue
+		}
+		removed, err := m.remove(p)
+		if err != nil {
+			return Report{}, err
+		}
+		if removed {
+			m.report.Removed = append(m.report.Removed, p)
+		}
+	}
+
+	if !m.check {
+		slices.Sort(manifest)
+		manifest = slices.Compact(manifest)
+		if err := m.writeManifest(manifest); err != nil {
+			return Report{}, err
+		}
+	}
+
+	report := m.report
+	for _, paths := range []*[]string{&report.Added, &report.Changed, &report.Unchanged, &report.Removed} {
+		*paths = slices.Sorted(slices.Values(*paths))
+	}
+	return report, nil
+}
+
+func (m *Managed) inScope(p string) bool {
+	if m.scope == nil {
+		return true
+	}
+	return slices.Contains(m.scope, path.Base(path.Dir(p)))
+}
+
+// remove deletes a file and its component directory once empty. It reports whether the file existed.
+func (m *Managed) remove(p string) (bool, error) {
+	file := filepath.Join(m.root, filepath.FromSlash(p))
+	if _, err := os.Stat(file); errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("failed to stat file: %w", err)
+	}
+	if m.check {
+		return true, nil
+	}
+	if err := os.Remove(file); err != nil {
+		return false, fmt.Errorf("failed to remove file: %w", err)
+	}
+	// Removing the component directory fails as long as it is not empty, which is fine.
+	_ = os.Remove(filepath.Dir(file))
+	return true, nil
+}
+
+func (m *Managed) readManifest() ([]string, error) {
+	f, err := os.Open(filepath.Join(m.root, ManifestFileName))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open manifest: %w", err)
+	}
+	defer f.Close()
+
+	paths := []string{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		p := strings.TrimSpace(scanner.Text())
+		if p == "" {
+			continue
+		}
+		// Never follow a manifest out of the directory it manages.
+		if !filepath.IsLocal(filepath.FromSlash(p)) || path.Clean(p) != p {
+			return nil, fmt.Errorf("manifest %s lists %q, which is not a path inside %s", ManifestFileName, p, m.root)
+		}
+		paths = append(paths, p)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+	return paths, nil
+}
+
+func (m *Managed) writeManifest(paths []string) error {
+	if err := os.MkdirAll(m.root, os.ModePerm); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+	var buf bytes.Buffer
+	for _, p := range paths {
+		buf.WriteString(p)
+		buf.WriteByte('\n')
+	}
+	if err := os.WriteFile(filepath.Join(m.root, ManifestFileName), buf.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+	return nil
+}
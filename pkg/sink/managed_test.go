@@ -0,0 +1,214 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func writeFile(t *testing.T, root string, p string, data string) {
+	t.Helper()
+	file := filepath.Join(root, filepath.FromSlash(p))
+	if err := os.MkdirAll(filepath.Dir(file), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(file, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readManifest(t *testing.T, root string) []string {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join(root, ManifestFileName))
+	if err != nil {
+		t.Fatalf("failed to read manifest: %v", err)
+	}
+	return strings.Fields(string(data))
+}
+
+func exists(root string, p string) bool {
+	_, err := os.Stat(filepath.Join(root, filepath.FromSlash(p)))
+	return err == nil
+}
+
+// setupPreviousRun lays out a directory as left by a managed run that wrote
+// etcd/etcd-overview.yaml and kubernetes/old-overview.yaml, plus a file the generator does not own.
+func setupPreviousRun(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+	writeFile(t, root, "etcd/etcd-overview.yaml", "old etcd\n")
+	writeFile(t, root, "kubernetes/old-overview.yaml", "old kubernetes\n")
+	writeFile(t, root, "kubernetes/hand-written.yaml", "not generated\n")
+	writeFile(t, root, ManifestFileName, "etcd/etcd-overview.yaml\nkubernetes/old-overview.yaml\n")
+	return root
+}
+
+func TestManaged(t *testing.T) {
+	root := setupPreviousRun(t)
+	m := NewManaged(root, false)
+	if err := m.Write("etcd", "etcd-overview", "yaml", []byte("new etcd\n")); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Write("kubernetes", "new-overview", "yaml", []byte("new kubernetes\n")); err != nil {
+		t.Fatal(err)
+	}
+	report, err := m.Finish()
+	if err != nil {
+		t.Fatalf("Finish() returned error: %v", err)
+	}
+
+	want := Report{
+		Added:   []string{"kubernetes/new-overview.yaml"},
+		Changed: []string{"etcd/etcd-overview.yaml"},
+		Removed: []string{"kubernetes/old-overview.yaml"},
+	}
+	if !slices.Equal(report.Added, want.Added) || !slices.Equal(report.Changed, want.Changed) ||
+		len(report.Unchanged) != 0 || !slices.Equal(report.Removed, want.Removed) {
+		t.Errorf("Finish() = %+v, want %+v", report, want)
+	}
+	if !report.HasChanges() {
+		t.Error("HasChanges() = false, want true")
+	}
+
+	if exists(root, "kubernetes/old-overview.yaml") {
+		t.Error("orphan file was not removed")
+	}
+	if !exists(root, "kubernetes/hand-written.yaml") {
+		t.Error("file missing from the manifest was removed")
+	}
+	if got, _ := os.ReadFile(filepath.Join(root, "etcd", "etcd-overview.yaml")); string(got) != "new etcd\n" {
+		t.Errorf("changed file content = %q", got)
+	}
+	if got := readManifest(t, root); !slices.Equal(got, []string{"etcd/etcd-overview.yaml", "kubernetes/new-overview.yaml"}) {
+		t.Errorf("manifest = %v", got)
+	}
+
+	// A second identical run has nothing to do.
+	m = NewManaged(root, true)
+	_ = m.Write("etcd", "etcd-overview", "yaml", []byte("new etcd\n"))
+	_ = m.Write("kubernetes", "new-overview", "yaml", []byte("new kubernetes\n"))
+	report, err = m.Finish()
+	if err != nil {
+		t.Fatalf("Finish() returned error: %v", err)
+	}
+	if report.HasChanges() || len(report.Unchanged) != 2 {
+		t.Errorf("second run report = %+v, want 2 unchanged files only", report)
+	}
+}
+
+func TestManaged_Check(t *testing.T) {
+	root := setupPreviousRun(t)
+	m := NewManaged(root, true)
+	if err := m.Write("etcd", "etcd-overview", "yaml", []byte("new etcd\n")); err != nil {
+		t.Fatal(err)
+	}
+	report, err := m.Finish()
+	if err != nil {
+		t.Fatalf("Finish() returned error: %v", err)
+	}
+	if !report.HasChanges() || !slices.Equal(report.Removed, []string{"kubernetes/old-overview.yaml"}) {
+		t.Errorf("Finish() = %+v, want etcd changed and kubernetes/old-overview.yaml removed", report)
+	}
+
+	// Nothing is touched on disk in check mode.
+	if got, _ := os.ReadFile(filepath.Join(root, "etcd", "etcd-overview.yaml")); string(got) != "old etcd\n" {
+		t.Errorf("check mode rewrote a file: %q", got)
+	}
+	if !exists(root, "kubernetes/old-overview.yaml") {
+		t.Error("check mode removed a file")
+	}
+	if got := readManifest(t, root); !slices.Equal(got, []string{"etcd/etcd-overview.yaml", "kubernetes/old-overview.yaml"}) {
+		t.Errorf("check mode rewrote the manifest: %v", got)
+	}
+}
+
+func TestManaged_Scope(t *testing.T) {
+	root := setupPreviousRun(t)
+	m := NewManaged(root, false).Scope("etcd")
+	if err := m.Write("etcd", "etcd-overview", "yaml", []byte("old etcd\n")); err != nil {
+		t.Fatal(err)
+	}
+	report, err := m.Finish()
+	if err != nil {
+		t.Fatalf("Finish() returned error: %v", err)
+	}
+	if report.HasChanges() {
+		t.Errorf("Finish() = %+v, want no changes", report)
+	}
+	if !exists(root, "kubernetes/old-overview.yaml") {
+		t.Error("file of a component out of scope was removed")
+	}
+	if got := readManifest(t, root); !slices.Equal(got, []string{"etcd/etcd-overview.yaml", "kubernetes/old-overview.yaml"}) {
+		t.Errorf("manifest = %v, want the out of scope file kept", got)
+	}
+}
+
+func TestManaged_ScopeHelm(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, root, "templates/etcd/old-etcd.yaml", "old etcd\n")
+	writeFile(t, root, "templates/kubernetes/kubernetes-overview.yaml", "kubernetes\n")
+	writeFile(t, root, ManifestFileName, "Chart.yaml\ntemplates/etcd/old-etcd.yaml\ntemplates/kubernetes/kubernetes-overview.yaml\nvalues.yaml\n")
+
+	m := NewManaged(root, false).Scope("etcd")
+	h := NewHelm(m, HelmChart{Name: "mixins", Version: "1.2.3"})
+	if err := h.Write("etcd", "etcd-overview", "yaml", []byte(testDashboardResource)); err != nil {
+		t.Fatal(err)
+	}
+	if err := h.Finish(); err != nil {
+		t.Fatal(err)
+	}
+	report, err := m.Finish()
+	if err != nil {
+		t.Fatalf("Finish() returned error: %v", err)
+	}
+
+	if !slices.Equal(report.Removed, []string{"templates/etcd/old-etcd.yaml"}) {
+		t.Errorf("Removed = %v, want the template of etcd no longer generated", report.Removed)
+	}
+	if !exists(root, "templates/kubernetes/kubernetes-overview.yaml") {
+		t.Error("template of a component out of scope was removed")
+	}
+	want := []string{"Chart.yaml", "templates/etcd/etcd-overview.yaml", "templates/kubernetes/kubernetes-overview.yaml", "values.yaml"}
+	if got := readManifest(t, root); !slices.Equal(got, want) {
+		t.Errorf("manifest = %v, want %v", got, want)
+	}
+}
+
+func TestManaged_ManifestOutsideRoot(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, root, ManifestFileName, "../outside.yaml\n")
+	if _, err := NewManaged(root, false).Finish(); err == nil {
+		t.Error("Finish() accepted a manifest pointing outside of the directory")
+	}
+}
+
+func TestReport_WriteSummary(t *testing.T) {
+	var buf strings.Builder
+	report := Report{
+		Added:     []string{"etcd/a.yaml"},
+		Unchanged: []string{"etcd/b.yaml"},
+		Removed:   []string{"etcd/c.yaml"},
+	}
+	if err := report.WriteSummary(&buf); err != nil {
+		t.Fatal(err)
+	}
+	want := "1 added, 0 changed, 1 unchanged, 1 removed\n  added    etcd/a.yaml\n  removed  etcd/c.yaml\n"
+	if buf.String() != want {
+		t.Errorf("WriteSummary() = %q, want %q", buf.String(), want)
+	}
+}
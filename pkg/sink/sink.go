@@ -0,0 +1,90 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sink defines where the dashboard and rule writers put the rendered files.
+package sink
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"path"
+	"slices"
+	"sync"
+)
+
+// Sink receives the rendered dashboards and rules, one file at a time.
+// Files are identified by the component they belong to, their name and their extension.
+type Sink interface {
+	Write(component string, name string, ext string, data []byte) error
+}
+
+// FilePath returns the slash-separated path of a file relative to the sink root,
+// in the <component>/<name>.<ext> layout shared by every sink.
+func FilePath(component string, name string, ext string) string {
+	return path.Join(component, name+"."+ext)
+}
+
+// Dir writes every file under a root directory, as <root>/<component>/<name>.<ext>.
+type Dir struct {
+	root string
+}
+
+func NewDir(root string) *Dir {
+	return &Dir{root: root}
+}
+
+func (d *Dir) Write(component string, name string, ext string, data []byte) error {
+	outputDir := path.Join(d.root, component)
+	// create output directory if not exists
+	if err := os.MkdirAll(outputDir, os.ModePerm); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+	if err := os.WriteFile(path.Join(d.root, FilePath(component, name, ext)), data, os.ModePerm); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	return nil
+}
+
+// Memory keeps every file in memory, keyed by <component>/<name>.
+// It is safe for concurrent use.
+type Memory struct {
+	mu    sync.Mutex
+	files map[string][]byte
+}
+
+func NewMemory() *Memory {
+	return &Memory{files: map[string][]byte{}}
+}
+
+func (m *Memory) Write(component string, name string, _ string, data []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.files[path.Join(component, name)] = slices.Clone(data)
+	return nil
+}
+
+// Get returns the content written for the given component and name.
+func (m *Memory) Get(component string, name string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	data, ok := m.files[path.Join(component, name)]
+	return data, ok
+}
+
+// Files returns a copy of every file written so far, keyed by <component>/<name>.
+func (m *Memory) Files() map[string][]byte {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return maps.Clone(m.files)
+}
@@ -0,0 +1,177 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type file struct {
+	component string
+	name      string
+	ext       string
+	data      string
+}
+
+var testFiles = []file{
+	{"etcd", "etcd-overview", "yaml", "kind: Dashboard\nname: etcd\n"},
+	{"kubernetes", "kubelet-overview", "yaml", "kind: Dashboard\nname: kubelet\n"},
+}
+
+func writeAll(t *testing.T, s Sink) {
+	t.Helper()
+	for _, f := range testFiles {
+		if err := s.Write(f.component, f.name, f.ext, []byte(f.data)); err != nil {
+			t.Fatalf("Write(%s, %s) returned error: %v", f.component, f.name, err)
+		}
+	}
+}
+
+func TestDir(t *testing.T) {
+	root := t.TempDir()
+	writeAll(t, NewDir(root))
+
+	for _, f := range testFiles {
+		got, err := os.ReadFile(filepath.Join(root, f.component, f.name+"."+f.ext))
+		if err != nil {
+			t.Fatalf("failed to read %s/%s: %v", f.component, f.name, err)
+		}
+		if string(got) != f.data {
+			t.Errorf("%s/%s = %q, want %q", f.component, f.name, got, f.data)
+		}
+	}
+}
+
+func TestMemory(t *testing.T) {
+	m := NewMemory()
+	writeAll(t, m)
+
+	files := m.Files()
+	if len(files) != len(testFiles) {
+		t.Fatalf("Files() returned %d files, want %d", len(files), len(testFiles))
+	}
+	for _, f := range testFiles {
+		if got := string(files[f.component+"/"+f.name]); got != f.data {
+			t.Errorf("Files()[%s/%s] = %q, want %q", f.component, f.name, got, f.data)
+		}
+		got, ok := m.Get(f.component, f.name)
+		if !ok || string(got) != f.data {
+			t.Errorf("Get(%s, %s) = %q, %v, want %q", f.component, f.name, got, ok, f.data)
+		}
+	}
+	if _, ok := m.Get("etcd", "unknown"); ok {
+		t.Error("Get() found a file that was never written")
+	}
+}
+
+func TestStream(t *testing.T) {
+	var buf bytes.Buffer
+	s := NewStream(&buf)
+	writeAll(t, s)
+	if err := s.Write("etcd", "already-separated", "yaml", []byte("---\nkind: Dashboard")); err != nil {
+		t.Fatalf("Write() returned error: %v", err)
+	}
+
+	want := "---\nkind: Dashboard\nname: etcd\n---\nkind: Dashboard\nname: kubelet\n---\nkind: Dashboard\n"
+	if buf.String() != want {
+		t.Errorf("stream = %q, want %q", buf.String(), want)
+	}
+}
+
+func TestStream_JSON(t *testing.T) {
+	var buf bytes.Buffer
+	s := NewStream(&buf)
+	if err := s.Write("etcd", "etcd-overview", "json", []byte(`{"kind":"Dashboard"}`)); err != nil {
+		t.Fatalf("Write() returned error: %v", err)
+	}
+	if err := s.Write("etcd", "etcd-rules", "json", []byte(`{"kind":"PrometheusRule"}`)); err != nil {
+		t.Fatalf("Write() returned error: %v", err)
+	}
+
+	want := "{\"kind\":\"Dashboard\"}\n{\"kind\":\"PrometheusRule\"}\n"
+	if buf.String() != want {
+		t.Errorf("stream = %q, want %q", buf.String(), want)
+	}
+}
+
+func TestTar(t *testing.T) {
+	var buf bytes.Buffer
+	archive := NewTar(&buf)
+	writeAll(t, archive)
+	if err := archive.Close(); err != nil {
+		t.Fatalf("Close() returned error: %v", err)
+	}
+
+	tr := tar.NewReader(&buf)
+	for _, f := range testFiles {
+		header, err := tr.Next()
+		if err != nil {
+			t.Fatalf("failed to read tar entry: %v", err)
+		}
+		if want := f.component + "/" + f.name + "." + f.ext; header.Name != want {
+			t.Errorf("tar entry name = %q, want %q", header.Name, want)
+		}
+		got, err := io.ReadAll(tr)
+		if err != nil {
+			t.Fatalf("failed to read tar entry content: %v", err)
+		}
+		if string(got) != f.data {
+			t.Errorf("tar entry %s = %q, want %q", header.Name, got, f.data)
+		}
+	}
+	if _, err := tr.Next(); err != io.EOF {
+		t.Errorf("expected the end of the archive, got %v", err)
+	}
+}
+
+func TestZip(t *testing.T) {
+	var buf bytes.Buffer
+	archive := NewZip(&buf)
+	writeAll(t, archive)
+	if err := archive.Close(); err != nil {
+		t.Fatalf("Close() returned error: %v", err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("failed to open zip archive: %v", err)
+	}
+	if len(zr.File) != len(testFiles) {
+		t.Fatalf("zip archive has %d entries, want %d", len(zr.File), len(testFiles))
+	}
+	for i, f := range testFiles {
+		entry := zr.File[i]
+		if want := f.component + "/" + f.name + "." + f.ext; entry.Name != want {
+			t.Errorf("zip entry name = %q, want %q", entry.Name, want)
+		}
+		rc, err := entry.Open()
+		if err != nil {
+			t.Fatalf("failed to open zip entry: %v", err)
+		}
+		got, err := io.ReadAll(rc)
+		_ = rc.Close()
+		if err != nil {
+			t.Fatalf("failed to read zip entry: %v", err)
+		}
+		if string(got) != f.data {
+			t.Errorf("zip entry %s = %q, want %q", entry.Name, got, f.data)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the \"License\");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an \"AS IS\" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// Stream writes every file to a single writer, such as stdout. YAML files are written as
+// one multi-document YAML stream, other files are concatenated one per line.
+type Stream struct {
+	w io.Writer
+}
+
+func NewStream(w io.Writer) *Stream {
+	return &Stream{w: w}
+}
+
+func (s *Stream) Write(_ string, _ string, ext string, data []byte) error {
+	var buf bytes.Buffer
+	if ext == "yaml" && !bytes.HasPrefix(data, []byte("---")) {
+		buf.WriteString("---\n")
+	}
+	buf.Write(data)
+	if !bytes.HasSuffix(data, []byte("\n")) {
+		buf.WriteByte('\n')
+	}
+	if _, err := s.w.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("failed to write to stream: %w", err)
+	}
+	return nil
+}